@@ -0,0 +1,65 @@
+package chunks
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AnteDecorator charges the chunk data of a tx the chunk gas of its stores, on top of the tx size
+// cost. It only rejects the txs no block can hold: the block budget is left to the proposer,
+// which skips the txs that do not fit in the block it builds, and to PostDecorator.
+type AnteDecorator struct {
+	stores Stores
+}
+
+// NewAnteDecorator returns an AnteDecorator reading the chunk params of stores.
+func NewAnteDecorator(stores Stores) AnteDecorator {
+	return AnteDecorator{stores: stores}
+}
+
+func (d AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	usage, err := d.stores.TxUsage(ctx, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if usage.Bytes == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	ctx.GasMeter().ConsumeGas(usage.Gas, "chunk data")
+	if !usage.Fits(0) {
+		return ctx, errorsmod.Wrapf(ErrBlockChunkBytes, "%d bytes of chunk data, more than the %d of a block", usage.Bytes, usage.MaxBlockBytes)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// PostDecorator counts the chunk data of the txs of a block against its budget. It runs after
+// the messages, in FinalizeBlock only: a tx the block cannot hold fails and has its messages
+// reverted, but keeps paying the fees the ante handler deducted.
+type PostDecorator struct {
+	budget Budget
+}
+
+// NewPostDecorator returns a PostDecorator counting against budget.
+func NewPostDecorator(budget Budget) PostDecorator {
+	return PostDecorator{budget: budget}
+}
+
+func (d PostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return next(ctx, tx, simulate, success)
+	}
+
+	usage, err := d.budget.TxUsage(ctx, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if usage.Bytes != 0 {
+		if err := d.budget.Consume(ctx, usage); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
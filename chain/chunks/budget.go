@@ -0,0 +1,115 @@
+package chunks
+
+import (
+	"context"
+	"errors"
+	"math/bits"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Params are the chunk params of a store module.
+type Params struct {
+	// GasPerByte is the gas charged for every byte of chunk data of the store's messages.
+	GasPerByte uint64
+	// MaxBlockBytes bounds the chunk data of a block carrying the store's messages, 0 for no
+	// bound.
+	MaxBlockBytes uint64
+}
+
+// Store is a store module whose messages carry chunk data.
+type Store struct {
+	MsgBytes MsgBytesFunc
+	Params   func(ctx context.Context) (Params, error)
+}
+
+// Stores are the store modules of a chain.
+type Stores []Store
+
+// Usage is the chunk data of a tx, what it is charged and the bound of the block holding it.
+type Usage struct {
+	Bytes uint64
+	Gas   uint64
+	// MaxBlockBytes is the smallest max of the stores the tx carries chunk data for, 0 for no
+	// bound.
+	MaxBlockBytes uint64
+}
+
+// Fits reports whether the tx fits in a block already carrying used bytes of chunk data.
+func (u Usage) Fits(used uint64) bool {
+	return u.MaxBlockBytes == 0 || (u.Bytes <= u.MaxBlockBytes && used <= u.MaxBlockBytes-u.Bytes)
+}
+
+// TxUsage returns the chunk data msgs carry for the stores. The params of a store are only read
+// when msgs carry chunk data for it.
+func (s Stores) TxUsage(ctx context.Context, msgs []sdk.Msg) (Usage, error) {
+	var usage Usage
+	for _, store := range s {
+		n, err := ChunkBytes(msgs, store.MsgBytes)
+		if err != nil {
+			return Usage{}, err
+		}
+		if n == 0 {
+			continue
+		}
+		params, err := store.Params(ctx)
+		if err != nil {
+			return Usage{}, err
+		}
+
+		hi, gas := bits.Mul64(n, params.GasPerByte)
+		if hi != 0 || usage.Gas+gas < gas {
+			return Usage{}, errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "%d bytes of chunk data", n)
+		}
+		usage.Bytes += n
+		usage.Gas += gas
+		if params.MaxBlockBytes != 0 && (usage.MaxBlockBytes == 0 || params.MaxBlockBytes < usage.MaxBlockBytes) {
+			usage.MaxBlockBytes = params.MaxBlockBytes
+		}
+	}
+	return usage, nil
+}
+
+// Budget counts the chunk data of the txs of the current block, shared by all the stores of the
+// chain.
+type Budget struct {
+	Stores
+
+	// used lives in a transient store, which is cleared at every commit.
+	used collections.Item[uint64]
+}
+
+// NewBudget returns the budget of stores, counting the chunk data of a block in storeService.
+func NewBudget(storeService corestore.TransientStoreService, stores Stores) Budget {
+	sb := collections.NewSchemaBuilderFromAccessor(storeService.OpenTransientStore)
+	return Budget{
+		Stores: stores,
+		used:   collections.NewItem(sb, collections.NewPrefix(0), "blockChunkBytes", collections.Uint64Value),
+	}
+}
+
+// Used returns the chunk data carried by the txs of the current block so far.
+func (b Budget) Used(ctx context.Context) (uint64, error) {
+	n, err := b.used.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return n, err
+}
+
+// Consume adds the chunk data of a tx to the current block, failing with ErrBlockChunkBytes if
+// the block cannot hold it.
+func (b Budget) Consume(ctx context.Context, usage Usage) error {
+	used, err := b.Used(ctx)
+	if err != nil {
+		return err
+	}
+	if !usage.Fits(used) {
+		return errorsmod.Wrapf(ErrBlockChunkBytes, "%d bytes requested, %d of %d used", usage.Bytes, used, usage.MaxBlockBytes)
+	}
+	return b.used.Set(ctx, used+usage.Bytes)
+}
@@ -0,0 +1,116 @@
+package chunks_test
+
+import (
+	"context"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"chunks"
+)
+
+// chunkMsg carries data for the chunk store named store.
+type chunkMsg struct {
+	banktypes.MsgSend
+	store string
+	data  []byte
+}
+
+func storeBytes(store string) chunks.MsgBytesFunc {
+	return func(msg sdk.Msg) uint64 {
+		if msg, ok := msg.(*chunkMsg); ok && msg.store == store {
+			return uint64(len(msg.data))
+		}
+		return 0
+	}
+}
+
+type chunkTx struct {
+	sdk.Tx
+	msgs []sdk.Msg
+}
+
+func (tx chunkTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func testStores(data, meta chunks.Params) chunks.Stores {
+	return chunks.Stores{
+		{MsgBytes: storeBytes("data"), Params: func(context.Context) (chunks.Params, error) { return data, nil }},
+		{MsgBytes: storeBytes("meta"), Params: func(context.Context) (chunks.Params, error) { return meta, nil }},
+	}
+}
+
+func TestTxUsage(t *testing.T) {
+	stores := testStores(chunks.Params{GasPerByte: 3, MaxBlockBytes: 10}, chunks.Params{GasPerByte: 2, MaxBlockBytes: 8})
+
+	usage, err := stores.TxUsage(context.Background(), []sdk.Msg{&banktypes.MsgSend{}})
+	require.NoError(t, err)
+	require.Equal(t, chunks.Usage{}, usage)
+
+	// the data of every store adds up, and the smallest max of the stores applies
+	usage, err = stores.TxUsage(context.Background(), []sdk.Msg{&chunkMsg{store: "data", data: []byte("12")}, &chunkMsg{store: "meta", data: []byte("345")}})
+	require.NoError(t, err)
+	require.Equal(t, chunks.Usage{Bytes: 5, Gas: 12, MaxBlockBytes: 8}, usage)
+	require.True(t, usage.Fits(3))
+	require.False(t, usage.Fits(4))
+
+	// a store without chunk data in the tx does not bound it
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{&chunkMsg{store: "data", data: []byte("123456789")}})
+	usage, err = stores.TxUsage(context.Background(), []sdk.Msg{&exec})
+	require.NoError(t, err)
+	require.Equal(t, chunks.Usage{Bytes: 9, Gas: 27, MaxBlockBytes: 10}, usage)
+
+	// a max of zero is no bound
+	usage, err = testStores(chunks.Params{}, chunks.Params{}).TxUsage(context.Background(), []sdk.Msg{&chunkMsg{store: "data", data: make([]byte, 1024)}})
+	require.NoError(t, err)
+	require.True(t, usage.Fits(1<<40))
+}
+
+func TestDecorators(t *testing.T) {
+	key := storetypes.NewTransientStoreKey("transient_chunks")
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey("test"), key).Ctx
+	budget := chunks.NewBudget(runtime.NewTransientStoreService(key), testStores(chunks.Params{GasPerByte: 3, MaxBlockBytes: 10}, chunks.Params{}))
+	ante, post := chunks.NewAnteDecorator(budget.Stores), chunks.NewPostDecorator(budget)
+
+	run := func(mode sdk.ExecMode, data string) (storetypes.Gas, error) {
+		tx := chunkTx{msgs: []sdk.Msg{&chunkMsg{store: "data", data: []byte(data)}}}
+		runCtx := ctx.WithExecMode(mode).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		if _, err := ante.AnteHandle(runCtx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		}); err != nil {
+			return 0, err
+		}
+		_, err := post.PostHandle(runCtx, tx, false, true, func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		return runCtx.GasMeter().GasConsumed(), err
+	}
+
+	// CheckTx charges the chunk data but leaves the budget to the block
+	gas, err := run(sdk.ExecModeCheck, "123456")
+	require.NoError(t, err)
+	require.Equal(t, storetypes.Gas(18), gas)
+	used, err := budget.Used(ctx)
+	require.NoError(t, err)
+	require.Zero(t, used)
+
+	// only a tx no block can hold is rejected before its messages run
+	_, err = run(sdk.ExecModeCheck, "12345678901")
+	require.ErrorIs(t, err, chunks.ErrBlockChunkBytes)
+
+	_, err = run(sdk.ExecModeFinalize, "123456")
+	require.NoError(t, err)
+	_, err = run(sdk.ExecModeFinalize, "12345")
+	require.ErrorIs(t, err, chunks.ErrBlockChunkBytes)
+	_, err = run(sdk.ExecModeFinalize, "1234")
+	require.NoError(t, err)
+
+	used, err = budget.Used(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), used)
+}
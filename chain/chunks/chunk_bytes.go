@@ -0,0 +1,33 @@
+package chunks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MsgBytesFunc returns the chunk data carried by a message of a store module, 0 for the messages
+// carrying none and for those of other modules.
+type MsgBytesFunc func(msg sdk.Msg) uint64
+
+// ChunkBytes returns the chunk data msgBytes counts in msgs, including the messages they execute
+// through authz.
+func ChunkBytes(msgs []sdk.Msg, msgBytes MsgBytesFunc) (uint64, error) {
+	var n uint64
+	for _, msg := range msgs {
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			n += msgBytes(msg)
+			continue
+		}
+		inner, err := exec.GetMessages()
+		if err != nil {
+			return 0, err
+		}
+		innerBytes, err := ChunkBytes(inner, msgBytes)
+		if err != nil {
+			return 0, err
+		}
+		n += innerBytes
+	}
+	return n, nil
+}
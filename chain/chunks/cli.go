@@ -0,0 +1,106 @@
+package chunks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdGrantUploader returns the command granting an account the uploader messages typeURLs of a
+// store module on behalf of the sender, with its fees paid by the sender. uploads describes what
+// the grantee may do, and example is the command generating one of its messages.
+func CmdGrantUploader(typeURLs []string, uploads, example string) *cobra.Command {
+	flagSpendLimit := "spend-limit"
+	flagExpiration := "expiration"
+
+	short := fmt.Sprintf("Let an account %s on behalf of the sender, paying its fees from a fee allowance", uploads)
+	cmd := &cobra.Command{
+		Use:   "grant-uploader [grantee]",
+		Short: short,
+		Long: short + `.
+
+The grantee wraps the messages in an authz exec transaction, with the sender as their creator and
+as fee granter:
+
+  ` + example + ` --from [granter] --generate-only > tx.json
+  raidchaind tx authz exec tx.json --from [grantee] --fee-granter [granter]`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := cmd.Flags().GetString(flagSpendLimit)
+			if err != nil {
+				return err
+			}
+			limit, err := sdk.ParseCoinsNormalized(spendLimit)
+			if err != nil {
+				return err
+			}
+
+			expirationStr, err := cmd.Flags().GetString(flagExpiration)
+			if err != nil {
+				return err
+			}
+			var expiration *time.Time
+			if expirationStr != "" {
+				t, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+				expiration = &t
+			}
+
+			msgs, err := NewUploaderGrantMsgs(typeURLs, clientCtx.GetFromAddress(), grantee, limit, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "Fees the grantee may spend from the sender's balance, unlimited if empty")
+	cmd.Flags().String(flagExpiration, "", "Expiration of the grants in RFC3339 format, none if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRevokeUploader returns the command revoking the grants of CmdGrantUploader.
+func CmdRevokeUploader(typeURLs []string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-uploader [grantee]",
+		Short: "Revoke the upload rights and fee allowance granted to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), NewUploaderRevokeMsgs(typeURLs, clientCtx.GetFromAddress(), grantee)...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,3 @@
+// Package chunks holds the chunk upload handling shared by the datastore and metastore modules:
+// the uploader grants and their commands, and the gas and per-block budget of chunk data.
+package chunks
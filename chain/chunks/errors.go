@@ -0,0 +1,15 @@
+package chunks
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// Codespace is the codespace of the errors of the chunk budget.
+const Codespace = "chunks"
+
+// chunk budget sentinel errors
+var (
+	ErrBlockChunkBytes = errors.Register(Codespace, 1100, "block chunk bytes exhausted")
+)
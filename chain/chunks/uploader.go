@@ -0,0 +1,50 @@
+package chunks
+
+import (
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// NewUploaderGrantMsgs returns the messages with which granter lets grantee upload on its behalf:
+// an authz grant for each of the uploader messages typeURLs of a store module, and a fee allowance
+// of up to spendLimit (unlimited if empty) which only pays for authz MsgExec transactions. Both
+// expire at expiration, if set.
+func NewUploaderGrantMsgs(typeURLs []string, granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiration *time.Time) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(typeURLs)+1)
+	for _, typeURL := range typeURLs {
+		grant, err := authz.NewMsgGrant(granter, grantee, authz.NewGenericAuthorization(typeURL), expiration)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, grant)
+	}
+
+	allowance, err := feegrant.NewAllowedMsgAllowance(
+		&feegrant.BasicAllowance{SpendLimit: spendLimit, Expiration: expiration},
+		[]string{sdk.MsgTypeURL(&authz.MsgExec{})},
+	)
+	if err != nil {
+		return nil, err
+	}
+	allowanceMsg, err := feegrant.NewMsgGrantAllowance(allowance, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(msgs, allowanceMsg), nil
+}
+
+// NewUploaderRevokeMsgs returns the messages revoking the grants of NewUploaderGrantMsgs.
+func NewUploaderRevokeMsgs(typeURLs []string, granter, grantee sdk.AccAddress) []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, len(typeURLs)+1)
+	for _, typeURL := range typeURLs {
+		revoke := authz.NewMsgRevoke(granter, grantee, typeURL)
+		msgs = append(msgs, &revoke)
+	}
+
+	revokeAllowance := feegrant.NewMsgRevokeAllowance(granter, grantee)
+	return append(msgs, &revokeAllowance)
+}
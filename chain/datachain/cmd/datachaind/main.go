@@ -1,20 +0,0 @@
-package main
-
-import (
-	"fmt"
-	"os"
-
-	clienthelpers "cosmossdk.io/client/v2/helpers"
-	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
-
-	"datachain/app"
-	"datachain/cmd/datachaind/cmd"
-)
-
-func main() {
-	rootCmd := cmd.NewRootCmd()
-	if err := svrcmd.Execute(rootCmd, clienthelpers.EnvPrefix, app.DefaultNodeHome); err != nil {
-		fmt.Fprintln(rootCmd.OutOrStderr(), err)
-		os.Exit(1)
-	}
-}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	// "github.com/cosmos/cosmos-sdk/client/flags"
+
+	"chunks"
 	"datachain/x/datastore/types"
 )
 
@@ -25,6 +27,9 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 	cmd.AddCommand(CmdSendChunk())
+	cmd.AddCommand(CmdProveStorage())
+	cmd.AddCommand(chunks.CmdGrantUploader(types.UploaderMsgTypeURLs(), "create chunks", "raidchaind tx datastore create-stored-chunk [index] [data]"))
+	cmd.AddCommand(chunks.CmdRevokeUploader(types.UploaderMsgTypeURLs()))
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"datachain/x/datastore/types"
+)
+
+// CmdProveStorage returns the command answering a storage challenge.
+// This command does not use AutoCLI because the proof is computed from the challenged chunk,
+// which it reads from the node it queries like any other client.
+func CmdProveStorage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove-storage [challenge-id]",
+		Short: "Answer a storage challenge for the validator operated by the sender",
+		Long: `Answer a storage challenge for the validator operated by the sender.
+
+The challenged chunk is read from the queried node, so the answer does not show that the
+validator keeps the data itself.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			challengeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			challengeRes, err := queryClient.GetStorageChallenge(cmd.Context(), &types.QueryGetStorageChallengeRequest{Id: challengeID})
+			if err != nil {
+				return err
+			}
+			chunkRes, err := queryClient.GetStoredChunk(cmd.Context(), &types.QueryGetStoredChunkRequest{Index: challengeRes.Challenge.Index, Raw: true})
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitStorageProof(clientCtx.GetFromAddress(), challengeRes.Challenge, chunkRes.StoredChunk.Data)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
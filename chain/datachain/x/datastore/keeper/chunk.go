@@ -3,11 +3,13 @@ package keeper
 
 import (
 	"context"
-	"fmt"
+	"crypto/sha256"
+	"errors"
 	"strings"
 
 	"datachain/x/datastore/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -19,7 +21,7 @@ import (
 // This function implements the core business logic for the datastore module.
 func (k Keeper) OnRecvChunkPacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkPacketData) (*types.ChunkPacketAck, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	fmt.Printf("datachain [DEBUG]: OnRecvChunkPacket received packet with index/addresses: %s\n", data.Index)
+	sdkCtx.Logger().Debug("verifying chunk packet", "addresses", data.Index)
 
 	// --- ★★★ ここからが実装されたビジネスロジックです ★★★ ---
 	// Logic:
@@ -29,31 +31,61 @@ func (k Keeper) OnRecvChunkPacket(ctx context.Context, packet channeltypes.Packe
 	// 4. If ANY of the chunks do not exist, we return a specific error acknowledgement.
 	// 5. If ALL chunks exist, we return a successful acknowledgement.
 
-	addresses := strings.Split(data.Index, ",")
+	chunks, err := k.verifyChunks(sdkCtx, strings.Split(data.Index, ","))
+	if err != nil {
+		return nil, err
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventChunksVerified{Chunks: chunks}); err != nil {
+		return nil, err
+	}
+
+	// If the loop completes without errors, it means all chunks were found.
+	// We return a successful acknowledgement attesting each chunk.
+	// --- ★★★ ロジックここまで ★★★ ---
+
+	return &types.ChunkPacketAck{Version: types.AckVersion, Chunks: chunks}, nil
+}
+
+// verifyChunks checks that every index is present in the store and returns ErrChunkNotFound
+// for the first one that is not. Found chunks are attested in request order.
+func (k Keeper) verifyChunks(ctx context.Context, addresses []string) ([]types.VerifiedChunk, error) {
 	if len(addresses) == 0 {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "addresses list in packet index cannot be empty")
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+	verified := make([]types.VerifiedChunk, 0, len(addresses))
 	for _, addr := range addresses {
-		found, err := k.StoredChunk.Has(sdkCtx, addr)
+		chunk, err := k.StoredChunk.Get(ctx, addr)
+		if errors.Is(err, collections.ErrNotFound) {
+			// If a chunk is not found, immediately return a custom error.
+			// This error will be sent back to the metachain.
+			sdkCtx.Logger().Debug("chunk not found", "index", addr)
+			return nil, errorsmod.Wrapf(types.ErrChunkNotFound, "chunk with index %s not found", addr)
+		}
 		if err != nil {
 			// This indicates an internal store/database error.
 			return nil, errorsmod.Wrapf(err, "error checking for chunk with index %s", addr)
 		}
-		if !found {
-			// If a chunk is not found, immediately return a custom error.
-			// This error will be sent back to the metachain.
-			fmt.Printf("datachain [ERROR]: Chunk with index '%s' not found.\n", addr)
-			return nil, errorsmod.Wrapf(types.ErrChunkNotFound, "chunk with index %s not found", addr)
+		sdkCtx.Logger().Debug("verified chunk", "index", addr)
+
+		// the attestation covers the data as uploaded, whatever codec it is stored with
+		chunk, err = chunk.Decompressed()
+		if err != nil {
+			return nil, err
 		}
-		fmt.Printf("datachain [SUCCESS]: Verified chunk with index '%s' exists.\n", addr)
-	}
 
-	// If the loop completes without errors, it means all chunks were found.
-	// We return a successful (but empty) acknowledgement.
-	// --- ★★★ ロジックここまで ★★★ ---
+		hash := sha256.Sum256(chunk.Data)
+		verified = append(verified, types.VerifiedChunk{
+			Index:  addr,
+			Size_:  uint64(len(chunk.Data)),
+			Hash:   hash[:],
+			Height: height,
+		})
+	}
 
-	return &types.ChunkPacketAck{}, nil
+	return verified, nil
 }
 
 // TransmitChunkPacket transmits the packet over IBC with the specified source port and source channel
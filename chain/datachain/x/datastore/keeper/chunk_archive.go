@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"datachain/x/datastore/types"
+)
+
+// SetChunkArchiveDir sets the directory holding the chunk archive a genesis refers to, usually
+// the config directory of the node.
+func (k *Keeper) SetChunkArchiveDir(dir string) {
+	k.chunkArchiveDir = dir
+}
+
+// ExportChunks streams every stored chunk to w as a chunk archive and returns its description
+// under the given file name.
+func (k Keeper) ExportChunks(ctx context.Context, w io.Writer, file string) (types.ChunkArchive, error) {
+	aw := types.NewChunkArchiveWriter(w)
+	if err := k.StoredChunk.Walk(ctx, nil, func(_ string, val types.StoredChunk) (stop bool, err error) {
+		return false, aw.Write(val)
+	}); err != nil {
+		return types.ChunkArchive{}, err
+	}
+	if err := aw.Close(); err != nil {
+		return types.ChunkArchive{}, err
+	}
+
+	return aw.Archive(file), nil
+}
+
+// ImportChunks stores every chunk of the chunk archive read from r, then verifies the archive
+// against its description. Chunks already in the store are rejected.
+func (k Keeper) ImportChunks(ctx context.Context, r io.Reader, archive types.ChunkArchive) error {
+	ar := types.NewChunkArchiveReader(r)
+	for {
+		chunk, err := ar.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		has, err := k.StoredChunk.Has(ctx, chunk.Index)
+		if err != nil {
+			return err
+		}
+		if has {
+			return fmt.Errorf("duplicated index %q for storedChunk in chunk archive", chunk.Index)
+		}
+		if err := k.putStoredChunk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	return ar.Verify(archive)
+}
+
+// ExportGenesisWithChunkArchive exports the module's genesis like ExportGenesis, but streams the
+// stored chunks to w and refers to them by the archive file name instead of inlining them.
+func (k Keeper) ExportGenesisWithChunkArchive(ctx context.Context, w io.Writer, file string) (*types.GenesisState, error) {
+	genesis, err := k.exportGenesisState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := k.ExportChunks(ctx, w, file)
+	if err != nil {
+		return nil, err
+	}
+	genesis.ChunkArchive = &archive
+
+	return genesis, nil
+}
+
+// importChunkArchive loads the chunk archive of the genesis from the chunk archive directory.
+func (k Keeper) importChunkArchive(ctx context.Context, archive types.ChunkArchive) error {
+	f, err := os.Open(filepath.Join(k.chunkArchiveDir, archive.File))
+	if err != nil {
+		return fmt.Errorf("open chunk archive: %w", err)
+	}
+	defer f.Close()
+
+	return k.ImportChunks(ctx, f, archive)
+}
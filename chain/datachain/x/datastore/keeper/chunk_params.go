@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	"chunks"
+)
+
+// ChunkParams returns the chunk gas and block bound of the module's messages.
+func (k Keeper) ChunkParams(ctx context.Context) (chunks.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return chunks.Params{}, err
+	}
+	return chunks.Params{GasPerByte: params.ChunkGasPerByte, MaxBlockBytes: params.MaxBlockChunkBytes}, nil
+}
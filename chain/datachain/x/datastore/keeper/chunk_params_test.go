@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"chunks"
+
+	"datachain/x/datastore/types"
+)
+
+func TestChunkParams(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.ChunkGasPerByte = 3
+	params.MaxBlockChunkBytes = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	stores := chunks.Stores{{MsgBytes: types.MsgChunkBytes, Params: f.keeper.ChunkParams}}
+
+	// messages without chunk data are neither charged nor bounded
+	usage, err := stores.TxUsage(f.ctx, []sdk.Msg{&types.MsgDeleteStoredChunk{Index: "a"}})
+	require.NoError(t, err)
+	require.Equal(t, chunks.Usage{}, usage)
+
+	// chunk data executed through authz counts like chunk data sent directly
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{&types.MsgSendChunk{Index: "b", Data: []byte("12")}})
+	usage, err = stores.TxUsage(f.ctx, []sdk.Msg{
+		&types.MsgCreateStoredChunk{Index: "a", Data: []byte("1234")},
+		&types.MsgUpdateStoredChunk{Index: "c", Data: []byte("5")},
+		&exec,
+	})
+	require.NoError(t, err)
+	require.Equal(t, chunks.Usage{Bytes: 7, Gas: 21, MaxBlockBytes: 10}, usage)
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	"datachain/x/datastore/types"
+)
+
+// setStoredChunk stores chunk with its data compressed by the chunk codec of the params, or as is
+// when compression does not shrink it. State, and so the gas of the write, holds the stored size.
+func (k Keeper) setStoredChunk(ctx context.Context, chunk types.StoredChunk) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	chunk.Codec, chunk.Data, err = types.CompressChunkData(params.ChunkCodec, chunk.Data)
+	if err != nil {
+		return err
+	}
+	return k.putStoredChunk(ctx, chunk)
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"datachain/x/datastore/keeper"
+	"datachain/x/datastore/types"
+)
+
+func TestStoredChunkCompression(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.ChunkCodec = types.ChunkCodec_CHUNK_CODEC_ZSTD
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	html := []byte(strings.Repeat("<p>hello, raidchain</p>\n", 200))
+	random := sha256.Sum256([]byte("incompressible"))
+
+	for index, data := range map[string][]byte{"html": html, "random": random[:]} {
+		_, err := srv.CreateStoredChunk(f.ctx, &types.MsgCreateStoredChunk{Creator: creator, Index: index, Data: data})
+		require.NoError(t, err)
+	}
+
+	stored, err := f.keeper.StoredChunk.Get(f.ctx, "html")
+	require.NoError(t, err)
+	require.Equal(t, types.ChunkCodec_CHUNK_CODEC_ZSTD, stored.Codec)
+	require.Less(t, len(stored.Data), len(html))
+
+	// compression that does not shrink the chunk is not applied
+	stored, err = f.keeper.StoredChunk.Get(f.ctx, "random")
+	require.NoError(t, err)
+	require.Equal(t, types.ChunkCodec_CHUNK_CODEC_NONE, stored.Codec)
+	require.Equal(t, random[:], stored.Data)
+
+	res, err := qs.GetStoredChunk(f.ctx, &types.QueryGetStoredChunkRequest{Index: "html"})
+	require.NoError(t, err)
+	require.Equal(t, types.StoredChunk{Index: "html", Data: html, Creator: creator}, res.StoredChunk)
+
+	raw, err := qs.GetStoredChunk(f.ctx, &types.QueryGetStoredChunkRequest{Index: "html", Raw: true})
+	require.NoError(t, err)
+	require.Equal(t, types.ChunkCodec_CHUNK_CODEC_ZSTD, raw.StoredChunk.Codec)
+
+	list, err := qs.ListStoredChunk(f.ctx, &types.QueryAllStoredChunkRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.StoredChunk, 2)
+	require.Equal(t, html, list.StoredChunk[0].Data)
+
+	// packets attest and return the data as uploaded
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ack, err := f.keeper.OnRecvChunkPacket(ctx, channeltypes.Packet{}, types.ChunkPacketData{Index: "html"})
+	require.NoError(t, err)
+	hash := sha256.Sum256(html)
+	require.Equal(t, uint64(len(html)), ack.Chunks[0].Size_)
+	require.Equal(t, hash[:], ack.Chunks[0].Hash)
+
+	retrieved, err := f.keeper.OnRecvChunkRetrievalPacket(ctx, channeltypes.Packet{}, types.ChunkRetrievalPacketData{Indexes: []string{"html"}})
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(html, retrieved.Chunks[0].Data))
+}
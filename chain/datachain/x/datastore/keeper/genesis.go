@@ -3,10 +3,12 @@ package keeper
 import (
 	"context"
 	"errors"
+	"time"
 
 	"datachain/x/datastore/types"
 
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
@@ -15,7 +17,49 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		return err
 	}
 	for _, elem := range genState.StoredChunkMap {
-		if err := k.StoredChunk.Set(ctx, elem.Index, elem); err != nil {
+		if err := k.putStoredChunk(ctx, elem); err != nil {
+			return err
+		}
+	}
+	if genState.ChunkArchive != nil {
+		if err := k.importChunkArchive(ctx, *genState.ChunkArchive); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.ChunkReferences {
+		if err := k.addReferences(ctx, elem.Holder, []string{elem.Index}); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.PendingPrunes {
+		if err := k.schedulePrune(ctx, elem.Index, elem.PruneAfter); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.StorageChallenges {
+		if err := k.StorageChallenge.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.StorageChallengeSeq.Set(ctx, genState.StorageChallengeCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.StorageProofs {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(elem.Validator)
+		if err != nil {
+			return err
+		}
+		if err := k.StorageProof.Set(ctx, collections.Join(elem.ChallengeId, sdk.ValAddress(valAddr))); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.StorageReputations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(elem.Validator)
+		if err != nil {
+			return err
+		}
+		if err := k.StorageReputation.Set(ctx, valAddr, elem); err != nil {
 			return err
 		}
 	}
@@ -25,6 +69,22 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 
 // ExportGenesis returns the module's exported genesis.
 func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	genesis, err := k.exportGenesisState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.StoredChunk.Walk(ctx, nil, func(_ string, val types.StoredChunk) (stop bool, err error) {
+		genesis.StoredChunkMap = append(genesis.StoredChunkMap, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+
+// exportGenesisState exports everything but the stored chunks.
+func (k Keeper) exportGenesisState(ctx context.Context) (*types.GenesisState, error) {
 	var err error
 
 	genesis := types.DefaultGenesis()
@@ -36,8 +96,40 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		return nil, err
 	}
-	if err := k.StoredChunk.Walk(ctx, nil, func(_ string, val types.StoredChunk) (stop bool, err error) {
-		genesis.StoredChunkMap = append(genesis.StoredChunkMap, val)
+	if err := k.ChunkReference.Walk(ctx, nil, func(key collections.Pair[string, string]) (stop bool, err error) {
+		genesis.ChunkReferences = append(genesis.ChunkReferences, types.ChunkReference{Index: key.K1(), Holder: key.K2()})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PruneAfter.Walk(ctx, nil, func(index string, pruneAfter time.Time) (stop bool, err error) {
+		genesis.PendingPrunes = append(genesis.PendingPrunes, types.PendingPrune{Index: index, PruneAfter: pruneAfter})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.StorageChallenge.Walk(ctx, nil, func(_ uint64, val types.StorageChallenge) (stop bool, err error) {
+		genesis.StorageChallenges = append(genesis.StorageChallenges, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.StorageChallengeCount, err = k.StorageChallengeSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.StorageProof.Walk(ctx, nil, func(key collections.Pair[uint64, sdk.ValAddress]) (stop bool, err error) {
+		validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		genesis.StorageProofs = append(genesis.StorageProofs, types.StorageProofRecord{ChallengeId: key.K1(), Validator: validator})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.StorageReputation.Walk(ctx, nil, func(_ sdk.ValAddress, val types.StorageReputation) (stop bool, err error) {
+		genesis.StorageReputations = append(genesis.StorageReputations, val)
 		return false, nil
 	}); err != nil {
 		return nil, err
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"datachain/x/datastore/types"
@@ -26,3 +30,50 @@ func TestGenesis(t *testing.T) {
 	require.EqualExportedValues(t, genesisState.StoredChunkMap, got.StoredChunkMap)
 
 }
+
+func TestGenesisChunkArchive(t *testing.T) {
+	src := initFixture(t)
+	chunks := []types.StoredChunk{
+		{Index: "a", Data: []byte("first"), Creator: "alice"},
+		{Index: "b", Data: bytes.Repeat([]byte{0xff}, 1<<16), Creator: "bob"},
+	}
+	require.NoError(t, src.keeper.InitGenesis(src.ctx, types.GenesisState{
+		Params:          types.DefaultParams(),
+		PortId:          types.PortID,
+		StoredChunkMap:  chunks,
+		ChunkReferences: []types.ChunkReference{{Index: "a", Holder: "channel-0/file"}},
+	}))
+
+	var archive bytes.Buffer
+	exported, err := src.keeper.ExportGenesisWithChunkArchive(src.ctx, &archive, "chunks.bin")
+	require.NoError(t, err)
+	require.Empty(t, exported.StoredChunkMap)
+	require.NotNil(t, exported.ChunkArchive)
+	require.Equal(t, uint64(len(chunks)), exported.ChunkArchive.Chunks)
+	require.NoError(t, exported.Validate())
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "chunks.bin"), archive.Bytes(), 0o600))
+
+	dst := initFixture(t)
+	dst.keeper.SetChunkArchiveDir(dir)
+	require.NoError(t, dst.keeper.InitGenesis(dst.ctx, *exported))
+	got, err := dst.keeper.ExportGenesis(dst.ctx)
+	require.NoError(t, err)
+	require.Equal(t, chunks, got.StoredChunkMap)
+	require.Equal(t, exported.ChunkReferences, got.ChunkReferences)
+
+	// an archive that does not match the genesis is rejected
+	tampered := initFixture(t)
+	tampered.keeper.SetChunkArchiveDir(dir)
+	otherDigest := *exported
+	otherDigest.ChunkArchive = &types.ChunkArchive{File: "chunks.bin", Chunks: 2, Sha256: strings.Repeat("00", 32)}
+	require.ErrorContains(t, tampered.keeper.InitGenesis(tampered.ctx, otherDigest), "sha256")
+
+	// chunks already inlined in the genesis are not imported twice
+	duplicated := initFixture(t)
+	duplicated.keeper.SetChunkArchiveDir(dir)
+	withMap := *exported
+	withMap.StoredChunkMap = chunks[:1]
+	require.ErrorContains(t, duplicated.keeper.InitGenesis(duplicated.ctx, withMap), "duplicated index")
+}
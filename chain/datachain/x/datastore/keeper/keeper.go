@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	"datachain/x/datastore/types"
@@ -29,6 +32,27 @@ type Keeper struct {
 
 	bankKeeper  types.BankKeeper
 	StoredChunk collections.Map[string, types.StoredChunk]
+	// StorageUsage counts the chunks of StoredChunk and the bytes of their data.
+	StorageUsage collections.Item[types.StorageUsage]
+	// ChunkReference holds one (chunk index, holder) pair per metadata entry referring to a chunk,
+	// ChunkRefCount the number of pairs per chunk.
+	ChunkReference collections.KeySet[collections.Pair[string, string]]
+	ChunkRefCount  collections.Map[string, uint64]
+	// PruneQueue orders released chunks by the time they may be pruned, PruneAfter indexes the
+	// same entries by chunk.
+	PruneQueue collections.KeySet[collections.Pair[time.Time, string]]
+	PruneAfter collections.Map[string, time.Time]
+
+	stakingKeeper types.StakingKeeper
+	// StorageChallenge holds the storage challenges of the current challenge epoch, StorageProof
+	// the (challenge, validator) pairs proven so far.
+	StorageChallenge    collections.Map[uint64, types.StorageChallenge]
+	StorageChallengeSeq collections.Sequence
+	StorageProof        collections.KeySet[collections.Pair[uint64, sdk.ValAddress]]
+	StorageReputation   collections.Map[sdk.ValAddress, types.StorageReputation]
+
+	// chunkArchiveDir is where InitGenesis looks up the chunk archive of the genesis.
+	chunkArchiveDir string
 }
 
 func NewKeeper(
@@ -39,6 +63,7 @@ func NewKeeper(
 	ibcKeeperFn func() *ibckeeper.Keeper,
 
 	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -52,11 +77,26 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
-		bankKeeper:  bankKeeper,
-		ibcKeeperFn: ibcKeeperFn,
-		Port:        collections.NewItem(sb, types.PortKey, "port", collections.StringValue),
-		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		StoredChunk: collections.NewMap(sb, types.StoredChunkKey, "storedChunk", collections.StringKey, codec.CollValue[types.StoredChunk](cdc))}
+		bankKeeper:   bankKeeper,
+		ibcKeeperFn:  ibcKeeperFn,
+		Port:         collections.NewItem(sb, types.PortKey, "port", collections.StringValue),
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		StoredChunk:  collections.NewMap(sb, types.StoredChunkKey, "storedChunk", collections.StringKey, codec.CollValue[types.StoredChunk](cdc)),
+		StorageUsage: collections.NewItem(sb, types.StorageUsageKey, "storageUsage", codec.CollValue[types.StorageUsage](cdc)),
+		ChunkReference: collections.NewKeySet(sb, types.ChunkReferenceKey, "chunkReference",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		ChunkRefCount: collections.NewMap(sb, types.ChunkRefCountKey, "chunkRefCount", collections.StringKey, collections.Uint64Value),
+		PruneQueue: collections.NewKeySet(sb, types.PruneQueueKey, "pruneQueue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		PruneAfter: collections.NewMap(sb, types.PruneAfterKey, "pruneAfter", collections.StringKey, collcodec.KeyToValueCodec(sdk.TimeKey)),
+
+		stakingKeeper:       stakingKeeper,
+		StorageChallenge:    collections.NewMap(sb, types.StorageChallengeKey, "storageChallenge", collections.Uint64Key, codec.CollValue[types.StorageChallenge](cdc)),
+		StorageChallengeSeq: collections.NewSequence(sb, types.StorageChallengeCountKey, "storageChallengeSequence"),
+		StorageProof: collections.NewKeySet(sb, types.StorageProofKey, "storageProof",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.ValAddressKey)),
+		StorageReputation: collections.NewMap(sb, types.StorageReputationKey, "storageReputation", sdk.ValAddressKey, codec.CollValue[types.StorageReputation](cdc)),
+	}
 
 	schema, err := sb.Build()
 	if err != nil {
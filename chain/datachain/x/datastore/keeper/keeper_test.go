@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"sort"
 	"testing"
 
 	"cosmossdk.io/core/address"
@@ -15,6 +16,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	ibctypes "github.com/cosmos/ibc-go/v10/modules/core/types"
@@ -25,9 +28,10 @@ import (
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx           context.Context
+	keeper        keeper.Keeper
+	addressCodec  address.Codec
+	stakingKeeper *mockStakingKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -42,6 +46,7 @@ func initFixture(t *testing.T) *fixture {
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 	mockUpgradeKeeper := newMockUpgradeKeeper()
+	stakingKeeper := newMockStakingKeeper()
 
 	k := keeper.NewKeeper(
 		storeService,
@@ -52,6 +57,7 @@ func initFixture(t *testing.T) *fixture {
 			return ibckeeper.NewKeeper(encCfg.Codec, storeService, newMockParams(), mockUpgradeKeeper, authority.String())
 		},
 		nil,
+		stakingKeeper,
 	)
 
 	// Initialize params
@@ -60,12 +66,29 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:           ctx,
+		keeper:        k,
+		addressCodec:  addressCodec,
+		stakingKeeper: stakingKeeper,
 	}
 }
 
+// typedEvents returns the typed events emitted on ctx, in order.
+func typedEvents(t *testing.T, ctx context.Context) []proto.Message {
+	t.Helper()
+
+	var events []proto.Message
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			// not a typed event
+			continue
+		}
+		events = append(events, msg)
+	}
+	return events
+}
+
 type mockUpgradeKeeper struct {
 	clienttypes.UpgradeKeeper
 
@@ -92,3 +115,41 @@ func newMockParams() *mockParams {
 
 func (mockParams) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
 }
+
+type mockStakingKeeper struct {
+	validators map[string]stakingtypes.Validator
+}
+
+func newMockStakingKeeper() *mockStakingKeeper {
+	return &mockStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+}
+
+// addValidator registers a validator operated by operator with the given status.
+func (m *mockStakingKeeper) addValidator(operator sdk.AccAddress, status stakingtypes.BondStatus) sdk.ValAddress {
+	valAddr := sdk.ValAddress(operator)
+	m.validators[valAddr.String()] = stakingtypes.Validator{OperatorAddress: valAddr.String(), Status: status}
+	return valAddr
+}
+
+func (m *mockStakingKeeper) ValidatorAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+}
+
+func (m *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, ok := m.validators[addr.String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+func (m *mockStakingKeeper) GetBondedValidatorsByPower(context.Context) ([]stakingtypes.Validator, error) {
+	var validators []stakingtypes.Validator
+	for _, validator := range m.validators {
+		if validator.IsBonded() {
+			validators = append(validators, validator)
+		}
+	}
+	sort.Slice(validators, func(i, j int) bool { return validators[i].OperatorAddress < validators[j].OperatorAddress })
+	return validators, nil
+}
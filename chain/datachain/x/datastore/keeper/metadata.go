@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"datachain/x/datastore/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// OnRecvMetadataPacket is called when metachain asks the datachain to verify the chunks a
+// metadata entry refers to. A successful ack means every listed chunk is stored here, and
+// registers the entry as a reference on each of them. Only chunks created by the creator of
+// the entry can be referenced, so nobody can keep the chunks of another account from being deleted.
+func (k Keeper) OnRecvMetadataPacket(ctx context.Context, packet channeltypes.Packet, data types.MetadataPacketData) (*types.MetadataPacketAck, error) {
+	sdk.UnwrapSDKContext(ctx).Logger().Debug("verifying metadata chunks", "url", data.Url, "addresses", data.Addresses)
+
+	if err := k.requireChunkCreator(ctx, data.Creator, data.Addresses); err != nil {
+		return nil, err
+	}
+	chunks, err := k.verifyChunks(ctx, data.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	holder := referenceHolder(packet, data.Url)
+	if err := k.addReferences(ctx, holder, data.Addresses); err != nil {
+		return nil, err
+	}
+
+	eventManager := sdk.UnwrapSDKContext(ctx).EventManager()
+	if err := eventManager.EmitTypedEvent(&types.EventChunksVerified{Url: data.Url, Chunks: chunks}); err != nil {
+		return nil, err
+	}
+	if err := eventManager.EmitTypedEvent(&types.EventChunksReferenced{Holder: holder, Indexes: data.Addresses}); err != nil {
+		return nil, err
+	}
+
+	return &types.MetadataPacketAck{Version: types.AckVersion, Chunks: chunks}, nil
+}
+
+// OnAcknowledgementMetadataPacket is called when datachain receives an acknowledgement for a metadata packet.
+func (k Keeper) OnAcknowledgementMetadataPacket(ctx context.Context, packet channeltypes.Packet, data types.MetadataPacketData, ack channeltypes.Acknowledgement) error {
+	// Metadata packets only travel from metachain to datachain.
+	return nil
+}
+
+// OnTimeoutMetadataPacket is called when a metadata packet sent from datachain times out.
+func (k Keeper) OnTimeoutMetadataPacket(ctx context.Context, packet channeltypes.Packet, data types.MetadataPacketData) error {
+	// Metadata packets only travel from metachain to datachain.
+	return nil
+}
+
+// requireChunkCreator fails unless every stored chunk of indexes was created by creator, the
+// metachain account the entry belongs to. Missing chunks are left to verifyChunks.
+func (k Keeper) requireChunkCreator(ctx context.Context, creator string, indexes []string) error {
+	creatorBz, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator: %s", err)
+	}
+	for _, index := range indexes {
+		chunk, err := k.StoredChunk.Get(ctx, index)
+		if err != nil {
+			continue
+		}
+		ownerBz, err := k.addressCodec.StringToBytes(chunk.Creator)
+		if err != nil || !bytes.Equal(ownerBz, creatorBz) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "chunk %s was not created by %s", index, creator)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"datachain/x/datastore/types"
+)
+
+func TestOnRecvMetadataPacket(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(42)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	for index, data := range map[string]string{"idx0": "hello", "idx1": "world!"} {
+		require.NoError(t, f.keeper.StoredChunk.Set(ctx, index, types.StoredChunk{Index: index, Data: []byte(data), Creator: creator}))
+	}
+
+	packetAck, err := f.keeper.OnRecvMetadataPacket(ctx, channeltypes.Packet{}, types.MetadataPacketData{Addresses: []string{"idx1", "idx0"}, Creator: creator})
+	require.NoError(t, err)
+
+	hash0, hash1 := sha256.Sum256([]byte("hello")), sha256.Sum256([]byte("world!"))
+	require.Equal(t, &types.MetadataPacketAck{
+		Version: types.AckVersion,
+		Chunks: []types.VerifiedChunk{
+			{Index: "idx1", Size_: 6, Hash: hash1[:], Height: 42},
+			{Index: "idx0", Size_: 5, Hash: hash0[:], Height: 42},
+		},
+	}, packetAck)
+
+	_, err = f.keeper.OnRecvMetadataPacket(ctx, channeltypes.Packet{}, types.MetadataPacketData{Addresses: []string{"idx0", "missing"}, Creator: creator})
+	require.ErrorIs(t, err, types.ErrChunkNotFound)
+
+	// another account cannot reference the chunks, which would keep their creator from deleting them
+	other, err := f.addressCodec.BytesToString([]byte("other_______________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.StoredChunk.Set(ctx, "idx2", types.StoredChunk{Index: "idx2", Data: []byte("!"), Creator: creator}))
+	_, err = f.keeper.OnRecvMetadataPacket(ctx, channeltypes.Packet{}, types.MetadataPacketData{Addresses: []string{"idx2"}, Creator: other})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	referenced, err := f.keeper.IsChunkReferenced(ctx, "idx2")
+	require.NoError(t, err)
+	require.False(t, referenced)
+}
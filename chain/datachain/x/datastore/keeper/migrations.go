@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"datachain/x/datastore/types"
+)
+
+// Migrator migrates the datastore state between consensus versions.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator of the state of keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the state of the first release. Its params were empty, so they are given
+// their defaults, and its chunks, which predate chunk compression and reference counting, are
+// stored uncompressed and unreferenced as they are. The storage usage it did not keep is counted.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.Params.Set(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+	return m.keeper.countStorageUsage(ctx)
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"datachain/x/datastore/keeper"
+	module "datachain/x/datastore/module"
+	"datachain/x/datastore/types"
+)
+
+// TestMigrate1to2 loads each genesis exported by a version 1 chain in testdata/migrations/v1 into
+// the store the way version 1 wrote it, migrates it, and compares the genesis exported afterwards
+// with the fixture of the same name in testdata/migrations/v2.
+func TestMigrate1to2(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "migrations", "v1", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures)
+
+	for _, fixture := range fixtures {
+		t.Run(filepath.Base(fixture), func(t *testing.T) {
+			f := initFixture(t)
+			ctx := sdk.UnwrapSDKContext(f.ctx)
+
+			bz, err := os.ReadFile(fixture)
+			require.NoError(t, err)
+			var v1 types.GenesisState
+			require.NoError(t, cdc.UnmarshalJSON(bz, &v1))
+
+			require.NoError(t, f.keeper.Port.Set(ctx, v1.PortId))
+			require.NoError(t, f.keeper.Params.Set(ctx, v1.Params))
+			for _, chunk := range v1.StoredChunkMap {
+				require.NoError(t, f.keeper.StoredChunk.Set(ctx, chunk.Index, chunk))
+			}
+
+			require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+			exported, err := f.keeper.ExportGenesis(ctx)
+			require.NoError(t, err)
+			require.NoError(t, exported.Validate())
+			got, err := cdc.MarshalJSON(exported)
+			require.NoError(t, err)
+
+			want, err := os.ReadFile(filepath.Join("testdata", "migrations", "v2", filepath.Base(fixture)))
+			require.NoError(t, err)
+			require.JSONEq(t, string(want), string(got))
+
+			var usage types.StorageUsage
+			for _, chunk := range exported.StoredChunkMap {
+				usage.Chunks++
+				usage.Bytes += uint64(len(chunk.Data))
+			}
+			res, err := keeper.NewQueryServerImpl(f.keeper).StorageUsage(ctx, &types.QueryStorageUsageRequest{})
+			require.NoError(t, err)
+			require.Equal(t, usage, res.Usage)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"datachain/x/datastore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SubmitStorageProof(ctx context.Context, msg *types.MsgSubmitStorageProof) (*types.MsgSubmitStorageProofResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	// Proofs are answered by validator operators, for the validator they operate
+	valAddr := sdk.ValAddress(creator)
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil || !validator.IsBonded() {
+		return nil, errorsmod.Wrapf(types.ErrNotBondedValidator, "signer %s", msg.Creator)
+	}
+
+	challenge, err := k.StorageChallenge.Get(ctx, msg.ChallengeId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrChallengeNotFound, "challenge %d", msg.ChallengeId)
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	key := collections.Join(msg.ChallengeId, valAddr)
+	proven, err := k.StorageProof.Has(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if proven {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "challenge %d already proven", msg.ChallengeId)
+	}
+
+	chunk, err := k.StoredChunk.Get(ctx, challenge.Index)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrChunkNotFound, "chunk %s", challenge.Index)
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := types.VerifyStorageProof(chunk.Data, challenge, valAddr, msg.SliceHash, msg.MerklePath); err != nil {
+		return nil, err
+	}
+
+	if err := k.StorageProof.Set(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStorageProof,
+			sdk.NewAttribute(types.AttributeKeyChallengeID, strconv.FormatUint(msg.ChallengeId, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		),
+	)
+
+	return &types.MsgSubmitStorageProofResponse{}, nil
+}
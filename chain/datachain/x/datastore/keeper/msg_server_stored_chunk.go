@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 
@@ -9,6 +10,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -31,7 +33,17 @@ func (k msgServer) CreateStoredChunk(ctx context.Context, msg *types.MsgCreateSt
 		Data:    msg.Data,
 	}
 
-	if err := k.StoredChunk.Set(ctx, storedChunk.Index, storedChunk); err != nil {
+	if err := k.setStoredChunk(ctx, storedChunk); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	hash := sha256.Sum256(msg.Data)
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventStoredChunkCreated{
+		Index:   msg.Index,
+		Creator: msg.Creator,
+		Size_:   uint64(len(msg.Data)),
+		Hash:    hash[:],
+	}); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
@@ -58,16 +70,35 @@ func (k msgServer) UpdateStoredChunk(ctx context.Context, msg *types.MsgUpdateSt
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// Referenced chunks back metadata on metachain, they change only through release packets
+	referenced, err := k.IsChunkReferenced(ctx, msg.Index)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if referenced {
+		return nil, errorsmod.Wrapf(types.ErrChunkReferenced, "chunk %s", msg.Index)
+	}
+
 	var storedChunk = types.StoredChunk{
 		Creator: msg.Creator,
 		Index:   msg.Index,
 		Data:    msg.Data,
 	}
 
-	if err := k.StoredChunk.Set(ctx, storedChunk.Index, storedChunk); err != nil {
+	if err := k.setStoredChunk(ctx, storedChunk); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update storedChunk")
 	}
 
+	hash := sha256.Sum256(msg.Data)
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventStoredChunkUpdated{
+		Index:   msg.Index,
+		Creator: msg.Creator,
+		Size_:   uint64(len(msg.Data)),
+		Hash:    hash[:],
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
 	return &types.MsgUpdateStoredChunkResponse{}, nil
 }
 
@@ -91,9 +122,28 @@ func (k msgServer) DeleteStoredChunk(ctx context.Context, msg *types.MsgDeleteSt
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	if err := k.StoredChunk.Remove(ctx, msg.Index); err != nil {
+	// a referenced chunk is only removed by pruning, after its last release
+	referenced, err := k.IsChunkReferenced(ctx, msg.Index)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if referenced {
+		return nil, errorsmod.Wrapf(types.ErrChunkReferenced, "chunk %s", msg.Index)
+	}
+
+	if err := k.removeStoredChunk(ctx, val); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove storedChunk")
 	}
+	if err := k.unschedulePrune(ctx, msg.Index); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventStoredChunkDeleted{
+		Index:   msg.Index,
+		Creator: val.Creator,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
 
 	return &types.MsgDeleteStoredChunkResponse{}, nil
 }
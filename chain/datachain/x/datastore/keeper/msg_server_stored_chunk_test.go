@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
 	"strconv"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
 	"datachain/x/datastore/keeper"
@@ -155,3 +157,28 @@ func TestStoredChunkMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestStoredChunkMsgServerEvents(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateStoredChunk(f.ctx, &types.MsgCreateStoredChunk{Creator: creator, Index: "0", Data: []byte("created")})
+	require.NoError(t, err)
+	_, err = srv.UpdateStoredChunk(f.ctx, &types.MsgUpdateStoredChunk{Creator: creator, Index: "0", Data: []byte("updated")})
+	require.NoError(t, err)
+	_, err = srv.DeleteStoredChunk(f.ctx, &types.MsgDeleteStoredChunk{Creator: creator, Index: "0"})
+	require.NoError(t, err)
+
+	// rejected messages emit nothing
+	_, err = srv.DeleteStoredChunk(f.ctx, &types.MsgDeleteStoredChunk{Creator: creator, Index: "0"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	created, updated := sha256.Sum256([]byte("created")), sha256.Sum256([]byte("updated"))
+	require.Equal(t, []proto.Message{
+		&types.EventStoredChunkCreated{Index: "0", Creator: creator, Size_: 7, Hash: created[:]},
+		&types.EventStoredChunkUpdated{Index: "0", Creator: creator, Size_: 7, Hash: updated[:]},
+		&types.EventStoredChunkDeleted{Index: "0", Creator: creator},
+	}, typedEvents(t, f.ctx))
+}
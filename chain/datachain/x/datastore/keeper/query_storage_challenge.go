@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"datachain/x/datastore/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListStorageChallenges(ctx context.Context, req *types.QueryAllStorageChallengeRequest) (*types.QueryAllStorageChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	challenges, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.StorageChallenge,
+		req.Pagination,
+		func(_ uint64, value types.StorageChallenge) (types.StorageChallenge, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllStorageChallengeResponse{Challenges: challenges, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetStorageChallenge(ctx context.Context, req *types.QueryGetStorageChallengeRequest) (*types.QueryGetStorageChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.StorageChallenge.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetStorageChallengeResponse{Challenge: val}, nil
+}
+
+func (q queryServer) ListStorageReputations(ctx context.Context, req *types.QueryAllStorageReputationRequest) (*types.QueryAllStorageReputationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	reputations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.StorageReputation,
+		req.Pagination,
+		func(_ sdk.ValAddress, value types.StorageReputation) (types.StorageReputation, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllStorageReputationResponse{Reputations: reputations, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetStorageReputation(ctx context.Context, req *types.QueryGetStorageReputationRequest) (*types.QueryGetStorageReputationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := q.k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+
+	val, err := q.k.StorageReputation.Get(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetStorageReputationResponse{Reputation: val}, nil
+}
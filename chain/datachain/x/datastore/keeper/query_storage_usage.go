@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"datachain/x/datastore/types"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) StorageUsage(ctx context.Context, req *types.QueryStorageUsageRequest) (*types.QueryStorageUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	usage, err := q.k.StorageUsage.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStorageUsageResponse{Usage: usage}, nil
+}
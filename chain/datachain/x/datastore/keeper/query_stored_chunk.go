@@ -22,7 +22,10 @@ func (q queryServer) ListStoredChunk(ctx context.Context, req *types.QueryAllSto
 		q.k.StoredChunk,
 		req.Pagination,
 		func(_ string, value types.StoredChunk) (types.StoredChunk, error) {
-			return value, nil
+			if req.Raw {
+				return value, nil
+			}
+			return value.Decompressed()
 		},
 	)
 	if err != nil {
@@ -45,6 +48,11 @@ func (q queryServer) GetStoredChunk(ctx context.Context, req *types.QueryGetStor
 
 		return nil, status.Error(codes.Internal, "internal error")
 	}
+	if !req.Raw {
+		if val, err = val.Decompressed(); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
 
 	return &types.QueryGetStoredChunkResponse{StoredChunk: val}, nil
 }
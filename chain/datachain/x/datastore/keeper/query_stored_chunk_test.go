@@ -18,7 +18,7 @@ func createNStoredChunk(keeper keeper.Keeper, ctx context.Context, n int) []type
 	items := make([]types.StoredChunk, n)
 	for i := range items {
 		items[i].Index = strconv.Itoa(i)
-		items[i].Data = []byte{1 + i%1, 2 + i%2, 3 + i%3}
+		items[i].Data = []byte{byte(1 + i%1), byte(2 + i%2), byte(3 + i%3)}
 		_ = keeper.StoredChunk.Set(ctx, items[i].Index, items[i])
 	}
 	return items
@@ -119,3 +119,34 @@ func TestStoredChunkQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestStorageUsageQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	res, err := qs.StorageUsage(f.ctx, &types.QueryStorageUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.StorageUsage{}, res.Usage)
+
+	for i, data := range []string{"abc", "defgh"} {
+		_, err := srv.CreateStoredChunk(f.ctx, &types.MsgCreateStoredChunk{Creator: creator, Index: strconv.Itoa(i), Data: []byte(data)})
+		require.NoError(t, err)
+	}
+	_, err = srv.UpdateStoredChunk(f.ctx, &types.MsgUpdateStoredChunk{Creator: creator, Index: "0", Data: []byte("a")})
+	require.NoError(t, err)
+	res, err = qs.StorageUsage(f.ctx, &types.QueryStorageUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.StorageUsage{Chunks: 2, Bytes: 6}, res.Usage)
+
+	_, err = srv.DeleteStoredChunk(f.ctx, &types.MsgDeleteStoredChunk{Creator: creator, Index: "1"})
+	require.NoError(t, err)
+	res, err = qs.StorageUsage(f.ctx, &types.QueryStorageUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.StorageUsage{Chunks: 1, Bytes: 1}, res.Usage)
+
+	_, err = qs.StorageUsage(f.ctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"datachain/x/datastore/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListUnreferencedChunks(ctx context.Context, req *types.QueryUnreferencedChunksRequest) (*types.QueryUnreferencedChunksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	chunks, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.StoredChunk,
+		req.Pagination,
+		func(index string, _ types.StoredChunk) (bool, error) {
+			referenced, err := q.k.IsChunkReferenced(ctx, index)
+			return !referenced, err
+		},
+		func(index string, _ types.StoredChunk) (types.UnreferencedChunk, error) {
+			chunk := types.UnreferencedChunk{Index: index}
+			pruneAfter, err := q.k.PruneAfter.Get(ctx, index)
+			if err == nil {
+				chunk.PruneAfter = &pruneAfter
+			} else if !errors.Is(err, collections.ErrNotFound) {
+				return chunk, err
+			}
+			return chunk, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnreferencedChunksResponse{Chunks: chunks, Pagination: pageRes}, nil
+}
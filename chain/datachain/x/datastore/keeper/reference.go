@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"datachain/x/datastore/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// referenceHolder names the metadata entry a packet registers or releases. The datachain end of
+// the packet tells metachains apart, the url tells entries of the same metachain apart.
+func referenceHolder(packet channeltypes.Packet, url string) string {
+	return packet.DestinationChannel + "/" + url
+}
+
+// addReferences records that holder refers to each chunk. Adding an existing reference is a
+// no-op, so a metadata entry verified twice still counts once. A referenced chunk is taken off
+// the prune queue.
+func (k Keeper) addReferences(ctx context.Context, holder string, indexes []string) error {
+	for _, index := range indexes {
+		key := collections.Join(index, holder)
+		has, err := k.ChunkReference.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+		if err := k.ChunkReference.Set(ctx, key); err != nil {
+			return err
+		}
+
+		count, err := k.ChunkRefCount.Get(ctx, index)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err := k.ChunkRefCount.Set(ctx, index, count+1); err != nil {
+			return err
+		}
+		if count == 0 {
+			if err := k.unschedulePrune(ctx, index); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// releaseReferences drops the references holder has on the chunks and returns the indexes it
+// actually referenced. Chunks left without references are queued for pruning once the release
+// grace period has passed.
+func (k Keeper) releaseReferences(ctx context.Context, holder string, indexes []string) ([]string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pruneAfter := sdk.UnwrapSDKContext(ctx).BlockTime().Add(params.ReleaseGracePeriod)
+
+	released := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		key := collections.Join(index, holder)
+		has, err := k.ChunkReference.Has(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if !has {
+			continue
+		}
+		if err := k.ChunkReference.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+		released = append(released, index)
+
+		count, err := k.ChunkRefCount.Get(ctx, index)
+		if err != nil {
+			return nil, err
+		}
+		if count > 1 {
+			if err := k.ChunkRefCount.Set(ctx, index, count-1); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := k.ChunkRefCount.Remove(ctx, index); err != nil {
+			return nil, err
+		}
+		if err := k.schedulePrune(ctx, index, pruneAfter); err != nil {
+			return nil, err
+		}
+	}
+
+	return released, nil
+}
+
+func (k Keeper) schedulePrune(ctx context.Context, index string, pruneAfter time.Time) error {
+	if err := k.PruneAfter.Set(ctx, index, pruneAfter); err != nil {
+		return err
+	}
+	return k.PruneQueue.Set(ctx, collections.Join(pruneAfter, index))
+}
+
+func (k Keeper) unschedulePrune(ctx context.Context, index string) error {
+	pruneAfter, err := k.PruneAfter.Get(ctx, index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := k.PruneAfter.Remove(ctx, index); err != nil {
+		return err
+	}
+	return k.PruneQueue.Remove(ctx, collections.Join(pruneAfter, index))
+}
+
+// IsChunkReferenced reports whether any metadata entry refers to the chunk.
+func (k Keeper) IsChunkReferenced(ctx context.Context, index string) (bool, error) {
+	return k.ChunkRefCount.Has(ctx, index)
+}
+
+// PruneReleasedChunks deletes up to MaxPrunesPerBlock chunks whose release grace period has
+// passed. It runs in EndBlock.
+func (k Keeper) PruneReleasedChunks(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// the queue is ordered by time, everything due sorts before (now+1ns, "")
+	rng := new(collections.Range[collections.Pair[time.Time, string]]).
+		EndExclusive(collections.Join(sdkCtx.BlockTime().Add(time.Nanosecond), ""))
+	iter, err := k.PruneQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	// collect first, the store must not be written while it is iterated
+	var due []collections.Pair[time.Time, string]
+	for ; iter.Valid() && len(due) < types.MaxPrunesPerBlock; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		due = append(due, key)
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		index := key.K2()
+		if err := k.PruneQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.PruneAfter.Remove(ctx, index); err != nil {
+			return err
+		}
+		chunk, err := k.StoredChunk.Get(ctx, index)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := k.removeStoredChunk(ctx, chunk); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChunkPruned,
+				sdk.NewAttribute(types.AttributeKeyIndex, index),
+			),
+		)
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventStoredChunkPruned{
+			Index:   index,
+			Creator: chunk.Creator,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
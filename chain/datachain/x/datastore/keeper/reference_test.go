@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"datachain/x/datastore/keeper"
+	"datachain/x/datastore/types"
+)
+
+func TestChunkReleaseAndPrune(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(types.DefaultMaxRetrievalBytes, time.Hour, types.DefaultChallengeEpochIdentifier, types.DefaultChallengesPerEpoch, types.DefaultChallengeSliceSize, types.DefaultChunkCodec, types.DefaultChunkGasPerByte, types.DefaultMaxBlockChunkBytes, true)))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	for _, index := range []string{"idx0", "idx1"} {
+		require.NoError(t, f.keeper.StoredChunk.Set(ctx, index, types.StoredChunk{Index: index, Data: []byte(index), Creator: creator}))
+	}
+
+	// two metadata entries share idx0, registering one of them twice counts once
+	channel := channeltypes.Packet{DestinationChannel: "channel-0"}
+	_, err = f.keeper.OnRecvMetadataPacket(ctx, channel, types.MetadataPacketData{Url: "a", Addresses: []string{"idx0", "idx1"}, Creator: creator})
+	require.NoError(t, err)
+	_, err = f.keeper.OnRecvMetadataPacket(ctx, channel, types.MetadataPacketData{Url: "a", Addresses: []string{"idx0", "idx1"}, Creator: creator})
+	require.NoError(t, err)
+	_, err = f.keeper.OnRecvMetadataPacket(ctx, channel, types.MetadataPacketData{Url: "b", Addresses: []string{"idx0"}, Creator: creator})
+	require.NoError(t, err)
+
+	count, err := f.keeper.ChunkRefCount.Get(ctx, "idx0")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, err = srv.DeleteStoredChunk(ctx, &types.MsgDeleteStoredChunk{Creator: creator, Index: "idx1"})
+	require.ErrorIs(t, err, types.ErrChunkReferenced)
+
+	_, err = f.keeper.OnRecvChunkReleasePacket(ctx, channel, types.ChunkReleasePacketData{})
+	require.Error(t, err)
+
+	ack, err := f.keeper.OnRecvChunkReleasePacket(ctx, channel, types.ChunkReleasePacketData{Url: "a", Addresses: []string{"idx0", "idx1", "missing"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"idx0", "idx1"}, ack.Released)
+
+	referenced, err := f.keeper.IsChunkReferenced(ctx, "idx0")
+	require.NoError(t, err)
+	require.True(t, referenced)
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	res, err := qs.ListUnreferencedChunks(ctx, &types.QueryUnreferencedChunksRequest{})
+	require.NoError(t, err)
+	pruneAfter := now.Add(time.Hour)
+	require.Equal(t, []types.UnreferencedChunk{{Index: "idx1", PruneAfter: &pruneAfter}}, res.Chunks)
+
+	// nothing is pruned before the grace period ends
+	require.NoError(t, f.keeper.PruneReleasedChunks(ctx.WithBlockTime(now.Add(time.Hour-time.Second))))
+	_, err = f.keeper.StoredChunk.Get(ctx, "idx1")
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.PruneReleasedChunks(ctx.WithBlockTime(pruneAfter)))
+	_, err = f.keeper.StoredChunk.Get(ctx, "idx1")
+	require.Error(t, err)
+	_, err = f.keeper.StoredChunk.Get(ctx, "idx0")
+	require.NoError(t, err)
+
+	// a chunk registered again during its grace period is kept
+	_, err = f.keeper.OnRecvChunkReleasePacket(ctx, channel, types.ChunkReleasePacketData{Url: "b", Addresses: []string{"idx0"}})
+	require.NoError(t, err)
+	_, err = f.keeper.OnRecvMetadataPacket(ctx, channel, types.MetadataPacketData{Url: "c", Addresses: []string{"idx0"}, Creator: creator})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.PruneReleasedChunks(ctx.WithBlockTime(now.Add(2*time.Hour))))
+	_, err = f.keeper.StoredChunk.Get(ctx, "idx0")
+	require.NoError(t, err)
+}
+
+func TestChunkReferenceEvents(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(types.DefaultMaxRetrievalBytes, time.Hour, types.DefaultChallengeEpochIdentifier, types.DefaultChallengesPerEpoch, types.DefaultChallengeSliceSize, types.DefaultChunkCodec, types.DefaultChunkGasPerByte, types.DefaultMaxBlockChunkBytes, true)))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.StoredChunk.Set(ctx, "idx0", types.StoredChunk{Index: "idx0", Data: []byte("idx0"), Creator: creator}))
+
+	channel := channeltypes.Packet{DestinationChannel: "channel-0"}
+	ack, err := f.keeper.OnRecvMetadataPacket(ctx, channel, types.MetadataPacketData{Url: "a", Addresses: []string{"idx0"}, Creator: creator})
+	require.NoError(t, err)
+	_, err = f.keeper.OnRecvChunkReleasePacket(ctx, channel, types.ChunkReleasePacketData{Url: "a", Addresses: []string{"idx0"}})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.PruneReleasedChunks(ctx.WithBlockTime(now.Add(time.Hour))))
+
+	require.Equal(t, []proto.Message{
+		&types.EventChunksVerified{Url: "a", Chunks: ack.Chunks},
+		&types.EventChunksReferenced{Holder: "channel-0/a", Indexes: []string{"idx0"}},
+		&types.EventChunksReleased{Holder: "channel-0/a", Released: []string{"idx0"}},
+		&types.EventStoredChunkPruned{Index: "idx0", Creator: creator},
+	}, typedEvents(t, ctx))
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"datachain/x/datastore/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// OnRecvChunkReleasePacket is called when metachain deletes a metadata entry. The references the
+// entry held are dropped; chunks left unreferenced are pruned after the release grace period.
+func (k Keeper) OnRecvChunkReleasePacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkReleasePacketData) (*types.ChunkReleasePacketAck, error) {
+	if data.Url == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "url cannot be empty")
+	}
+
+	holder := referenceHolder(packet, data.Url)
+	released, err := k.releaseReferences(ctx, holder, data.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventChunksReleased{Holder: holder, Released: released}); err != nil {
+		return nil, err
+	}
+
+	return &types.ChunkReleasePacketAck{Version: types.AckVersion, Released: released}, nil
+}
+
+// OnAcknowledgementChunkReleasePacket is called when datachain receives an acknowledgement for a release packet.
+func (k Keeper) OnAcknowledgementChunkReleasePacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkReleasePacketData, ack channeltypes.Acknowledgement) error {
+	// Release packets only travel from metachain to datachain.
+	return nil
+}
+
+// OnTimeoutChunkReleasePacket is called when a release packet sent from datachain times out.
+func (k Keeper) OnTimeoutChunkReleasePacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkReleasePacketData) error {
+	// Release packets only travel from metachain to datachain.
+	return nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"datachain/x/datastore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// OnRecvChunkRetrievalPacket returns the decompressed data of the requested chunks in the ack. The
+// request is refused as a whole when a chunk is missing or the returned data exceeds the max
+// retrieval bytes.
+func (k Keeper) OnRecvChunkRetrievalPacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkRetrievalPacketData) (*types.ChunkRetrievalPacketAck, error) {
+	if len(data.Indexes) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "indexes cannot be empty")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	chunks := make([]types.RetrievedChunk, 0, len(data.Indexes))
+	var total uint64
+	for _, index := range data.Indexes {
+		chunk, err := k.StoredChunk.Get(ctx, index)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrChunkNotFound, "chunk with index %s not found", index)
+		}
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "error reading chunk with index %s", index)
+		}
+		chunk, err = chunk.Decompressed()
+		if err != nil {
+			return nil, err
+		}
+
+		total += uint64(len(chunk.Data))
+		if total > params.MaxRetrievalBytes {
+			return nil, errorsmod.Wrapf(types.ErrRetrievalTooLarge, "more than %d bytes requested", params.MaxRetrievalBytes)
+		}
+
+		chunks = append(chunks, types.RetrievedChunk{
+			Index:  index,
+			Data:   chunk.Data,
+			Height: height,
+		})
+	}
+
+	return &types.ChunkRetrievalPacketAck{Version: types.AckVersion, Chunks: chunks}, nil
+}
+
+// OnAcknowledgementChunkRetrievalPacket is called when datachain receives an acknowledgement for a retrieval packet.
+func (k Keeper) OnAcknowledgementChunkRetrievalPacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkRetrievalPacketData, ack channeltypes.Acknowledgement) error {
+	// Retrieval packets only travel from metachain to datachain.
+	return nil
+}
+
+// OnTimeoutChunkRetrievalPacket is called when a retrieval packet sent from datachain times out.
+func (k Keeper) OnTimeoutChunkRetrievalPacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkRetrievalPacketData) error {
+	// Retrieval packets only travel from metachain to datachain.
+	return nil
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"datachain/x/datastore/types"
+)
+
+func TestOnRecvChunkRetrievalPacket(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(42)
+	for index, data := range map[string]string{"idx0": "hello", "idx1": "world!"} {
+		require.NoError(t, f.keeper.StoredChunk.Set(ctx, index, types.StoredChunk{Index: index, Data: []byte(data)}))
+	}
+
+	packetAck, err := f.keeper.OnRecvChunkRetrievalPacket(ctx, channeltypes.Packet{}, types.ChunkRetrievalPacketData{Indexes: []string{"idx1", "idx0"}})
+	require.NoError(t, err)
+	require.Equal(t, &types.ChunkRetrievalPacketAck{
+		Version: types.AckVersion,
+		Chunks: []types.RetrievedChunk{
+			{Index: "idx1", Data: []byte("world!"), Height: 42},
+			{Index: "idx0", Data: []byte("hello"), Height: 42},
+		},
+	}, packetAck)
+
+	_, err = f.keeper.OnRecvChunkRetrievalPacket(ctx, channeltypes.Packet{}, types.ChunkRetrievalPacketData{})
+	require.Error(t, err)
+
+	_, err = f.keeper.OnRecvChunkRetrievalPacket(ctx, channeltypes.Packet{}, types.ChunkRetrievalPacketData{Indexes: []string{"idx0", "missing"}})
+	require.ErrorIs(t, err, types.ErrChunkNotFound)
+
+	// 11 bytes in total, one over the limit
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(10, types.DefaultReleaseGracePeriod, types.DefaultChallengeEpochIdentifier, types.DefaultChallengesPerEpoch, types.DefaultChallengeSliceSize, types.DefaultChunkCodec, types.DefaultChunkGasPerByte, types.DefaultMaxBlockChunkBytes, true)))
+	_, err = f.keeper.OnRecvChunkRetrievalPacket(ctx, channeltypes.Packet{}, types.ChunkRetrievalPacketData{Indexes: []string{"idx0", "idx1"}})
+	require.ErrorIs(t, err, types.ErrRetrievalTooLarge)
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	snapshot "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"datachain/x/datastore/types"
+)
+
+const (
+	// SnapshotFormat is the format of the payloads of the datastore snapshot extension.
+	SnapshotFormat = 1
+
+	// snapshotSegmentSize is the amount of chunk data after which a snapshot segment is closed.
+	snapshotSegmentSize = 4 << 20
+)
+
+var _ snapshot.ExtensionSnapshotter = (*Snapshotter)(nil)
+
+// Snapshotter is the state-sync snapshot extension of the datastore module. It writes the stored
+// chunks as segments of checksummed entries, each naming the chunk it resumes after. A restoring
+// node checks each segment on its own against the index range it covers in the restored store,
+// so a corrupted or incomplete snapshot fails its restore, and state sync fetches another one,
+// before the node starts on it.
+type Snapshotter struct {
+	cms         storetypes.MultiStore
+	keeper      Keeper
+	segmentSize int
+}
+
+// NewSnapshotter returns the snapshot extension of the datastore module reading cms.
+func NewSnapshotter(cms storetypes.MultiStore, keeper Keeper) *Snapshotter {
+	return &Snapshotter{cms: cms, keeper: keeper, segmentSize: snapshotSegmentSize}
+}
+
+// SnapshotName implements snapshot.ExtensionSnapshotter.
+func (s *Snapshotter) SnapshotName() string {
+	return types.ModuleName
+}
+
+// SnapshotFormat implements snapshot.ExtensionSnapshotter.
+func (s *Snapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements snapshot.ExtensionSnapshotter.
+func (s *Snapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension implements snapshot.ExtensionSnapshotter.
+func (s *Snapshotter) SnapshotExtension(height uint64, payloadWriter snapshot.ExtensionPayloadWriter) error {
+	cacheMS, err := s.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return err
+	}
+	ctx := sdk.NewContext(cacheMS, cmtproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+
+	var (
+		segment types.ChunkSnapshotSegment
+		size    int
+	)
+	flush := func() error {
+		bz, err := segment.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := payloadWriter(bz); err != nil {
+			return err
+		}
+		after := segment.Entries[len(segment.Entries)-1].Chunk.Index
+		segment = types.ChunkSnapshotSegment{Sequence: segment.Sequence + 1, After: after}
+		size = 0
+		return nil
+	}
+
+	if err := s.keeper.StoredChunk.Walk(ctx, nil, func(_ string, val types.StoredChunk) (stop bool, err error) {
+		entry, err := types.NewChunkArchiveEntry(val)
+		if err != nil {
+			return true, err
+		}
+		segment.Entries = append(segment.Entries, entry)
+		if size += len(val.Data); size >= s.segmentSize {
+			return false, flush()
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	if len(segment.Entries) > 0 {
+		return flush()
+	}
+
+	return nil
+}
+
+// RestoreExtension implements snapshot.ExtensionSnapshotter. The stored chunks are part of the
+// module store, which the multistore snapshot restored already; the segments are checked against
+// it rather than written.
+func (s *Snapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshot.ExtensionPayloadReader) error {
+	if format != SnapshotFormat {
+		return fmt.Errorf("%w: %d", snapshot.ErrUnknownFormat, format)
+	}
+
+	ctx := sdk.NewContext(s.cms, cmtproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+
+	var (
+		sequence uint64
+		last     string
+	)
+	for ; ; sequence++ {
+		payload, err := payloadReader()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		var segment types.ChunkSnapshotSegment
+		if err := segment.Unmarshal(payload); err != nil {
+			return err
+		}
+		if segment.Sequence != sequence {
+			return fmt.Errorf("snapshot segment %d received, expected %d", segment.Sequence, sequence)
+		}
+		if segment.After != last {
+			return fmt.Errorf("snapshot segment %d resumes after chunk %q, expected %q", sequence, segment.After, last)
+		}
+		if len(segment.Entries) == 0 {
+			return fmt.Errorf("snapshot segment %d is empty", sequence)
+		}
+		if err := s.restoreSegment(ctx, segment); err != nil {
+			return fmt.Errorf("snapshot segment %d: %w", sequence, err)
+		}
+		last = segment.Entries[len(segment.Entries)-1].Chunk.Index
+	}
+
+	// no chunk may follow the last segment
+	rng := new(collections.Range[string])
+	if sequence > 0 {
+		rng = rng.StartExclusive(last)
+	}
+	iter, err := s.keeper.StoredChunk.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if iter.Valid() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("restored chunk %q is missing from the snapshot", key)
+	}
+
+	return nil
+}
+
+// restoreSegment checks the entries of segment against the stored chunks that follow its After
+// index, which must be exactly the chunks of the segment.
+func (s *Snapshotter) restoreSegment(ctx sdk.Context, segment types.ChunkSnapshotSegment) error {
+	rng := new(collections.Range[string])
+	if segment.After != "" {
+		rng = rng.StartExclusive(segment.After)
+	}
+	iter, err := s.keeper.StoredChunk.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for _, entry := range segment.Entries {
+		if err := entry.Verify(); err != nil {
+			return err
+		}
+		if !iter.Valid() {
+			return fmt.Errorf("snapshot chunk %q is missing from the restored store", entry.Chunk.Index)
+		}
+		stored, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		if stored.Index != entry.Chunk.Index {
+			return fmt.Errorf("snapshot chunk %q does not match the restored chunk %q", entry.Chunk.Index, stored.Index)
+		}
+		if err := equalChunks(stored, entry.Chunk); err != nil {
+			return err
+		}
+		iter.Next()
+	}
+
+	return nil
+}
+
+// equalChunks compares two chunks by their encoding.
+func equalChunks(stored, snapshotted types.StoredChunk) error {
+	a, err := stored.Marshal()
+	if err != nil {
+		return err
+	}
+	b, err := snapshotted.Marshal()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(a, b) {
+		return fmt.Errorf("snapshot chunk %q differs from the restored chunk", stored.Index)
+	}
+	return nil
+}
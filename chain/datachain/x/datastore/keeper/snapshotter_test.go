@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	snapshot "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"datachain/x/datastore/keeper"
+	"datachain/x/datastore/types"
+)
+
+func payloadReader(payloads [][]byte) snapshot.ExtensionPayloadReader {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+}
+
+func TestSnapshotter(t *testing.T) {
+	f := initFixture(t)
+	for _, index := range []string{"a", "b", "c"} {
+		require.NoError(t, f.keeper.StoredChunk.Set(f.ctx, index, types.StoredChunk{Index: index, Data: []byte("data-" + index), Creator: "alice"}))
+	}
+	cms := sdk.UnwrapSDKContext(f.ctx).MultiStore().(storetypes.CommitMultiStore)
+	cms.Commit()
+
+	snapshotter := keeper.NewSnapshotter(cms, f.keeper)
+	var payloads [][]byte
+	require.NoError(t, snapshotter.SnapshotExtension(1, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+	require.Len(t, payloads, 1)
+
+	segment := func() types.ChunkSnapshotSegment {
+		var segment types.ChunkSnapshotSegment
+		require.NoError(t, segment.Unmarshal(payloads[0]))
+		return segment
+	}
+	marshal := func(segment types.ChunkSnapshotSegment) [][]byte {
+		bz, err := segment.Marshal()
+		require.NoError(t, err)
+		return [][]byte{bz}
+	}
+
+	tests := []struct {
+		name     string
+		format   uint32
+		payloads [][]byte
+		err      string
+	}{
+		{
+			name:     "valid",
+			format:   keeper.SnapshotFormat,
+			payloads: payloads,
+		}, {
+			name:     "unknown format",
+			format:   keeper.SnapshotFormat + 1,
+			payloads: payloads,
+			err:      snapshot.ErrUnknownFormat.Error(),
+		}, {
+			name:   "corrupted chunk",
+			format: keeper.SnapshotFormat,
+			payloads: func() [][]byte {
+				s := segment()
+				s.Entries[1].Chunk.Data = []byte("corrupted")
+				return marshal(s)
+			}(),
+			err: "checksum mismatch",
+		}, {
+			name:   "chunk missing from the snapshot",
+			format: keeper.SnapshotFormat,
+			payloads: func() [][]byte {
+				s := segment()
+				s.Entries = s.Entries[:2]
+				return marshal(s)
+			}(),
+			err: "missing from the snapshot",
+		}, {
+			name:   "out of sequence",
+			format: keeper.SnapshotFormat,
+			payloads: func() [][]byte {
+				s := segment()
+				s.Sequence = 1
+				return marshal(s)
+			}(),
+			err: "expected 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := snapshotter.RestoreExtension(1, tt.format, payloadReader(tt.payloads))
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSnapshotterSegments(t *testing.T) {
+	f := initFixture(t)
+	// 3 MiB chunks, so every segment closes after two of them
+	for _, index := range []string{"a", "b", "c", "d", "e"} {
+		require.NoError(t, f.keeper.StoredChunk.Set(f.ctx, index, types.StoredChunk{Index: index, Data: bytes.Repeat([]byte(index), 3<<20), Creator: "alice"}))
+	}
+	cms := sdk.UnwrapSDKContext(f.ctx).MultiStore().(storetypes.CommitMultiStore)
+	cms.Commit()
+
+	snapshotter := keeper.NewSnapshotter(cms, f.keeper)
+	var payloads [][]byte
+	require.NoError(t, snapshotter.SnapshotExtension(1, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+	require.Len(t, payloads, 3)
+
+	var after []string
+	for _, payload := range payloads {
+		var segment types.ChunkSnapshotSegment
+		require.NoError(t, segment.Unmarshal(payload))
+		after = append(after, segment.After)
+	}
+	require.Equal(t, []string{"", "b", "d"}, after)
+
+	require.NoError(t, snapshotter.RestoreExtension(1, keeper.SnapshotFormat, payloadReader(payloads)))
+
+	// a segment lost in transfer shows up as a gap, even when the sequence is renumbered
+	var last types.ChunkSnapshotSegment
+	require.NoError(t, last.Unmarshal(payloads[2]))
+	last.Sequence = 1
+	renumbered, err := last.Marshal()
+	require.NoError(t, err)
+	err = snapshotter.RestoreExtension(1, keeper.SnapshotFormat, payloadReader([][]byte{payloads[0], renumbered}))
+	require.ErrorContains(t, err, `resumes after chunk "d", expected "b"`)
+
+	// so does a missing last segment
+	err = snapshotter.RestoreExtension(1, keeper.SnapshotFormat, payloadReader(payloads[:2]))
+	require.ErrorContains(t, err, `restored chunk "e" is missing from the snapshot`)
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"strconv"
+
+	"datachain/x/datastore/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Keeper{}
+
+// AfterEpochEnd settles the storage challenges of the ending challenge epoch and publishes the
+// challenges of the next one.
+func (k Keeper) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.ChallengeEpochIdentifier == "" || params.ChallengeEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	if err := k.settleStorageChallenges(ctx); err != nil {
+		return err
+	}
+	return k.publishStorageChallenges(ctx, params, epochNumber)
+}
+
+// BeforeEpochStart implements epochstypes.EpochHooks.
+func (k Keeper) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// publishStorageChallenges draws the challenges of an epoch. The chunks and slices are picked from
+// the hash of the block header, which no validator knows before the block is proposed.
+// Slices are cut from the chunk data as stored, which is what validators hold.
+func (k Keeper) publishStorageChallenges(ctx context.Context, params types.Params, epoch int64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	seed := sha256.New()
+	seed.Write(sdkCtx.HeaderHash())
+	seed.Write(binary.BigEndian.AppendUint64(nil, uint64(epoch)))
+	seedHash := seed.Sum(nil)
+
+	for i := uint32(0); i < params.ChallengesPerEpoch; i++ {
+		draw := sha256.Sum256(binary.BigEndian.AppendUint32(seedHash, i))
+
+		chunk, found, err := k.challengedChunk(ctx, string(draw[:]))
+		if err != nil {
+			return err
+		}
+		if !found {
+			return nil
+		}
+
+		slices := (uint64(len(chunk.Data)) + params.ChallengeSliceSize - 1) / params.ChallengeSliceSize
+		slice := binary.BigEndian.Uint64(draw[8:16]) % slices
+		offset := slice * params.ChallengeSliceSize
+
+		id, err := k.StorageChallengeSeq.Next(ctx)
+		if err != nil {
+			return err
+		}
+		challenge := types.StorageChallenge{
+			Id:        id,
+			Epoch:     epoch,
+			Index:     chunk.Index,
+			Slice:     slice,
+			SliceSize: params.ChallengeSliceSize,
+			Offset:    offset,
+			Length:    min(params.ChallengeSliceSize, uint64(len(chunk.Data))-offset),
+		}
+		if err := k.StorageChallenge.Set(ctx, id, challenge); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStorageChallenge,
+				sdk.NewAttribute(types.AttributeKeyChallengeID, strconv.FormatUint(id, 10)),
+				sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatInt(epoch, 10)),
+				sdk.NewAttribute(types.AttributeKeyIndex, chunk.Index),
+				sdk.NewAttribute(types.AttributeKeyOffset, strconv.FormatUint(challenge.Offset, 10)),
+				sdk.NewAttribute(types.AttributeKeyLength, strconv.FormatUint(challenge.Length, 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// challengedChunk returns the first non-empty chunk whose index is not lower than start, wrapping
+// around to the lowest index. It reports false if no chunk holds data.
+func (k Keeper) challengedChunk(ctx context.Context, start string) (types.StoredChunk, bool, error) {
+	for _, ranger := range []*collections.Range[string]{
+		new(collections.Range[string]).StartInclusive(start),
+		new(collections.Range[string]).EndExclusive(start),
+	} {
+		var (
+			chunk types.StoredChunk
+			found bool
+		)
+		if err := k.StoredChunk.Walk(ctx, ranger, func(_ string, val types.StoredChunk) (stop bool, err error) {
+			if len(val.Data) == 0 {
+				return false, nil
+			}
+			chunk, found = val, true
+			return true, nil
+		}); err != nil {
+			return types.StoredChunk{}, false, err
+		}
+		if found {
+			return chunk, true, nil
+		}
+	}
+
+	return types.StoredChunk{}, false, nil
+}
+
+// settleStorageChallenges adds the challenges of the ending epoch to the reputation of every bonded
+// validator and clears them. Challenges of chunks deleted during the epoch are void.
+func (k Keeper) settleStorageChallenges(ctx context.Context) error {
+	var challenges []uint64
+	if err := k.StorageChallenge.Walk(ctx, nil, func(id uint64, challenge types.StorageChallenge) (stop bool, err error) {
+		ok, err := k.StoredChunk.Has(ctx, challenge.Index)
+		if err != nil {
+			return true, err
+		}
+		if ok {
+			challenges = append(challenges, id)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	if len(challenges) > 0 {
+		validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+		if err != nil {
+			return err
+		}
+		for _, validator := range validators {
+			if err := k.settleValidator(ctx, validator.GetOperator(), challenges); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := k.StorageProof.Clear(ctx, nil); err != nil {
+		return err
+	}
+	return k.StorageChallenge.Clear(ctx, nil)
+}
+
+func (k Keeper) settleValidator(ctx context.Context, operator string, challenges []uint64) error {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(operator)
+	if err != nil {
+		return err
+	}
+
+	var proven, missed uint64
+	for _, id := range challenges {
+		ok, err := k.StorageProof.Has(ctx, collections.Join(id, sdk.ValAddress(valAddr)))
+		if err != nil {
+			return err
+		}
+		if ok {
+			proven++
+		} else {
+			missed++
+		}
+	}
+
+	reputation, err := k.StorageReputation.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		reputation = types.StorageReputation{Validator: operator}
+	} else if err != nil {
+		return err
+	}
+	reputation.Settle(proven, missed)
+	if err := k.StorageReputation.Set(ctx, valAddr, reputation); err != nil {
+		return err
+	}
+
+	if missed > 0 {
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStorageMissed,
+				sdk.NewAttribute(types.AttributeKeyValidator, operator),
+				sdk.NewAttribute(types.AttributeKeyMissed, strconv.FormatUint(missed, 10)),
+				sdk.NewAttribute(types.AttributeKeyConsecutiveFailedEpochs, strconv.FormatUint(reputation.ConsecutiveFailedEpochs, 10)),
+			),
+		)
+	}
+
+	return nil
+}
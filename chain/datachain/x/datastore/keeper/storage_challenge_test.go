@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"datachain/x/datastore/keeper"
+	"datachain/x/datastore/types"
+)
+
+// storageChallenges returns the challenges of the current challenge epoch.
+func storageChallenges(t *testing.T, f *fixture) []types.StorageChallenge {
+	t.Helper()
+
+	var challenges []types.StorageChallenge
+	require.NoError(t, f.keeper.StorageChallenge.Walk(f.ctx, nil, func(_ uint64, val types.StorageChallenge) (bool, error) {
+		challenges = append(challenges, val)
+		return false, nil
+	}))
+	return challenges
+}
+
+func TestStorageChallenges(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+
+	chunks := map[string][]byte{
+		"a": bytes.Repeat([]byte("a"), 5000),
+		"b": {},
+		"c": bytes.Repeat([]byte("c"), 100),
+	}
+	for index, data := range chunks {
+		require.NoError(t, f.keeper.StoredChunk.Set(f.ctx, index, types.StoredChunk{Index: index, Data: data}))
+	}
+
+	prover := sdk.AccAddress("prover______________")
+	idle := sdk.AccAddress("idle________________")
+	unbonded := sdk.AccAddress("unbonded____________")
+	proverVal := f.stakingKeeper.addValidator(prover, stakingtypes.Bonded)
+	idleVal := f.stakingKeeper.addValidator(idle, stakingtypes.Bonded)
+	f.stakingKeeper.addValidator(unbonded, stakingtypes.Unbonded)
+
+	// other epochs do not publish challenges
+	require.NoError(t, f.keeper.AfterEpochEnd(f.ctx, "day", 1))
+	require.Empty(t, storageChallenges(t, f))
+
+	require.NoError(t, f.keeper.AfterEpochEnd(f.ctx, params.ChallengeEpochIdentifier, 1))
+	challenges := storageChallenges(t, f)
+	require.Len(t, challenges, int(params.ChallengesPerEpoch))
+	for i, challenge := range challenges {
+		data := chunks[challenge.Index]
+		require.NotEmpty(t, data, "empty chunks are not challenged")
+		require.Equal(t, uint64(i), challenge.Id)
+		require.Equal(t, int64(1), challenge.Epoch)
+		require.Equal(t, challenge.Slice*params.ChallengeSliceSize, challenge.Offset)
+		require.LessOrEqual(t, challenge.Offset+challenge.Length, uint64(len(data)))
+	}
+
+	for _, challenge := range challenges {
+		msg, err := types.NewMsgSubmitStorageProof(prover, challenge, chunks[challenge.Index])
+		require.NoError(t, err)
+		_, err = srv.SubmitStorageProof(f.ctx, msg)
+		require.NoError(t, err)
+
+		_, err = srv.SubmitStorageProof(f.ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+		// a proof computed for another validator does not verify
+		replayed := *msg
+		replayed.Creator = idle.String()
+		_, err = srv.SubmitStorageProof(f.ctx, &replayed)
+		require.ErrorIs(t, err, types.ErrInvalidStorageProof)
+	}
+
+	msg, err := types.NewMsgSubmitStorageProof(unbonded, challenges[0], chunks[challenges[0].Index])
+	require.NoError(t, err)
+	_, err = srv.SubmitStorageProof(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrNotBondedValidator)
+
+	msg.Creator = prover.String()
+	msg.ChallengeId = 100
+	_, err = srv.SubmitStorageProof(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrChallengeNotFound)
+
+	require.NoError(t, f.keeper.AfterEpochEnd(f.ctx, params.ChallengeEpochIdentifier, 2))
+
+	reputation, err := f.keeper.StorageReputation.Get(f.ctx, proverVal)
+	require.NoError(t, err)
+	require.Equal(t, types.StorageReputation{
+		Validator: proverVal.String(),
+		Proven:    uint64(len(challenges)),
+		Score:     1000,
+	}, reputation)
+
+	reputation, err = f.keeper.StorageReputation.Get(f.ctx, idleVal)
+	require.NoError(t, err)
+	require.Equal(t, types.StorageReputation{
+		Validator:               idleVal.String(),
+		Missed:                  uint64(len(challenges)),
+		ConsecutiveFailedEpochs: 1,
+	}, reputation)
+
+	// settled challenges and proofs are cleared, the next epoch's challenges follow on
+	next := storageChallenges(t, f)
+	require.Len(t, next, int(params.ChallengesPerEpoch))
+	require.Equal(t, uint64(len(challenges)), next[0].Id)
+	require.Equal(t, int64(2), next[0].Epoch)
+	ok, err := f.keeper.StorageProof.Has(f.ctx, collections.Join(uint64(0), proverVal))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// challenges of deleted chunks are void
+	for index := range chunks {
+		require.NoError(t, f.keeper.StoredChunk.Remove(f.ctx, index))
+	}
+	require.NoError(t, f.keeper.AfterEpochEnd(f.ctx, params.ChallengeEpochIdentifier, 3))
+	reputation, err = f.keeper.StorageReputation.Get(f.ctx, idleVal)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(challenges)), reputation.Missed)
+	require.Empty(t, storageChallenges(t, f))
+
+	genesis, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2*len(challenges)), genesis.StorageChallengeCount)
+	require.Len(t, genesis.StorageReputations, 2)
+}
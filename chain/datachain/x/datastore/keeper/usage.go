@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"datachain/x/datastore/types"
+)
+
+// putStoredChunk writes chunk as is and counts it in StorageUsage, in place of the chunk it
+// overwrites.
+func (k Keeper) putStoredChunk(ctx context.Context, chunk types.StoredChunk) error {
+	usage, err := k.StorageUsage.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	old, err := k.StoredChunk.Get(ctx, chunk.Index)
+	if err == nil {
+		usage = subUsage(usage, old)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	usage.Chunks++
+	usage.Bytes += uint64(len(chunk.Data))
+
+	if err := k.StoredChunk.Set(ctx, chunk.Index, chunk); err != nil {
+		return err
+	}
+	return k.StorageUsage.Set(ctx, usage)
+}
+
+// removeStoredChunk deletes chunk, read from the store beforehand, and takes it out of
+// StorageUsage.
+func (k Keeper) removeStoredChunk(ctx context.Context, chunk types.StoredChunk) error {
+	usage, err := k.StorageUsage.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	usage = subUsage(usage, chunk)
+
+	if err := k.StoredChunk.Remove(ctx, chunk.Index); err != nil {
+		return err
+	}
+	return k.StorageUsage.Set(ctx, usage)
+}
+
+// subUsage takes chunk out of usage. It stops at zero rather than wrapping around for chunks
+// written around the count, as the first release did.
+func subUsage(usage types.StorageUsage, chunk types.StoredChunk) types.StorageUsage {
+	usage.Chunks -= min(usage.Chunks, 1)
+	usage.Bytes -= min(usage.Bytes, uint64(len(chunk.Data)))
+	return usage
+}
+
+// countStorageUsage recounts StorageUsage from the stored chunks.
+func (k Keeper) countStorageUsage(ctx context.Context) error {
+	var usage types.StorageUsage
+	if err := k.StoredChunk.Walk(ctx, nil, func(_ string, chunk types.StoredChunk) (stop bool, err error) {
+		usage.Chunks++
+		usage.Bytes += uint64(len(chunk.Data))
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	return k.StorageUsage.Set(ctx, usage)
+}
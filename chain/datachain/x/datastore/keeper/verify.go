@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"datachain/x/datastore/types"
+)
+
+// VerifyStoredChunks checks that every stored chunk is kept under its own index and decodes
+// with its codec, and that StorageUsage counts exactly the stored chunks. It is run once a
+// state-sync snapshot is restored, before the node starts on the restored store.
+func (k Keeper) VerifyStoredChunks(ctx context.Context) error {
+	var counted types.StorageUsage
+	if err := k.StoredChunk.Walk(ctx, nil, func(index string, chunk types.StoredChunk) (stop bool, err error) {
+		if chunk.Index != index {
+			return true, fmt.Errorf("chunk %q is stored under %q", chunk.Index, index)
+		}
+		if _, err := chunk.Decompressed(); err != nil {
+			return true, err
+		}
+		counted.Chunks++
+		counted.Bytes += uint64(len(chunk.Data))
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	usage, err := k.StorageUsage.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if usage != counted {
+		return fmt.Errorf("storage usage records %d chunks of %d bytes, %d chunks of %d bytes are stored",
+			usage.Chunks, usage.Bytes, counted.Chunks, counted.Bytes)
+	}
+	return nil
+}
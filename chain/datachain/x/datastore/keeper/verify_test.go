@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"datachain/x/datastore/types"
+)
+
+func TestVerifyStoredChunks(t *testing.T) {
+	codec, compressed, err := types.CompressChunkData(types.ChunkCodec_CHUNK_CODEC_SNAPPY, make([]byte, 1024))
+	require.NoError(t, err)
+	require.Equal(t, types.ChunkCodec_CHUNK_CODEC_SNAPPY, codec)
+	chunks := []types.StoredChunk{
+		{Index: "a", Data: []byte("data-a"), Creator: "alice"},
+		{Index: "b", Data: compressed, Creator: "alice", Codec: types.ChunkCodec_CHUNK_CODEC_SNAPPY},
+	}
+	usage := types.StorageUsage{Chunks: 2, Bytes: uint64(len(chunks[0].Data) + len(chunks[1].Data))}
+
+	tests := []struct {
+		name   string
+		stored map[string]types.StoredChunk
+		usage  types.StorageUsage
+		err    string
+	}{
+		{
+			name:   "consistent",
+			stored: map[string]types.StoredChunk{"a": chunks[0], "b": chunks[1]},
+			usage:  usage,
+		}, {
+			name:   "empty",
+			stored: map[string]types.StoredChunk{},
+		}, {
+			name:   "chunk under another index",
+			stored: map[string]types.StoredChunk{"a": chunks[0], "c": chunks[1]},
+			usage:  usage,
+			err:    `chunk "b" is stored under "c"`,
+		}, {
+			name: "undecodable chunk",
+			stored: map[string]types.StoredChunk{"a": chunks[0], "b": {
+				Index: "b", Data: []byte("not snappy"), Creator: "alice", Codec: types.ChunkCodec_CHUNK_CODEC_SNAPPY,
+			}},
+			usage: types.StorageUsage{Chunks: 2, Bytes: 16},
+			err:   `chunk "b"`,
+		}, {
+			name:   "usage off",
+			stored: map[string]types.StoredChunk{"a": chunks[0]},
+			usage:  usage,
+			err:    "storage usage records 2 chunks",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := initFixture(t)
+			for index, chunk := range tt.stored {
+				require.NoError(t, f.keeper.StoredChunk.Set(f.ctx, index, chunk))
+			}
+			require.NoError(t, f.keeper.StorageUsage.Set(f.ctx, tt.usage))
+
+			err := f.keeper.VerifyStoredChunks(f.ctx)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -29,6 +29,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-stored-chunk"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod: "ListUnreferencedChunks",
+					Use:       "list-unreferenced-chunks",
+					Short:     "List stored chunks that no manifest references, with their prune time",
+				},
+				{
+					RpcMethod: "ListStorageChallenges",
+					Use:       "list-storage-challenges",
+					Short:     "List the storage challenges of the current challenge epoch",
+				},
+				{
+					RpcMethod:      "GetStorageChallenge",
+					Use:            "get-storage-challenge [id]",
+					Short:          "Gets a storage challenge",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "ListStorageReputations",
+					Use:       "list-storage-reputations",
+					Short:     "List the storage reputation of every challenged validator",
+				},
+				{
+					RpcMethod:      "GetStorageReputation",
+					Use:            "get-storage-reputation [validator]",
+					Short:          "Gets the storage reputation of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+				{
+					RpcMethod: "StorageUsage",
+					Use:       "storage-usage",
+					Short:     "Shows the number of stored chunks and the bytes they take in state",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -58,6 +90,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete stored-chunk",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod: "SubmitStorageProof",
+					Skip:      true, // skipped because the proof is computed by the prove-storage command
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
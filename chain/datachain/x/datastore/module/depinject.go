@@ -1,13 +1,20 @@
 package datastore
 
 import (
+	"path/filepath"
+
+	"github.com/spf13/cast"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	"datachain/x/datastore/keeper"
@@ -34,10 +41,12 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper    types.AuthKeeper
+	BankKeeper    types.BankKeeper
+	StakingKeeper types.StakingKeeper
 
 	IBCKeeperFn func() *ibckeeper.Keeper `optional:"true"`
+	AppOpts     servertypes.AppOptions   `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -45,6 +54,7 @@ type ModuleOutputs struct {
 
 	DatastoreKeeper keeper.Keeper
 	Module          appmodule.AppModule
+	EpochHooks      epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -60,8 +70,15 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority,
 		in.IBCKeeperFn,
 		in.BankKeeper,
+		in.StakingKeeper,
 	)
+	// a genesis chunk archive is read from the config directory, next to genesis.json
+	if in.AppOpts != nil {
+		if home := cast.ToString(in.AppOpts.Get(flags.FlagHome)); home != "" {
+			k.SetChunkArchiveDir(filepath.Join(home, "config"))
+		}
+	}
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{DatastoreKeeper: k, Module: m}
+	return ModuleOutputs{DatastoreKeeper: k, Module: m, EpochHooks: epochstypes.EpochHooksWrapper{EpochHooks: k}}
 }
@@ -112,7 +112,11 @@ func (im IBCModule) OnRecvPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	var ack channeltypes.Acknowledgement
+	var (
+		ack       channeltypes.Acknowledgement
+		eventType string
+		recvErr   error
+	)
 
 	var modulePacketData types.DatastorePacketData
 	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
@@ -141,15 +145,96 @@ func (im IBCModule) OnRecvPacket(
 			sdk.NewEvent(
 				types.EventTypeChunkPacket,
 				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
 			),
 		)
+		eventType, recvErr = types.EventTypeChunkPacket, err
+
+	case *types.DatastorePacketData_MetadataPacket:
+		packetAck, err := im.keeper.OnRecvMetadataPacket(ctx, modulePacket, *packet.MetadataPacket)
+		if err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err)
+		} else {
+			// Encode packet acknowledgment using the binary codec, like chunk acks
+			packetAckBytes, err := im.cdc.Marshal(packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(packetAckBytes)
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMetadataPacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+			),
+		)
+		eventType, recvErr = types.EventTypeMetadataPacket, err
+
+	case *types.DatastorePacketData_RetrievalPacket:
+		packetAck, err := im.keeper.OnRecvChunkRetrievalPacket(ctx, modulePacket, *packet.RetrievalPacket)
+		if err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err)
+		} else {
+			packetAckBytes, err := im.cdc.Marshal(packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(packetAckBytes)
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRetrievalPacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+			),
+		)
+		eventType, recvErr = types.EventTypeRetrievalPacket, err
+
+	case *types.DatastorePacketData_ReleasePacket:
+		packetAck, err := im.keeper.OnRecvChunkReleasePacket(ctx, modulePacket, *packet.ReleasePacket)
+		if err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err)
+		} else {
+			packetAckBytes, err := im.cdc.Marshal(packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(packetAckBytes)
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleasePacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+			),
+		)
+		eventType, recvErr = types.EventTypeReleasePacket, err
 
 	default:
 		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packet)
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	event := &types.EventPacketReceived{
+		PacketType:    eventType,
+		SourceChannel: modulePacket.SourceChannel,
+		Sequence:      modulePacket.Sequence,
+		Success:       recvErr == nil,
+	}
+	if recvErr != nil {
+		event.Error = recvErr.Error()
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
 	return ack
 }
 
@@ -181,6 +266,24 @@ func (im IBCModule) OnAcknowledgementPacket(
 			return err
 		}
 		eventType = types.EventTypeChunkPacket
+	case *types.DatastorePacketData_MetadataPacket:
+		err := im.keeper.OnAcknowledgementMetadataPacket(ctx, modulePacket, *packet.MetadataPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeMetadataPacket
+	case *types.DatastorePacketData_RetrievalPacket:
+		err := im.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, modulePacket, *packet.RetrievalPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeRetrievalPacket
+	case *types.DatastorePacketData_ReleasePacket:
+		err := im.keeper.OnAcknowledgementChunkReleasePacket(ctx, modulePacket, *packet.ReleasePacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeReleasePacket
 		// this line is used by starport scaffolding # ibc/packet/module/ack
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -211,7 +314,13 @@ func (im IBCModule) OnAcknowledgementPacket(
 		)
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventPacketAcknowledged{
+		PacketType:    eventType,
+		SourceChannel: modulePacket.SourceChannel,
+		Sequence:      modulePacket.Sequence,
+		Success:       ack.Success(),
+		Error:         ack.GetError(),
+	})
 }
 
 // OnTimeoutPacket implements the IBCModule interface
@@ -226,6 +335,8 @@ func (im IBCModule) OnTimeoutPacket(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
 	}
 
+	var eventType string
+
 	// Dispatch packet
 	switch packet := modulePacketData.Packet.(type) {
 	case *types.DatastorePacketData_ChunkPacket:
@@ -233,11 +344,34 @@ func (im IBCModule) OnTimeoutPacket(
 		if err != nil {
 			return err
 		}
+		eventType = types.EventTypeChunkPacket
+	case *types.DatastorePacketData_MetadataPacket:
+		err := im.keeper.OnTimeoutMetadataPacket(ctx, modulePacket, *packet.MetadataPacket)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeMetadataPacket
+	case *types.DatastorePacketData_RetrievalPacket:
+		err := im.keeper.OnTimeoutChunkRetrievalPacket(ctx, modulePacket, *packet.RetrievalPacket)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeRetrievalPacket
+	case *types.DatastorePacketData_ReleasePacket:
+		err := im.keeper.OnTimeoutChunkReleasePacket(ctx, modulePacket, *packet.ReleasePacket)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeReleasePacket
 		// this line is used by starport scaffolding # ibc/packet/module/timeout
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
 		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventPacketTimedOut{
+		PacketType:    eventType,
+		SourceChannel: modulePacket.SourceChannel,
+		Sequence:      modulePacket.Sequence,
+	})
 }
@@ -0,0 +1,173 @@
+package datastore_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
+	"github.com/stretchr/testify/require"
+
+	"datachain/testutil/sample"
+	"datachain/x/datastore/keeper"
+	datastore "datachain/x/datastore/module"
+	"datachain/x/datastore/types"
+)
+
+// initKeeper returns a datastore keeper holding one chunk, "idx1", on a fresh store.
+func initKeeper(t *testing.T) (sdk.Context, keeper.Keeper, codec.Codec) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(datastore.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		func() *ibckeeper.Keeper { return nil },
+		nil,
+		nil,
+	)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+	require.NoError(t, k.StoredChunk.Set(ctx, "idx1", types.StoredChunk{Index: "idx1", Data: []byte("data"), Creator: sample.AccAddress()}))
+
+	return ctx, k, encCfg.Codec
+}
+
+// recvPackets are one packet of every type the datastore receives, with whether receiving it succeeds.
+var recvPackets = []struct {
+	name      string
+	data      types.DatastorePacketData
+	eventType string
+	success   bool
+}{
+	{
+		name:      "chunk",
+		data:      types.DatastorePacketData{Packet: &types.DatastorePacketData_ChunkPacket{ChunkPacket: &types.ChunkPacketData{Index: "idx1"}}},
+		eventType: types.EventTypeChunkPacket,
+		success:   true,
+	},
+	{
+		name:      "missing chunk",
+		data:      types.DatastorePacketData{Packet: &types.DatastorePacketData_ChunkPacket{ChunkPacket: &types.ChunkPacketData{Index: "idx2"}}},
+		eventType: types.EventTypeChunkPacket,
+	},
+	{
+		name:      "metadata without chunks",
+		data:      types.DatastorePacketData{Packet: &types.DatastorePacketData_MetadataPacket{MetadataPacket: &types.MetadataPacketData{Url: "site/a", Creator: sample.AccAddress()}}},
+		eventType: types.EventTypeMetadataPacket,
+	},
+	{
+		name:      "retrieval",
+		data:      types.DatastorePacketData{Packet: &types.DatastorePacketData_RetrievalPacket{RetrievalPacket: &types.ChunkRetrievalPacketData{Indexes: []string{"idx1"}, Requester: sample.AccAddress()}}},
+		eventType: types.EventTypeRetrievalPacket,
+		success:   true,
+	},
+	{
+		name:      "release",
+		data:      types.DatastorePacketData{Packet: &types.DatastorePacketData_ReleasePacket{ReleasePacket: &types.ChunkReleasePacketData{Url: "site/a", Addresses: []string{"idx1"}}}},
+		eventType: types.EventTypeReleasePacket,
+		success:   true,
+	},
+	{
+		name:      "release without url",
+		data:      types.DatastorePacketData{Packet: &types.DatastorePacketData_ReleasePacket{ReleasePacket: &types.ChunkReleasePacketData{Addresses: []string{"idx1"}}}},
+		eventType: types.EventTypeReleasePacket,
+	},
+}
+
+// typedEvents returns the typed events emitted on ctx, in order.
+func typedEvents(ctx sdk.Context) []proto.Message {
+	var events []proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			// not a typed event
+			continue
+		}
+		events = append(events, msg)
+	}
+	return events
+}
+
+// packetReceived returns the only EventPacketReceived emitted on ctx.
+func packetReceived(t *testing.T, ctx sdk.Context) *types.EventPacketReceived {
+	t.Helper()
+
+	var received []*types.EventPacketReceived
+	for _, msg := range typedEvents(ctx) {
+		if event, ok := msg.(*types.EventPacketReceived); ok {
+			received = append(received, event)
+		}
+	}
+	require.Len(t, received, 1)
+	return received[0]
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	for _, tc := range recvPackets {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, cdc := initKeeper(t)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			data, err := tc.data.Marshal()
+			require.NoError(t, err)
+			packet := channeltypes.Packet{Sequence: 7, SourcePort: types.PortID, SourceChannel: "channel-0", DestinationPort: types.PortID, DestinationChannel: "channel-1", Data: data}
+
+			ack := datastore.NewIBCModule(cdc, k).OnRecvPacket(ctx, types.Version, packet, nil)
+			require.Equal(t, tc.success, ack.Success())
+
+			event := packetReceived(t, ctx)
+			require.Equal(t, tc.eventType, event.PacketType)
+			require.Equal(t, "channel-0", event.SourceChannel)
+			require.Equal(t, uint64(7), event.Sequence)
+			require.Equal(t, tc.success, event.Success)
+			require.Equal(t, tc.success, event.Error == "")
+		})
+	}
+}
+
+func TestOnRecvPacketUndecodable(t *testing.T) {
+	ctx, k, cdc := initKeeper(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	packet := channeltypes.Packet{Sequence: 1, SourceChannel: "channel-0", Data: []byte("not a packet")}
+
+	ack := datastore.NewIBCModule(cdc, k).OnRecvPacket(ctx, types.Version, packet, nil)
+	require.False(t, ack.Success())
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestOnAcknowledgementAndTimeoutPacket(t *testing.T) {
+	ctx, k, cdc := initKeeper(t)
+	im := datastore.NewIBCModule(cdc, k)
+	data, err := types.ChunkPacketData{Index: "idx1"}.GetBytes()
+	require.NoError(t, err)
+	packet := channeltypes.Packet{Sequence: 3, SourceChannel: "channel-0", Data: data}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrChunkNotFound)
+	require.NoError(t, im.OnAcknowledgementPacket(ctx, types.Version, packet, ack.Acknowledgement(), nil))
+	require.Contains(t, typedEvents(ctx), &types.EventPacketAcknowledged{
+		PacketType:    types.EventTypeChunkPacket,
+		SourceChannel: "channel-0",
+		Sequence:      3,
+		Error:         ack.GetError(),
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, im.OnTimeoutPacket(ctx, types.Version, packet, nil))
+	require.Contains(t, typedEvents(ctx), &types.EventPacketTimedOut{PacketType: types.EventTypeChunkPacket, SourceChannel: "channel-0", Sequence: 3})
+
+	require.Error(t, im.OnAcknowledgementPacket(ctx, types.Version, packet, []byte("not an ack"), nil))
+}
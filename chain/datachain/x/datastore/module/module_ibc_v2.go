@@ -0,0 +1,273 @@
+package datastore
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"datachain/x/datastore/keeper"
+	"datachain/x/datastore/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+var _ ibcapi.IBCModule = (*IBCModuleV2)(nil)
+
+// IBCModuleV2 implements the IBC v2 application callbacks. Payloads carry the same
+// DatastorePacketData as channel packets, and are handed to the same keeper callbacks.
+type IBCModuleV2 struct {
+	cdc    codec.Codec
+	keeper keeper.Keeper
+}
+
+// NewIBCModuleV2 creates a new IBCModuleV2 given the associated keeper
+func NewIBCModuleV2(cdc codec.Codec, k keeper.Keeper) *IBCModuleV2 {
+	return &IBCModuleV2{
+		cdc:    cdc,
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBC v2 IBCModule interface
+func (im *IBCModuleV2) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	modulePacketData, err := unmarshalPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	// metadata, retrieval and release packets come from metachain, datachain never originates them
+	if _, ok := modulePacketData.Packet.(*types.DatastorePacketData_ChunkPacket); !ok {
+		return errorsmod.Wrapf(channeltypesv2.ErrInvalidPacket, "%s cannot send %T", types.ModuleName, modulePacketData.Packet)
+	}
+
+	return nil
+}
+
+// OnRecvPacket implements the IBC v2 IBCModule interface
+func (im *IBCModuleV2) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	modulePacketData, err := unmarshalPayload(payload)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("%s sequence %d", err.Error(), sequence))
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	modulePacket := packetFromPayload(sourceClient, destinationClient, sequence, payload)
+
+	var (
+		eventType string
+		packetAck gogoproto.Message
+	)
+
+	// Dispatch packet
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.DatastorePacketData_MetadataPacket:
+		eventType = types.EventTypeMetadataPacket
+		packetAck, err = im.keeper.OnRecvMetadataPacket(ctx, modulePacket, *packet.MetadataPacket)
+	case *types.DatastorePacketData_ChunkPacket:
+		eventType = types.EventTypeChunkPacket
+		packetAck, err = im.keeper.OnRecvChunkPacket(ctx, modulePacket, *packet.ChunkPacket)
+	case *types.DatastorePacketData_RetrievalPacket:
+		eventType = types.EventTypeRetrievalPacket
+		packetAck, err = im.keeper.OnRecvChunkRetrievalPacket(ctx, modulePacket, *packet.RetrievalPacket)
+	case *types.DatastorePacketData_ReleasePacket:
+		eventType = types.EventTypeReleasePacket
+		packetAck, err = im.keeper.OnRecvChunkReleasePacket(ctx, modulePacket, *packet.ReleasePacket)
+	default:
+		ctx.Logger().Error(fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet))
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	// IBC v2 replaces error acks with a sentinel, so the reason only survives in the event
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyAckError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+
+	event := &types.EventPacketReceived{
+		PacketType:    eventType,
+		SourceChannel: sourceClient,
+		Sequence:      sequence,
+		Success:       err == nil,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	if emitErr := ctx.EventManager().EmitTypedEvent(event); emitErr != nil {
+		ctx.Logger().Error(fmt.Sprintf("cannot emit %s event: %s", types.ModuleName, emitErr.Error()))
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	if err != nil {
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	packetAckBytes, err := im.cdc.Marshal(packetAck)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("cannot marshal %s acknowledgement: %s", types.ModuleName, err.Error()))
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: channeltypes.NewResultAcknowledgement(packetAckBytes).Acknowledgement(),
+	}
+}
+
+// OnAcknowledgementPacket implements the IBC v2 IBCModule interface
+func (im *IBCModuleV2) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		ack = channeltypes.NewErrorAcknowledgement(types.ErrPacketFailed)
+	} else if err := im.cdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	modulePacketData, err := unmarshalPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	modulePacket := packetFromPayload(sourceClient, destinationClient, sequence, payload)
+
+	var eventType string
+
+	// Dispatch packet
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.DatastorePacketData_ChunkPacket:
+		eventType = types.EventTypeChunkPacket
+		err = im.keeper.OnAcknowledgementChunkPacket(ctx, modulePacket, *packet.ChunkPacket, ack)
+	case *types.DatastorePacketData_MetadataPacket:
+		eventType = types.EventTypeMetadataPacket
+		err = im.keeper.OnAcknowledgementMetadataPacket(ctx, modulePacket, *packet.MetadataPacket, ack)
+	case *types.DatastorePacketData_RetrievalPacket:
+		eventType = types.EventTypeRetrievalPacket
+		err = im.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, modulePacket, *packet.RetrievalPacket, ack)
+	case *types.DatastorePacketData_ReleasePacket:
+		eventType = types.EventTypeReleasePacket
+		err = im.keeper.OnAcknowledgementChunkReleasePacket(ctx, modulePacket, *packet.ReleasePacket, ack)
+	default:
+		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPacketAcknowledged{
+		PacketType:    eventType,
+		SourceChannel: sourceClient,
+		Sequence:      sequence,
+		Success:       ack.Success(),
+		Error:         ack.GetError(),
+	})
+}
+
+// OnTimeoutPacket implements the IBC v2 IBCModule interface
+func (im *IBCModuleV2) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	modulePacketData, err := unmarshalPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	modulePacket := packetFromPayload(sourceClient, destinationClient, sequence, payload)
+
+	var eventType string
+
+	// Dispatch packet
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.DatastorePacketData_ChunkPacket:
+		eventType = types.EventTypeChunkPacket
+		err = im.keeper.OnTimeoutChunkPacket(ctx, modulePacket, *packet.ChunkPacket)
+	case *types.DatastorePacketData_MetadataPacket:
+		eventType = types.EventTypeMetadataPacket
+		err = im.keeper.OnTimeoutMetadataPacket(ctx, modulePacket, *packet.MetadataPacket)
+	case *types.DatastorePacketData_RetrievalPacket:
+		eventType = types.EventTypeRetrievalPacket
+		err = im.keeper.OnTimeoutChunkRetrievalPacket(ctx, modulePacket, *packet.RetrievalPacket)
+	case *types.DatastorePacketData_ReleasePacket:
+		eventType = types.EventTypeReleasePacket
+		err = im.keeper.OnTimeoutChunkReleasePacket(ctx, modulePacket, *packet.ReleasePacket)
+	default:
+		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPacketTimedOut{
+		PacketType:    eventType,
+		SourceChannel: sourceClient,
+		Sequence:      sequence,
+	})
+}
+
+// unmarshalPayload checks the payload version and encoding before decoding its packet data.
+func unmarshalPayload(payload channeltypesv2.Payload) (types.DatastorePacketData, error) {
+	var modulePacketData types.DatastorePacketData
+
+	if payload.Version != types.Version {
+		return modulePacketData, errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", payload.Version, types.Version)
+	}
+	if payload.Encoding != types.EncodingProtobuf {
+		return modulePacketData, errorsmod.Wrapf(channeltypesv2.ErrInvalidPacket, "unsupported encoding %s, expected %s", payload.Encoding, types.EncodingProtobuf)
+	}
+	if err := modulePacketData.Unmarshal(payload.Value); err != nil {
+		return modulePacketData, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	return modulePacketData, nil
+}
+
+// packetFromPayload describes a v2 payload as the channel packet the keeper callbacks take.
+// Client identifiers stand in for the channel identifiers.
+func packetFromPayload(sourceClient, destinationClient string, sequence uint64, payload channeltypesv2.Payload) channeltypes.Packet {
+	return channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         payload.SourcePort,
+		SourceChannel:      sourceClient,
+		DestinationPort:    payload.DestinationPort,
+		DestinationChannel: destinationClient,
+		Data:               payload.Value,
+	}
+}
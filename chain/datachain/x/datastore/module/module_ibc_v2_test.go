@@ -0,0 +1,104 @@
+package datastore_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/stretchr/testify/require"
+
+	datastore "datachain/x/datastore/module"
+	"datachain/x/datastore/types"
+)
+
+func payload(t *testing.T, data types.DatastorePacketData) channeltypesv2.Payload {
+	t.Helper()
+
+	value, err := data.Marshal()
+	require.NoError(t, err)
+	return channeltypesv2.NewPayload(types.PortID, types.PortID, types.Version, types.EncodingProtobuf, value)
+}
+
+func TestOnRecvPacketV2(t *testing.T) {
+	for _, tc := range recvPackets {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, cdc := initKeeper(t)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			result := datastore.NewIBCModuleV2(cdc, k).OnRecvPacket(ctx, "07-tendermint-0", "07-tendermint-1", 7, payload(t, tc.data), nil)
+			if tc.success {
+				require.Equal(t, channeltypesv2.PacketStatus_Success, result.Status)
+				require.NotEmpty(t, result.Acknowledgement)
+			} else {
+				require.Equal(t, channeltypesv2.PacketStatus_Failure, result.Status)
+			}
+
+			event := packetReceived(t, ctx)
+			require.Equal(t, tc.eventType, event.PacketType)
+			require.Equal(t, "07-tendermint-0", event.SourceChannel)
+			require.Equal(t, uint64(7), event.Sequence)
+			require.Equal(t, tc.success, event.Success)
+			require.Equal(t, tc.success, event.Error == "")
+		})
+	}
+}
+
+func TestOnRecvPacketV2Payload(t *testing.T) {
+	valid := payload(t, recvPackets[0].data)
+	for _, tc := range []struct {
+		name   string
+		modify func(*channeltypesv2.Payload)
+	}{
+		{name: "version", modify: func(p *channeltypesv2.Payload) { p.Version = "ibc-proto-0" }},
+		{name: "encoding", modify: func(p *channeltypesv2.Payload) { p.Encoding = "application/json" }},
+		{name: "value", modify: func(p *channeltypesv2.Payload) { p.Value = []byte("not a packet") }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, cdc := initKeeper(t)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			p := valid
+			tc.modify(&p)
+
+			result := datastore.NewIBCModuleV2(cdc, k).OnRecvPacket(ctx, "07-tendermint-0", "07-tendermint-1", 1, p, nil)
+			require.Equal(t, channeltypesv2.PacketStatus_Failure, result.Status)
+			require.Empty(t, ctx.EventManager().Events())
+		})
+	}
+}
+
+func TestOnSendPacketV2(t *testing.T) {
+	ctx, k, cdc := initKeeper(t)
+	im := datastore.NewIBCModuleV2(cdc, k)
+
+	// datachain only ever sends chunk packets
+	for _, tc := range recvPackets {
+		_, isChunk := tc.data.Packet.(*types.DatastorePacketData_ChunkPacket)
+		err := im.OnSendPacket(ctx, "07-tendermint-0", "07-tendermint-1", 1, payload(t, tc.data), nil)
+		if isChunk {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, channeltypesv2.ErrInvalidPacket, tc.name)
+		}
+	}
+}
+
+func TestOnAcknowledgementAndTimeoutPacketV2(t *testing.T) {
+	ctx, k, cdc := initKeeper(t)
+	im := datastore.NewIBCModuleV2(cdc, k)
+	p := payload(t, recvPackets[0].data)
+	failed := channeltypes.NewErrorAcknowledgement(types.ErrPacketFailed)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, im.OnAcknowledgementPacket(ctx, "07-tendermint-0", "07-tendermint-1", 3, channeltypesv2.ErrorAcknowledgement[:], p, nil))
+	require.Contains(t, typedEvents(ctx), &types.EventPacketAcknowledged{
+		PacketType:    types.EventTypeChunkPacket,
+		SourceChannel: "07-tendermint-0",
+		Sequence:      3,
+		Error:         failed.GetError(),
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, im.OnTimeoutPacket(ctx, "07-tendermint-0", "07-tendermint-1", 3, p, nil))
+	require.Contains(t, typedEvents(ctx), &types.EventPacketTimedOut{PacketType: types.EventTypeChunkPacket, SourceChannel: "07-tendermint-0", Sequence: 3})
+}
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"path/filepath"
+
+	protoio "github.com/cosmos/gogoproto/io"
+)
+
+const (
+	// chunkArchiveMagic starts every chunk archive and versions its format.
+	chunkArchiveMagic = "datastore-chunks/v1\n"
+	// MaxChunkArchiveEntrySize bounds a single encoded entry of a chunk archive.
+	MaxChunkArchiveEntrySize = 256 << 20
+)
+
+// ChunkArchiveWriter writes stored chunks as a chunk archive: a magic line followed by one
+// length-prefixed ChunkArchiveEntry per chunk, in ascending index order.
+type ChunkArchiveWriter struct {
+	hash   hash.Hash
+	out    io.Writer
+	w      protoio.Writer
+	count  uint64
+	last   string
+	header bool
+}
+
+// NewChunkArchiveWriter returns a writer appending a chunk archive to w.
+func NewChunkArchiveWriter(w io.Writer) *ChunkArchiveWriter {
+	h := sha256.New()
+	out := io.MultiWriter(w, h)
+	return &ChunkArchiveWriter{
+		hash: h,
+		out:  out,
+		w:    protoio.NewDelimitedWriter(out),
+	}
+}
+
+// Write appends chunk to the archive. Chunks must be written in ascending index order.
+func (w *ChunkArchiveWriter) Write(chunk StoredChunk) error {
+	if w.count > 0 && chunk.Index <= w.last {
+		return fmt.Errorf("chunk %q written after %q", chunk.Index, w.last)
+	}
+	if !w.header {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+	}
+
+	entry, err := NewChunkArchiveEntry(chunk)
+	if err != nil {
+		return err
+	}
+	if err := w.w.WriteMsg(&entry); err != nil {
+		return err
+	}
+
+	w.count++
+	w.last = chunk.Index
+	return nil
+}
+
+// Close writes the archive header if no chunk was written. It does not close the underlying
+// writer.
+func (w *ChunkArchiveWriter) Close() error {
+	if w.header {
+		return nil
+	}
+	return w.writeHeader()
+}
+
+func (w *ChunkArchiveWriter) writeHeader() error {
+	w.header = true
+	_, err := io.WriteString(w.out, chunkArchiveMagic)
+	return err
+}
+
+// Archive describes the archive written so far as the file name.
+func (w *ChunkArchiveWriter) Archive(file string) ChunkArchive {
+	return ChunkArchive{File: file, Chunks: w.count, Sha256: hex.EncodeToString(w.hash.Sum(nil))}
+}
+
+// ChunkArchiveReader reads the chunks of a chunk archive, verifying the checksum and order of
+// each entry.
+type ChunkArchiveReader struct {
+	hash   hash.Hash
+	raw    io.Reader
+	r      protoio.Reader
+	count  uint64
+	last   string
+	header bool
+}
+
+// NewChunkArchiveReader returns a reader of the chunk archive read from r.
+func NewChunkArchiveReader(r io.Reader) *ChunkArchiveReader {
+	h := sha256.New()
+	return &ChunkArchiveReader{hash: h, raw: io.TeeReader(r, h)}
+}
+
+// Next returns the next chunk of the archive, or io.EOF once every chunk was read.
+func (r *ChunkArchiveReader) Next() (StoredChunk, error) {
+	if !r.header {
+		magic := make([]byte, len(chunkArchiveMagic))
+		if _, err := io.ReadFull(r.raw, magic); err != nil {
+			return StoredChunk{}, fmt.Errorf("read chunk archive header: %w", err)
+		}
+		if !bytes.Equal(magic, []byte(chunkArchiveMagic)) {
+			return StoredChunk{}, errors.New("not a chunk archive")
+		}
+		r.header = true
+		r.r = protoio.NewDelimitedReader(r.raw, MaxChunkArchiveEntrySize)
+	}
+
+	var entry ChunkArchiveEntry
+	if err := r.r.ReadMsg(&entry); err != nil {
+		if errors.Is(err, io.EOF) {
+			return StoredChunk{}, io.EOF
+		}
+		return StoredChunk{}, fmt.Errorf("read chunk archive entry %d: %w", r.count, err)
+	}
+
+	if err := entry.Verify(); err != nil {
+		return StoredChunk{}, err
+	}
+	if r.count > 0 && entry.Chunk.Index <= r.last {
+		return StoredChunk{}, fmt.Errorf("chunk %q out of order after %q", entry.Chunk.Index, r.last)
+	}
+
+	r.count++
+	r.last = entry.Chunk.Index
+	return entry.Chunk, nil
+}
+
+// Verify checks that the chunks read so far and the bytes consumed match archive. It is meant to
+// be called once Next returned io.EOF.
+func (r *ChunkArchiveReader) Verify(archive ChunkArchive) error {
+	if r.count != archive.Chunks {
+		return fmt.Errorf("chunk archive %s holds %d chunks, expected %d", archive.File, r.count, archive.Chunks)
+	}
+	if sum := hex.EncodeToString(r.hash.Sum(nil)); sum != archive.Sha256 {
+		return fmt.Errorf("chunk archive %s has sha256 %s, expected %s", archive.File, sum, archive.Sha256)
+	}
+	return nil
+}
+
+// NewChunkArchiveEntry returns the entry of chunk, checksummed.
+func NewChunkArchiveEntry(chunk StoredChunk) (ChunkArchiveEntry, error) {
+	bz, err := chunk.Marshal()
+	if err != nil {
+		return ChunkArchiveEntry{}, err
+	}
+	checksum := sha256.Sum256(bz)
+	return ChunkArchiveEntry{Chunk: chunk, Checksum: checksum[:]}, nil
+}
+
+// Verify checks the chunk of the entry against its checksum.
+func (e ChunkArchiveEntry) Verify() error {
+	bz, err := e.Chunk.Marshal()
+	if err != nil {
+		return err
+	}
+	if checksum := sha256.Sum256(bz); !bytes.Equal(checksum[:], e.Checksum) {
+		return fmt.Errorf("checksum mismatch for chunk %q", e.Chunk.Index)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the archive description.
+func (a ChunkArchive) Validate() error {
+	if a.File == "" || filepath.Base(a.File) != a.File || a.File == "." || a.File == ".." {
+		return fmt.Errorf("invalid chunk archive file %q, expected a file name", a.File)
+	}
+	if sum, err := hex.DecodeString(a.Sha256); err != nil || len(sum) != sha256.Size {
+		return fmt.Errorf("invalid chunk archive sha256 %q", a.Sha256)
+	}
+	return nil
+}
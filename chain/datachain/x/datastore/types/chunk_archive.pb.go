@@ -0,0 +1,919 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: datachain/datastore/v1/chunk_archive.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChunkArchive points the genesis at an archive of stored chunks kept next to
+// the genesis file, for chunk stores too large to inline in stored_chunk_map.
+type ChunkArchive struct {
+	// file is the name of the archive in the node's config directory.
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// chunks is the number of chunks in the archive.
+	Chunks uint64 `protobuf:"varint,2,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// sha256 is the hex encoded SHA-256 of the whole archive file.
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (m *ChunkArchive) Reset()         { *m = ChunkArchive{} }
+func (m *ChunkArchive) String() string { return proto.CompactTextString(m) }
+func (*ChunkArchive) ProtoMessage()    {}
+func (*ChunkArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94cea8ef35bd72b4, []int{0}
+}
+func (m *ChunkArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkArchive.Merge(m, src)
+}
+func (m *ChunkArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkArchive proto.InternalMessageInfo
+
+func (m *ChunkArchive) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *ChunkArchive) GetChunks() uint64 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *ChunkArchive) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+// ChunkArchiveEntry is one length-prefixed record of a chunk archive.
+type ChunkArchiveEntry struct {
+	Chunk StoredChunk `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk"`
+	// checksum is the SHA-256 of the protobuf encoding of chunk.
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *ChunkArchiveEntry) Reset()         { *m = ChunkArchiveEntry{} }
+func (m *ChunkArchiveEntry) String() string { return proto.CompactTextString(m) }
+func (*ChunkArchiveEntry) ProtoMessage()    {}
+func (*ChunkArchiveEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94cea8ef35bd72b4, []int{1}
+}
+func (m *ChunkArchiveEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkArchiveEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkArchiveEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkArchiveEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkArchiveEntry.Merge(m, src)
+}
+func (m *ChunkArchiveEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkArchiveEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkArchiveEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkArchiveEntry proto.InternalMessageInfo
+
+func (m *ChunkArchiveEntry) GetChunk() StoredChunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return StoredChunk{}
+}
+
+func (m *ChunkArchiveEntry) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+// ChunkSnapshotSegment is one payload of the datastore state-sync snapshot
+// extension, holding consecutive stored chunks in ascending index order.
+type ChunkSnapshotSegment struct {
+	// sequence numbers the segments of a snapshot from zero.
+	Sequence uint64              `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Entries  []ChunkArchiveEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+	// after is the index of the last chunk of the previous segment, empty for
+	// the first one. The segment holds every stored chunk from after up to its
+	// last entry, so it can be checked without the segments before it.
+	After string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (m *ChunkSnapshotSegment) Reset()         { *m = ChunkSnapshotSegment{} }
+func (m *ChunkSnapshotSegment) String() string { return proto.CompactTextString(m) }
+func (*ChunkSnapshotSegment) ProtoMessage()    {}
+func (*ChunkSnapshotSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94cea8ef35bd72b4, []int{2}
+}
+func (m *ChunkSnapshotSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkSnapshotSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkSnapshotSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkSnapshotSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkSnapshotSegment.Merge(m, src)
+}
+func (m *ChunkSnapshotSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkSnapshotSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkSnapshotSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkSnapshotSegment proto.InternalMessageInfo
+
+func (m *ChunkSnapshotSegment) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ChunkSnapshotSegment) GetEntries() []ChunkArchiveEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ChunkSnapshotSegment) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ChunkArchive)(nil), "datachain.datastore.v1.ChunkArchive")
+	proto.RegisterType((*ChunkArchiveEntry)(nil), "datachain.datastore.v1.ChunkArchiveEntry")
+	proto.RegisterType((*ChunkSnapshotSegment)(nil), "datachain.datastore.v1.ChunkSnapshotSegment")
+}
+
+func init() {
+	proto.RegisterFile("datachain/datastore/v1/chunk_archive.proto", fileDescriptor_94cea8ef35bd72b4)
+}
+
+var fileDescriptor_94cea8ef35bd72b4 = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xc1, 0x4e, 0xf2, 0x40,
+	0x18, 0xec, 0xfe, 0x14, 0x7e, 0x5d, 0xb8, 0xb8, 0x21, 0x84, 0x60, 0x52, 0x09, 0x5e, 0xc0, 0x43,
+	0x1b, 0x30, 0x78, 0x35, 0x62, 0x3c, 0x78, 0x2d, 0x37, 0x2f, 0x64, 0x2d, 0x1f, 0xb4, 0x41, 0xb6,
+	0xb5, 0xbb, 0x10, 0x79, 0x0b, 0x13, 0x5f, 0x8a, 0x23, 0x47, 0x4f, 0xc6, 0xc0, 0x8b, 0x98, 0xfd,
+	0xb6, 0x56, 0x12, 0xe5, 0x36, 0xb3, 0x99, 0x99, 0xce, 0xf4, 0xa3, 0x17, 0x63, 0xae, 0x78, 0x10,
+	0xf2, 0x48, 0x78, 0x1a, 0x49, 0x15, 0xa7, 0xe0, 0x2d, 0xbb, 0x5e, 0x10, 0x2e, 0xc4, 0x6c, 0xc4,
+	0xd3, 0x20, 0x8c, 0x96, 0xe0, 0x26, 0x69, 0xac, 0x62, 0x56, 0xcb, 0xb5, 0x6e, 0xae, 0x75, 0x97,
+	0xdd, 0x46, 0xe7, 0x40, 0x06, 0x82, 0xf1, 0x08, 0xa3, 0x4c, 0x44, 0xa3, 0x3a, 0x8d, 0xa7, 0x31,
+	0x42, 0x4f, 0x23, 0xf3, 0xda, 0xf2, 0x69, 0xe5, 0x56, 0x8b, 0x6e, 0xcc, 0xe7, 0x18, 0xa3, 0xf6,
+	0x24, 0x7a, 0x82, 0x3a, 0x69, 0x92, 0xf6, 0xb1, 0x8f, 0x98, 0xd5, 0x68, 0x09, 0x83, 0x64, 0xfd,
+	0x5f, 0x93, 0xb4, 0x6d, 0x3f, 0x63, 0xfa, 0x5d, 0x86, 0xbc, 0xd7, 0xbf, 0xaa, 0x17, 0x50, 0x9d,
+	0xb1, 0x56, 0x42, 0x4f, 0xf6, 0x33, 0xef, 0x84, 0x4a, 0x57, 0xec, 0x9a, 0x16, 0xd1, 0x86, 0xc9,
+	0xe5, 0xde, 0xb9, 0xfb, 0xf7, 0x22, 0x77, 0x88, 0xcd, 0xd1, 0x3f, 0xb0, 0xd7, 0x1f, 0x67, 0x96,
+	0x6f, 0x7c, 0xac, 0x41, 0x8f, 0x82, 0x10, 0x82, 0x99, 0x5c, 0xcc, 0xb1, 0x47, 0xc5, 0xcf, 0x79,
+	0xeb, 0x8d, 0xd0, 0x2a, 0x5a, 0x86, 0x82, 0x27, 0x32, 0x8c, 0xd5, 0x10, 0xa6, 0x73, 0x10, 0x4a,
+	0x9b, 0x24, 0x3c, 0x2f, 0x40, 0x04, 0x66, 0x92, 0xed, 0xe7, 0x9c, 0xdd, 0xd3, 0xff, 0x20, 0x54,
+	0x1a, 0x81, 0xde, 0x55, 0x68, 0x97, 0x7b, 0x9d, 0x43, 0x9d, 0x7e, 0xad, 0xc9, 0x9a, 0x7d, 0xfb,
+	0x59, 0x95, 0x16, 0xf9, 0x44, 0x41, 0x9a, 0xfd, 0x08, 0x43, 0x06, 0xfd, 0xf5, 0xd6, 0x21, 0x9b,
+	0xad, 0x43, 0x3e, 0xb7, 0x0e, 0x79, 0xdd, 0x39, 0xd6, 0x66, 0xe7, 0x58, 0xef, 0x3b, 0xc7, 0x7a,
+	0x38, 0xfd, 0x39, 0xdb, 0xcb, 0xde, 0xe1, 0xd4, 0x2a, 0x01, 0xf9, 0x58, 0xc2, 0xcb, 0x5c, 0x7e,
+	0x0d, 0x00, 0xc4, 0x85, 0x10, 0x73, 0x20, 0x02, 0x00, 0x00,
+}
+
+func (m *ChunkArchive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkArchive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintChunkArchive(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintChunkArchive(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintChunkArchive(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkArchiveEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkArchiveEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkArchiveEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintChunkArchive(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChunkArchive(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkSnapshotSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkSnapshotSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkSnapshotSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.After) > 0 {
+		i -= len(m.After)
+		copy(dAtA[i:], m.After)
+		i = encodeVarintChunkArchive(dAtA, i, uint64(len(m.After)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChunkArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintChunkArchive(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChunkArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChunkArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChunkArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovChunkArchive(uint64(l))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovChunkArchive(uint64(m.Chunks))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovChunkArchive(uint64(l))
+	}
+	return n
+}
+
+func (m *ChunkArchiveEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chunk.Size()
+	n += 1 + l + sovChunkArchive(uint64(l))
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovChunkArchive(uint64(l))
+	}
+	return n
+}
+
+func (m *ChunkSnapshotSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovChunkArchive(uint64(m.Sequence))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovChunkArchive(uint64(l))
+		}
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovChunkArchive(uint64(l))
+	}
+	return n
+}
+
+func sovChunkArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChunkArchive(x uint64) (n int) {
+	return sovChunkArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChunkArchive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChunkArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkArchive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkArchive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChunkArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkArchiveEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChunkArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkArchiveEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkArchiveEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChunkArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkSnapshotSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChunkArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkSnapshotSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkSnapshotSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, ChunkArchiveEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChunkArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChunkArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChunkArchive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChunkArchive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChunkArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChunkArchive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChunkArchive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChunkArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChunkArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChunkArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChunkArchive = fmt.Errorf("proto: unexpected end of group")
+)
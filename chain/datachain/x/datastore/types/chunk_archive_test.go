@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"datachain/x/datastore/types"
+)
+
+func readChunkArchive(r *types.ChunkArchiveReader) ([]types.StoredChunk, error) {
+	var chunks []types.StoredChunk
+	for {
+		chunk, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return chunks, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, chunk)
+	}
+}
+
+func TestChunkArchive(t *testing.T) {
+	chunks := []types.StoredChunk{
+		{Index: "0", Data: []byte("zero"), Creator: "alice"},
+		{Index: "1", Data: []byte("one"), Creator: "bob"},
+	}
+
+	var buf bytes.Buffer
+	w := types.NewChunkArchiveWriter(&buf)
+	for _, chunk := range chunks {
+		require.NoError(t, w.Write(chunk))
+	}
+	require.NoError(t, w.Close())
+	require.Error(t, w.Write(chunks[0]), "chunks must be written in ascending order")
+	archive := w.Archive("chunks.bin")
+	require.NoError(t, archive.Validate())
+
+	t.Run("round trip", func(t *testing.T) {
+		r := types.NewChunkArchiveReader(bytes.NewReader(buf.Bytes()))
+		got, err := readChunkArchive(r)
+		require.NoError(t, err)
+		require.Equal(t, chunks, got)
+		require.NoError(t, r.Verify(archive))
+	})
+
+	t.Run("empty archive", func(t *testing.T) {
+		var empty bytes.Buffer
+		w := types.NewChunkArchiveWriter(&empty)
+		require.NoError(t, w.Close())
+
+		r := types.NewChunkArchiveReader(&empty)
+		got, err := readChunkArchive(r)
+		require.NoError(t, err)
+		require.Empty(t, got)
+		require.NoError(t, r.Verify(w.Archive("empty.bin")))
+	})
+
+	t.Run("corrupted entry", func(t *testing.T) {
+		corrupted := bytes.Clone(buf.Bytes())
+		i := bytes.Index(corrupted, []byte("zero"))
+		corrupted[i] = 'Z'
+
+		_, err := readChunkArchive(types.NewChunkArchiveReader(bytes.NewReader(corrupted)))
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+
+	t.Run("truncated archive", func(t *testing.T) {
+		r := types.NewChunkArchiveReader(bytes.NewReader(buf.Bytes()[:buf.Len()-3]))
+		_, err := readChunkArchive(r)
+		require.Error(t, err)
+	})
+
+	t.Run("missing entries", func(t *testing.T) {
+		var partial bytes.Buffer
+		w := types.NewChunkArchiveWriter(&partial)
+		require.NoError(t, w.Write(chunks[0]))
+
+		r := types.NewChunkArchiveReader(&partial)
+		_, err := readChunkArchive(r)
+		require.NoError(t, err)
+		require.Error(t, r.Verify(archive))
+	})
+
+	t.Run("not an archive", func(t *testing.T) {
+		_, err := readChunkArchive(types.NewChunkArchiveReader(bytes.NewReader([]byte("{}"))))
+		require.Error(t, err)
+	})
+}
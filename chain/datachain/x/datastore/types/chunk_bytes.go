@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgChunkBytes returns the chunk data carried by msg, 0 for the messages that carry none. It
+// counts the messages of the module only, chunks.ChunkBytes walks the ones executed through
+// authz.
+func MsgChunkBytes(msg sdk.Msg) uint64 {
+	switch msg := msg.(type) {
+	case *MsgCreateStoredChunk:
+		return uint64(len(msg.Data))
+	case *MsgUpdateStoredChunk:
+		return uint64(len(msg.Data))
+	case *MsgSendChunk:
+		return uint64(len(msg.Data))
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// MaxChunkDataSize bounds the size chunk data may decompress to.
+const MaxChunkDataSize = 256 << 20
+
+// The compressed data is part of the consensus state: both codecs produce the same output for the
+// same input, and every node must run the same codec versions.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(MaxChunkDataSize))
+)
+
+// Validate checks that the codec is known.
+func (c ChunkCodec) Validate() error {
+	if _, ok := ChunkCodec_name[int32(c)]; !ok {
+		return fmt.Errorf("unknown chunk codec %d", c)
+	}
+	return nil
+}
+
+// CompressChunkData compresses data with codec. It returns the data as is, with
+// CHUNK_CODEC_NONE, when the compressed data is not smaller.
+func CompressChunkData(codec ChunkCodec, data []byte) (ChunkCodec, []byte, error) {
+	var compressed []byte
+	switch codec {
+	case ChunkCodec_CHUNK_CODEC_NONE:
+		return ChunkCodec_CHUNK_CODEC_NONE, data, nil
+	case ChunkCodec_CHUNK_CODEC_ZSTD:
+		compressed = zstdEncoder.EncodeAll(data, nil)
+	case ChunkCodec_CHUNK_CODEC_SNAPPY:
+		compressed = snappy.Encode(nil, data)
+	default:
+		return 0, nil, fmt.Errorf("unknown chunk codec %d", codec)
+	}
+
+	if len(compressed) >= len(data) {
+		return ChunkCodec_CHUNK_CODEC_NONE, data, nil
+	}
+	return codec, compressed, nil
+}
+
+// DecompressChunkData reverses CompressChunkData.
+func DecompressChunkData(codec ChunkCodec, data []byte) ([]byte, error) {
+	switch codec {
+	case ChunkCodec_CHUNK_CODEC_NONE:
+		return data, nil
+	case ChunkCodec_CHUNK_CODEC_ZSTD:
+		return zstdDecoder.DecodeAll(data, nil)
+	case ChunkCodec_CHUNK_CODEC_SNAPPY:
+		n, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+		if n > MaxChunkDataSize {
+			return nil, fmt.Errorf("snappy data decompresses to %d bytes, more than %d", n, MaxChunkDataSize)
+		}
+		return snappy.Decode(nil, data)
+	default:
+		return nil, fmt.Errorf("unknown chunk codec %d", codec)
+	}
+}
+
+// Decompressed returns the chunk with its data decompressed.
+func (c StoredChunk) Decompressed() (StoredChunk, error) {
+	data, err := DecompressChunkData(c.Codec, c.Data)
+	if err != nil {
+		return StoredChunk{}, fmt.Errorf("chunk %q: %w", c.Index, err)
+	}
+	c.Data, c.Codec = data, ChunkCodec_CHUNK_CODEC_NONE
+	return c, nil
+}
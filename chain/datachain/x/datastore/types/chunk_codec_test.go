@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"datachain/x/datastore/types"
+)
+
+func TestChunkCodec(t *testing.T) {
+	text := bytes.Repeat([]byte(`{"key": "value"}`), 100)
+
+	for _, codec := range []types.ChunkCodec{types.ChunkCodec_CHUNK_CODEC_ZSTD, types.ChunkCodec_CHUNK_CODEC_SNAPPY} {
+		t.Run(codec.String(), func(t *testing.T) {
+			got, compressed, err := types.CompressChunkData(codec, text)
+			require.NoError(t, err)
+			require.Equal(t, codec, got)
+			require.Less(t, len(compressed), len(text))
+
+			chunk, err := types.StoredChunk{Index: "a", Data: compressed, Codec: codec}.Decompressed()
+			require.NoError(t, err)
+			require.Equal(t, types.StoredChunk{Index: "a", Data: text}, chunk)
+
+			// data that compression does not shrink is kept as is
+			got, kept, err := types.CompressChunkData(codec, []byte("ab"))
+			require.NoError(t, err)
+			require.Equal(t, types.ChunkCodec_CHUNK_CODEC_NONE, got)
+			require.Equal(t, []byte("ab"), kept)
+
+			_, err = types.DecompressChunkData(codec, []byte("not compressed"))
+			require.Error(t, err)
+		})
+	}
+
+	_, _, err := types.CompressChunkData(types.ChunkCodec(42), text)
+	require.Error(t, err)
+	require.Error(t, types.ChunkCodec(42).Validate())
+}
@@ -13,6 +13,10 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgDeleteStoredChunk{},
 	)
 
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitStorageProof{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSendChunk{},
 	)
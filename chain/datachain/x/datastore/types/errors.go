@@ -13,4 +13,10 @@ var (
 	ErrInvalidPacketTimeout = errors.Register(ModuleName, 1500, "invalid packet timeout")
 	ErrInvalidVersion       = errors.Register(ModuleName, 1501, "invalid version")
 	ErrChunkNotFound        = errors.Register(ModuleName, 1502, "chunk not found") // ★ この行を追加
+	ErrPacketFailed         = errors.Register(ModuleName, 1503, "packet failed on the counterparty")
+	ErrRetrievalTooLarge    = errors.Register(ModuleName, 1504, "retrieval exceeds max retrieval bytes")
+	ErrChunkReferenced      = errors.Register(ModuleName, 1505, "chunk is referenced by metadata")
+	ErrChallengeNotFound    = errors.Register(ModuleName, 1506, "storage challenge not found")
+	ErrNotBondedValidator   = errors.Register(ModuleName, 1507, "signer does not operate a bonded validator")
+	ErrInvalidStorageProof  = errors.Register(ModuleName, 1508, "invalid storage proof")
 )
@@ -0,0 +1,16 @@
+package types
+
+// Storage challenge events
+const (
+	EventTypeStorageChallenge = "storage_challenge"
+	EventTypeStorageProof     = "storage_proof"
+	EventTypeStorageMissed    = "storage_challenge_missed"
+
+	AttributeKeyChallengeID             = "challenge_id"
+	AttributeKeyEpoch                   = "epoch"
+	AttributeKeyOffset                  = "offset"
+	AttributeKeyLength                  = "length"
+	AttributeKeyValidator               = "validator"
+	AttributeKeyMissed                  = "missed"
+	AttributeKeyConsecutiveFailedEpochs = "consecutive_failed_epochs"
+)
@@ -2,11 +2,16 @@ package types
 
 // IBC events
 const (
-	EventTypeTimeout     = "timeout"
-	EventTypeChunkPacket = "chunk_packet"
+	EventTypeTimeout         = "timeout"
+	EventTypeChunkPacket     = "chunk_packet"
+	EventTypeMetadataPacket  = "metadata_packet"
+	EventTypeRetrievalPacket = "retrieval_packet"
+	EventTypeReleasePacket   = "release_packet"
+	EventTypeChunkPruned     = "chunk_pruned"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAck        = "acknowledgement"
 	AttributeKeyAckError   = "error"
+	AttributeKeyIndex      = "index"
 )
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -20,6 +21,13 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// StakingKeeper defines the expected interface for the Staking module.
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+	GetValidator(context.Context, sdk.ValAddress) (stakingtypes.Validator, error)
+	GetBondedValidatorsByPower(context.Context) ([]stakingtypes.Validator, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -10,7 +10,10 @@ import (
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params: DefaultParams(),
-		PortId: PortID, StoredChunkMap: []StoredChunk{}}
+		PortId: PortID, StoredChunkMap: []StoredChunk{},
+		ChunkReferences: []ChunkReference{}, PendingPrunes: []PendingPrune{},
+		StorageChallenges: []StorageChallenge{}, StorageProofs: []StorageProofRecord{},
+		StorageReputations: []StorageReputation{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -26,8 +29,63 @@ func (gs GenesisState) Validate() error {
 		if _, ok := storedChunkIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for storedChunk")
 		}
+		if err := elem.Codec.Validate(); err != nil {
+			return fmt.Errorf("storedChunk %s: %w", index, err)
+		}
 		storedChunkIndexMap[index] = struct{}{}
 	}
 
+	if gs.ChunkArchive != nil {
+		if err := gs.ChunkArchive.Validate(); err != nil {
+			return err
+		}
+	}
+
+	chunkReferenceMap := make(map[ChunkReference]struct{})
+	for _, elem := range gs.ChunkReferences {
+		if _, ok := chunkReferenceMap[elem]; ok {
+			return fmt.Errorf("duplicated chunk reference %s for %s", elem.Holder, elem.Index)
+		}
+		chunkReferenceMap[elem] = struct{}{}
+	}
+
+	pendingPruneIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PendingPrunes {
+		if _, ok := pendingPruneIndexMap[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for pendingPrune")
+		}
+		pendingPruneIndexMap[elem.Index] = struct{}{}
+	}
+
+	storageChallengeIDMap := make(map[uint64]struct{})
+	for _, elem := range gs.StorageChallenges {
+		if _, ok := storageChallengeIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for storageChallenge")
+		}
+		if elem.Id >= gs.StorageChallengeCount {
+			return fmt.Errorf("storageChallenge id should be lower than storageChallengeCount")
+		}
+		storageChallengeIDMap[elem.Id] = struct{}{}
+	}
+
+	storageProofMap := make(map[StorageProofRecord]struct{})
+	for _, elem := range gs.StorageProofs {
+		if _, ok := storageChallengeIDMap[elem.ChallengeId]; !ok {
+			return fmt.Errorf("storage proof for unknown challenge %d", elem.ChallengeId)
+		}
+		if _, ok := storageProofMap[elem]; ok {
+			return fmt.Errorf("duplicated storage proof of %s for challenge %d", elem.Validator, elem.ChallengeId)
+		}
+		storageProofMap[elem] = struct{}{}
+	}
+
+	storageReputationMap := make(map[string]struct{})
+	for _, elem := range gs.StorageReputations {
+		if _, ok := storageReputationMap[elem.Validator]; ok {
+			return fmt.Errorf("duplicated validator for storageReputation")
+		}
+		storageReputationMap[elem.Validator] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
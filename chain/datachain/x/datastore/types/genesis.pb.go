@@ -27,9 +27,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the datastore module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params         Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId         string        `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	StoredChunkMap []StoredChunk `protobuf:"bytes,3,rep,name=stored_chunk_map,json=storedChunkMap,proto3" json:"stored_chunk_map"`
+	Params          Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId          string           `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	StoredChunkMap  []StoredChunk    `protobuf:"bytes,3,rep,name=stored_chunk_map,json=storedChunkMap,proto3" json:"stored_chunk_map"`
+	ChunkReferences []ChunkReference `protobuf:"bytes,4,rep,name=chunk_references,json=chunkReferences,proto3" json:"chunk_references"`
+	PendingPrunes   []PendingPrune   `protobuf:"bytes,5,rep,name=pending_prunes,json=pendingPrunes,proto3" json:"pending_prunes"`
+	// chunk_archive, when set, holds stored chunks in addition to
+	// stored_chunk_map. See `raidchaind export-chunks`.
+	ChunkArchive          *ChunkArchive        `protobuf:"bytes,6,opt,name=chunk_archive,json=chunkArchive,proto3" json:"chunk_archive,omitempty"`
+	StorageChallenges     []StorageChallenge   `protobuf:"bytes,7,rep,name=storage_challenges,json=storageChallenges,proto3" json:"storage_challenges"`
+	StorageChallengeCount uint64               `protobuf:"varint,8,opt,name=storage_challenge_count,json=storageChallengeCount,proto3" json:"storage_challenge_count,omitempty"`
+	StorageProofs         []StorageProofRecord `protobuf:"bytes,9,rep,name=storage_proofs,json=storageProofs,proto3" json:"storage_proofs"`
+	StorageReputations    []StorageReputation  `protobuf:"bytes,10,rep,name=storage_reputations,json=storageReputations,proto3" json:"storage_reputations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +95,55 @@ func (m *GenesisState) GetStoredChunkMap() []StoredChunk {
 	return nil
 }
 
+func (m *GenesisState) GetChunkReferences() []ChunkReference {
+	if m != nil {
+		return m.ChunkReferences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingPrunes() []PendingPrune {
+	if m != nil {
+		return m.PendingPrunes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChunkArchive() *ChunkArchive {
+	if m != nil {
+		return m.ChunkArchive
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageChallenges() []StorageChallenge {
+	if m != nil {
+		return m.StorageChallenges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageChallengeCount() uint64 {
+	if m != nil {
+		return m.StorageChallengeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStorageProofs() []StorageProofRecord {
+	if m != nil {
+		return m.StorageProofs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageReputations() []StorageReputation {
+	if m != nil {
+		return m.StorageReputations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "datachain.datastore.v1.GenesisState")
 }
@@ -95,25 +153,39 @@ func init() {
 }
 
 var fileDescriptor_6c927bad7c8ee07f = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x49, 0x2c, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0x8a, 0x4b, 0xf2, 0x8b, 0x52, 0xf5, 0xcb, 0x0c,
-	0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4,
-	0xe0, 0xaa, 0xf4, 0xe0, 0xaa, 0xf4, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5,
-	0xc1, 0x24, 0x44, 0xa9, 0x94, 0x32, 0x0e, 0x03, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0xa1, 0xe6, 0x49,
-	0x69, 0xe2, 0x50, 0x04, 0x66, 0xa4, 0xc4, 0x27, 0x67, 0x94, 0xe6, 0x65, 0x43, 0x95, 0x8a, 0xa4,
-	0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0x69, 0x3f, 0x23, 0x17, 0x8f, 0x3b,
-	0xc4, 0x89, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x8e, 0x5c, 0x6c, 0x10, 0x1b, 0x24, 0x18, 0x15,
-	0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0xb0, 0x3b, 0x59, 0x2f, 0x00, 0xac, 0xca, 0x89, 0xf3, 0xc4,
-	0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0x35, 0x0a, 0x89, 0x73, 0xb1, 0x17,
-	0xe4, 0x17, 0x95, 0xc4, 0x67, 0xa6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0xb1, 0x81, 0xb8,
-	0x9e, 0x29, 0x42, 0xc1, 0x5c, 0x02, 0xc8, 0x0e, 0x8b, 0xcf, 0x4d, 0x2c, 0x90, 0x60, 0x56, 0x60,
-	0xd6, 0xe0, 0x36, 0x52, 0xc6, 0x65, 0x4b, 0x30, 0x58, 0xbd, 0x33, 0x48, 0xb9, 0x13, 0x0b, 0xc8,
-	0xaa, 0x20, 0xbe, 0x62, 0x84, 0x90, 0x6f, 0x62, 0x81, 0x93, 0xe9, 0x89, 0x47, 0x72, 0x8c, 0x17,
-	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
-	0x37, 0x1e, 0xcb, 0x31, 0x44, 0x49, 0x23, 0x02, 0xa7, 0x02, 0x29, 0x78, 0x4a, 0x2a, 0x0b, 0x52,
-	0x8b, 0x93, 0xd8, 0xc0, 0xfe, 0x37, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xbf, 0xf0, 0xb0,
-	0xb8, 0x01, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x56, 0x3a, 0xea, 0x6d, 0x65, 0x33, 0x7f, 0x66, 0x15, 0x29, 0x54, 0x6c, 0x9a,
+	0xba, 0x1e, 0x12, 0x6d, 0x08, 0xee, 0x6b, 0x0f, 0x68, 0x07, 0xa4, 0x92, 0x1e, 0x26, 0x21, 0xa1,
+	0x60, 0x92, 0x77, 0x69, 0xc4, 0x6a, 0x5b, 0xb6, 0x5b, 0xc1, 0x37, 0xe0, 0xc8, 0xc7, 0xe0, 0xc8,
+	0xc7, 0xd8, 0x71, 0x47, 0x4e, 0x08, 0xb5, 0x07, 0xbe, 0x06, 0x8a, 0x93, 0x74, 0x69, 0x59, 0xda,
+	0x4b, 0xf4, 0xe6, 0xf5, 0xef, 0x7d, 0xde, 0x47, 0x8f, 0x6c, 0x74, 0x18, 0x52, 0x4d, 0x83, 0x21,
+	0x8d, 0x99, 0x9b, 0x54, 0x4a, 0x73, 0x09, 0xee, 0xe4, 0xc4, 0x8d, 0x80, 0x81, 0x8a, 0x95, 0x23,
+	0x24, 0xd7, 0x1c, 0x3f, 0x9d, 0x53, 0xce, 0x9c, 0x72, 0x26, 0x27, 0xcd, 0x3d, 0x3a, 0x8a, 0x19,
+	0x77, 0xcd, 0x37, 0x45, 0x9b, 0x9d, 0x12, 0xc1, 0x60, 0x38, 0x66, 0x9f, 0x7d, 0x2a, 0x83, 0x61,
+	0x3c, 0x81, 0x8c, 0x3d, 0x28, 0x61, 0x05, 0x95, 0x74, 0x94, 0xed, 0x6e, 0x1e, 0x95, 0x40, 0x12,
+	0x2e, 0x41, 0x02, 0x0b, 0x60, 0xcd, 0xe2, 0xa4, 0xa0, 0x11, 0xf8, 0x42, 0x72, 0x7e, 0x99, 0xb1,
+	0xc7, 0x2b, 0x58, 0x08, 0x7d, 0xe3, 0x35, 0x43, 0x1f, 0x47, 0x3c, 0xe2, 0xa6, 0x74, 0x93, 0x2a,
+	0xed, 0xbe, 0xf8, 0x56, 0x43, 0xdb, 0x6f, 0xd2, 0x88, 0x06, 0x9a, 0x6a, 0xc0, 0x67, 0xa8, 0x96,
+	0xba, 0x26, 0x56, 0xcb, 0x6a, 0x6f, 0x9d, 0xda, 0xce, 0xdd, 0x91, 0x39, 0x7d, 0x43, 0x75, 0xeb,
+	0xd7, 0xbf, 0x9f, 0x57, 0x7e, 0xfc, 0xfd, 0xd9, 0xb1, 0xbc, 0x6c, 0x10, 0xef, 0xa3, 0x4d, 0xc1,
+	0xa5, 0xf6, 0xe3, 0x90, 0xdc, 0x6b, 0x59, 0xed, 0xba, 0x57, 0x4b, 0x7e, 0xcf, 0x43, 0x3c, 0x40,
+	0xbb, 0x45, 0x63, 0xfe, 0x88, 0x0a, 0xb2, 0xd1, 0xda, 0x68, 0x6f, 0x9d, 0x1e, 0x94, 0x6d, 0x19,
+	0x18, 0xbe, 0x97, 0xe0, 0xdd, 0x6a, 0xb2, 0xca, 0x6b, 0xa8, 0xdb, 0xd6, 0x5b, 0x2a, 0xf0, 0x05,
+	0xda, 0x4d, 0xd5, 0xe6, 0x39, 0x2a, 0x52, 0x35, 0xa2, 0x47, 0x65, 0xa2, 0x66, 0xd6, 0xcb, 0xf1,
+	0x4c, 0xf7, 0x61, 0xb0, 0xd0, 0x55, 0xf8, 0x1d, 0x6a, 0x08, 0x60, 0x61, 0xcc, 0x22, 0x5f, 0xc8,
+	0x31, 0x03, 0x45, 0xee, 0x1b, 0xd9, 0xc3, 0xd2, 0x44, 0x52, 0xba, 0x9f, 0xc0, 0x99, 0xe8, 0x8e,
+	0x28, 0xf4, 0x14, 0x3e, 0x47, 0x3b, 0x0b, 0xd7, 0x87, 0xd4, 0x5a, 0xd6, 0x2a, 0x45, 0x63, 0xf4,
+	0x2c, 0x65, 0xbd, 0xed, 0xa0, 0xf0, 0x87, 0x3f, 0x20, 0x9c, 0x5f, 0x88, 0x60, 0x48, 0xaf, 0xae,
+	0x80, 0x45, 0xa0, 0xc8, 0xa6, 0x71, 0xd8, 0x5e, 0x95, 0x26, 0x8d, 0xa0, 0x97, 0x0f, 0x64, 0x2e,
+	0xf7, 0xd4, 0x52, 0x5f, 0xe1, 0xd7, 0x68, 0xff, 0x3f, 0x79, 0x3f, 0xe0, 0x63, 0xa6, 0xc9, 0x83,
+	0x96, 0xd5, 0xae, 0x7a, 0x4f, 0x96, 0x67, 0x7a, 0xc9, 0x21, 0xbe, 0x40, 0x8d, 0x85, 0x7b, 0xaa,
+	0x48, 0xdd, 0x58, 0xea, 0xac, 0xb1, 0xd4, 0x4f, 0x60, 0x0f, 0x02, 0x2e, 0xc3, 0x3c, 0x3a, 0x55,
+	0x38, 0x51, 0xf8, 0x23, 0x7a, 0x94, 0x0b, 0x4b, 0x10, 0x63, 0x4d, 0x75, 0xcc, 0x99, 0x22, 0xc8,
+	0xa8, 0x1f, 0xaf, 0x51, 0xf7, 0xe6, 0x13, 0x99, 0x38, 0x56, 0xcb, 0x07, 0xaa, 0xfb, 0xea, 0x7a,
+	0x6a, 0x5b, 0x37, 0x53, 0xdb, 0xfa, 0x33, 0xb5, 0xad, 0xef, 0x33, 0xbb, 0x72, 0x33, 0xb3, 0x2b,
+	0xbf, 0x66, 0x76, 0xe5, 0xfd, 0xb3, 0xdb, 0x57, 0xf6, 0xa5, 0xf0, 0xce, 0xf4, 0x57, 0x01, 0xea,
+	0x53, 0xcd, 0x3c, 0xa4, 0x97, 0xff, 0x06, 0x00, 0x08, 0x1f, 0x42, 0xd5, 0x81, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +208,93 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageReputations) > 0 {
+		for iNdEx := len(m.StorageReputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageReputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.StorageProofs) > 0 {
+		for iNdEx := len(m.StorageProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.StorageChallengeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StorageChallengeCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.StorageChallenges) > 0 {
+		for iNdEx := len(m.StorageChallenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageChallenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ChunkArchive != nil {
+		{
+			size, err := m.ChunkArchive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PendingPrunes) > 0 {
+		for iNdEx := len(m.PendingPrunes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPrunes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ChunkReferences) > 0 {
+		for iNdEx := len(m.ChunkReferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChunkReferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.StoredChunkMap) > 0 {
 		for iNdEx := len(m.StoredChunkMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -199,6 +358,43 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ChunkReferences) > 0 {
+		for _, e := range m.ChunkReferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingPrunes) > 0 {
+		for _, e := range m.PendingPrunes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ChunkArchive != nil {
+		l = m.ChunkArchive.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.StorageChallenges) > 0 {
+		for _, e := range m.StorageChallenges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StorageChallengeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.StorageChallengeCount))
+	}
+	if len(m.StorageProofs) > 0 {
+		for _, e := range m.StorageProofs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StorageReputations) > 0 {
+		for _, e := range m.StorageReputations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +532,231 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkReferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChunkReferences = append(m.ChunkReferences, ChunkReference{})
+			if err := m.ChunkReferences[len(m.ChunkReferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPrunes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPrunes = append(m.PendingPrunes, PendingPrune{})
+			if err := m.PendingPrunes[len(m.PendingPrunes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkArchive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChunkArchive == nil {
+				m.ChunkArchive = &ChunkArchive{}
+			}
+			if err := m.ChunkArchive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageChallenges = append(m.StorageChallenges, StorageChallenge{})
+			if err := m.StorageChallenges[len(m.StorageChallenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeCount", wireType)
+			}
+			m.StorageChallengeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageChallengeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProofs = append(m.StorageProofs, StorageProofRecord{})
+			if err := m.StorageProofs[len(m.StorageProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageReputations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageReputations = append(m.StorageReputations, StorageReputation{})
+			if err := m.StorageReputations[len(m.StorageReputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"datachain/x/datastore/types"
@@ -22,6 +23,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
 				PortId:         types.PortID,
 				StoredChunkMap: []types.StoredChunk{{Index: "0"}, {Index: "1"}}},
 			valid: true,
@@ -38,6 +40,70 @@ func TestGenesisState_Validate(t *testing.T) {
 				},
 			},
 			valid: false,
+		}, {
+			desc: "duplicated chunk reference",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				ChunkReferences: []types.ChunkReference{
+					{Index: "0", Holder: "channel-0/a"},
+					{Index: "0", Holder: "channel-0/a"},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "valid chunk archive",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				PortId:       types.PortID,
+				ChunkArchive: &types.ChunkArchive{File: "chunks.bin", Chunks: 2, Sha256: strings.Repeat("ab", 32)},
+			},
+			valid: true,
+		}, {
+			desc: "chunk archive outside the config directory",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				PortId:       types.PortID,
+				ChunkArchive: &types.ChunkArchive{File: "../chunks.bin", Sha256: strings.Repeat("ab", 32)},
+			},
+			valid: false,
+		}, {
+			desc: "chunk archive without digest",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				PortId:       types.PortID,
+				ChunkArchive: &types.ChunkArchive{File: "chunks.bin"},
+			},
+			valid: false,
+		}, {
+			desc: "valid storage challenges",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				PortId:                types.PortID,
+				StorageChallenges:     []types.StorageChallenge{{Id: 0}, {Id: 1}},
+				StorageChallengeCount: 2,
+				StorageProofs:         []types.StorageProofRecord{{ChallengeId: 1, Validator: "val"}},
+			},
+			valid: true,
+		}, {
+			desc: "storage challenge beyond the count",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				PortId:                types.PortID,
+				StorageChallenges:     []types.StorageChallenge{{Id: 2}},
+				StorageChallengeCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "storage proof of an unknown challenge",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				PortId:                types.PortID,
+				StorageChallenges:     []types.StorageChallenge{{Id: 0}},
+				StorageChallengeCount: 1,
+				StorageProofs:         []types.StorageProofRecord{{ChallengeId: 1, Validator: "val"}},
+			},
+			valid: false,
 		},
 	}
 	for _, tc := range tests {
@@ -19,6 +19,12 @@ const (
 
 	// PortID is the default port id that module binds to
 	PortID = "datastore"
+
+	// EncodingProtobuf is the payload encoding the module accepts on IBC v2
+	EncodingProtobuf = "application/x-protobuf"
+
+	// AckVersion is the version of the proto-binary packet acknowledgement results
+	AckVersion uint32 = 1
 )
 
 var (
@@ -28,3 +34,35 @@ var (
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_datastore")
+
+var (
+	// ChunkReferenceKey is the prefix of the (chunk index, holder) reference set
+	ChunkReferenceKey = collections.NewPrefix("chunkReference/value/")
+
+	// ChunkRefCountKey is the prefix of the per-chunk reference counts
+	ChunkRefCountKey = collections.NewPrefix("chunkRefCount/value/")
+
+	// PruneQueueKey is the prefix of the (prune after, chunk index) queue of released chunks
+	PruneQueueKey = collections.NewPrefix("pruneQueue/value/")
+
+	// PruneAfterKey is the prefix mapping released chunks to their prune time
+	PruneAfterKey = collections.NewPrefix("pruneAfter/value/")
+
+	// StorageChallengeKey is the prefix of the open storage challenges
+	StorageChallengeKey = collections.NewPrefix("storageChallenge/value/")
+
+	// StorageChallengeCountKey is the key of the storage challenge id sequence
+	StorageChallengeCountKey = collections.NewPrefix("storageChallenge/count/")
+
+	// StorageProofKey is the prefix of the (challenge id, validator) set of accepted proofs
+	StorageProofKey = collections.NewPrefix("storageProof/value/")
+
+	// StorageReputationKey is the prefix of the per-validator storage reputations
+	StorageReputationKey = collections.NewPrefix("storageReputation/value/")
+
+	// StorageUsageKey is the key of the count of stored chunks and bytes
+	StorageUsageKey = collections.NewPrefix("storageUsage/value/")
+)
+
+// MaxPrunesPerBlock bounds the chunks deleted in one EndBlock, the rest wait for the next block.
+const MaxPrunesPerBlock = 100
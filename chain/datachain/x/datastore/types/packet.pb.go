@@ -27,7 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type DatastorePacketData struct {
 	// Types that are valid to be assigned to Packet:
 	//	*DatastorePacketData_NoData
+	//	*DatastorePacketData_MetadataPacket
 	//	*DatastorePacketData_ChunkPacket
+	//	*DatastorePacketData_RetrievalPacket
+	//	*DatastorePacketData_ReleasePacket
 	Packet isDatastorePacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -73,12 +76,24 @@ type isDatastorePacketData_Packet interface {
 type DatastorePacketData_NoData struct {
 	NoData *NoData `protobuf:"bytes,1,opt,name=noData,proto3,oneof" json:"noData,omitempty"`
 }
+type DatastorePacketData_MetadataPacket struct {
+	MetadataPacket *MetadataPacketData `protobuf:"bytes,2,opt,name=metadata_packet,json=metadataPacket,proto3,oneof" json:"metadata_packet,omitempty"`
+}
 type DatastorePacketData_ChunkPacket struct {
-	ChunkPacket *ChunkPacketData `protobuf:"bytes,2,opt,name=chunk_packet,json=chunkPacket,proto3,oneof" json:"chunk_packet,omitempty"`
+	ChunkPacket *ChunkPacketData `protobuf:"bytes,3,opt,name=chunk_packet,json=chunkPacket,proto3,oneof" json:"chunk_packet,omitempty"`
+}
+type DatastorePacketData_RetrievalPacket struct {
+	RetrievalPacket *ChunkRetrievalPacketData `protobuf:"bytes,4,opt,name=retrieval_packet,json=retrievalPacket,proto3,oneof" json:"retrieval_packet,omitempty"`
+}
+type DatastorePacketData_ReleasePacket struct {
+	ReleasePacket *ChunkReleasePacketData `protobuf:"bytes,5,opt,name=release_packet,json=releasePacket,proto3,oneof" json:"release_packet,omitempty"`
 }
 
-func (*DatastorePacketData_NoData) isDatastorePacketData_Packet()      {}
-func (*DatastorePacketData_ChunkPacket) isDatastorePacketData_Packet() {}
+func (*DatastorePacketData_NoData) isDatastorePacketData_Packet()          {}
+func (*DatastorePacketData_MetadataPacket) isDatastorePacketData_Packet()  {}
+func (*DatastorePacketData_ChunkPacket) isDatastorePacketData_Packet()     {}
+func (*DatastorePacketData_RetrievalPacket) isDatastorePacketData_Packet() {}
+func (*DatastorePacketData_ReleasePacket) isDatastorePacketData_Packet()   {}
 
 func (m *DatastorePacketData) GetPacket() isDatastorePacketData_Packet {
 	if m != nil {
@@ -94,6 +109,13 @@ func (m *DatastorePacketData) GetNoData() *NoData {
 	return nil
 }
 
+func (m *DatastorePacketData) GetMetadataPacket() *MetadataPacketData {
+	if x, ok := m.GetPacket().(*DatastorePacketData_MetadataPacket); ok {
+		return x.MetadataPacket
+	}
+	return nil
+}
+
 func (m *DatastorePacketData) GetChunkPacket() *ChunkPacketData {
 	if x, ok := m.GetPacket().(*DatastorePacketData_ChunkPacket); ok {
 		return x.ChunkPacket
@@ -101,11 +123,28 @@ func (m *DatastorePacketData) GetChunkPacket() *ChunkPacketData {
 	return nil
 }
 
+func (m *DatastorePacketData) GetRetrievalPacket() *ChunkRetrievalPacketData {
+	if x, ok := m.GetPacket().(*DatastorePacketData_RetrievalPacket); ok {
+		return x.RetrievalPacket
+	}
+	return nil
+}
+
+func (m *DatastorePacketData) GetReleasePacket() *ChunkReleasePacketData {
+	if x, ok := m.GetPacket().(*DatastorePacketData_ReleasePacket); ok {
+		return x.ReleasePacket
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DatastorePacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*DatastorePacketData_NoData)(nil),
+		(*DatastorePacketData_MetadataPacket)(nil),
 		(*DatastorePacketData_ChunkPacket)(nil),
+		(*DatastorePacketData_RetrievalPacket)(nil),
+		(*DatastorePacketData_ReleasePacket)(nil),
 	}
 }
 
@@ -146,6 +185,196 @@ func (m *NoData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NoData proto.InternalMessageInfo
 
+// MetadataPacketData asks the datachain to verify that the chunks listed in
+// addresses are stored. It mirrors metachain.metastore.v1.MetadataPacketData,
+// which metachain sends in the same oneof slot.
+type MetadataPacketData struct {
+	Url       string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Creator   string   `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MetadataPacketData) Reset()         { *m = MetadataPacketData{} }
+func (m *MetadataPacketData) String() string { return proto.CompactTextString(m) }
+func (*MetadataPacketData) ProtoMessage()    {}
+func (*MetadataPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef51fd6f10fcf6af, []int{2}
+}
+func (m *MetadataPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataPacketData.Merge(m, src)
+}
+func (m *MetadataPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataPacketData proto.InternalMessageInfo
+
+func (m *MetadataPacketData) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *MetadataPacketData) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *MetadataPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// VerifiedChunk is the datachain's attestation of one stored chunk.
+type VerifiedChunk struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// hash is the SHA-256 digest of the chunk data.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// height is the block height the chunk was checked at.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *VerifiedChunk) Reset()         { *m = VerifiedChunk{} }
+func (m *VerifiedChunk) String() string { return proto.CompactTextString(m) }
+func (*VerifiedChunk) ProtoMessage()    {}
+func (*VerifiedChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef51fd6f10fcf6af, []int{3}
+}
+func (m *VerifiedChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifiedChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifiedChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifiedChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifiedChunk.Merge(m, src)
+}
+func (m *VerifiedChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifiedChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifiedChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifiedChunk proto.InternalMessageInfo
+
+func (m *VerifiedChunk) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *VerifiedChunk) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *VerifiedChunk) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *VerifiedChunk) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MetadataPacketAck defines a struct for the packet acknowledgment. It is
+// proto-binary encoded into the result of the channel acknowledgement.
+type MetadataPacketAck struct {
+	// version is the ack encoding version, see types.AckVersion.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// chunks lists every requested address in request order.
+	Chunks []VerifiedChunk `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks"`
+}
+
+func (m *MetadataPacketAck) Reset()         { *m = MetadataPacketAck{} }
+func (m *MetadataPacketAck) String() string { return proto.CompactTextString(m) }
+func (*MetadataPacketAck) ProtoMessage()    {}
+func (*MetadataPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef51fd6f10fcf6af, []int{4}
+}
+func (m *MetadataPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataPacketAck.Merge(m, src)
+}
+func (m *MetadataPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataPacketAck proto.InternalMessageInfo
+
+func (m *MetadataPacketAck) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MetadataPacketAck) GetChunks() []VerifiedChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
 // ChunkPacketData defines a struct for the packet payload
 type ChunkPacketData struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
@@ -156,7 +385,7 @@ func (m *ChunkPacketData) Reset()         { *m = ChunkPacketData{} }
 func (m *ChunkPacketData) String() string { return proto.CompactTextString(m) }
 func (*ChunkPacketData) ProtoMessage()    {}
 func (*ChunkPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef51fd6f10fcf6af, []int{2}
+	return fileDescriptor_ef51fd6f10fcf6af, []int{5}
 }
 func (m *ChunkPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -199,15 +428,18 @@ func (m *ChunkPacketData) GetData() []byte {
 	return nil
 }
 
-// ChunkPacketAck defines a struct for the packet acknowledgment
+// ChunkPacketAck defines a struct for the packet acknowledgment. It is encoded
+// like MetadataPacketAck.
 type ChunkPacketAck struct {
+	Version uint32          `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Chunks  []VerifiedChunk `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks"`
 }
 
 func (m *ChunkPacketAck) Reset()         { *m = ChunkPacketAck{} }
 func (m *ChunkPacketAck) String() string { return proto.CompactTextString(m) }
 func (*ChunkPacketAck) ProtoMessage()    {}
 func (*ChunkPacketAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef51fd6f10fcf6af, []int{3}
+	return fileDescriptor_ef51fd6f10fcf6af, []int{6}
 }
 func (m *ChunkPacketAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,135 +468,373 @@ func (m *ChunkPacketAck) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChunkPacketAck proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*DatastorePacketData)(nil), "datachain.datastore.v1.DatastorePacketData")
-	proto.RegisterType((*NoData)(nil), "datachain.datastore.v1.NoData")
-	proto.RegisterType((*ChunkPacketData)(nil), "datachain.datastore.v1.ChunkPacketData")
-	proto.RegisterType((*ChunkPacketAck)(nil), "datachain.datastore.v1.ChunkPacketAck")
+func (m *ChunkPacketAck) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("datachain/datastore/v1/packet.proto", fileDescriptor_ef51fd6f10fcf6af)
+func (m *ChunkPacketAck) GetChunks() []VerifiedChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
 }
 
-var fileDescriptor_ef51fd6f10fcf6af = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x49, 0x2c, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0x8a, 0x4b, 0xf2, 0x8b, 0x52, 0xf5, 0xcb, 0x0c,
-	0xf5, 0x0b, 0x12, 0x93, 0xb3, 0x53, 0x4b, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe0,
-	0x8a, 0xf4, 0xe0, 0x8a, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4a, 0xf4,
-	0x41, 0x2c, 0x88, 0x6a, 0xa5, 0xe5, 0x8c, 0x5c, 0xc2, 0x2e, 0x30, 0x65, 0x01, 0x60, 0x73, 0x40,
-	0x5c, 0x21, 0x0b, 0x2e, 0xb6, 0xbc, 0x7c, 0x10, 0x4b, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48,
-	0x4e, 0x0f, 0xbb, 0xb1, 0x7a, 0x7e, 0x60, 0x55, 0x1e, 0x0c, 0x41, 0x50, 0xf5, 0x42, 0x3e, 0x5c,
-	0x3c, 0xc9, 0x19, 0xa5, 0x79, 0xd9, 0xf1, 0x10, 0x57, 0x49, 0x30, 0x81, 0xf5, 0xab, 0xe3, 0xd2,
-	0xef, 0x0c, 0x52, 0x8b, 0xb0, 0xd8, 0x83, 0x21, 0x88, 0x3b, 0x19, 0x21, 0xe4, 0xc4, 0xc1, 0xc5,
-	0x06, 0x31, 0x47, 0x89, 0x83, 0x8b, 0x0d, 0x62, 0x97, 0x92, 0x35, 0x17, 0x3f, 0x9a, 0x2e, 0x21,
-	0x11, 0x2e, 0xd6, 0xcc, 0xbc, 0x94, 0xd4, 0x0a, 0xb0, 0x6b, 0x39, 0x83, 0x20, 0x1c, 0x21, 0x21,
-	0x2e, 0x16, 0x90, 0x5d, 0x60, 0x27, 0xf0, 0x04, 0x81, 0xd9, 0x4a, 0x02, 0x5c, 0x7c, 0x48, 0x9a,
-	0x1d, 0x93, 0xb3, 0x9d, 0x4c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x1a, 0x11, 0xde, 0x15, 0x48, 0x21, 0x5e, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x40,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0x04, 0xfa, 0x41, 0x95, 0x01, 0x00, 0x00,
+// ChunkRetrievalPacketData asks the datachain for the bytes of the chunks
+// listed in indexes. The datachain refuses requests whose chunks add up to
+// more than its max_retrieval_bytes param.
+type ChunkRetrievalPacketData struct {
+	Indexes   []string `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Requester string   `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	// cache_bytes is how much of the returned chunk data metachain keeps in its
+	// chunk cache. The datachain ignores it.
+	CacheBytes uint64 `protobuf:"varint,4,opt,name=cache_bytes,json=cacheBytes,proto3" json:"cache_bytes,omitempty"`
 }
 
-func (m *DatastorePacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChunkRetrievalPacketData) Reset()         { *m = ChunkRetrievalPacketData{} }
+func (m *ChunkRetrievalPacketData) String() string { return proto.CompactTextString(m) }
+func (*ChunkRetrievalPacketData) ProtoMessage()    {}
+func (*ChunkRetrievalPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef51fd6f10fcf6af, []int{7}
+}
+func (m *ChunkRetrievalPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkRetrievalPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkRetrievalPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ChunkRetrievalPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkRetrievalPacketData.Merge(m, src)
+}
+func (m *ChunkRetrievalPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkRetrievalPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkRetrievalPacketData.DiscardUnknown(m)
 }
 
-func (m *DatastorePacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ChunkRetrievalPacketData proto.InternalMessageInfo
+
+func (m *ChunkRetrievalPacketData) GetIndexes() []string {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
 }
 
-func (m *DatastorePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Packet != nil {
-		{
-			size := m.Packet.Size()
-			i -= size
-			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *ChunkRetrievalPacketData) GetRequester() string {
+	if m != nil {
+		return m.Requester
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DatastorePacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChunkRetrievalPacketData) GetCacheBytes() uint64 {
+	if m != nil {
+		return m.CacheBytes
+	}
+	return 0
 }
 
-func (m *DatastorePacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NoData != nil {
-		{
-			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPacket(dAtA, i, uint64(size))
+// RetrievedChunk carries the data of one chunk back to the requester.
+type RetrievedChunk struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Data  []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// height is the block height the chunk was read at.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RetrievedChunk) Reset()         { *m = RetrievedChunk{} }
+func (m *RetrievedChunk) String() string { return proto.CompactTextString(m) }
+func (*RetrievedChunk) ProtoMessage()    {}
+func (*RetrievedChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef51fd6f10fcf6af, []int{8}
+}
+func (m *RetrievedChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetrievedChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetrievedChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *DatastorePacketData_ChunkPacket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RetrievedChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrievedChunk.Merge(m, src)
+}
+func (m *RetrievedChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetrievedChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrievedChunk.DiscardUnknown(m)
 }
 
-func (m *DatastorePacketData_ChunkPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ChunkPacket != nil {
-		{
-			size, err := m.ChunkPacket.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPacket(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_RetrievedChunk proto.InternalMessageInfo
+
+func (m *RetrievedChunk) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *NoData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *RetrievedChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RetrievedChunk) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// ChunkRetrievalPacketAck defines a struct for the packet acknowledgment. It
+// is encoded like MetadataPacketAck and lists the chunks in request order.
+type ChunkRetrievalPacketAck struct {
+	Version uint32           `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Chunks  []RetrievedChunk `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks"`
 }
 
-func (m *ChunkPacketData) Marshal() (dAtA []byte, err error) {
+func (m *ChunkRetrievalPacketAck) Reset()         { *m = ChunkRetrievalPacketAck{} }
+func (m *ChunkRetrievalPacketAck) String() string { return proto.CompactTextString(m) }
+func (*ChunkRetrievalPacketAck) ProtoMessage()    {}
+func (*ChunkRetrievalPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef51fd6f10fcf6af, []int{9}
+}
+func (m *ChunkRetrievalPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkRetrievalPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkRetrievalPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkRetrievalPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkRetrievalPacketAck.Merge(m, src)
+}
+func (m *ChunkRetrievalPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkRetrievalPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkRetrievalPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkRetrievalPacketAck proto.InternalMessageInfo
+
+func (m *ChunkRetrievalPacketAck) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ChunkRetrievalPacketAck) GetChunks() []RetrievedChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+// ChunkReleasePacketData tells the datachain that the metadata entry for url
+// no longer references the chunks listed in addresses.
+type ChunkReleasePacketData struct {
+	Url       string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *ChunkReleasePacketData) Reset()         { *m = ChunkReleasePacketData{} }
+func (m *ChunkReleasePacketData) String() string { return proto.CompactTextString(m) }
+func (*ChunkReleasePacketData) ProtoMessage()    {}
+func (*ChunkReleasePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef51fd6f10fcf6af, []int{10}
+}
+func (m *ChunkReleasePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkReleasePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkReleasePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkReleasePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkReleasePacketData.Merge(m, src)
+}
+func (m *ChunkReleasePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkReleasePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkReleasePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkReleasePacketData proto.InternalMessageInfo
+
+func (m *ChunkReleasePacketData) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ChunkReleasePacketData) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// ChunkReleasePacketAck defines a struct for the packet acknowledgment. It is
+// encoded like MetadataPacketAck.
+type ChunkReleasePacketAck struct {
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// released lists the addresses whose reference was dropped. Addresses the
+	// entry did not reference are left out.
+	Released []string `protobuf:"bytes,2,rep,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *ChunkReleasePacketAck) Reset()         { *m = ChunkReleasePacketAck{} }
+func (m *ChunkReleasePacketAck) String() string { return proto.CompactTextString(m) }
+func (*ChunkReleasePacketAck) ProtoMessage()    {}
+func (*ChunkReleasePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef51fd6f10fcf6af, []int{11}
+}
+func (m *ChunkReleasePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkReleasePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkReleasePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkReleasePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkReleasePacketAck.Merge(m, src)
+}
+func (m *ChunkReleasePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkReleasePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkReleasePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkReleasePacketAck proto.InternalMessageInfo
+
+func (m *ChunkReleasePacketAck) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ChunkReleasePacketAck) GetReleased() []string {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DatastorePacketData)(nil), "datachain.datastore.v1.DatastorePacketData")
+	proto.RegisterType((*NoData)(nil), "datachain.datastore.v1.NoData")
+	proto.RegisterType((*MetadataPacketData)(nil), "datachain.datastore.v1.MetadataPacketData")
+	proto.RegisterType((*VerifiedChunk)(nil), "datachain.datastore.v1.VerifiedChunk")
+	proto.RegisterType((*MetadataPacketAck)(nil), "datachain.datastore.v1.MetadataPacketAck")
+	proto.RegisterType((*ChunkPacketData)(nil), "datachain.datastore.v1.ChunkPacketData")
+	proto.RegisterType((*ChunkPacketAck)(nil), "datachain.datastore.v1.ChunkPacketAck")
+	proto.RegisterType((*ChunkRetrievalPacketData)(nil), "datachain.datastore.v1.ChunkRetrievalPacketData")
+	proto.RegisterType((*RetrievedChunk)(nil), "datachain.datastore.v1.RetrievedChunk")
+	proto.RegisterType((*ChunkRetrievalPacketAck)(nil), "datachain.datastore.v1.ChunkRetrievalPacketAck")
+	proto.RegisterType((*ChunkReleasePacketData)(nil), "datachain.datastore.v1.ChunkReleasePacketData")
+	proto.RegisterType((*ChunkReleasePacketAck)(nil), "datachain.datastore.v1.ChunkReleasePacketAck")
+}
+
+func init() {
+	proto.RegisterFile("datachain/datastore/v1/packet.proto", fileDescriptor_ef51fd6f10fcf6af)
+}
+
+var fileDescriptor_ef51fd6f10fcf6af = []byte{
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xed, 0xda, 0xf5, 0x97, 0x4c, 0xda, 0xb4, 0xdf, 0x52, 0x8a, 0x55, 0x90, 0x5b, 0x19,
+	0x01, 0x15, 0x07, 0x87, 0x16, 0x21, 0x21, 0x71, 0x22, 0xed, 0xa1, 0x42, 0x14, 0xa1, 0x95, 0x00,
+	0x09, 0x09, 0x2a, 0xd7, 0x1e, 0x6a, 0xab, 0x6d, 0x5c, 0x76, 0xdd, 0xa8, 0xe1, 0x29, 0x78, 0x1a,
+	0x9e, 0xa1, 0xc7, 0x1e, 0x39, 0x21, 0x94, 0xbc, 0x08, 0xda, 0xb1, 0x9d, 0xc4, 0x69, 0x9c, 0x8a,
+	0x0b, 0xb7, 0x99, 0xd1, 0x7f, 0x7f, 0x3b, 0xf3, 0x1f, 0x7b, 0xe1, 0x7e, 0xe8, 0xa7, 0x7e, 0x10,
+	0xf9, 0x71, 0xa7, 0xa5, 0x22, 0x99, 0x26, 0x02, 0x5b, 0xdd, 0xad, 0xd6, 0x99, 0x1f, 0x1c, 0x63,
+	0xea, 0x9d, 0x89, 0x24, 0x4d, 0xd8, 0xea, 0x50, 0xe4, 0x0d, 0x45, 0x5e, 0x77, 0x6b, 0x6d, 0xe5,
+	0x28, 0x39, 0x4a, 0x48, 0xd2, 0x52, 0x51, 0xa6, 0x76, 0x7f, 0x18, 0x70, 0x6b, 0xb7, 0x90, 0xbd,
+	0x25, 0x8e, 0x4a, 0xd9, 0x73, 0xb0, 0x3a, 0x89, 0x8a, 0x6c, 0x7d, 0x43, 0xdf, 0x6c, 0x6c, 0x3b,
+	0xde, 0x74, 0xac, 0xf7, 0x86, 0x54, 0x7b, 0x1a, 0xcf, 0xf5, 0xec, 0x1d, 0x2c, 0x9d, 0x62, 0xea,
+	0x2b, 0xd1, 0x41, 0xd6, 0x98, 0x3d, 0x47, 0x88, 0xc7, 0x55, 0x88, 0xfd, 0x5c, 0x3e, 0xba, 0x7e,
+	0x4f, 0xe3, 0xcd, 0xd3, 0x52, 0x95, 0xbd, 0x86, 0x85, 0x20, 0x3a, 0xef, 0x1c, 0x17, 0x4c, 0x83,
+	0x98, 0x8f, 0xaa, 0x98, 0x3b, 0x4a, 0x5b, 0x02, 0x36, 0x82, 0x51, 0x89, 0x7d, 0x82, 0x65, 0x81,
+	0xa9, 0x88, 0xb1, 0xeb, 0x9f, 0x14, 0x44, 0x93, 0x88, 0x4f, 0x66, 0x12, 0x79, 0x71, 0xa8, 0x84,
+	0x5e, 0x12, 0xe5, 0x32, 0xfb, 0x00, 0x4d, 0x81, 0x27, 0xe8, 0x4b, 0x2c, 0xe0, 0xf3, 0x04, 0xf7,
+	0x6e, 0x80, 0xd3, 0x91, 0x12, 0x7a, 0x51, 0x8c, 0x17, 0xdb, 0x35, 0xb0, 0x32, 0xa0, 0x5b, 0x03,
+	0x2b, 0xb3, 0xde, 0xfd, 0x0c, 0xec, 0xba, 0x83, 0x6c, 0x19, 0x8c, 0x73, 0x71, 0x42, 0xdb, 0xab,
+	0x73, 0x15, 0xb2, 0x7b, 0x50, 0xf7, 0xc3, 0x50, 0xa0, 0x94, 0x28, 0xed, 0xb9, 0x0d, 0x63, 0xb3,
+	0xce, 0x47, 0x05, 0x66, 0xc3, 0x7f, 0x81, 0x40, 0x3f, 0x4d, 0x04, 0x59, 0x5b, 0xe7, 0x45, 0xea,
+	0x22, 0x2c, 0xbe, 0x47, 0x11, 0x7f, 0x89, 0x31, 0xa4, 0x36, 0xd9, 0x0a, 0xcc, 0xc7, 0x9d, 0x10,
+	0x2f, 0x72, 0x78, 0x96, 0x30, 0x06, 0xa6, 0x8c, 0xbf, 0x21, 0x2d, 0xdb, 0xe4, 0x14, 0xab, 0x5a,
+	0xe4, 0xcb, 0x88, 0x88, 0x0b, 0x9c, 0x62, 0xb6, 0x0a, 0x56, 0x84, 0xf1, 0x51, 0x94, 0x19, 0x6e,
+	0xf0, 0x3c, 0x73, 0x05, 0xfc, 0x5f, 0x1e, 0xe3, 0x65, 0x70, 0xac, 0xba, 0xea, 0xa2, 0x90, 0x71,
+	0xd2, 0xa1, 0xcb, 0x16, 0x79, 0x91, 0xb2, 0x1d, 0xb0, 0x68, 0xa1, 0xd9, 0x28, 0x8d, 0xed, 0x07,
+	0x55, 0xd6, 0x96, 0x7a, 0x6f, 0x9b, 0x97, 0xbf, 0xd6, 0x35, 0x9e, 0x1f, 0x75, 0x5f, 0xc0, 0xd2,
+	0xc4, 0x87, 0x52, 0x3d, 0x9c, 0x82, 0xd2, 0x70, 0x0b, 0x9c, 0x62, 0x37, 0x81, 0xe6, 0xd8, 0xe1,
+	0x7f, 0xd0, 0x6d, 0x0f, 0xec, 0xaa, 0x8f, 0x50, 0x5d, 0x4d, 0x9d, 0xa2, 0xb4, 0x75, 0x5a, 0x6d,
+	0x91, 0xaa, 0xb5, 0x0b, 0xfc, 0x7a, 0x8e, 0x32, 0x45, 0x41, 0xfd, 0xd7, 0xf9, 0xa8, 0xc0, 0xd6,
+	0xa1, 0x11, 0xf8, 0x41, 0x84, 0x07, 0x87, 0xbd, 0x14, 0x25, 0xad, 0xc4, 0xe4, 0x40, 0xa5, 0xb6,
+	0xaa, 0xbc, 0x32, 0x6b, 0xc6, 0xb2, 0xe9, 0x72, 0x68, 0xe6, 0xb7, 0xde, 0xf8, 0x11, 0x4c, 0xfa,
+	0x34, 0xb6, 0x70, 0xa3, 0xb4, 0xf0, 0x1e, 0xdc, 0x99, 0x36, 0xce, 0x6c, 0x23, 0x77, 0x27, 0x8c,
+	0x7c, 0x58, 0x65, 0x64, 0xb9, 0xdd, 0x09, 0x27, 0xf7, 0x60, 0x75, 0xfa, 0x1f, 0xf7, 0xb7, 0xbf,
+	0x8d, 0xbb, 0x0f, 0xb7, 0xaf, 0x93, 0x66, 0x8f, 0xb0, 0x06, 0xb5, 0xfc, 0xa7, 0x0e, 0x73, 0xde,
+	0x30, 0x6f, 0x3f, 0xbb, 0xec, 0x3b, 0xfa, 0x55, 0xdf, 0xd1, 0x7f, 0xf7, 0x1d, 0xfd, 0xfb, 0xc0,
+	0xd1, 0xae, 0x06, 0x8e, 0xf6, 0x73, 0xe0, 0x68, 0x1f, 0xef, 0x8e, 0xde, 0xfe, 0x8b, 0xb1, 0xd7,
+	0x3f, 0xed, 0x9d, 0xa1, 0x3c, 0xb4, 0xe8, 0x31, 0x7f, 0xfa, 0x67, 0x00, 0xff, 0x8a, 0x31, 0x19,
+	0x21, 0x06, 0x00, 0x00,
+}
+
+func (m *DatastorePacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -374,34 +844,134 @@ func (m *ChunkPacketData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChunkPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *DatastorePacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChunkPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DatastorePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DatastorePacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatastorePacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DatastorePacketData_MetadataPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatastorePacketData_MetadataPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MetadataPacket != nil {
+		{
+			size, err := m.MetadataPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Index)))
+	return len(dAtA) - i, nil
+}
+func (m *DatastorePacketData_ChunkPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatastorePacketData_ChunkPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChunkPacket != nil {
+		{
+			size, err := m.ChunkPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
+func (m *DatastorePacketData_RetrievalPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *ChunkPacketAck) Marshal() (dAtA []byte, err error) {
+func (m *DatastorePacketData_RetrievalPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RetrievalPacket != nil {
+		{
+			size, err := m.RetrievalPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DatastorePacketData_ReleasePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatastorePacketData_ReleasePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReleasePacket != nil {
+		{
+			size, err := m.ReleasePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -411,12 +981,12 @@ func (m *ChunkPacketAck) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChunkPacketAck) MarshalTo(dAtA []byte) (int, error) {
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChunkPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -424,95 +994,1749 @@ func (m *ChunkPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPacket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MetadataPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DatastorePacketData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MetadataPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Packet != nil {
-		n += m.Packet.Size()
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *DatastorePacketData_NoData) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifiedChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifiedChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiedChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NoData != nil {
-		l = m.NoData.Size()
-		n += 1 + l + sovPacket(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *DatastorePacketData_ChunkPacket) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MetadataPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChunkPacket != nil {
-		l = m.ChunkPacket.Size()
-		n += 1 + l + sovPacket(uint64(l))
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *NoData) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ChunkPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChunkPacketData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChunkPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkRetrievalPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkRetrievalPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkRetrievalPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CacheBytes != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.CacheBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Indexes[iNdEx])
+			copy(dAtA[i:], m.Indexes[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Indexes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetrievedChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrievedChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrievedChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkRetrievalPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkRetrievalPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkRetrievalPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkReleasePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkReleasePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkReleasePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkReleasePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkReleasePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkReleasePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Released[iNdEx])
+			copy(dAtA[i:], m.Released[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Released[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DatastorePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *DatastorePacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *DatastorePacketData_MetadataPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetadataPacket != nil {
+		l = m.MetadataPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *DatastorePacketData_ChunkPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChunkPacket != nil {
+		l = m.ChunkPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *DatastorePacketData_RetrievalPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetrievalPacket != nil {
+		l = m.RetrievalPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *DatastorePacketData_ReleasePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleasePacket != nil {
+		l = m.ReleasePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MetadataPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifiedChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPacket(uint64(m.Size_))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MetadataPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChunkPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *ChunkPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChunkRetrievalPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for _, s := range m.Indexes {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.CacheBytes != 0 {
+		n += 1 + sovPacket(uint64(m.CacheBytes))
+	}
+	return n
+}
+
+func (m *RetrievedChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ChunkRetrievalPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChunkReleasePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChunkReleasePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	if len(m.Released) > 0 {
+		for _, s := range m.Released {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DatastorePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatastorePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatastorePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &DatastorePacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MetadataPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &DatastorePacketData_MetadataPacket{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChunkPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &DatastorePacketData_ChunkPacket{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChunkRetrievalPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &DatastorePacketData_RetrievalPacket{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChunkReleasePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &DatastorePacketData_ReleasePacket{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifiedChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifiedChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifiedChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, VerifiedChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, VerifiedChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkRetrievalPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkRetrievalPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkRetrievalPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheBytes", wireType)
+			}
+			m.CacheBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ChunkPacketAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovPacket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPacket(x uint64) (n int) {
-	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DatastorePacketData) Unmarshal(dAtA []byte) error {
+func (m *RetrievedChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -535,17 +2759,17 @@ func (m *DatastorePacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatastorePacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetrievedChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatastorePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetrievedChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -555,32 +2779,29 @@ func (m *DatastorePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &NoData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Packet = &DatastorePacketData_NoData{v}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkPacket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -590,27 +2811,45 @@ func (m *DatastorePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ChunkPacketData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.Packet = &DatastorePacketData_ChunkPacket{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -632,7 +2871,7 @@ func (m *DatastorePacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoData) Unmarshal(dAtA []byte) error {
+func (m *ChunkRetrievalPacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -655,12 +2894,65 @@ func (m *NoData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkRetrievalPacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkRetrievalPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, RetrievedChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -682,7 +2974,7 @@ func (m *NoData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChunkPacketData) Unmarshal(dAtA []byte) error {
+func (m *ChunkReleasePacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -705,15 +2997,15 @@ func (m *ChunkPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChunkPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkReleasePacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChunkPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkReleasePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -741,13 +3033,13 @@ func (m *ChunkPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -757,25 +3049,23 @@ func (m *ChunkPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -798,7 +3088,7 @@ func (m *ChunkPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChunkPacketAck) Unmarshal(dAtA []byte) error {
+func (m *ChunkReleasePacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -821,12 +3111,63 @@ func (m *ChunkPacketAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChunkPacketAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkReleasePacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChunkPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkReleasePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
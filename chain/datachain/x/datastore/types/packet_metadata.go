@@ -0,0 +1,10 @@
+package types
+
+// GetBytes is a helper for serialising
+func (p MetadataPacketData) GetBytes() ([]byte, error) {
+	var modulePacket DatastorePacketData
+
+	modulePacket.Packet = &DatastorePacketData_MetadataPacket{&p}
+
+	return modulePacket.Marshal()
+}
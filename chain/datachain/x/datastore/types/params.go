@@ -1,17 +1,94 @@
 package types
 
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultMaxRetrievalBytes is the default bound on the chunk data returned by one retrieval packet.
+	DefaultMaxRetrievalBytes uint64 = 256 * 1024
+
+	// DefaultReleaseGracePeriod is how long an unreferenced chunk is kept by default.
+	DefaultReleaseGracePeriod = 24 * time.Hour
+
+	// DefaultChallengeEpochIdentifier is the x/epochs epoch storage challenges follow by default.
+	DefaultChallengeEpochIdentifier = "hour"
+
+	// DefaultChallengesPerEpoch is the default number of slices challenged per epoch.
+	DefaultChallengesPerEpoch uint32 = 4
+
+	// DefaultChallengeSliceSize is the default size of the slices chunks are challenged by.
+	DefaultChallengeSliceSize uint64 = 1024
+
+	// DefaultChunkCodec stores chunk data as received by default.
+	DefaultChunkCodec = ChunkCodec_CHUNK_CODEC_NONE
+
+	// DefaultChunkGasPerByte charges chunk data as much gas again as the default tx size cost.
+	DefaultChunkGasPerByte uint64 = 10
+
+	// DefaultMaxBlockChunkBytes keeps the chunk data of a block below the 21 MiB CometBFT
+	// default block size, leaving room for the rest of the block.
+	DefaultMaxBlockChunkBytes uint64 = 16 * 1024 * 1024
+
+	// DefaultEnabled serves the chunk store by default.
+	DefaultEnabled = true
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxRetrievalBytes uint64,
+	releaseGracePeriod time.Duration,
+	challengeEpochIdentifier string,
+	challengesPerEpoch uint32,
+	challengeSliceSize uint64,
+	chunkCodec ChunkCodec,
+	chunkGasPerByte uint64,
+	maxBlockChunkBytes uint64,
+	enabled bool,
+) Params {
+	return Params{
+		MaxRetrievalBytes:        maxRetrievalBytes,
+		ReleaseGracePeriod:       releaseGracePeriod,
+		ChallengeEpochIdentifier: challengeEpochIdentifier,
+		ChallengesPerEpoch:       challengesPerEpoch,
+		ChallengeSliceSize:       challengeSliceSize,
+		ChunkCodec:               chunkCodec,
+		ChunkGasPerByte:          chunkGasPerByte,
+		MaxBlockChunkBytes:       maxBlockChunkBytes,
+		Enabled:                  enabled,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxRetrievalBytes,
+		DefaultReleaseGracePeriod,
+		DefaultChallengeEpochIdentifier,
+		DefaultChallengesPerEpoch,
+		DefaultChallengeSliceSize,
+		DefaultChunkCodec,
+		DefaultChunkGasPerByte,
+		DefaultMaxBlockChunkBytes,
+		DefaultEnabled,
+	)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if p.MaxRetrievalBytes == 0 {
+		return fmt.Errorf("max retrieval bytes must be positive")
+	}
+	if p.ReleaseGracePeriod < 0 {
+		return fmt.Errorf("release grace period cannot be negative: %s", p.ReleaseGracePeriod)
+	}
+	if p.ChallengeEpochIdentifier != "" && p.ChallengeSliceSize == 0 {
+		return fmt.Errorf("challenge slice size must be positive")
+	}
+	if err := p.ChunkCodec.Validate(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -8,15 +8,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +30,32 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// max_retrieval_bytes bounds the total chunk data a single retrieval packet
+	// may return in its acknowledgement.
+	MaxRetrievalBytes uint64 `protobuf:"varint,1,opt,name=max_retrieval_bytes,json=maxRetrievalBytes,proto3" json:"max_retrieval_bytes,omitempty"`
+	// release_grace_period is how long a chunk stays stored after its last
+	// reference is released, before it is pruned.
+	ReleaseGracePeriod time.Duration `protobuf:"bytes,2,opt,name=release_grace_period,json=releaseGracePeriod,proto3,stdduration" json:"release_grace_period"`
+	// challenge_epoch_identifier is the x/epochs epoch at whose end storage
+	// challenges are settled and published. Empty disables the challenges.
+	ChallengeEpochIdentifier string `protobuf:"bytes,3,opt,name=challenge_epoch_identifier,json=challengeEpochIdentifier,proto3" json:"challenge_epoch_identifier,omitempty"`
+	// challenges_per_epoch is the number of chunk slices challenged per epoch.
+	ChallengesPerEpoch uint32 `protobuf:"varint,4,opt,name=challenges_per_epoch,json=challengesPerEpoch,proto3" json:"challenges_per_epoch,omitempty"`
+	// challenge_slice_size is the size of the slices chunks are split into for
+	// storage challenges.
+	ChallengeSliceSize uint64 `protobuf:"varint,5,opt,name=challenge_slice_size,json=challengeSliceSize,proto3" json:"challenge_slice_size,omitempty"`
+	// chunk_codec compresses the data of chunks written by messages. A chunk is
+	// stored as received when compression does not shrink it.
+	ChunkCodec ChunkCodec `protobuf:"varint,6,opt,name=chunk_codec,json=chunkCodec,proto3,enum=datachain.datastore.v1.ChunkCodec" json:"chunk_codec,omitempty"`
+	// chunk_gas_per_byte is the gas charged for every byte of chunk data a
+	// transaction carries, on top of the cost of the transaction size.
+	ChunkGasPerByte uint64 `protobuf:"varint,7,opt,name=chunk_gas_per_byte,json=chunkGasPerByte,proto3" json:"chunk_gas_per_byte,omitempty"`
+	// max_block_chunk_bytes bounds the chunk data carried by the transactions of
+	// a block. Zero disables the bound.
+	MaxBlockChunkBytes uint64 `protobuf:"varint,8,opt,name=max_block_chunk_bytes,json=maxBlockChunkBytes,proto3" json:"max_block_chunk_bytes,omitempty"`
+	// enabled reports whether the chain serves the chunk store. While it is
+	// false, raidchaind rejects the messages and packets of the module.
+	Enabled bool `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +91,69 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxRetrievalBytes() uint64 {
+	if m != nil {
+		return m.MaxRetrievalBytes
+	}
+	return 0
+}
+
+func (m *Params) GetReleaseGracePeriod() time.Duration {
+	if m != nil {
+		return m.ReleaseGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeEpochIdentifier() string {
+	if m != nil {
+		return m.ChallengeEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetChallengesPerEpoch() uint32 {
+	if m != nil {
+		return m.ChallengesPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeSliceSize() uint64 {
+	if m != nil {
+		return m.ChallengeSliceSize
+	}
+	return 0
+}
+
+func (m *Params) GetChunkCodec() ChunkCodec {
+	if m != nil {
+		return m.ChunkCodec
+	}
+	return ChunkCodec_CHUNK_CODEC_NONE
+}
+
+func (m *Params) GetChunkGasPerByte() uint64 {
+	if m != nil {
+		return m.ChunkGasPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockChunkBytes() uint64 {
+	if m != nil {
+		return m.MaxBlockChunkBytes
+	}
+	return 0
+}
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "datachain.datastore.v1.Params")
 }
@@ -70,18 +163,38 @@ func init() {
 }
 
 var fileDescriptor_fad6ab341e49fbf6 = []byte{
-	// 161 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x49, 0x2c, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0x8a, 0x4b, 0xf2, 0x8b, 0x52, 0xf5, 0xcb, 0x0c,
-	0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe0,
-	0x8a, 0xf4, 0xe0, 0x8a, 0xf4, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1,
-	0x24, 0x44, 0xa9, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95,
-	0xf4, 0xb9, 0xd8, 0x02, 0xc0, 0x06, 0x5a, 0xa9, 0xbe, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83,
-	0x96, 0x0c, 0xc2, 0xe2, 0x0a, 0x24, 0xab, 0x21, 0xca, 0x9c, 0x4c, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x1a, 0xbb, 0xbe, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0x75, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xeb, 0x86, 0x15, 0xd6, 0x00, 0x00,
-	0x00,
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x41, 0x48, 0xdb, 0xab, 0x0a, 0xea, 0x11, 0xd0, 0x11, 0x90, 0x63, 0x15, 0x21, 0x99,
+	0x22, 0xd9, 0xa4, 0x88, 0x05, 0x31, 0x25, 0xa0, 0x8a, 0x2d, 0x72, 0xb7, 0x2e, 0xa7, 0xcb, 0xf9,
+	0xd5, 0x39, 0xd5, 0xf1, 0x45, 0x67, 0x27, 0x4a, 0xfb, 0x13, 0x98, 0x18, 0x19, 0x18, 0x18, 0x19,
+	0xfb, 0x33, 0x3a, 0x76, 0x64, 0x02, 0x94, 0x0c, 0xe5, 0x67, 0xa0, 0xbb, 0x4b, 0x1c, 0x90, 0xca,
+	0x12, 0xbd, 0xcb, 0xf7, 0xbd, 0xf7, 0x7d, 0x7e, 0xdf, 0xc3, 0x4f, 0x13, 0x5e, 0x72, 0x31, 0xe4,
+	0x32, 0x8f, 0x4c, 0x55, 0x94, 0x4a, 0x43, 0x34, 0xed, 0x44, 0x63, 0xae, 0xf9, 0xa8, 0x08, 0xc7,
+	0x5a, 0x95, 0x8a, 0x3c, 0xac, 0x48, 0x61, 0x45, 0x0a, 0xa7, 0x9d, 0xd6, 0x2e, 0x1f, 0xc9, 0x5c,
+	0x45, 0xf6, 0xd7, 0x51, 0x5b, 0xcd, 0x54, 0xa5, 0xca, 0x96, 0x91, 0xa9, 0x96, 0xff, 0x3e, 0xff,
+	0x8f, 0x8a, 0x2d, 0x12, 0x26, 0x86, 0x93, 0xfc, 0x74, 0x49, 0xf5, 0x52, 0xa5, 0xd2, 0x0c, 0x22,
+	0xfb, 0x1a, 0x4c, 0x4e, 0xa2, 0x64, 0xa2, 0x79, 0x29, 0x55, 0xee, 0xf0, 0xbd, 0x2f, 0x75, 0xdc,
+	0xe8, 0x5b, 0x73, 0x24, 0xc4, 0xf7, 0x47, 0x7c, 0xc6, 0x34, 0x94, 0x5a, 0xc2, 0x94, 0x67, 0x6c,
+	0x70, 0x56, 0x42, 0x41, 0x91, 0x8f, 0x82, 0x7a, 0xbc, 0x3b, 0xe2, 0xb3, 0x78, 0x85, 0x74, 0x0d,
+	0x40, 0x8e, 0x71, 0x53, 0x43, 0x06, 0xbc, 0x00, 0x96, 0x6a, 0x2e, 0x80, 0x8d, 0x41, 0x4b, 0x95,
+	0xd0, 0x5b, 0x3e, 0x0a, 0xb6, 0x0f, 0x1e, 0x85, 0x4e, 0x39, 0x5c, 0x29, 0x87, 0xef, 0x96, 0xca,
+	0xdd, 0x9d, 0xcb, 0x1f, 0xed, 0xda, 0xe7, 0x9f, 0x6d, 0xf4, 0xed, 0xfa, 0x62, 0x1f, 0xc5, 0x64,
+	0x39, 0xe5, 0xd0, 0x0c, 0xe9, 0xdb, 0x19, 0xe4, 0x2d, 0x6e, 0x89, 0x21, 0xcf, 0x32, 0xc8, 0x53,
+	0x60, 0x30, 0x56, 0x62, 0xc8, 0x64, 0x02, 0x79, 0x29, 0x4f, 0x24, 0x68, 0x7a, 0xdb, 0x47, 0xc1,
+	0x56, 0x4c, 0x2b, 0xc6, 0x7b, 0x43, 0xf8, 0x50, 0xe1, 0xe4, 0x25, 0x6e, 0x56, 0x58, 0x61, 0x6c,
+	0xb9, 0x11, 0xb4, 0xee, 0xa3, 0x60, 0x27, 0x26, 0x6b, 0xac, 0x0f, 0xda, 0xf6, 0xfe, 0xd3, 0xc1,
+	0x8a, 0x4c, 0x0a, 0x60, 0x85, 0x3c, 0x07, 0x7a, 0xc7, 0x7e, 0xfc, 0xba, 0xe3, 0xc8, 0x40, 0x47,
+	0xf2, 0x1c, 0x48, 0x0f, 0x6f, 0xdb, 0x3d, 0x33, 0xa1, 0x12, 0x10, 0xb4, 0xe1, 0xa3, 0xe0, 0xee,
+	0xc1, 0x5e, 0x78, 0x73, 0xb4, 0x61, 0xcf, 0x50, 0x7b, 0x86, 0x19, 0x63, 0x51, 0xd5, 0xe4, 0x05,
+	0x26, 0x6e, 0x48, 0xca, 0x9d, 0x4f, 0xb3, 0x72, 0xba, 0x61, 0x45, 0xef, 0x59, 0xe4, 0x90, 0x1b,
+	0x93, 0x66, 0xe1, 0xa4, 0x83, 0x1f, 0x98, 0x7c, 0x06, 0x99, 0x12, 0xa7, 0x2e, 0xe3, 0x65, 0x42,
+	0x9b, 0xce, 0xe4, 0x88, 0xcf, 0xba, 0x06, 0xb3, 0x5a, 0x2e, 0x22, 0x8a, 0x37, 0x20, 0xe7, 0x83,
+	0x0c, 0x12, 0xba, 0xe5, 0xa3, 0x60, 0x33, 0x5e, 0x3d, 0xdf, 0x3c, 0xfb, 0xfd, 0xb5, 0x8d, 0x3e,
+	0x5e, 0x5f, 0xec, 0x3f, 0x59, 0xdf, 0xd2, 0xec, 0xaf, 0x6b, 0x72, 0x37, 0xd1, 0x7d, 0x7d, 0x39,
+	0xf7, 0xd0, 0xd5, 0xdc, 0x43, 0xbf, 0xe6, 0x1e, 0xfa, 0xb4, 0xf0, 0x6a, 0x57, 0x0b, 0xaf, 0xf6,
+	0x7d, 0xe1, 0xd5, 0x8e, 0x1f, 0xdf, 0xdc, 0x57, 0x9e, 0x8d, 0xa1, 0x18, 0x34, 0x6c, 0xe8, 0xaf,
+	0xfe, 0x0c, 0x00, 0x5e, 0xc5, 0x26, 0x14, 0x0f, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +216,33 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxRetrievalBytes != that1.MaxRetrievalBytes {
+		return false
+	}
+	if this.ReleaseGracePeriod != that1.ReleaseGracePeriod {
+		return false
+	}
+	if this.ChallengeEpochIdentifier != that1.ChallengeEpochIdentifier {
+		return false
+	}
+	if this.ChallengesPerEpoch != that1.ChallengesPerEpoch {
+		return false
+	}
+	if this.ChallengeSliceSize != that1.ChallengeSliceSize {
+		return false
+	}
+	if this.ChunkCodec != that1.ChunkCodec {
+		return false
+	}
+	if this.ChunkGasPerByte != that1.ChunkGasPerByte {
+		return false
+	}
+	if this.MaxBlockChunkBytes != that1.MaxBlockChunkBytes {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +265,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxBlockChunkBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockChunkBytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ChunkGasPerByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChunkGasPerByte))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ChunkCodec != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChunkCodec))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ChallengeSliceSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeSliceSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChallengesPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengesPerEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChallengeEpochIdentifier) > 0 {
+		i -= len(m.ChallengeEpochIdentifier)
+		copy(dAtA[i:], m.ChallengeEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChallengeEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ReleaseGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReleaseGracePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxRetrievalBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRetrievalBytes))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +340,33 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxRetrievalBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxRetrievalBytes))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReleaseGracePeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.ChallengeEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ChallengesPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.ChallengesPerEpoch))
+	}
+	if m.ChallengeSliceSize != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeSliceSize))
+	}
+	if m.ChunkCodec != 0 {
+		n += 1 + sovParams(uint64(m.ChunkCodec))
+	}
+	if m.ChunkGasPerByte != 0 {
+		n += 1 + sovParams(uint64(m.ChunkGasPerByte))
+	}
+	if m.MaxBlockChunkBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlockChunkBytes))
+	}
+	if m.Enabled {
+		n += 2
+	}
 	return n
 }
 
@@ -183,6 +405,205 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetrievalBytes", wireType)
+			}
+			m.MaxRetrievalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetrievalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ReleaseGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengeEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesPerEpoch", wireType)
+			}
+			m.ChallengesPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengesPerEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeSliceSize", wireType)
+			}
+			m.ChallengeSliceSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeSliceSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCodec", wireType)
+			}
+			m.ChunkCodec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCodec |= ChunkCodec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkGasPerByte", wireType)
+			}
+			m.ChunkGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChunkBytes", wireType)
+			}
+			m.MaxBlockChunkBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockChunkBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -117,6 +118,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 // QueryGetStoredChunkRequest defines the QueryGetStoredChunkRequest message.
 type QueryGetStoredChunkRequest struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// raw returns the data as stored instead of decompressed.
+	Raw bool `protobuf:"varint,2,opt,name=raw,proto3" json:"raw,omitempty"`
 }
 
 func (m *QueryGetStoredChunkRequest) Reset()         { *m = QueryGetStoredChunkRequest{} }
@@ -159,6 +162,13 @@ func (m *QueryGetStoredChunkRequest) GetIndex() string {
 	return ""
 }
 
+func (m *QueryGetStoredChunkRequest) GetRaw() bool {
+	if m != nil {
+		return m.Raw
+	}
+	return false
+}
+
 // QueryGetStoredChunkResponse defines the QueryGetStoredChunkResponse message.
 type QueryGetStoredChunkResponse struct {
 	StoredChunk StoredChunk `protobuf:"bytes,1,opt,name=stored_chunk,json=storedChunk,proto3" json:"stored_chunk"`
@@ -207,6 +217,8 @@ func (m *QueryGetStoredChunkResponse) GetStoredChunk() StoredChunk {
 // QueryAllStoredChunkRequest defines the QueryAllStoredChunkRequest message.
 type QueryAllStoredChunkRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// raw returns the data as stored instead of decompressed.
+	Raw bool `protobuf:"varint,2,opt,name=raw,proto3" json:"raw,omitempty"`
 }
 
 func (m *QueryAllStoredChunkRequest) Reset()         { *m = QueryAllStoredChunkRequest{} }
@@ -249,6 +261,13 @@ func (m *QueryAllStoredChunkRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllStoredChunkRequest) GetRaw() bool {
+	if m != nil {
+		return m.Raw
+	}
+	return false
+}
+
 // QueryAllStoredChunkResponse defines the QueryAllStoredChunkResponse message.
 type QueryAllStoredChunkResponse struct {
 	StoredChunk []StoredChunk       `protobuf:"bytes,1,rep,name=stored_chunk,json=storedChunk,proto3" json:"stored_chunk"`
@@ -302,512 +321,2862 @@ func (m *QueryAllStoredChunkResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "datachain.datastore.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "datachain.datastore.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetStoredChunkRequest)(nil), "datachain.datastore.v1.QueryGetStoredChunkRequest")
-	proto.RegisterType((*QueryGetStoredChunkResponse)(nil), "datachain.datastore.v1.QueryGetStoredChunkResponse")
-	proto.RegisterType((*QueryAllStoredChunkRequest)(nil), "datachain.datastore.v1.QueryAllStoredChunkRequest")
-	proto.RegisterType((*QueryAllStoredChunkResponse)(nil), "datachain.datastore.v1.QueryAllStoredChunkResponse")
+// QueryUnreferencedChunksRequest defines the QueryUnreferencedChunksRequest message.
+type QueryUnreferencedChunksRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("datachain/datastore/v1/query.proto", fileDescriptor_6fe8615d92653abd)
+func (m *QueryUnreferencedChunksRequest) Reset()         { *m = QueryUnreferencedChunksRequest{} }
+func (m *QueryUnreferencedChunksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnreferencedChunksRequest) ProtoMessage()    {}
+func (*QueryUnreferencedChunksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{6}
 }
-
-var fileDescriptor_6fe8615d92653abd = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x0d, 0x74, 0x2a, 0x8a, 0x63, 0x10, 0xd9, 0xca, 0x58, 0xa6, 0x12, 0x35,
-	0x96, 0x19, 0x92, 0xea, 0x07, 0x68, 0x04, 0x7b, 0xe9, 0xa1, 0xae, 0x17, 0xf1, 0x22, 0x93, 0x64,
-	0xd8, 0x2e, 0x4d, 0x66, 0xb6, 0x3b, 0x93, 0xd0, 0x22, 0x5e, 0xbc, 0x79, 0x13, 0xfc, 0x12, 0xbd,
-	0xe9, 0xc1, 0x0f, 0xd1, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0x44, 0xf0, 0xea, 0x47, 0x90, 0x9d, 0x19,
-	0x9a, 0x8d, 0xee, 0xb6, 0xa9, 0x97, 0xf0, 0x32, 0xfc, 0xdf, 0x7b, 0xbf, 0x37, 0xef, 0x3f, 0x0b,
-	0x49, 0x8f, 0x1b, 0xde, 0xdd, 0xe3, 0xb1, 0x64, 0x59, 0xa4, 0x8d, 0x4a, 0x05, 0x1b, 0x35, 0xd9,
-	0xc1, 0x50, 0xa4, 0x47, 0x34, 0x49, 0x95, 0x51, 0xe8, 0xd6, 0x99, 0x86, 0x9e, 0x69, 0xe8, 0xa8,
-	0x19, 0xdc, 0xe0, 0x83, 0x58, 0x2a, 0x66, 0x7f, 0x9d, 0x34, 0x68, 0x74, 0x95, 0x1e, 0x28, 0xcd,
-	0x3a, 0x5c, 0x0b, 0x57, 0x83, 0x8d, 0x9a, 0x1d, 0x61, 0x78, 0x93, 0x25, 0x3c, 0x8a, 0x25, 0x37,
-	0xb1, 0x92, 0x5e, 0xbb, 0x5e, 0xd2, 0x3a, 0xe1, 0x29, 0x1f, 0x68, 0x2f, 0x7a, 0x58, 0x22, 0xb2,
-	0x41, 0xef, 0x75, 0x77, 0x6f, 0x28, 0xf7, 0xbd, 0xb4, 0x16, 0xa9, 0x48, 0xd9, 0x90, 0x65, 0x91,
-	0x3f, 0xbd, 0x13, 0x29, 0x15, 0xf5, 0x05, 0xe3, 0x49, 0xcc, 0xb8, 0x94, 0xca, 0x58, 0x04, 0x5f,
-	0x9e, 0xd4, 0x20, 0x7a, 0x9e, 0x51, 0xee, 0xda, 0x9e, 0xa1, 0x38, 0x18, 0x0a, 0x6d, 0xc8, 0x4b,
-	0x78, 0x73, 0xe6, 0x54, 0x27, 0x4a, 0x6a, 0x81, 0xb6, 0x60, 0xd5, 0xb1, 0xdd, 0x06, 0x6b, 0xe0,
-	0xc1, 0x4a, 0x0b, 0xd3, 0xe2, 0x8b, 0xa1, 0x2e, 0xaf, 0xbd, 0x7c, 0xf2, 0xfd, 0x6e, 0xe5, 0xf8,
-	0xd7, 0xe7, 0x06, 0x08, 0x7d, 0x22, 0x69, 0xc1, 0xc0, 0x56, 0xde, 0x16, 0xe6, 0x85, 0x9d, 0xe0,
-	0x69, 0x36, 0x80, 0xef, 0x8b, 0x6a, 0x70, 0x29, 0x96, 0x3d, 0x71, 0x68, 0xeb, 0x2f, 0x87, 0xee,
-	0x0f, 0xd9, 0x87, 0xab, 0x85, 0x39, 0x9e, 0x6a, 0x07, 0x5e, 0xcd, 0x5f, 0x86, 0x67, 0x5b, 0x2f,
-	0x63, 0xcb, 0x95, 0x68, 0x5f, 0xc9, 0x00, 0xc3, 0x15, 0x3d, 0x3d, 0x22, 0x3d, 0x0f, 0xb8, 0xd5,
-	0xef, 0x17, 0x00, 0x3e, 0x83, 0x70, 0xba, 0x46, 0xdf, 0xa9, 0x4e, 0xdd, 0xce, 0x69, 0xb6, 0x73,
-	0xea, 0x7c, 0xe3, 0x77, 0x4e, 0x77, 0x79, 0x24, 0x7c, 0x6e, 0x98, 0xcb, 0x24, 0x5f, 0x80, 0x9f,
-	0xe9, 0xef, 0x36, 0xa5, 0x33, 0x2d, 0xfe, 0xff, 0x4c, 0x68, 0x7b, 0x86, 0x7a, 0xc1, 0x52, 0xdf,
-	0xbf, 0x90, 0xda, 0xa1, 0xe4, 0xb1, 0x5b, 0xbf, 0x17, 0xe1, 0x92, 0xc5, 0x46, 0xef, 0x01, 0xac,
-	0xba, 0x2d, 0xa3, 0x46, 0x19, 0xd5, 0xbf, 0xc6, 0x0a, 0x1e, 0xcd, 0xa5, 0x75, 0x9d, 0x49, 0xfd,
-	0xdd, 0xd7, 0x9f, 0x1f, 0x17, 0xd6, 0x10, 0x66, 0xe7, 0x3e, 0x14, 0xf4, 0x09, 0xc0, 0x6b, 0xb3,
-	0xde, 0x40, 0xad, 0x73, 0xfb, 0x14, 0x9a, 0x2f, 0xd8, 0xbc, 0x54, 0x8e, 0x67, 0x7c, 0x6c, 0x19,
-	0x29, 0xda, 0x60, 0x73, 0xbc, 0x53, 0xf6, 0xc6, 0x1a, 0xfa, 0x2d, 0x3a, 0x06, 0xf0, 0xfa, 0x4e,
-	0xac, 0x2f, 0x81, 0x5c, 0x68, 0xc7, 0x0b, 0x90, 0x8b, 0xbd, 0x45, 0x36, 0x2c, 0x72, 0x1d, 0xdd,
-	0x9b, 0x07, 0xb9, 0xfd, 0xe4, 0x64, 0x8c, 0xc1, 0xe9, 0x18, 0x83, 0x1f, 0x63, 0x0c, 0x3e, 0x4c,
-	0x70, 0xe5, 0x74, 0x82, 0x2b, 0xdf, 0x26, 0xb8, 0xf2, 0x6a, 0x75, 0x9a, 0x7e, 0x98, 0x2b, 0x60,
-	0x8e, 0x12, 0xa1, 0x3b, 0x55, 0xfb, 0x79, 0xd9, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x81, 0x51,
-	0x5e, 0xb8, 0x5f, 0x05, 0x00, 0x00,
+func (m *QueryUnreferencedChunksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnreferencedChunksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnreferencedChunksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnreferencedChunksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnreferencedChunksRequest.Merge(m, src)
+}
+func (m *QueryUnreferencedChunksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnreferencedChunksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnreferencedChunksRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryUnreferencedChunksRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListStoredChunk Queries a list of StoredChunk items.
-	GetStoredChunk(ctx context.Context, in *QueryGetStoredChunkRequest, opts ...grpc.CallOption) (*QueryGetStoredChunkResponse, error)
-	// ListStoredChunk defines the ListStoredChunk RPC.
-	ListStoredChunk(ctx context.Context, in *QueryAllStoredChunkRequest, opts ...grpc.CallOption) (*QueryAllStoredChunkResponse, error)
+func (m *QueryUnreferencedChunksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryUnreferencedChunksResponse defines the QueryUnreferencedChunksResponse message.
+type QueryUnreferencedChunksResponse struct {
+	Chunks     []UnreferencedChunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryUnreferencedChunksResponse) Reset()         { *m = QueryUnreferencedChunksResponse{} }
+func (m *QueryUnreferencedChunksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnreferencedChunksResponse) ProtoMessage()    {}
+func (*QueryUnreferencedChunksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnreferencedChunksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnreferencedChunksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnreferencedChunksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryUnreferencedChunksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnreferencedChunksResponse.Merge(m, src)
+}
+func (m *QueryUnreferencedChunksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnreferencedChunksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnreferencedChunksResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetStoredChunk(ctx context.Context, in *QueryGetStoredChunkRequest, opts ...grpc.CallOption) (*QueryGetStoredChunkResponse, error) {
-	out := new(QueryGetStoredChunkResponse)
-	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/GetStoredChunk", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnreferencedChunksResponse proto.InternalMessageInfo
+
+func (m *QueryUnreferencedChunksResponse) GetChunks() []UnreferencedChunk {
+	if m != nil {
+		return m.Chunks
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListStoredChunk(ctx context.Context, in *QueryAllStoredChunkRequest, opts ...grpc.CallOption) (*QueryAllStoredChunkResponse, error) {
-	out := new(QueryAllStoredChunkResponse)
-	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/ListStoredChunk", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnreferencedChunksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListStoredChunk Queries a list of StoredChunk items.
-	GetStoredChunk(context.Context, *QueryGetStoredChunkRequest) (*QueryGetStoredChunkResponse, error)
-	// ListStoredChunk defines the ListStoredChunk RPC.
-	ListStoredChunk(context.Context, *QueryAllStoredChunkRequest) (*QueryAllStoredChunkResponse, error)
+// QueryGetStorageChallengeRequest defines the QueryGetStorageChallengeRequest message.
+type QueryGetStorageChallengeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGetStorageChallengeRequest) Reset()         { *m = QueryGetStorageChallengeRequest{} }
+func (m *QueryGetStorageChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStorageChallengeRequest) ProtoMessage()    {}
+func (*QueryGetStorageChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetStorageChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetStoredChunk(ctx context.Context, req *QueryGetStoredChunkRequest) (*QueryGetStoredChunkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStoredChunk not implemented")
+func (m *QueryGetStorageChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStorageChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListStoredChunk(ctx context.Context, req *QueryAllStoredChunkRequest) (*QueryAllStoredChunkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListStoredChunk not implemented")
+func (m *QueryGetStorageChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStorageChallengeRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetStorageChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStorageChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStorageChallengeRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/datachain.datastore.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGetStorageChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryGetStorageChallengeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetStoredChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStoredChunkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStoredChunk(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/datachain.datastore.v1.Query/GetStoredChunk",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStoredChunk(ctx, req.(*QueryGetStoredChunkRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetStorageChallengeResponse defines the QueryGetStorageChallengeResponse message.
+type QueryGetStorageChallengeResponse struct {
+	Challenge StorageChallenge `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge"`
 }
 
-func _Query_ListStoredChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllStoredChunkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListStoredChunk(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/datachain.datastore.v1.Query/ListStoredChunk",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListStoredChunk(ctx, req.(*QueryAllStoredChunkRequest))
+func (m *QueryGetStorageChallengeResponse) Reset()         { *m = QueryGetStorageChallengeResponse{} }
+func (m *QueryGetStorageChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStorageChallengeResponse) ProtoMessage()    {}
+func (*QueryGetStorageChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{9}
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStorageChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "datachain.datastore.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetStoredChunk",
-			Handler:    _Query_GetStoredChunk_Handler,
-		},
-		{
-			MethodName: "ListStoredChunk",
-			Handler:    _Query_ListStoredChunk_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "datachain/datastore/v1/query.proto",
+func (m *QueryGetStorageChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStorageChallengeResponse.Merge(m, src)
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStorageChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStorageChallengeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetStorageChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryGetStorageChallengeResponse) GetChallenge() StorageChallenge {
+	if m != nil {
+		return m.Challenge
 	}
-	return dAtA[:n], nil
+	return StorageChallenge{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllStorageChallengeRequest defines the QueryAllStorageChallengeRequest message.
+type QueryAllStorageChallengeRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllStorageChallengeRequest) Reset()         { *m = QueryAllStorageChallengeRequest{} }
+func (m *QueryAllStorageChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStorageChallengeRequest) ProtoMessage()    {}
+func (*QueryAllStorageChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{10}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllStorageChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllStorageChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllStorageChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllStorageChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStorageChallengeRequest.Merge(m, src)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *QueryAllStorageChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllStorageChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStorageChallengeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetStoredChunkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllStorageChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryAllStorageChallengeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetStoredChunkRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllStorageChallengeResponse defines the QueryAllStorageChallengeResponse message.
+type QueryAllStorageChallengeResponse struct {
+	Challenges []StorageChallenge  `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetStoredChunkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllStorageChallengeResponse) Reset()         { *m = QueryAllStorageChallengeResponse{} }
+func (m *QueryAllStorageChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStorageChallengeResponse) ProtoMessage()    {}
+func (*QueryAllStorageChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{11}
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllStorageChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStorageChallengeResponse.Merge(m, src)
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllStorageChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStorageChallengeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetStoredChunkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllStorageChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryAllStorageChallengeResponse) GetChallenges() []StorageChallenge {
+	if m != nil {
+		return m.Challenges
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetStoredChunkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllStorageChallengeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetStoredChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StoredChunk.MarshalToSizedBuffer(dAtA[:i])
+// QueryGetStorageReputationRequest defines the QueryGetStorageReputationRequest message.
+type QueryGetStorageReputationRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *QueryGetStorageReputationRequest) Reset()         { *m = QueryGetStorageReputationRequest{} }
+func (m *QueryGetStorageReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStorageReputationRequest) ProtoMessage()    {}
+func (*QueryGetStorageReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{12}
+}
+func (m *QueryGetStorageReputationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetStorageReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStorageReputationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetStorageReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStorageReputationRequest.Merge(m, src)
+}
+func (m *QueryGetStorageReputationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStorageReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStorageReputationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllStoredChunkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetStorageReputationRequest proto.InternalMessageInfo
+
+func (m *QueryGetStorageReputationRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllStoredChunkRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetStorageReputationResponse defines the QueryGetStorageReputationResponse message.
+type QueryGetStorageReputationResponse struct {
+	Reputation StorageReputation `protobuf:"bytes,1,opt,name=reputation,proto3" json:"reputation"`
 }
 
-func (m *QueryAllStoredChunkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetStorageReputationResponse) Reset()         { *m = QueryGetStorageReputationResponse{} }
+func (m *QueryGetStorageReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStorageReputationResponse) ProtoMessage()    {}
+func (*QueryGetStorageReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{13}
+}
+func (m *QueryGetStorageReputationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetStorageReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStorageReputationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetStorageReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStorageReputationResponse.Merge(m, src)
+}
+func (m *QueryGetStorageReputationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStorageReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStorageReputationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllStoredChunkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetStorageReputationResponse proto.InternalMessageInfo
+
+func (m *QueryGetStorageReputationResponse) GetReputation() StorageReputation {
+	if m != nil {
+		return m.Reputation
 	}
-	return dAtA[:n], nil
+	return StorageReputation{}
 }
 
-func (m *QueryAllStoredChunkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllStorageReputationRequest defines the QueryAllStorageReputationRequest message.
+type QueryAllStorageReputationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllStoredChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StoredChunk) > 0 {
-		for iNdEx := len(m.StoredChunk) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StoredChunk[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllStorageReputationRequest) Reset()         { *m = QueryAllStorageReputationRequest{} }
+func (m *QueryAllStorageReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStorageReputationRequest) ProtoMessage()    {}
+func (*QueryAllStorageReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{14}
+}
+func (m *QueryAllStorageReputationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllStorageReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllStorageReputationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryAllStorageReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStorageReputationRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllStorageReputationRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllStorageReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStorageReputationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetStoredChunkRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAllStorageReputationRequest proto.InternalMessageInfo
+
+func (m *QueryAllStorageReputationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetStoredChunkResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.StoredChunk.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryAllStorageReputationResponse defines the QueryAllStorageReputationResponse message.
+type QueryAllStorageReputationResponse struct {
+	Reputations []StorageReputation `protobuf:"bytes,1,rep,name=reputations,proto3" json:"reputations"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllStoredChunkRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllStorageReputationResponse) Reset()         { *m = QueryAllStorageReputationResponse{} }
+func (m *QueryAllStorageReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStorageReputationResponse) ProtoMessage()    {}
+func (*QueryAllStorageReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{15}
+}
+func (m *QueryAllStorageReputationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllStorageReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllStorageReputationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllStorageReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStorageReputationResponse.Merge(m, src)
+}
+func (m *QueryAllStorageReputationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllStorageReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStorageReputationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllStorageReputationResponse proto.InternalMessageInfo
+
+func (m *QueryAllStorageReputationResponse) GetReputations() []StorageReputation {
+	if m != nil {
+		return m.Reputations
+	}
+	return nil
+}
+
+func (m *QueryAllStorageReputationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStorageUsageRequest defines the QueryStorageUsageRequest message.
+type QueryStorageUsageRequest struct {
+}
+
+func (m *QueryStorageUsageRequest) Reset()         { *m = QueryStorageUsageRequest{} }
+func (m *QueryStorageUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageUsageRequest) ProtoMessage()    {}
+func (*QueryStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{16}
+}
+func (m *QueryStorageUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageUsageRequest.Merge(m, src)
+}
+func (m *QueryStorageUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageUsageRequest proto.InternalMessageInfo
+
+// QueryStorageUsageResponse defines the QueryStorageUsageResponse message.
+type QueryStorageUsageResponse struct {
+	Usage StorageUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryStorageUsageResponse) Reset()         { *m = QueryStorageUsageResponse{} }
+func (m *QueryStorageUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageUsageResponse) ProtoMessage()    {}
+func (*QueryStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fe8615d92653abd, []int{17}
+}
+func (m *QueryStorageUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageUsageResponse.Merge(m, src)
+}
+func (m *QueryStorageUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageUsageResponse proto.InternalMessageInfo
+
+func (m *QueryStorageUsageResponse) GetUsage() StorageUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return StorageUsage{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "datachain.datastore.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "datachain.datastore.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetStoredChunkRequest)(nil), "datachain.datastore.v1.QueryGetStoredChunkRequest")
+	proto.RegisterType((*QueryGetStoredChunkResponse)(nil), "datachain.datastore.v1.QueryGetStoredChunkResponse")
+	proto.RegisterType((*QueryAllStoredChunkRequest)(nil), "datachain.datastore.v1.QueryAllStoredChunkRequest")
+	proto.RegisterType((*QueryAllStoredChunkResponse)(nil), "datachain.datastore.v1.QueryAllStoredChunkResponse")
+	proto.RegisterType((*QueryUnreferencedChunksRequest)(nil), "datachain.datastore.v1.QueryUnreferencedChunksRequest")
+	proto.RegisterType((*QueryUnreferencedChunksResponse)(nil), "datachain.datastore.v1.QueryUnreferencedChunksResponse")
+	proto.RegisterType((*QueryGetStorageChallengeRequest)(nil), "datachain.datastore.v1.QueryGetStorageChallengeRequest")
+	proto.RegisterType((*QueryGetStorageChallengeResponse)(nil), "datachain.datastore.v1.QueryGetStorageChallengeResponse")
+	proto.RegisterType((*QueryAllStorageChallengeRequest)(nil), "datachain.datastore.v1.QueryAllStorageChallengeRequest")
+	proto.RegisterType((*QueryAllStorageChallengeResponse)(nil), "datachain.datastore.v1.QueryAllStorageChallengeResponse")
+	proto.RegisterType((*QueryGetStorageReputationRequest)(nil), "datachain.datastore.v1.QueryGetStorageReputationRequest")
+	proto.RegisterType((*QueryGetStorageReputationResponse)(nil), "datachain.datastore.v1.QueryGetStorageReputationResponse")
+	proto.RegisterType((*QueryAllStorageReputationRequest)(nil), "datachain.datastore.v1.QueryAllStorageReputationRequest")
+	proto.RegisterType((*QueryAllStorageReputationResponse)(nil), "datachain.datastore.v1.QueryAllStorageReputationResponse")
+	proto.RegisterType((*QueryStorageUsageRequest)(nil), "datachain.datastore.v1.QueryStorageUsageRequest")
+	proto.RegisterType((*QueryStorageUsageResponse)(nil), "datachain.datastore.v1.QueryStorageUsageResponse")
+}
+
+func init() {
+	proto.RegisterFile("datachain/datastore/v1/query.proto", fileDescriptor_6fe8615d92653abd)
+}
+
+var fileDescriptor_6fe8615d92653abd = []byte{
+	// 1023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x6c, 0x9b, 0x88, 0xbc, 0x44, 0x05, 0xa6, 0xa1, 0x4a, 0x1d, 0x70, 0x36, 0x6e, 0x49,
+	0xf3, 0xd3, 0xee, 0x26, 0x90, 0x94, 0x0b, 0x90, 0x14, 0x91, 0x4b, 0x05, 0xad, 0xab, 0x22, 0x84,
+	0x84, 0xa2, 0xc9, 0x7a, 0xea, 0x98, 0x6e, 0xec, 0xad, 0xc7, 0xbb, 0xb4, 0x8a, 0x72, 0xe1, 0xc6,
+	0x0d, 0x89, 0xff, 0x80, 0x53, 0x6f, 0x20, 0xd1, 0x1b, 0xe2, 0xd7, 0xad, 0x12, 0x97, 0x0a, 0x2e,
+	0x9c, 0x10, 0x4a, 0x90, 0x38, 0xf0, 0x4f, 0x20, 0x8f, 0xdf, 0xda, 0x8e, 0xd6, 0xf6, 0xda, 0xd1,
+	0x5e, 0x22, 0x7b, 0xfc, 0xbe, 0xf7, 0xbe, 0xef, 0x9b, 0xb7, 0xf3, 0x26, 0xa0, 0x59, 0x2c, 0x60,
+	0xcd, 0x7d, 0xe6, 0xb8, 0x46, 0xf8, 0x24, 0x02, 0xcf, 0xe7, 0x46, 0xb7, 0x61, 0x3c, 0xec, 0x70,
+	0xff, 0xb1, 0xde, 0xf6, 0xbd, 0xc0, 0xa3, 0x97, 0xe2, 0x18, 0x3d, 0x8e, 0xd1, 0xbb, 0x0d, 0xe5,
+	0x65, 0x76, 0xe0, 0xb8, 0x9e, 0x21, 0xff, 0x46, 0xa1, 0xca, 0x52, 0xd3, 0x13, 0x07, 0x9e, 0x30,
+	0xf6, 0x98, 0xe0, 0x51, 0x0e, 0xa3, 0xdb, 0xd8, 0xe3, 0x01, 0x6b, 0x18, 0x6d, 0x66, 0x3b, 0x2e,
+	0x0b, 0x1c, 0xcf, 0xc5, 0xd8, 0xcb, 0x51, 0xec, 0xae, 0x7c, 0x33, 0xa2, 0x17, 0xfc, 0x74, 0x25,
+	0x87, 0x55, 0x9b, 0xf9, 0xec, 0xa0, 0x17, 0x34, 0x9f, 0x13, 0xe4, 0xf3, 0xfb, 0xdc, 0xe7, 0x6e,
+	0x93, 0xf7, 0x38, 0xe5, 0xc4, 0x85, 0x0f, 0xcc, 0xe6, 0x61, 0x7d, 0xef, 0x3e, 0xc6, 0x2e, 0x16,
+	0xc4, 0x72, 0x6b, 0xb7, 0xb9, 0xdf, 0x71, 0x1f, 0x60, 0xe8, 0x94, 0xed, 0xd9, 0x5e, 0xc4, 0x3d,
+	0x7c, 0xc2, 0xd5, 0x57, 0x6d, 0xcf, 0xb3, 0x5b, 0xdc, 0x60, 0x6d, 0xc7, 0x60, 0xae, 0xeb, 0x05,
+	0x52, 0x31, 0x52, 0xd6, 0xa6, 0x80, 0xde, 0x09, 0x4d, 0xb9, 0x2d, 0x75, 0x98, 0xfc, 0x61, 0x87,
+	0x8b, 0x40, 0xfb, 0x18, 0x2e, 0x9e, 0x5a, 0x15, 0x6d, 0xcf, 0x15, 0x9c, 0x6e, 0xc1, 0x58, 0xa4,
+	0x77, 0x9a, 0xd4, 0xc9, 0xc2, 0xc4, 0x9a, 0xaa, 0x67, 0xef, 0x83, 0x1e, 0xe1, 0xb6, 0xc7, 0x9f,
+	0xfd, 0x35, 0x3b, 0xf2, 0xe4, 0xdf, 0xef, 0x96, 0x88, 0x89, 0x40, 0xed, 0x3d, 0x50, 0x64, 0xe6,
+	0x1d, 0x1e, 0xdc, 0x95, 0x0a, 0x6e, 0x86, 0x02, 0xb0, 0x2e, 0x9d, 0x82, 0x51, 0xc7, 0xb5, 0xf8,
+	0x23, 0x99, 0x7f, 0xdc, 0x8c, 0x5e, 0xe8, 0x4b, 0x70, 0xce, 0x67, 0x9f, 0x4f, 0xd7, 0xea, 0x64,
+	0xe1, 0x05, 0x33, 0x7c, 0xd4, 0x1e, 0xc0, 0x4c, 0x66, 0x16, 0xe4, 0x79, 0x0b, 0x26, 0xd3, 0xf6,
+	0x20, 0xdb, 0x2b, 0x79, 0x6c, 0x53, 0x29, 0xb6, 0xcf, 0x87, 0x94, 0xcd, 0x09, 0x91, 0x2c, 0x69,
+	0x5d, 0xa4, 0xbc, 0xd5, 0x6a, 0x65, 0x50, 0x7e, 0x1f, 0x20, 0xe9, 0x23, 0xac, 0x34, 0xaf, 0x63,
+	0xef, 0x84, 0x4d, 0xa7, 0x47, 0x8d, 0x8b, 0x4d, 0xa7, 0xdf, 0x66, 0x36, 0x47, 0xac, 0x99, 0x42,
+	0x66, 0x88, 0x7c, 0x4a, 0x60, 0x26, 0xb3, 0x70, 0xae, 0xca, 0x73, 0x67, 0x57, 0x49, 0x77, 0x4e,
+	0xe9, 0xa8, 0x49, 0x1d, 0xd7, 0x06, 0xea, 0x88, 0xa8, 0xa4, 0x85, 0x68, 0xfb, 0xa0, 0x4a, 0xd6,
+	0xf7, 0xdc, 0xb8, 0xed, 0xa3, 0x12, 0x62, 0xc8, 0x96, 0x69, 0xdf, 0x13, 0x98, 0xcd, 0x2d, 0x85,
+	0x26, 0xed, 0xc0, 0x98, 0x74, 0x47, 0xa0, 0x3d, 0x8b, 0x79, 0xf6, 0xf4, 0xe5, 0x40, 0x93, 0x10,
+	0x3e, 0x3c, 0x7f, 0x1a, 0x30, 0x9b, 0xee, 0x5d, 0x66, 0xf3, 0x9b, 0xfb, 0xac, 0xd5, 0xe2, 0x6e,
+	0x2c, 0x92, 0x5e, 0x80, 0x9a, 0x63, 0x49, 0x63, 0xce, 0x9b, 0x35, 0xc7, 0xd2, 0xda, 0x50, 0xcf,
+	0x87, 0xc4, 0xdd, 0x30, 0xde, 0xec, 0x2d, 0xa2, 0xa7, 0x0b, 0x45, 0xad, 0x90, 0x4e, 0x82, 0x52,
+	0x93, 0x04, 0x9a, 0x03, 0xb3, 0xe9, 0xd6, 0xcb, 0x22, 0x39, 0xac, 0x5d, 0xfc, 0x81, 0x40, 0x3d,
+	0xbf, 0x16, 0xaa, 0xfb, 0x00, 0x20, 0x26, 0xd7, 0xdb, 0xca, 0xaa, 0xf2, 0x52, 0x19, 0x86, 0xb7,
+	0x9b, 0xcd, 0xbe, 0xad, 0x31, 0x79, 0xbb, 0x13, 0x9d, 0xb1, 0x3d, 0xa7, 0xde, 0x81, 0xf1, 0x2e,
+	0x6b, 0x39, 0x16, 0x0b, 0x3c, 0x3f, 0x3a, 0xd9, 0xb6, 0xe7, 0x7e, 0x7f, 0xba, 0xfa, 0x1a, 0x96,
+	0xfb, 0xa8, 0xf7, 0x6d, 0xcb, 0xb2, 0x7c, 0x2e, 0xc4, 0xdd, 0xc0, 0x77, 0x5c, 0xdb, 0x4c, 0x30,
+	0x5a, 0x00, 0x73, 0x05, 0x45, 0xd0, 0xa2, 0x0f, 0x01, 0xfc, 0x78, 0x15, 0xf7, 0x63, 0x71, 0x80,
+	0x45, 0x49, 0x9a, 0x9e, 0x47, 0x49, 0x0a, 0xed, 0xb3, 0xbe, 0x7d, 0xe9, 0x97, 0x36, 0xac, 0x26,
+	0xf8, 0x99, 0xc0, 0x5c, 0x41, 0x31, 0x94, 0x78, 0x07, 0x26, 0x12, 0x7e, 0x03, 0x7f, 0xd1, 0x79,
+	0x1a, 0xd3, 0x39, 0x86, 0xd7, 0x08, 0x0a, 0x4c, 0x4b, 0x01, 0x58, 0xf5, 0x9e, 0x48, 0x94, 0x6a,
+	0x9f, 0xc2, 0xe5, 0x8c, 0x6f, 0x28, 0xea, 0x5d, 0x18, 0xed, 0x84, 0x0b, 0xe8, 0xde, 0xd5, 0x01,
+	0x72, 0x24, 0x18, 0x95, 0x44, 0xc0, 0xb5, 0xff, 0x26, 0x61, 0x54, 0xe6, 0xa7, 0x5f, 0x12, 0x18,
+	0x8b, 0x66, 0x2f, 0x5d, 0xca, 0xcb, 0xd3, 0x3f, 0xee, 0x95, 0xe5, 0x52, 0xb1, 0x11, 0x5f, 0x6d,
+	0xfe, 0x8b, 0x3f, 0xfe, 0xf9, 0xba, 0x56, 0xa7, 0xaa, 0x51, 0x78, 0x25, 0xa2, 0xdf, 0x12, 0xb8,
+	0x70, 0x7a, 0x3e, 0xd3, 0xb5, 0xc2, 0x3a, 0x99, 0x57, 0x02, 0x65, 0xbd, 0x12, 0x06, 0x39, 0xbe,
+	0x21, 0x39, 0xea, 0x74, 0xc5, 0x28, 0x71, 0x7b, 0x32, 0x0e, 0xe5, 0x35, 0xe3, 0x88, 0x3e, 0x21,
+	0xf0, 0xe2, 0x2d, 0x47, 0x54, 0xa0, 0x9c, 0x79, 0x25, 0x50, 0xd6, 0x2b, 0x61, 0x90, 0xf2, 0x8a,
+	0xa4, 0x3c, 0x4f, 0xaf, 0x96, 0xa1, 0x4c, 0x7f, 0x22, 0x70, 0x29, 0xa4, 0xda, 0x3f, 0xf9, 0xe8,
+	0x46, 0x61, 0xf5, 0xdc, 0xa9, 0xac, 0x6c, 0x56, 0xc6, 0x21, 0xf3, 0x75, 0xc9, 0x7c, 0x95, 0x2e,
+	0xe7, 0x31, 0xef, 0xa4, 0xb0, 0xbb, 0x38, 0x4e, 0x7f, 0x21, 0x70, 0x31, 0x63, 0x9c, 0xd1, 0xcd,
+	0x32, 0xdb, 0x9d, 0x31, 0x8e, 0x94, 0x1b, 0xd5, 0x81, 0xc8, 0x7f, 0x43, 0xf2, 0xbf, 0x4e, 0x75,
+	0x63, 0xc0, 0xb5, 0x3c, 0x9e, 0x1f, 0xc6, 0xa1, 0x63, 0x1d, 0xd1, 0x1f, 0x09, 0xbc, 0xd2, 0x6b,
+	0x97, 0x74, 0x62, 0x41, 0x37, 0xcb, 0x34, 0x40, 0x75, 0x11, 0x05, 0x03, 0x52, 0x6b, 0x48, 0x11,
+	0xcb, 0x74, 0xb1, 0xb4, 0x08, 0xfa, 0x1b, 0x81, 0xa9, 0xac, 0x89, 0x42, 0xcb, 0x5a, 0xd9, 0x37,
+	0x0e, 0x94, 0xb7, 0xce, 0x80, 0x44, 0x01, 0x6f, 0x4b, 0x01, 0x37, 0xe8, 0xc6, 0x20, 0x01, 0xc9,
+	0xe9, 0x6d, 0x1c, 0xc6, 0x23, 0xf2, 0x88, 0xfe, 0x8a, 0xbf, 0x88, 0xbe, 0x0a, 0x82, 0x96, 0x75,
+	0xb5, 0xaa, 0x9e, 0xa2, 0x59, 0xa5, 0xad, 0x49, 0x3d, 0x2b, 0x74, 0xa9, 0xbc, 0x1e, 0xfa, 0x0d,
+	0x81, 0xc9, 0xf4, 0x31, 0x4f, 0xaf, 0x17, 0xd6, 0xcf, 0x18, 0x35, 0x4a, 0xa3, 0x02, 0x02, 0x99,
+	0xae, 0x4a, 0xa6, 0xd7, 0xe8, 0xeb, 0x83, 0x98, 0xca, 0x69, 0xb3, 0xfd, 0xe6, 0xb3, 0x63, 0x95,
+	0x3c, 0x3f, 0x56, 0xc9, 0xdf, 0xc7, 0x2a, 0xf9, 0xea, 0x44, 0x1d, 0x79, 0x7e, 0xa2, 0x8e, 0xfc,
+	0x79, 0xa2, 0x8e, 0x7c, 0x32, 0x93, 0xe0, 0x1f, 0xa5, 0x32, 0x04, 0x8f, 0xdb, 0x5c, 0xec, 0x8d,
+	0xc9, 0xff, 0x37, 0xd7, 0xff, 0x1f, 0x00, 0xb0, 0xeb, 0xdd, 0x12, 0xdf, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListStoredChunk Queries a list of StoredChunk items.
+	GetStoredChunk(ctx context.Context, in *QueryGetStoredChunkRequest, opts ...grpc.CallOption) (*QueryGetStoredChunkResponse, error)
+	// ListStoredChunk defines the ListStoredChunk RPC.
+	ListStoredChunk(ctx context.Context, in *QueryAllStoredChunkRequest, opts ...grpc.CallOption) (*QueryAllStoredChunkResponse, error)
+	// ListUnreferencedChunks lists stored chunks no metadata entry refers to.
+	ListUnreferencedChunks(ctx context.Context, in *QueryUnreferencedChunksRequest, opts ...grpc.CallOption) (*QueryUnreferencedChunksResponse, error)
+	// GetStorageChallenge queries an open storage challenge.
+	GetStorageChallenge(ctx context.Context, in *QueryGetStorageChallengeRequest, opts ...grpc.CallOption) (*QueryGetStorageChallengeResponse, error)
+	// ListStorageChallenges lists the open storage challenges.
+	ListStorageChallenges(ctx context.Context, in *QueryAllStorageChallengeRequest, opts ...grpc.CallOption) (*QueryAllStorageChallengeResponse, error)
+	// GetStorageReputation queries the storage reputation of a validator.
+	GetStorageReputation(ctx context.Context, in *QueryGetStorageReputationRequest, opts ...grpc.CallOption) (*QueryGetStorageReputationResponse, error)
+	// ListStorageReputations lists the storage reputations of all validators.
+	ListStorageReputations(ctx context.Context, in *QueryAllStorageReputationRequest, opts ...grpc.CallOption) (*QueryAllStorageReputationResponse, error)
+	// StorageUsage queries the number of stored chunks and the bytes they take.
+	StorageUsage(ctx context.Context, in *QueryStorageUsageRequest, opts ...grpc.CallOption) (*QueryStorageUsageResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStoredChunk(ctx context.Context, in *QueryGetStoredChunkRequest, opts ...grpc.CallOption) (*QueryGetStoredChunkResponse, error) {
+	out := new(QueryGetStoredChunkResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/GetStoredChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListStoredChunk(ctx context.Context, in *QueryAllStoredChunkRequest, opts ...grpc.CallOption) (*QueryAllStoredChunkResponse, error) {
+	out := new(QueryAllStoredChunkResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/ListStoredChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListUnreferencedChunks(ctx context.Context, in *QueryUnreferencedChunksRequest, opts ...grpc.CallOption) (*QueryUnreferencedChunksResponse, error) {
+	out := new(QueryUnreferencedChunksResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/ListUnreferencedChunks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStorageChallenge(ctx context.Context, in *QueryGetStorageChallengeRequest, opts ...grpc.CallOption) (*QueryGetStorageChallengeResponse, error) {
+	out := new(QueryGetStorageChallengeResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/GetStorageChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListStorageChallenges(ctx context.Context, in *QueryAllStorageChallengeRequest, opts ...grpc.CallOption) (*QueryAllStorageChallengeResponse, error) {
+	out := new(QueryAllStorageChallengeResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/ListStorageChallenges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStorageReputation(ctx context.Context, in *QueryGetStorageReputationRequest, opts ...grpc.CallOption) (*QueryGetStorageReputationResponse, error) {
+	out := new(QueryGetStorageReputationResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/GetStorageReputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListStorageReputations(ctx context.Context, in *QueryAllStorageReputationRequest, opts ...grpc.CallOption) (*QueryAllStorageReputationResponse, error) {
+	out := new(QueryAllStorageReputationResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/ListStorageReputations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageUsage(ctx context.Context, in *QueryStorageUsageRequest, opts ...grpc.CallOption) (*QueryStorageUsageResponse, error) {
+	out := new(QueryStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Query/StorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListStoredChunk Queries a list of StoredChunk items.
+	GetStoredChunk(context.Context, *QueryGetStoredChunkRequest) (*QueryGetStoredChunkResponse, error)
+	// ListStoredChunk defines the ListStoredChunk RPC.
+	ListStoredChunk(context.Context, *QueryAllStoredChunkRequest) (*QueryAllStoredChunkResponse, error)
+	// ListUnreferencedChunks lists stored chunks no metadata entry refers to.
+	ListUnreferencedChunks(context.Context, *QueryUnreferencedChunksRequest) (*QueryUnreferencedChunksResponse, error)
+	// GetStorageChallenge queries an open storage challenge.
+	GetStorageChallenge(context.Context, *QueryGetStorageChallengeRequest) (*QueryGetStorageChallengeResponse, error)
+	// ListStorageChallenges lists the open storage challenges.
+	ListStorageChallenges(context.Context, *QueryAllStorageChallengeRequest) (*QueryAllStorageChallengeResponse, error)
+	// GetStorageReputation queries the storage reputation of a validator.
+	GetStorageReputation(context.Context, *QueryGetStorageReputationRequest) (*QueryGetStorageReputationResponse, error)
+	// ListStorageReputations lists the storage reputations of all validators.
+	ListStorageReputations(context.Context, *QueryAllStorageReputationRequest) (*QueryAllStorageReputationResponse, error)
+	// StorageUsage queries the number of stored chunks and the bytes they take.
+	StorageUsage(context.Context, *QueryStorageUsageRequest) (*QueryStorageUsageResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetStoredChunk(ctx context.Context, req *QueryGetStoredChunkRequest) (*QueryGetStoredChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoredChunk not implemented")
+}
+func (*UnimplementedQueryServer) ListStoredChunk(ctx context.Context, req *QueryAllStoredChunkRequest) (*QueryAllStoredChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoredChunk not implemented")
+}
+func (*UnimplementedQueryServer) ListUnreferencedChunks(ctx context.Context, req *QueryUnreferencedChunksRequest) (*QueryUnreferencedChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnreferencedChunks not implemented")
+}
+func (*UnimplementedQueryServer) GetStorageChallenge(ctx context.Context, req *QueryGetStorageChallengeRequest) (*QueryGetStorageChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageChallenge not implemented")
+}
+func (*UnimplementedQueryServer) ListStorageChallenges(ctx context.Context, req *QueryAllStorageChallengeRequest) (*QueryAllStorageChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageChallenges not implemented")
+}
+func (*UnimplementedQueryServer) GetStorageReputation(ctx context.Context, req *QueryGetStorageReputationRequest) (*QueryGetStorageReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageReputation not implemented")
+}
+func (*UnimplementedQueryServer) ListStorageReputations(ctx context.Context, req *QueryAllStorageReputationRequest) (*QueryAllStorageReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageReputations not implemented")
+}
+func (*UnimplementedQueryServer) StorageUsage(ctx context.Context, req *QueryStorageUsageRequest) (*QueryStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUsage not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStoredChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStoredChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStoredChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Query/GetStoredChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStoredChunk(ctx, req.(*QueryGetStoredChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListStoredChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStoredChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListStoredChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Query/ListStoredChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListStoredChunk(ctx, req.(*QueryAllStoredChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListUnreferencedChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnreferencedChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListUnreferencedChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Query/ListUnreferencedChunks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListUnreferencedChunks(ctx, req.(*QueryUnreferencedChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStorageChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStorageChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStorageChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Query/GetStorageChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStorageChallenge(ctx, req.(*QueryGetStorageChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListStorageChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStorageChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListStorageChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Query/ListStorageChallenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListStorageChallenges(ctx, req.(*QueryAllStorageChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStorageReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStorageReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStorageReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Query/GetStorageReputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStorageReputation(ctx, req.(*QueryGetStorageReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListStorageReputations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStorageReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListStorageReputations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Query/ListStorageReputations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListStorageReputations(ctx, req.(*QueryAllStorageReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Query/StorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageUsage(ctx, req.(*QueryStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "datachain.datastore.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetStoredChunk",
+			Handler:    _Query_GetStoredChunk_Handler,
+		},
+		{
+			MethodName: "ListStoredChunk",
+			Handler:    _Query_ListStoredChunk_Handler,
+		},
+		{
+			MethodName: "ListUnreferencedChunks",
+			Handler:    _Query_ListUnreferencedChunks_Handler,
+		},
+		{
+			MethodName: "GetStorageChallenge",
+			Handler:    _Query_GetStorageChallenge_Handler,
+		},
+		{
+			MethodName: "ListStorageChallenges",
+			Handler:    _Query_ListStorageChallenges_Handler,
+		},
+		{
+			MethodName: "GetStorageReputation",
+			Handler:    _Query_GetStorageReputation_Handler,
+		},
+		{
+			MethodName: "ListStorageReputations",
+			Handler:    _Query_ListStorageReputations_Handler,
+		},
+		{
+			MethodName: "StorageUsage",
+			Handler:    _Query_StorageUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "datachain/datastore/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStoredChunkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStoredChunkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStoredChunkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Raw {
+		i--
+		if m.Raw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStoredChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStoredChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStoredChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StoredChunk.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStoredChunkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStoredChunkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStoredChunkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Raw {
+		i--
+		if m.Raw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStoredChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStoredChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStoredChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredChunk) > 0 {
+		for iNdEx := len(m.StoredChunk) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredChunk[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnreferencedChunksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnreferencedChunksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnreferencedChunksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnreferencedChunksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnreferencedChunksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnreferencedChunksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStorageChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStorageChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStorageChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStorageChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStorageChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStorageChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Challenge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStorageChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStorageChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStorageChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStorageChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStorageChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStorageChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStorageReputationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStorageReputationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStorageReputationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStorageReputationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStorageReputationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStorageReputationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStorageReputationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStorageReputationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStorageReputationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStorageReputationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStorageReputationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStorageReputationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reputations) > 0 {
+		for iNdEx := len(m.Reputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetStoredChunkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Raw {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetStoredChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StoredChunk.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStoredChunkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Raw {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllStoredChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredChunk) > 0 {
+		for _, e := range m.StoredChunk {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnreferencedChunksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnreferencedChunksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStorageChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetStorageChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Challenge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStorageChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStorageChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStorageReputationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStorageReputationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reputation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStorageReputationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStorageReputationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reputations) > 0 {
+		for _, e := range m.Reputations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStorageUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredChunkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredChunkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Raw = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StoredChunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStoredChunkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStoredChunkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStoredChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Raw = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStoredChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStoredChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStoredChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredChunk = append(m.StoredChunk, StoredChunk{})
+			if err := m.StoredChunk[len(m.StoredChunk)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnreferencedChunksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnreferencedChunksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnreferencedChunksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
+}
+func (m *QueryUnreferencedChunksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnreferencedChunksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnreferencedChunksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, UnreferencedChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryGetStorageChallengeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStorageChallengeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStorageChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllStoredChunkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.StoredChunk) > 0 {
-		for _, e := range m.StoredChunk {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryGetStorageChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStorageChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStorageChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStorageChallengeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,12 +3199,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStorageChallengeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStorageChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -857,7 +3262,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStorageChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -880,15 +3285,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStorageChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStorageChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -915,7 +3320,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Challenges = append(m.Challenges, StorageChallenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -940,7 +3382,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredChunkRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStorageReputationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -963,15 +3405,15 @@ func (m *QueryGetStoredChunkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredChunkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStorageReputationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStorageReputationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -999,7 +3441,7 @@ func (m *QueryGetStoredChunkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1022,7 +3464,7 @@ func (m *QueryGetStoredChunkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredChunkResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStorageReputationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1045,15 +3487,15 @@ func (m *QueryGetStoredChunkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredChunkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStorageReputationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStorageReputationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredChunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1080,7 +3522,7 @@ func (m *QueryGetStoredChunkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StoredChunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1105,7 +3547,7 @@ func (m *QueryGetStoredChunkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStoredChunkRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStorageReputationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1128,10 +3570,10 @@ func (m *QueryAllStoredChunkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredChunkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStorageReputationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStorageReputationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1191,7 +3633,7 @@ func (m *QueryAllStoredChunkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStoredChunkResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStorageReputationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1214,15 +3656,15 @@ func (m *QueryAllStoredChunkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredChunkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStorageReputationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStorageReputationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredChunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1249,8 +3691,8 @@ func (m *QueryAllStoredChunkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoredChunk = append(m.StoredChunk, StoredChunk{})
-			if err := m.StoredChunk[len(m.StoredChunk)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reputations = append(m.Reputations, StorageReputation{})
+			if err := m.Reputations[len(m.Reputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1311,6 +3753,139 @@ func (m *QueryAllStoredChunkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryStorageUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StorageSlices splits chunk data into the slices storage challenges refer to. The last slice
+// may be shorter than sliceSize.
+func StorageSlices(data []byte, sliceSize uint64) [][]byte {
+	var slices [][]byte
+	for offset := uint64(0); offset < uint64(len(data)); offset += sliceSize {
+		end := min(offset+sliceSize, uint64(len(data)))
+		slices = append(slices, data[offset:end])
+	}
+	return slices
+}
+
+// StorageProofHash returns the slice hash a validator answers a challenge with. It covers the
+// validator address and the challenge id, so a proof cannot be replayed by another validator or
+// for another challenge.
+func StorageProofHash(validator sdk.ValAddress, challengeID uint64, slice []byte) []byte {
+	h := sha256.New()
+	h.Write(validator)
+	h.Write(binary.BigEndian.AppendUint64(nil, challengeID))
+	h.Write(slice)
+	return h.Sum(nil)
+}
+
+// NewMsgSubmitStorageProof answers challenge with the data of the challenged chunk on behalf of
+// the validator operated by creator.
+func NewMsgSubmitStorageProof(creator sdk.AccAddress, challenge StorageChallenge, data []byte) (*MsgSubmitStorageProof, error) {
+	slices := StorageSlices(data, challenge.SliceSize)
+	if challenge.Slice >= uint64(len(slices)) {
+		return nil, errors.Wrapf(ErrInvalidStorageProof, "chunk %s has no slice %d", challenge.Index, challenge.Slice)
+	}
+	_, proofs := merkle.ProofsFromByteSlices(slices)
+
+	return &MsgSubmitStorageProof{
+		Creator:     creator.String(),
+		ChallengeId: challenge.Id,
+		SliceHash:   StorageProofHash(sdk.ValAddress(creator), challenge.Id, slices[challenge.Slice]),
+		MerklePath:  proofs[challenge.Slice].Aunts,
+	}, nil
+}
+
+// VerifyStorageProof checks a proof of the validator for challenge against the data of the
+// challenged chunk. The Merkle root is recomputed from the on-chain data, so the path only shows
+// that the validator read the chunk when it answered.
+//
+// This is not a storage proof. Chunk data is public state, and anyone can answer a challenge
+// from a public RPC node without keeping the data; prove-storage does just that. Challenges only
+// tell whether a validator operator takes part, they do not show who holds the data.
+func VerifyStorageProof(data []byte, challenge StorageChallenge, validator sdk.ValAddress, sliceHash []byte, merklePath [][]byte) error {
+	slices := StorageSlices(data, challenge.SliceSize)
+	if challenge.Slice >= uint64(len(slices)) {
+		return errors.Wrapf(ErrInvalidStorageProof, "chunk %s has no slice %d", challenge.Index, challenge.Slice)
+	}
+	slice := slices[challenge.Slice]
+
+	root, proofs := merkle.ProofsFromByteSlices(slices)
+	proof := merkle.Proof{
+		Total:    int64(len(slices)),
+		Index:    int64(challenge.Slice),
+		LeafHash: proofs[challenge.Slice].LeafHash,
+		Aunts:    merklePath,
+	}
+	if err := proof.Verify(root, slice); err != nil {
+		return errors.Wrap(ErrInvalidStorageProof, err.Error())
+	}
+	if !bytes.Equal(sliceHash, StorageProofHash(validator, challenge.Id, slice)) {
+		return errors.Wrap(ErrInvalidStorageProof, "slice hash mismatch")
+	}
+
+	return nil
+}
+
+// Settle adds the outcome of the challenges of one epoch to the reputation.
+func (r *StorageReputation) Settle(proven, missed uint64) {
+	r.Proven += proven
+	r.Missed += missed
+	if missed > 0 {
+		r.ConsecutiveFailedEpochs++
+	} else {
+		r.ConsecutiveFailedEpochs = 0
+	}
+	if settled := r.Proven + r.Missed; settled > 0 {
+		r.Score = r.Proven * 1000 / settled
+	}
+}
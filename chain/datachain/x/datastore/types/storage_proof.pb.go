@@ -0,0 +1,1071 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: datachain/datastore/v1/storage_proof.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StorageChallenge asks every bonded validator to answer with one slice of a
+// stored chunk. Challenges are published at the end of a challenge epoch and
+// settled at the end of the next one.
+type StorageChallenge struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// epoch is the number of the epoch whose end published the challenge.
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// index is the index of the challenged chunk.
+	Index string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	// slice is the position of the challenged slice among the slices of the
+	// chunk, the leaves of its Merkle tree.
+	Slice     uint64 `protobuf:"varint,4,opt,name=slice,proto3" json:"slice,omitempty"`
+	SliceSize uint64 `protobuf:"varint,5,opt,name=slice_size,json=sliceSize,proto3" json:"slice_size,omitempty"`
+	// offset and length locate the slice in the chunk data.
+	Offset uint64 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint64 `protobuf:"varint,7,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *StorageChallenge) Reset()         { *m = StorageChallenge{} }
+func (m *StorageChallenge) String() string { return proto.CompactTextString(m) }
+func (*StorageChallenge) ProtoMessage()    {}
+func (*StorageChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc45f7250f18f3be, []int{0}
+}
+func (m *StorageChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageChallenge.Merge(m, src)
+}
+func (m *StorageChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageChallenge proto.InternalMessageInfo
+
+func (m *StorageChallenge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *StorageChallenge) GetSlice() uint64 {
+	if m != nil {
+		return m.Slice
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetSliceSize() uint64 {
+	if m != nil {
+		return m.SliceSize
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+// StorageProofRecord records that a validator answered a challenge.
+type StorageProofRecord struct {
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Validator   string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *StorageProofRecord) Reset()         { *m = StorageProofRecord{} }
+func (m *StorageProofRecord) String() string { return proto.CompactTextString(m) }
+func (*StorageProofRecord) ProtoMessage()    {}
+func (*StorageProofRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc45f7250f18f3be, []int{1}
+}
+func (m *StorageProofRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageProofRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageProofRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageProofRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageProofRecord.Merge(m, src)
+}
+func (m *StorageProofRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageProofRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageProofRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageProofRecord proto.InternalMessageInfo
+
+func (m *StorageProofRecord) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *StorageProofRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// StorageReputation sums up the storage challenges settled for a validator.
+type StorageReputation struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Proven    uint64 `protobuf:"varint,2,opt,name=proven,proto3" json:"proven,omitempty"`
+	Missed    uint64 `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	// consecutive_failed_epochs counts the epochs in a row in which the
+	// validator missed at least one challenge.
+	ConsecutiveFailedEpochs uint64 `protobuf:"varint,4,opt,name=consecutive_failed_epochs,json=consecutiveFailedEpochs,proto3" json:"consecutive_failed_epochs,omitempty"`
+	// score is the number of challenges proven per thousand settled.
+	Score uint64 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (m *StorageReputation) Reset()         { *m = StorageReputation{} }
+func (m *StorageReputation) String() string { return proto.CompactTextString(m) }
+func (*StorageReputation) ProtoMessage()    {}
+func (*StorageReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc45f7250f18f3be, []int{2}
+}
+func (m *StorageReputation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageReputation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageReputation.Merge(m, src)
+}
+func (m *StorageReputation) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageReputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageReputation proto.InternalMessageInfo
+
+func (m *StorageReputation) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *StorageReputation) GetProven() uint64 {
+	if m != nil {
+		return m.Proven
+	}
+	return 0
+}
+
+func (m *StorageReputation) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+func (m *StorageReputation) GetConsecutiveFailedEpochs() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailedEpochs
+	}
+	return 0
+}
+
+func (m *StorageReputation) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*StorageChallenge)(nil), "datachain.datastore.v1.StorageChallenge")
+	proto.RegisterType((*StorageProofRecord)(nil), "datachain.datastore.v1.StorageProofRecord")
+	proto.RegisterType((*StorageReputation)(nil), "datachain.datastore.v1.StorageReputation")
+}
+
+func init() {
+	proto.RegisterFile("datachain/datastore/v1/storage_proof.proto", fileDescriptor_dc45f7250f18f3be)
+}
+
+var fileDescriptor_dc45f7250f18f3be = []byte{
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0xad, 0xbb, 0xd9, 0xa2, 0x18, 0x84, 0xc0, 0x42, 0x4b, 0x16, 0xb4, 0x51, 0xb7, 0xa7, 0x0a,
+	0x89, 0x46, 0x2b, 0xc4, 0x85, 0x0b, 0x62, 0x11, 0x48, 0xdc, 0x90, 0x2b, 0x71, 0xe0, 0x12, 0x19,
+	0x7b, 0xd2, 0x58, 0xca, 0xc6, 0x91, 0xed, 0x46, 0xa5, 0x5f, 0xc1, 0xbf, 0xc0, 0x47, 0x70, 0xac,
+	0x38, 0x20, 0x8e, 0xa8, 0xfd, 0x11, 0x64, 0x3b, 0x4d, 0xcb, 0x71, 0x6f, 0x7e, 0xef, 0xcd, 0x8c,
+	0xe7, 0xcd, 0x0c, 0x7e, 0x26, 0x98, 0x65, 0xbc, 0x64, 0xb2, 0xce, 0xdc, 0xcb, 0x58, 0xa5, 0x21,
+	0x6b, 0xaf, 0x32, 0xf7, 0x60, 0x0b, 0xc8, 0x1b, 0xad, 0x54, 0x31, 0x6b, 0xb4, 0xb2, 0x8a, 0x9c,
+	0xf5, 0xb1, 0xb3, 0x3e, 0x76, 0xd6, 0x5e, 0x3d, 0x39, 0xe7, 0xca, 0xdc, 0x28, 0x93, 0xfb, 0xa8,
+	0x2c, 0x80, 0x90, 0x32, 0xf9, 0x8e, 0xf0, 0x83, 0x79, 0x28, 0xf5, 0xb6, 0x64, 0x55, 0x05, 0xf5,
+	0x02, 0xc8, 0x7d, 0x3c, 0x94, 0x22, 0x41, 0x63, 0x34, 0x8d, 0xe8, 0x50, 0x0a, 0xf2, 0x08, 0x9f,
+	0x42, 0xa3, 0x78, 0x99, 0x0c, 0xc7, 0x68, 0x7a, 0x42, 0x03, 0x70, 0xac, 0xac, 0x05, 0xac, 0x92,
+	0x93, 0x31, 0x9a, 0xc6, 0x34, 0x00, 0xc7, 0x9a, 0x4a, 0x72, 0x48, 0x22, 0x9f, 0x1e, 0x00, 0xb9,
+	0xc0, 0xd8, 0x3f, 0x72, 0x23, 0xd7, 0x90, 0x9c, 0x7a, 0x29, 0xf6, 0xcc, 0x5c, 0xae, 0x81, 0x9c,
+	0xe1, 0x91, 0x2a, 0x0a, 0x03, 0x36, 0x19, 0x79, 0xa9, 0x43, 0x8e, 0x77, 0x1d, 0xd9, 0x32, 0xb9,
+	0x13, 0xf8, 0x80, 0x26, 0x2b, 0x4c, 0xba, 0xa6, 0x3f, 0x3a, 0xfb, 0x14, 0xb8, 0xd2, 0x82, 0x5c,
+	0xe2, 0x7b, 0x7c, 0xef, 0x21, 0xef, 0x0d, 0xdc, 0xed, 0xb9, 0x0f, 0x82, 0xbc, 0xc6, 0x71, 0xcb,
+	0x2a, 0x29, 0x98, 0x55, 0xda, 0xbb, 0x89, 0xaf, 0x2f, 0x7f, 0xfd, 0x78, 0x7e, 0xd1, 0xcd, 0xe4,
+	0xd3, 0x5e, 0x7b, 0x23, 0x84, 0x06, 0x63, 0xe6, 0x56, 0xcb, 0x7a, 0x41, 0x0f, 0x39, 0x93, 0xdf,
+	0x08, 0x3f, 0xec, 0xbe, 0xa6, 0xd0, 0x2c, 0x2d, 0xb3, 0x52, 0xd5, 0xff, 0x97, 0x45, 0xb7, 0x2f,
+	0xeb, 0x8c, 0x36, 0x5a, 0xb5, 0x50, 0xfb, 0xa6, 0x22, 0xda, 0x21, 0xc7, 0xdf, 0x48, 0x63, 0x40,
+	0xf8, 0x21, 0x47, 0xb4, 0x43, 0xe4, 0x15, 0x3e, 0xe7, 0xaa, 0x36, 0xc0, 0x97, 0x56, 0xb6, 0x90,
+	0x17, 0x4c, 0x56, 0x20, 0x72, 0xbf, 0x17, 0xd3, 0x4d, 0xfe, 0xf1, 0x51, 0xc0, 0x7b, 0xaf, 0xbf,
+	0xf3, 0xb2, 0xdf, 0x10, 0x57, 0x7a, 0xbf, 0x86, 0x00, 0xae, 0x5f, 0xfe, 0xdc, 0xa6, 0x68, 0xb3,
+	0x4d, 0xd1, 0xdf, 0x6d, 0x8a, 0xbe, 0xed, 0xd2, 0xc1, 0x66, 0x97, 0x0e, 0xfe, 0xec, 0xd2, 0xc1,
+	0xe7, 0xa7, 0x87, 0x0b, 0x5c, 0x1d, 0xdd, 0xa0, 0xfd, 0xda, 0x80, 0xf9, 0x32, 0xf2, 0x67, 0xf4,
+	0xe2, 0xdf, 0x00, 0x1e, 0xe8, 0x82, 0x65, 0xa7, 0x02, 0x00, 0x00,
+}
+
+func (m *StorageChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Offset != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SliceSize != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.SliceSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Slice != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Slice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintStorageProof(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageProofRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageProofRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageProofRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStorageProof(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ConsecutiveFailedEpochs != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.ConsecutiveFailedEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Missed != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Missed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Proven != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Proven))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStorageProof(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStorageProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStorageProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StorageChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStorageProof(uint64(m.Id))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovStorageProof(uint64(m.Epoch))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovStorageProof(uint64(l))
+	}
+	if m.Slice != 0 {
+		n += 1 + sovStorageProof(uint64(m.Slice))
+	}
+	if m.SliceSize != 0 {
+		n += 1 + sovStorageProof(uint64(m.SliceSize))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovStorageProof(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovStorageProof(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *StorageProofRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovStorageProof(uint64(m.ChallengeId))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStorageProof(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStorageProof(uint64(l))
+	}
+	if m.Proven != 0 {
+		n += 1 + sovStorageProof(uint64(m.Proven))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovStorageProof(uint64(m.Missed))
+	}
+	if m.ConsecutiveFailedEpochs != 0 {
+		n += 1 + sovStorageProof(uint64(m.ConsecutiveFailedEpochs))
+	}
+	if m.Score != 0 {
+		n += 1 + sovStorageProof(uint64(m.Score))
+	}
+	return n
+}
+
+func sovStorageProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStorageProof(x uint64) (n int) {
+	return sovStorageProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StorageChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorageProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slice", wireType)
+			}
+			m.Slice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SliceSize", wireType)
+			}
+			m.SliceSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SliceSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorageProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageProofRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorageProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageProofRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageProofRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorageProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorageProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proven", wireType)
+			}
+			m.Proven = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Proven |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailedEpochs", wireType)
+			}
+			m.ConsecutiveFailedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorageProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStorageProof(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStorageProof
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStorageProof
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStorageProof
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStorageProof
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStorageProof        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStorageProof          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStorageProof = fmt.Errorf("proto: unexpected end of group")
+)
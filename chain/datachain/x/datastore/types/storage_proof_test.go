@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"datachain/x/datastore/types"
+)
+
+func TestStorageProof(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 100)
+	challenge := types.StorageChallenge{Id: 7, Index: "chunk", Slice: 3, SliceSize: 64, Offset: 192, Length: 64}
+	operator := sdk.AccAddress("operator____________")
+
+	msg, err := types.NewMsgSubmitStorageProof(operator, challenge, data)
+	require.NoError(t, err)
+	require.Equal(t, challenge.Id, msg.ChallengeId)
+	require.NoError(t, types.VerifyStorageProof(data, challenge, sdk.ValAddress(operator), msg.SliceHash, msg.MerklePath))
+
+	// the slice hash binds the proof to the validator
+	other := sdk.ValAddress("other_______________")
+	require.ErrorIs(t, types.VerifyStorageProof(data, challenge, other, msg.SliceHash, msg.MerklePath), types.ErrInvalidStorageProof)
+
+	// and the path to the chunk data
+	tampered := bytes.Clone(data)
+	tampered[len(tampered)-1] ^= 0xff
+	require.ErrorIs(t, types.VerifyStorageProof(tampered, challenge, sdk.ValAddress(operator), msg.SliceHash, msg.MerklePath), types.ErrInvalidStorageProof)
+	require.ErrorIs(t, types.VerifyStorageProof(data, challenge, sdk.ValAddress(operator), msg.SliceHash, msg.MerklePath[1:]), types.ErrInvalidStorageProof)
+
+	challenge.Slice = 16
+	_, err = types.NewMsgSubmitStorageProof(operator, challenge, data)
+	require.ErrorIs(t, err, types.ErrInvalidStorageProof)
+}
+
+func TestStorageReputationSettle(t *testing.T) {
+	var reputation types.StorageReputation
+
+	reputation.Settle(3, 1)
+	require.Equal(t, types.StorageReputation{Proven: 3, Missed: 1, ConsecutiveFailedEpochs: 1, Score: 750}, reputation)
+
+	reputation.Settle(0, 4)
+	require.Equal(t, uint64(2), reputation.ConsecutiveFailedEpochs)
+	require.Equal(t, uint64(375), reputation.Score)
+
+	reputation.Settle(4, 0)
+	require.Equal(t, uint64(0), reputation.ConsecutiveFailedEpochs)
+	require.Equal(t, uint64(583), reputation.Score)
+}
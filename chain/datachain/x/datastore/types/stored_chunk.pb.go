@@ -22,11 +22,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ChunkCodec is the compression applied to the data of a stored chunk.
+type ChunkCodec int32
+
+const (
+	// CHUNK_CODEC_NONE stores the data as received.
+	ChunkCodec_CHUNK_CODEC_NONE   ChunkCodec = 0
+	ChunkCodec_CHUNK_CODEC_ZSTD   ChunkCodec = 1
+	ChunkCodec_CHUNK_CODEC_SNAPPY ChunkCodec = 2
+)
+
+var ChunkCodec_name = map[int32]string{
+	0: "CHUNK_CODEC_NONE",
+	1: "CHUNK_CODEC_ZSTD",
+	2: "CHUNK_CODEC_SNAPPY",
+}
+
+var ChunkCodec_value = map[string]int32{
+	"CHUNK_CODEC_NONE":   0,
+	"CHUNK_CODEC_ZSTD":   1,
+	"CHUNK_CODEC_SNAPPY": 2,
+}
+
+func (x ChunkCodec) String() string {
+	return proto.EnumName(ChunkCodec_name, int32(x))
+}
+
+func (ChunkCodec) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b8e004da6708a33, []int{0}
+}
+
 // StoredChunk defines the StoredChunk message.
 type StoredChunk struct {
-	Index   string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Data    []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// data is the chunk data as stored, compressed with codec.
+	Data    []byte     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Creator string     `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Codec   ChunkCodec `protobuf:"varint,4,opt,name=codec,proto3,enum=datachain.datastore.v1.ChunkCodec" json:"codec,omitempty"`
 }
 
 func (m *StoredChunk) Reset()         { *m = StoredChunk{} }
@@ -83,8 +115,70 @@ func (m *StoredChunk) GetCreator() string {
 	return ""
 }
 
+func (m *StoredChunk) GetCodec() ChunkCodec {
+	if m != nil {
+		return m.Codec
+	}
+	return ChunkCodec_CHUNK_CODEC_NONE
+}
+
+// StorageUsage counts the stored chunks and the bytes of their data as stored, after compression.
+type StorageUsage struct {
+	Chunks uint64 `protobuf:"varint,1,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	Bytes  uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (m *StorageUsage) Reset()         { *m = StorageUsage{} }
+func (m *StorageUsage) String() string { return proto.CompactTextString(m) }
+func (*StorageUsage) ProtoMessage()    {}
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b8e004da6708a33, []int{1}
+}
+func (m *StorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUsage.Merge(m, src)
+}
+func (m *StorageUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUsage proto.InternalMessageInfo
+
+func (m *StorageUsage) GetChunks() uint64 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("datachain.datastore.v1.ChunkCodec", ChunkCodec_name, ChunkCodec_value)
 	proto.RegisterType((*StoredChunk)(nil), "datachain.datastore.v1.StoredChunk")
+	proto.RegisterType((*StorageUsage)(nil), "datachain.datastore.v1.StorageUsage")
 }
 
 func init() {
@@ -92,18 +186,26 @@ func init() {
 }
 
 var fileDescriptor_1b8e004da6708a33 = []byte{
-	// 176 bytes of a gzipped FileDescriptorProto
+	// 295 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4c, 0x49, 0x2c, 0x49,
 	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0x8a, 0x4b, 0xf2, 0x8b, 0x52, 0xf5, 0xcb, 0x0c,
 	0xf5, 0xc1, 0x8c, 0x94, 0xf8, 0xe4, 0x8c, 0xd2, 0xbc, 0x6c, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c,
-	0x21, 0x31, 0xb8, 0x52, 0x3d, 0xb8, 0x52, 0xbd, 0x32, 0x43, 0xa5, 0x40, 0x2e, 0xee, 0x60, 0xb0,
-	0x6a, 0x67, 0x90, 0x62, 0x21, 0x11, 0x2e, 0xd6, 0xcc, 0xbc, 0x94, 0xd4, 0x0a, 0x09, 0x46, 0x05,
-	0x46, 0x0d, 0xce, 0x20, 0x08, 0x47, 0x48, 0x88, 0x8b, 0x05, 0xa4, 0x49, 0x82, 0x49, 0x81, 0x51,
-	0x83, 0x27, 0x08, 0xcc, 0x16, 0x92, 0xe0, 0x62, 0x4f, 0x2e, 0x4a, 0x4d, 0x2c, 0xc9, 0x2f, 0x92,
-	0x60, 0x06, 0xab, 0x85, 0x71, 0x9d, 0x4c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x1a, 0xe1, 0xde, 0x0a, 0x24, 0x17, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x1d, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xc6, 0x21, 0xf4, 0xd5, 0x00, 0x00, 0x00,
+	0x21, 0x31, 0xb8, 0x52, 0x3d, 0xb8, 0x52, 0xbd, 0x32, 0x43, 0xa5, 0x6e, 0x46, 0x2e, 0xee, 0x60,
+	0xb0, 0x72, 0x67, 0x90, 0x6a, 0x21, 0x11, 0x2e, 0xd6, 0xcc, 0xbc, 0x94, 0xd4, 0x0a, 0x09, 0x46,
+	0x05, 0x46, 0x0d, 0xce, 0x20, 0x08, 0x47, 0x48, 0x88, 0x8b, 0x05, 0xa4, 0x4b, 0x82, 0x49, 0x81,
+	0x51, 0x83, 0x27, 0x08, 0xcc, 0x16, 0x92, 0xe0, 0x62, 0x4f, 0x2e, 0x4a, 0x4d, 0x2c, 0xc9, 0x2f,
+	0x92, 0x60, 0x06, 0xab, 0x85, 0x71, 0x85, 0x2c, 0xb8, 0x58, 0x93, 0xf3, 0x53, 0x52, 0x93, 0x25,
+	0x58, 0x14, 0x18, 0x35, 0xf8, 0x8c, 0x94, 0xf4, 0xb0, 0xdb, 0xad, 0x07, 0xb6, 0xd1, 0x19, 0xa4,
+	0x32, 0x08, 0xa2, 0x41, 0xc9, 0x86, 0x8b, 0x07, 0xe4, 0x98, 0xc4, 0xf4, 0xd4, 0xd0, 0xe2, 0xc4,
+	0xf4, 0x54, 0x21, 0x31, 0x2e, 0x36, 0xb0, 0x27, 0x8a, 0xc1, 0xce, 0x61, 0x09, 0x82, 0xf2, 0x40,
+	0xae, 0x4c, 0xaa, 0x2c, 0x49, 0x2d, 0x06, 0x3b, 0x88, 0x25, 0x08, 0xc2, 0xd1, 0x0a, 0xe0, 0xe2,
+	0x42, 0x18, 0x29, 0x24, 0xc2, 0x25, 0xe0, 0xec, 0x11, 0xea, 0xe7, 0x1d, 0xef, 0xec, 0xef, 0xe2,
+	0xea, 0x1c, 0xef, 0xe7, 0xef, 0xe7, 0x2a, 0xc0, 0x80, 0x2e, 0x1a, 0x15, 0x1c, 0xe2, 0x22, 0xc0,
+	0x28, 0x24, 0xc6, 0x25, 0x84, 0x2c, 0x1a, 0xec, 0xe7, 0x18, 0x10, 0x10, 0x29, 0xc0, 0xe4, 0x64,
+	0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
+	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xd2, 0x88, 0xa0, 0xaf, 0x40,
+	0x0a, 0xfc, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x98, 0x1b, 0x03, 0x06, 0x00, 0xfe,
+	0x2d, 0x6b, 0x03, 0xa0, 0x01, 0x00, 0x00,
 }
 
 func (m *StoredChunk) Marshal() (dAtA []byte, err error) {
@@ -126,6 +228,11 @@ func (m *StoredChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Codec != 0 {
+		i = encodeVarintStoredChunk(dAtA, i, uint64(m.Codec))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -150,6 +257,39 @@ func (m *StoredChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		i = encodeVarintStoredChunk(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintStoredChunk(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStoredChunk(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStoredChunk(v)
 	base := offset
@@ -179,6 +319,24 @@ func (m *StoredChunk) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStoredChunk(uint64(l))
 	}
+	if m.Codec != 0 {
+		n += 1 + sovStoredChunk(uint64(m.Codec))
+	}
+	return n
+}
+
+func (m *StorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chunks != 0 {
+		n += 1 + sovStoredChunk(uint64(m.Chunks))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovStoredChunk(uint64(m.Bytes))
+	}
 	return n
 }
 
@@ -315,6 +473,113 @@ func (m *StoredChunk) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			m.Codec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Codec |= ChunkCodec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStoredChunk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStoredChunk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStoredChunk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStoredChunk(dAtA[iNdEx:])
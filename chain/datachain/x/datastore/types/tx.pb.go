@@ -532,6 +532,118 @@ func (m *MsgDeleteStoredChunkResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteStoredChunkResponse proto.InternalMessageInfo
 
+// MsgSubmitStorageProof answers a storage challenge for the validator operated
+// by creator. It is checked against the chunk in state, so it does not prove
+// that the validator stores the slice.
+type MsgSubmitStorageProof struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChallengeId uint64 `protobuf:"varint,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// slice_hash is the SHA-256 of the validator address, the big-endian
+	// challenge id and the slice.
+	SliceHash []byte `protobuf:"bytes,3,opt,name=slice_hash,json=sliceHash,proto3" json:"slice_hash,omitempty"`
+	// merkle_path holds the sibling hashes from the slice up to the Merkle root
+	// of the chunk's slices.
+	MerklePath [][]byte `protobuf:"bytes,4,rep,name=merkle_path,json=merklePath,proto3" json:"merkle_path,omitempty"`
+}
+
+func (m *MsgSubmitStorageProof) Reset()         { *m = MsgSubmitStorageProof{} }
+func (m *MsgSubmitStorageProof) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitStorageProof) ProtoMessage()    {}
+func (*MsgSubmitStorageProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd9136c332fedb11, []int{10}
+}
+func (m *MsgSubmitStorageProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitStorageProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitStorageProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitStorageProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitStorageProof.Merge(m, src)
+}
+func (m *MsgSubmitStorageProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitStorageProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitStorageProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitStorageProof proto.InternalMessageInfo
+
+func (m *MsgSubmitStorageProof) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSubmitStorageProof) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *MsgSubmitStorageProof) GetSliceHash() []byte {
+	if m != nil {
+		return m.SliceHash
+	}
+	return nil
+}
+
+func (m *MsgSubmitStorageProof) GetMerklePath() [][]byte {
+	if m != nil {
+		return m.MerklePath
+	}
+	return nil
+}
+
+// MsgSubmitStorageProofResponse defines the MsgSubmitStorageProofResponse message.
+type MsgSubmitStorageProofResponse struct {
+}
+
+func (m *MsgSubmitStorageProofResponse) Reset()         { *m = MsgSubmitStorageProofResponse{} }
+func (m *MsgSubmitStorageProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitStorageProofResponse) ProtoMessage()    {}
+func (*MsgSubmitStorageProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd9136c332fedb11, []int{11}
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitStorageProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitStorageProofResponse.Merge(m, src)
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitStorageProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitStorageProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitStorageProofResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "datachain.datastore.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "datachain.datastore.v1.MsgUpdateParamsResponse")
@@ -543,49 +655,58 @@ func init() {
 	proto.RegisterType((*MsgUpdateStoredChunkResponse)(nil), "datachain.datastore.v1.MsgUpdateStoredChunkResponse")
 	proto.RegisterType((*MsgDeleteStoredChunk)(nil), "datachain.datastore.v1.MsgDeleteStoredChunk")
 	proto.RegisterType((*MsgDeleteStoredChunkResponse)(nil), "datachain.datastore.v1.MsgDeleteStoredChunkResponse")
+	proto.RegisterType((*MsgSubmitStorageProof)(nil), "datachain.datastore.v1.MsgSubmitStorageProof")
+	proto.RegisterType((*MsgSubmitStorageProofResponse)(nil), "datachain.datastore.v1.MsgSubmitStorageProofResponse")
 }
 
 func init() { proto.RegisterFile("datachain/datastore/v1/tx.proto", fileDescriptor_cd9136c332fedb11) }
 
 var fileDescriptor_cd9136c332fedb11 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x35, 0x3f, 0x90, 0x8f, 0x48, 0xd0, 0x53, 0xd4, 0xba, 0xa6, 0x72, 0xa3, 0x00, 0x22,
-	0x8a, 0x4a, 0xac, 0x86, 0x1f, 0x42, 0xdd, 0x9a, 0x76, 0x61, 0x88, 0x84, 0x1c, 0x58, 0x58, 0xaa,
-	0x23, 0x3e, 0x39, 0x16, 0xb5, 0xcf, 0xf2, 0x5d, 0x4a, 0xba, 0xa1, 0x8e, 0x4c, 0xfc, 0x19, 0x8c,
-	0x19, 0xf8, 0x0b, 0x18, 0x50, 0xc7, 0x8a, 0xa9, 0x13, 0x42, 0xc9, 0x90, 0x7f, 0x03, 0xdd, 0x39,
-	0xb1, 0x43, 0xec, 0xa4, 0x01, 0x09, 0x75, 0x89, 0xee, 0xee, 0x7d, 0xf7, 0xbe, 0xef, 0x7d, 0x79,
-	0xef, 0x0c, 0x77, 0x2c, 0xcc, 0x71, 0xa7, 0x8b, 0x1d, 0xcf, 0x10, 0x2b, 0xc6, 0x69, 0x40, 0x8c,
-	0xd3, 0x3d, 0x83, 0xf7, 0xeb, 0x7e, 0x40, 0x39, 0x45, 0x1b, 0x11, 0xa0, 0x1e, 0x01, 0xea, 0xa7,
-	0x7b, 0xda, 0x3a, 0x76, 0x1d, 0x8f, 0x1a, 0xf2, 0x37, 0x84, 0x6a, 0x9b, 0x1d, 0xca, 0x5c, 0xca,
-	0x0c, 0x97, 0xd9, 0x22, 0x85, 0xcb, 0xec, 0x49, 0x60, 0x2b, 0x0c, 0x1c, 0xcb, 0x9d, 0x11, 0x6e,
-	0x26, 0xa1, 0xfb, 0x0b, 0xf8, 0x7d, 0x1c, 0x60, 0x77, 0x0a, 0x2a, 0xd9, 0xd4, 0xa6, 0xe1, 0x65,
-	0xb1, 0x0a, 0x4f, 0x2b, 0xdf, 0x01, 0xbc, 0xd3, 0x62, 0xf6, 0x1b, 0xdf, 0xc2, 0x9c, 0xbc, 0x92,
-	0x78, 0xf4, 0x1c, 0x2a, 0xb8, 0xc7, 0xbb, 0x34, 0x70, 0xf8, 0x99, 0x0a, 0xca, 0xa0, 0xaa, 0x34,
-	0xd5, 0x1f, 0x5f, 0x1f, 0x97, 0x26, 0x9c, 0x07, 0x96, 0x15, 0x10, 0xc6, 0xda, 0x3c, 0x70, 0x3c,
-	0xdb, 0x8c, 0xa1, 0xe8, 0x00, 0x16, 0x42, 0x46, 0x75, 0xad, 0x0c, 0xaa, 0xb7, 0x1b, 0x7a, 0x3d,
-	0xbd, 0xec, 0x7a, 0xc8, 0xd3, 0x54, 0x2e, 0x7e, 0xee, 0x64, 0xbe, 0x8c, 0x07, 0x35, 0x60, 0x4e,
-	0x2e, 0xee, 0xbf, 0x38, 0x1f, 0x0f, 0x6a, 0x71, 0xca, 0x4f, 0xe3, 0x41, 0xed, 0x61, 0x5c, 0x5c,
-	0x7f, 0xa6, 0xbc, 0x39, 0xd1, 0x95, 0x2d, 0xb8, 0x39, 0x77, 0x64, 0x12, 0xe6, 0x53, 0x8f, 0x91,
-	0xca, 0x15, 0x80, 0xc5, 0x16, 0xb3, 0xdb, 0xc4, 0xb3, 0x0e, 0xbb, 0x3d, 0xef, 0x3d, 0x2a, 0xc1,
-	0xbc, 0xe3, 0x59, 0xa4, 0xaf, 0xe6, 0x45, 0x71, 0x66, 0xb8, 0x41, 0x08, 0xe6, 0x44, 0x7a, 0xb5,
-	0x50, 0x06, 0xd5, 0xa2, 0x29, 0xd7, 0xa8, 0x01, 0x6f, 0x75, 0x02, 0x82, 0x39, 0x0d, 0xae, 0x35,
-	0x62, 0x0a, 0x14, 0x79, 0x7c, 0x1a, 0x70, 0x69, 0x82, 0x62, 0xca, 0x35, 0xda, 0x86, 0x4a, 0xa7,
-	0x8b, 0x3d, 0x8f, 0x9c, 0xbc, 0x3c, 0x52, 0xb3, 0x32, 0x10, 0x1f, 0xa0, 0x1a, 0xbc, 0xcb, 0x1d,
-	0x97, 0xd0, 0x1e, 0x7f, 0xed, 0xb8, 0x84, 0x71, 0xec, 0xfa, 0x6a, 0xae, 0x0c, 0xaa, 0x39, 0x33,
-	0x71, 0xbe, 0x5f, 0x14, 0x0e, 0x4d, 0xb9, 0x2a, 0x1b, 0xb0, 0x34, 0x5b, 0x59, 0x54, 0xf2, 0x39,
-	0x90, 0x81, 0x43, 0x01, 0x23, 0x6d, 0x61, 0xd8, 0xa4, 0xf4, 0x7f, 0x29, 0x28, 0xb2, 0x6b, 0x2d,
-	0xcd, 0xae, 0x6c, 0x6c, 0xd7, 0x9c, 0x38, 0x1d, 0x6e, 0xa7, 0x69, 0x98, 0x17, 0x19, 0xfe, 0x67,
-	0x37, 0x2b, 0x32, 0xa1, 0x21, 0x12, 0xe9, 0x49, 0x8d, 0x47, 0xe4, 0x84, 0xfc, 0x27, 0x8d, 0xa9,
-	0x7a, 0x12, 0x7c, 0x53, 0x3d, 0x8d, 0x6f, 0x39, 0x98, 0x6d, 0x31, 0x1b, 0x75, 0x61, 0xf1, 0x8f,
-	0xa1, 0x7d, 0xb4, 0x68, 0xd8, 0xe6, 0xa6, 0x42, 0x33, 0x56, 0x04, 0x4e, 0x19, 0xd1, 0x31, 0x54,
-	0xe2, 0xd1, 0x79, 0xb0, 0xe4, 0x76, 0x84, 0xd2, 0x76, 0x57, 0x41, 0x45, 0x04, 0x1f, 0xe0, 0x7a,
-	0xb2, 0x51, 0x97, 0xa5, 0x48, 0xa0, 0xb5, 0xa7, 0x7f, 0x83, 0x9e, 0x25, 0x4e, 0x36, 0xdf, 0xee,
-	0xb5, 0xfe, 0xac, 0x4a, 0xbc, 0xb0, 0xa9, 0x04, 0x71, 0xb2, 0xa3, 0x96, 0x11, 0x27, 0xd0, 0x4b,
-	0x89, 0x17, 0x76, 0x8f, 0x96, 0xff, 0x28, 0x9e, 0xdb, 0xe6, 0xb3, 0x8b, 0xa1, 0x0e, 0x2e, 0x87,
-	0x3a, 0xf8, 0x35, 0xd4, 0xc1, 0xe7, 0x91, 0x9e, 0xb9, 0x1c, 0xe9, 0x99, 0xab, 0x91, 0x9e, 0x79,
-	0x7b, 0x2f, 0xfd, 0xb5, 0xe5, 0x67, 0x3e, 0x61, 0xef, 0x0a, 0xf2, 0x9b, 0xf1, 0xe4, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x37, 0x02, 0xd8, 0x2a, 0xf0, 0x06, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xbf, 0x4f, 0xdb, 0x4e,
+	0x14, 0xcf, 0x91, 0x84, 0xaf, 0xf2, 0x88, 0xf4, 0x2d, 0xa7, 0x14, 0x8c, 0x0b, 0x4e, 0x9a, 0xb6,
+	0x6a, 0x14, 0x41, 0x2c, 0x28, 0x54, 0x15, 0x1b, 0x3f, 0x86, 0x32, 0x20, 0x21, 0xd3, 0x2e, 0x5d,
+	0xa2, 0x23, 0xbe, 0xda, 0x16, 0xb1, 0xcf, 0xf2, 0x5d, 0x28, 0x74, 0xaa, 0x18, 0x3b, 0xf5, 0xcf,
+	0xe8, 0xc8, 0xd0, 0xb1, 0xea, 0x58, 0x31, 0xa2, 0x4e, 0x4c, 0x55, 0x05, 0x03, 0xff, 0x46, 0x75,
+	0x76, 0xe2, 0xd0, 0xd8, 0x84, 0x1f, 0x52, 0xd5, 0xc5, 0x3a, 0xbf, 0xf7, 0xb9, 0xf7, 0xf9, 0x7c,
+	0xde, 0xe9, 0xdd, 0x41, 0xd9, 0x24, 0x82, 0xb4, 0x6c, 0xe2, 0x78, 0xba, 0x5c, 0x71, 0xc1, 0x02,
+	0xaa, 0xef, 0xcd, 0xeb, 0x62, 0xbf, 0xe1, 0x07, 0x4c, 0x30, 0x3c, 0x11, 0x03, 0x1a, 0x31, 0xa0,
+	0xb1, 0x37, 0xaf, 0x8e, 0x13, 0xd7, 0xf1, 0x98, 0x1e, 0x7e, 0x23, 0xa8, 0x3a, 0xd9, 0x62, 0xdc,
+	0x65, 0x5c, 0x77, 0xb9, 0x25, 0x4b, 0xb8, 0xdc, 0xea, 0x26, 0xa6, 0xa2, 0x44, 0x33, 0xfc, 0xd3,
+	0xa3, 0x9f, 0x6e, 0xea, 0xd1, 0x15, 0xfc, 0x3e, 0x09, 0x88, 0xdb, 0x03, 0x95, 0x2c, 0x66, 0xb1,
+	0x68, 0xb3, 0x5c, 0x45, 0xd1, 0xea, 0x77, 0x04, 0xff, 0x6f, 0x72, 0xeb, 0xb5, 0x6f, 0x12, 0x41,
+	0xb7, 0x42, 0x3c, 0x7e, 0x0e, 0x05, 0xd2, 0x11, 0x36, 0x0b, 0x1c, 0x71, 0xa0, 0xa0, 0x0a, 0xaa,
+	0x15, 0x56, 0x95, 0x1f, 0x5f, 0xe6, 0x4a, 0x5d, 0xce, 0x15, 0xd3, 0x0c, 0x28, 0xe7, 0xdb, 0x22,
+	0x70, 0x3c, 0xcb, 0xe8, 0x43, 0xf1, 0x0a, 0x8c, 0x46, 0x8c, 0xca, 0x48, 0x05, 0xd5, 0xc6, 0x16,
+	0xb4, 0x46, 0xba, 0xed, 0x46, 0xc4, 0xb3, 0x5a, 0x38, 0xfe, 0x59, 0xce, 0x7c, 0xbe, 0x38, 0xaa,
+	0x23, 0xa3, 0xbb, 0x71, 0xf9, 0xc5, 0xe1, 0xc5, 0x51, 0xbd, 0x5f, 0xf2, 0xe3, 0xc5, 0x51, 0xfd,
+	0x49, 0xdf, 0xdc, 0xfe, 0x25, 0x7b, 0x03, 0xa2, 0xab, 0x53, 0x30, 0x39, 0x10, 0x32, 0x28, 0xf7,
+	0x99, 0xc7, 0x69, 0xf5, 0x14, 0x41, 0x71, 0x93, 0x5b, 0xdb, 0xd4, 0x33, 0xd7, 0xec, 0x8e, 0xb7,
+	0x8b, 0x4b, 0x90, 0x77, 0x3c, 0x93, 0xee, 0x2b, 0x79, 0x69, 0xce, 0x88, 0x7e, 0x30, 0x86, 0x9c,
+	0x2c, 0xaf, 0x8c, 0x56, 0x50, 0xad, 0x68, 0x84, 0x6b, 0xbc, 0x00, 0xff, 0xb5, 0x02, 0x4a, 0x04,
+	0x0b, 0xae, 0x6d, 0x44, 0x0f, 0x28, 0xeb, 0xf8, 0x2c, 0x10, 0x61, 0x13, 0x0a, 0x46, 0xb8, 0xc6,
+	0xd3, 0x50, 0x68, 0xd9, 0xc4, 0xf3, 0x68, 0x7b, 0x63, 0x5d, 0xc9, 0x86, 0x89, 0x7e, 0x00, 0xd7,
+	0xe1, 0x9e, 0x70, 0x5c, 0xca, 0x3a, 0xe2, 0x95, 0xe3, 0x52, 0x2e, 0x88, 0xeb, 0x2b, 0xb9, 0x0a,
+	0xaa, 0xe5, 0x8c, 0x44, 0x7c, 0xb9, 0x28, 0x3b, 0xd4, 0xe3, 0xaa, 0x4e, 0x40, 0xe9, 0xb2, 0xb3,
+	0xd8, 0xf2, 0x21, 0x0a, 0x13, 0x6b, 0x12, 0x46, 0xb7, 0x65, 0xc3, 0xba, 0xd6, 0xef, 0x62, 0x28,
+	0x6e, 0xd7, 0x48, 0x5a, 0xbb, 0xb2, 0xfd, 0x76, 0x0d, 0x88, 0xd3, 0x60, 0x3a, 0x4d, 0xc3, 0xa0,
+	0xc8, 0xe8, 0xcc, 0xfe, 0xad, 0xc8, 0x84, 0x86, 0x58, 0xa4, 0x17, 0x6a, 0x5c, 0xa7, 0x6d, 0xfa,
+	0x97, 0x34, 0xa6, 0xea, 0x49, 0xf0, 0xc5, 0x7a, 0xbe, 0x22, 0xb8, 0x2f, 0x8f, 0xbc, 0xb3, 0xe3,
+	0x3a, 0x42, 0x02, 0x88, 0x45, 0xb7, 0x02, 0xc6, 0xde, 0xde, 0x49, 0xd1, 0x43, 0x28, 0xb6, 0x6c,
+	0xd2, 0x6e, 0x53, 0xcf, 0xa2, 0x4d, 0xc7, 0x0c, 0x85, 0xe5, 0x8c, 0xb1, 0x38, 0xb6, 0x61, 0xe2,
+	0x19, 0x00, 0xde, 0x76, 0x5a, 0xb4, 0x69, 0x13, 0x6e, 0x77, 0x1b, 0x59, 0x08, 0x23, 0x2f, 0x09,
+	0xb7, 0x71, 0x19, 0xc6, 0x5c, 0x1a, 0xec, 0xb6, 0x69, 0xd3, 0x27, 0xc2, 0x56, 0x72, 0x95, 0x6c,
+	0xad, 0x68, 0x40, 0x14, 0xda, 0x22, 0xc2, 0x1e, 0xb0, 0x57, 0x86, 0x99, 0x54, 0xf5, 0x3d, 0x7f,
+	0x0b, 0xdf, 0xf2, 0x90, 0xdd, 0xe4, 0x16, 0xb6, 0xa1, 0xf8, 0xc7, 0xa5, 0xf4, 0xf4, 0xaa, 0xcb,
+	0x64, 0x60, 0xea, 0x55, 0xfd, 0x86, 0xc0, 0x1e, 0x23, 0x6e, 0x42, 0xa1, 0x7f, 0x35, 0x3c, 0x1e,
+	0xb2, 0x3b, 0x46, 0xa9, 0xb3, 0x37, 0x41, 0xc5, 0x04, 0xef, 0x60, 0x3c, 0x39, 0x88, 0xc3, 0x4a,
+	0x24, 0xd0, 0xea, 0xe2, 0x6d, 0xd0, 0x97, 0x89, 0x93, 0xc3, 0x35, 0x7b, 0x6d, 0x7f, 0x6e, 0x4a,
+	0x7c, 0xe5, 0xd0, 0x48, 0xe2, 0xe4, 0xc4, 0x0c, 0x23, 0x4e, 0xa0, 0xd5, 0xc5, 0xdb, 0xa0, 0x63,
+	0xe2, 0xf7, 0x80, 0x53, 0x26, 0x63, 0x6e, 0xd8, 0x71, 0x25, 0xe0, 0xea, 0xd2, 0xad, 0xe0, 0x3d,
+	0x6e, 0x35, 0xff, 0x41, 0x3e, 0x65, 0xab, 0x4b, 0xc7, 0x67, 0x1a, 0x3a, 0x39, 0xd3, 0xd0, 0xaf,
+	0x33, 0x0d, 0x7d, 0x3a, 0xd7, 0x32, 0x27, 0xe7, 0x5a, 0xe6, 0xf4, 0x5c, 0xcb, 0xbc, 0x79, 0x90,
+	0xfe, 0x92, 0x89, 0x03, 0x9f, 0xf2, 0x9d, 0xd1, 0xf0, 0x3d, 0x7e, 0xf6, 0x7b, 0x00, 0x87, 0x20,
+	0x02, 0xb9, 0x4c, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -611,6 +732,8 @@ type MsgClient interface {
 	UpdateStoredChunk(ctx context.Context, in *MsgUpdateStoredChunk, opts ...grpc.CallOption) (*MsgUpdateStoredChunkResponse, error)
 	// DeleteStoredChunk defines the DeleteStoredChunk RPC.
 	DeleteStoredChunk(ctx context.Context, in *MsgDeleteStoredChunk, opts ...grpc.CallOption) (*MsgDeleteStoredChunkResponse, error)
+	// SubmitStorageProof answers a storage challenge on behalf of a validator.
+	SubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error)
 }
 
 type msgClient struct {
@@ -666,6 +789,15 @@ func (c *msgClient) DeleteStoredChunk(ctx context.Context, in *MsgDeleteStoredCh
 	return out, nil
 }
 
+func (c *msgClient) SubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error) {
+	out := new(MsgSubmitStorageProofResponse)
+	err := c.cc.Invoke(ctx, "/datachain.datastore.v1.Msg/SubmitStorageProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -679,6 +811,8 @@ type MsgServer interface {
 	UpdateStoredChunk(context.Context, *MsgUpdateStoredChunk) (*MsgUpdateStoredChunkResponse, error)
 	// DeleteStoredChunk defines the DeleteStoredChunk RPC.
 	DeleteStoredChunk(context.Context, *MsgDeleteStoredChunk) (*MsgDeleteStoredChunkResponse, error)
+	// SubmitStorageProof answers a storage challenge on behalf of a validator.
+	SubmitStorageProof(context.Context, *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -700,6 +834,9 @@ func (*UnimplementedMsgServer) UpdateStoredChunk(ctx context.Context, req *MsgUp
 func (*UnimplementedMsgServer) DeleteStoredChunk(ctx context.Context, req *MsgDeleteStoredChunk) (*MsgDeleteStoredChunkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStoredChunk not implemented")
 }
+func (*UnimplementedMsgServer) SubmitStorageProof(ctx context.Context, req *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitStorageProof not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -795,6 +932,24 @@ func _Msg_DeleteStoredChunk_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitStorageProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datachain.datastore.v1.Msg/SubmitStorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitStorageProof(ctx, req.(*MsgSubmitStorageProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "datachain.datastore.v1.Msg",
@@ -820,6 +975,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStoredChunk",
 			Handler:    _Msg_DeleteStoredChunk_Handler,
 		},
+		{
+			MethodName: "SubmitStorageProof",
+			Handler:    _Msg_SubmitStorageProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "datachain/datastore/v1/tx.proto",
@@ -1168,6 +1327,80 @@ func (m *MsgDeleteStoredChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitStorageProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitStorageProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitStorageProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerklePath) > 0 {
+		for iNdEx := len(m.MerklePath) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MerklePath[iNdEx])
+			copy(dAtA[i:], m.MerklePath[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MerklePath[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SliceHash) > 0 {
+		i -= len(m.SliceHash)
+		copy(dAtA[i:], m.SliceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SliceHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitStorageProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitStorageProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitStorageProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1330,6 +1563,41 @@ func (m *MsgDeleteStoredChunkResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitStorageProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	l = len(m.SliceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MerklePath) > 0 {
+		for _, b := range m.MerklePath {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitStorageProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2342,6 +2610,223 @@ func (m *MsgDeleteStoredChunkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitStorageProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitStorageProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitStorageProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SliceHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SliceHash = append(m.SliceHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SliceHash == nil {
+				m.SliceHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePath", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerklePath = append(m.MerklePath, make([]byte, postIndex-iNdEx))
+			copy(m.MerklePath[len(m.MerklePath)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitStorageProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitStorageProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitStorageProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
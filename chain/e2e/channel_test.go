@@ -0,0 +1,167 @@
+package e2e
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastore "metachain/x/metastore/module"
+	metastoretypes "metachain/x/metastore/types"
+	raidchainapp "raidchain/app"
+)
+
+// ChannelTestSuite runs the deployment the Helm chart sets up: one metachain with a
+// metastore<->datastore channel to each of two datachains.
+type ChannelTestSuite struct {
+	suite.Suite
+
+	coord      *ibctesting.Coordinator
+	metaChain  *ibctesting.TestChain
+	dataChains []*ibctesting.TestChain
+	// paths[i] connects metaChain (endpoint A) to dataChains[i] (endpoint B)
+	paths []*ibctesting.Path
+}
+
+func TestChannelTestSuite(t *testing.T) {
+	suite.Run(t, new(ChannelTestSuite))
+}
+
+func (s *ChannelTestSuite) SetupTest() {
+	t := s.T()
+
+	s.coord = ibctesting.NewCustomAppCoordinator(t, 0, nil)
+	s.metaChain = ibctesting.NewCustomAppTestChain(t, s.coord, "meta-0", newChainApp(t, raidchainapp.RoleMetachain))
+	s.coord.Chains[s.metaChain.ChainID] = s.metaChain
+
+	s.dataChains, s.paths = nil, nil
+	for _, chainID := range []string{"data-0", "data-1"} {
+		dataChain := ibctesting.NewCustomAppTestChain(t, s.coord, chainID, newChainApp(t, raidchainapp.RoleDatachain))
+		s.coord.Chains[dataChain.ChainID] = dataChain
+
+		path := ibctesting.NewPath(s.metaChain, dataChain)
+		path.EndpointA.ChannelConfig.PortID = metastoretypes.PortID
+		path.EndpointA.ChannelConfig.Version = metastoretypes.Version
+		path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+		path.EndpointB.ChannelConfig.PortID = datastoretypes.PortID
+		path.EndpointB.ChannelConfig.Version = datastoretypes.Version
+		path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+		path.Setup()
+
+		s.dataChains = append(s.dataChains, dataChain)
+		s.paths = append(s.paths, path)
+	}
+}
+
+// sendMetadata sends MsgSendMetadata over the channel to dataChains[i] and relays the packet
+// and its acknowledgement. It returns the acknowledgement the datachain wrote.
+func (s *ChannelTestSuite) sendMetadata(i int, url string, addresses []string) channeltypes.Acknowledgement {
+	path := s.paths[i]
+
+	res, err := s.metaChain.SendMsgs(&metastoretypes.MsgSendMetadata{
+		Creator:          s.metaChain.SenderAccount.GetAddress().String(),
+		Port:             path.EndpointA.ChannelConfig.PortID,
+		ChannelID:        path.EndpointA.ChannelID,
+		TimeoutTimestamp: s.metaChain.GetTimeoutTimestamp(),
+		Url:              url,
+		Addresses:        addresses,
+	})
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	s.Require().NoError(err)
+
+	// receive on the datachain, then acknowledge on the metachain
+	_, ackBz, err := path.RelayPacketWithResults(packet)
+	s.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	s.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	return ack
+}
+
+// TestStoreThenVerify stores a chunk on each datachain and registers metadata for it over
+// the datachain's channel. This is the scenario tx-test.sh used to run against a live cluster.
+func (s *ChannelTestSuite) TestStoreThenVerify() {
+	uploads := []struct {
+		url, index string
+		data       []byte
+	}{
+		{url: "Hello.com", index: "hello", data: []byte("Hello")},
+		{url: "World.com", index: "world", data: []byte("World")},
+	}
+
+	for i, upload := range uploads {
+		storeChunks(s.T(), s.metaChain, s.dataChains[i], map[string][]byte{upload.index: upload.data})
+	}
+
+	for i, upload := range uploads {
+		ack := s.sendMetadata(i, upload.url, []string{upload.index})
+		s.Require().True(ack.Success(), ack.GetError())
+
+		var packetAck metastoretypes.MetadataPacketAck
+		s.Require().NoError(packetAck.Unmarshal(ack.GetResult()))
+		s.Require().Len(packetAck.Chunks, 1)
+		hash := sha256.Sum256(upload.data)
+		s.Require().Equal(hash[:], packetAck.Chunks[0].Hash)
+		s.Require().Equal(uint64(len(upload.data)), packetAck.Chunks[0].Size_)
+
+		meta, err := s.metaChain.App.(*raidchainapp.App).MetastoreKeeper.StoredMeta.Get(s.metaChain.GetContext(), upload.url)
+		s.Require().NoError(err)
+		s.Require().Equal(metastoretypes.StoredMeta{
+			Index:     upload.url,
+			Url:       upload.url,
+			Creator:   s.metaChain.SenderAccount.GetAddress().String(),
+			Chunks:    packetAck.Chunks,
+			ChannelId: s.paths[i].EndpointA.ChannelID,
+			// the datachain the chunks live on, for draining it later
+			ConnectionId: s.paths[i].EndpointA.ConnectionID,
+		}, meta)
+	}
+}
+
+// TestMissingChunk asks a datachain to verify a chunk only the other datachain holds.
+func (s *ChannelTestSuite) TestMissingChunk() {
+	storeChunks(s.T(), s.metaChain, s.dataChains[0], map[string][]byte{"hello": []byte("Hello")})
+
+	ack := s.sendMetadata(1, "Hello.com", []string{"hello"})
+	s.Require().False(ack.Success())
+
+	_, err := s.metaChain.App.(*raidchainapp.App).MetastoreKeeper.StoredMeta.Get(s.metaChain.GetContext(), "Hello.com")
+	s.Require().Error(err)
+}
+
+// TestMetadataPacketToMetachain delivers a metadata packet to the metachain, which only sends
+// them, and checks that its metadata_packet event reports the failed receive.
+func (s *ChannelTestSuite) TestMetadataPacketToMetachain() {
+	metaApp := s.metaChain.App.(*raidchainapp.App)
+	bz, err := metastoretypes.MetadataPacketData{Url: "Hello.com", Addresses: []string{"hello"}}.GetBytes()
+	s.Require().NoError(err)
+
+	ctx := s.metaChain.GetContext()
+	packet := channeltypes.Packet{
+		SourcePort:         datastoretypes.PortID,
+		SourceChannel:      s.paths[0].EndpointB.ChannelID,
+		DestinationPort:    metastoretypes.PortID,
+		DestinationChannel: s.paths[0].EndpointA.ChannelID,
+		Sequence:           1,
+		Data:               bz,
+	}
+	ack := metastore.NewIBCModule(metaApp.AppCodec(), metaApp.MetastoreKeeper).OnRecvPacket(ctx, metastoretypes.Version, packet, nil)
+	s.Require().False(ack.Success())
+
+	var success []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != metastoretypes.EventTypeMetadataPacket {
+			continue
+		}
+		attribute, found := event.GetAttribute(metastoretypes.AttributeKeyAckSuccess)
+		s.Require().True(found)
+		success = append(success, attribute.Value)
+	}
+	s.Require().Equal([]string{"false"}, success)
+}
@@ -0,0 +1,3 @@
+// Package e2e runs datachain and metachain apps in-process and relays IBC packets
+// between them with the ibc-go testing package.
+package e2e
@@ -0,0 +1,114 @@
+package e2e
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+	raidchainapp "raidchain/app"
+)
+
+func TestMetadataVerificationV2(t *testing.T) {
+	chunks := map[string][]byte{"idx0": []byte("hello"), "idx1": []byte("world")}
+
+	tests := []struct {
+		name      string
+		addresses []string
+		stored    bool
+	}{
+		{
+			name:      "all chunks stored",
+			addresses: []string{"idx0", "idx1"},
+			stored:    true,
+		},
+		{
+			name:      "missing chunk",
+			addresses: []string{"idx0", "missing"},
+			stored:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, metaChain, dataChain := newCoordinator(t)
+
+			storeChunks(t, metaChain, dataChain, chunks)
+
+			// no channel handshake: clients plus counterparty registration is all v2 needs
+			path := ibctesting.NewPath(metaChain, dataChain)
+			path.SetupV2()
+
+			packetData := metastoretypes.MetadataPacketData{
+				Url:       "HelloWorld.com",
+				Addresses: tt.addresses,
+				Creator:   metaChain.SenderAccount.GetAddress().String(),
+			}
+			bz, err := packetData.GetBytes()
+			require.NoError(t, err)
+
+			payload := channeltypesv2.NewPayload(metastoretypes.PortID, datastoretypes.PortID, metastoretypes.Version, metastoretypes.EncodingProtobuf, bz)
+			packet, err := path.EndpointA.MsgSendPacket(metaChain.GetTimeoutTimestampSecs(), payload)
+			require.NoError(t, err)
+
+			// the recv tx lands in the datachain's next block
+			recvHeight := dataChain.ProposedHeader.Height
+			require.NoError(t, path.EndpointB.MsgRecvPacket(packet))
+
+			// acknowledging only succeeds if the datachain committed exactly this ack
+			var (
+				appAck   []byte
+				attested []metastoretypes.VerifiedChunk
+			)
+			if tt.stored {
+				for _, index := range tt.addresses {
+					hash := sha256.Sum256(chunks[index])
+					attested = append(attested, metastoretypes.VerifiedChunk{Index: index, Size_: uint64(len(chunks[index])), Hash: hash[:], Height: recvHeight})
+				}
+				packetAck := metastoretypes.MetadataPacketAck{Version: metastoretypes.AckVersion, Chunks: attested}
+				bz, err := packetAck.Marshal()
+				require.NoError(t, err)
+				appAck = channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+			} else {
+				appAck = channeltypesv2.ErrorAcknowledgement[:]
+			}
+			ack := channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{appAck}}
+			require.NoError(t, path.EndpointA.MsgAcknowledgePacket(packet, ack))
+
+			app := metaChain.App.(*raidchainapp.App)
+			meta, err := app.MetastoreKeeper.StoredMeta.Get(metaChain.GetContext(), "HelloWorld.com")
+			if !tt.stored {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, attested, meta.Chunks)
+		})
+	}
+}
+
+func TestMetadataSendRequiresCreatorV2(t *testing.T) {
+	_, metaChain, dataChain := newCoordinator(t)
+
+	path := ibctesting.NewPath(metaChain, dataChain)
+	path.SetupV2()
+
+	packetData := metastoretypes.MetadataPacketData{
+		Url:       "HelloWorld.com",
+		Addresses: []string{"idx0"},
+		// MsgSendPacket is signed by metaChain.SenderAccount
+		Creator: metaChain.SenderAccounts[1].SenderAccount.GetAddress().String(),
+	}
+	bz, err := packetData.GetBytes()
+	require.NoError(t, err)
+
+	payload := channeltypesv2.NewPayload(metastoretypes.PortID, datastoretypes.PortID, metastoretypes.Version, metastoretypes.EncodingProtobuf, bz)
+	_, err = path.EndpointA.MsgSendPacket(metaChain.GetTimeoutTimestampSecs(), payload)
+	require.ErrorContains(t, err, "different from signer")
+}
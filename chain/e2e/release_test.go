@@ -0,0 +1,89 @@
+package e2e
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+	raidchainapp "raidchain/app"
+)
+
+func TestDeleteStoredMetaReleasesChunksV2(t *testing.T) {
+	coord, metaChain, dataChain := newCoordinator(t)
+	metaApp := metaChain.App.(*raidchainapp.App)
+	dataApp := dataChain.App.(*raidchainapp.App)
+
+	chunks := map[string][]byte{"idx0": []byte("hello"), "idx1": []byte("world")}
+	owner := storeChunks(t, metaChain, dataChain, chunks)
+	grace := time.Minute
+	require.NoError(t, dataApp.DatastoreKeeper.Params.Set(dataChain.GetContext(), datastoretypes.NewParams(datastoretypes.DefaultMaxRetrievalBytes, grace, datastoretypes.DefaultChallengeEpochIdentifier, datastoretypes.DefaultChallengesPerEpoch, datastoretypes.DefaultChallengeSliceSize, datastoretypes.DefaultChunkCodec, datastoretypes.DefaultChunkGasPerByte, datastoretypes.DefaultMaxBlockChunkBytes, true)))
+
+	path := ibctesting.NewPath(metaChain, dataChain)
+	path.SetupV2()
+
+	creator := metaChain.SenderAccount.GetAddress().String()
+	addresses := []string{"idx0", "idx1"}
+	bz, err := metastoretypes.MetadataPacketData{Url: "HelloWorld.com", Addresses: addresses, Creator: creator}.GetBytes()
+	require.NoError(t, err)
+
+	payload := channeltypesv2.NewPayload(metastoretypes.PortID, datastoretypes.PortID, metastoretypes.Version, metastoretypes.EncodingProtobuf, bz)
+	packet, err := path.EndpointA.MsgSendPacket(metaChain.GetTimeoutTimestampSecs(), payload)
+	require.NoError(t, err)
+	recvHeight := dataChain.ProposedHeader.Height
+	require.NoError(t, path.EndpointB.MsgRecvPacket(packet))
+
+	var attested []metastoretypes.VerifiedChunk
+	for _, index := range addresses {
+		hash := sha256.Sum256(chunks[index])
+		attested = append(attested, metastoretypes.VerifiedChunk{Index: index, Size_: uint64(len(chunks[index])), Hash: hash[:], Height: recvHeight})
+	}
+	bz, err = (&metastoretypes.MetadataPacketAck{Version: metastoretypes.AckVersion, Chunks: attested}).Marshal()
+	require.NoError(t, err)
+	ack := channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{channeltypes.NewResultAcknowledgement(bz).Acknowledgement()}}
+	require.NoError(t, path.EndpointA.MsgAcknowledgePacket(packet, ack))
+
+	meta, err := metaApp.MetastoreKeeper.StoredMeta.Get(metaChain.GetContext(), "HelloWorld.com")
+	require.NoError(t, err)
+	require.Equal(t, path.EndpointA.ClientID, meta.ClientId)
+
+	// registered chunks cannot be deleted by their owner
+	_, err = dataChain.SendMsgsWithSender(owner, &datastoretypes.MsgDeleteStoredChunk{Creator: owner.SenderAccount.GetAddress().String(), Index: "idx0"})
+	require.ErrorContains(t, err, datastoretypes.ErrChunkReferenced.Error())
+
+	res, err := metaChain.SendMsgs(&metastoretypes.MsgDeleteStoredMeta{Creator: creator, Index: "HelloWorld.com"})
+	require.NoError(t, err)
+	packet = parseV2PacketFromEvents(t, res.Events)
+	require.NoError(t, path.EndpointB.UpdateClient())
+	require.NoError(t, path.EndpointB.MsgRecvPacket(packet))
+
+	dataCtx := dataChain.GetContext()
+	for _, index := range addresses {
+		referenced, err := dataApp.DatastoreKeeper.IsChunkReferenced(dataCtx, index)
+		require.NoError(t, err)
+		require.False(t, referenced)
+	}
+
+	bz, err = (&metastoretypes.ChunkReleasePacketAck{Version: metastoretypes.AckVersion, Released: addresses}).Marshal()
+	require.NoError(t, err)
+	ack = channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{channeltypes.NewResultAcknowledgement(bz).Acknowledgement()}}
+	require.NoError(t, path.EndpointA.MsgAcknowledgePacket(packet, ack))
+
+	// chunks outlive the release until the grace period has passed
+	_, err = dataApp.DatastoreKeeper.StoredChunk.Get(dataChain.GetContext(), "idx0")
+	require.NoError(t, err)
+
+	coord.IncrementTimeBy(grace)
+	dataChain.NextBlock()
+	for _, index := range addresses {
+		_, err := dataApp.DatastoreKeeper.StoredChunk.Get(dataChain.GetContext(), index)
+		require.Error(t, err)
+	}
+}
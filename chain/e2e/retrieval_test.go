@@ -0,0 +1,96 @@
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+	raidchainapp "raidchain/app"
+)
+
+func TestChunkRetrievalV2(t *testing.T) {
+	_, metaChain, dataChain := newCoordinator(t)
+	storeChunks(t, metaChain, dataChain, map[string][]byte{"idx0": []byte("hello"), "idx1": []byte("world")})
+
+	path := ibctesting.NewPath(metaChain, dataChain)
+	path.SetupV2()
+
+	packetData := metastoretypes.ChunkRetrievalPacketData{
+		Indexes:    []string{"idx1", "idx0"},
+		Requester:  metaChain.SenderAccount.GetAddress().String(),
+		CacheBytes: 10,
+	}
+	bz, err := packetData.GetBytes()
+	require.NoError(t, err)
+
+	payload := channeltypesv2.NewPayload(metastoretypes.PortID, datastoretypes.PortID, metastoretypes.Version, metastoretypes.EncodingProtobuf, bz)
+	packet, err := path.EndpointA.MsgSendPacket(metaChain.GetTimeoutTimestampSecs(), payload)
+	require.NoError(t, err)
+
+	recvHeight := dataChain.ProposedHeader.Height
+	require.NoError(t, path.EndpointB.MsgRecvPacket(packet))
+
+	packetAck := metastoretypes.ChunkRetrievalPacketAck{
+		Version: metastoretypes.AckVersion,
+		Chunks: []metastoretypes.RetrievedChunk{
+			{Index: "idx1", Data: []byte("world"), Height: recvHeight},
+			{Index: "idx0", Data: []byte("hello"), Height: recvHeight},
+		},
+	}
+	ackBz, err := packetAck.Marshal()
+	require.NoError(t, err)
+	ack := channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{channeltypes.NewResultAcknowledgement(ackBz).Acknowledgement()}}
+	ackHeight := metaChain.ProposedHeader.Height
+	require.NoError(t, path.EndpointA.MsgAcknowledgePacket(packet, ack))
+
+	app := metaChain.App.(*raidchainapp.App)
+	cached, err := app.MetastoreKeeper.CachedChunk.Get(metaChain.GetContext(), collections.Join(path.EndpointA.ClientID, "idx0"))
+	require.NoError(t, err)
+	require.Equal(t, metastoretypes.CachedChunk{
+		Index:     "idx0",
+		Data:      []byte("hello"),
+		Height:    recvHeight,
+		ChannelId: path.EndpointA.ClientID,
+		Expires:   ackHeight + int64(metastoretypes.DefaultChunkCacheTTL),
+	}, cached)
+}
+
+func TestChunkRetrievalTooLargeV2(t *testing.T) {
+	_, metaChain, dataChain := newCoordinator(t)
+	storeChunks(t, metaChain, dataChain, map[string][]byte{"idx0": []byte("hello"), "idx1": []byte("world")})
+
+	// 10 bytes requested, the write to the uncached context is committed with the next block
+	dataApp := dataChain.App.(*raidchainapp.App)
+	require.NoError(t, dataApp.DatastoreKeeper.Params.Set(dataChain.GetContext(), datastoretypes.NewParams(8, datastoretypes.DefaultReleaseGracePeriod, datastoretypes.DefaultChallengeEpochIdentifier, datastoretypes.DefaultChallengesPerEpoch, datastoretypes.DefaultChallengeSliceSize, datastoretypes.DefaultChunkCodec, datastoretypes.DefaultChunkGasPerByte, datastoretypes.DefaultMaxBlockChunkBytes, true)))
+
+	path := ibctesting.NewPath(metaChain, dataChain)
+	path.SetupV2()
+
+	packetData := metastoretypes.ChunkRetrievalPacketData{
+		Indexes:    []string{"idx0", "idx1"},
+		Requester:  metaChain.SenderAccount.GetAddress().String(),
+		CacheBytes: 10,
+	}
+	bz, err := packetData.GetBytes()
+	require.NoError(t, err)
+
+	payload := channeltypesv2.NewPayload(metastoretypes.PortID, datastoretypes.PortID, metastoretypes.Version, metastoretypes.EncodingProtobuf, bz)
+	packet, err := path.EndpointA.MsgSendPacket(metaChain.GetTimeoutTimestampSecs(), payload)
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointB.MsgRecvPacket(packet))
+
+	ack := channeltypesv2.Acknowledgement{AppAcknowledgements: [][]byte{channeltypesv2.ErrorAcknowledgement[:]}}
+	require.NoError(t, path.EndpointA.MsgAcknowledgePacket(packet, ack))
+
+	app := metaChain.App.(*raidchainapp.App)
+	has, err := app.MetastoreKeeper.CachedChunk.Has(metaChain.GetContext(), collections.Join(path.EndpointA.ClientID, "idx0"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,104 @@
+package e2e
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	datastoretypes "datachain/x/datastore/types"
+	raidchainapp "raidchain/app"
+)
+
+// newChainApp returns the constructor of a raidchain app whose genesis sets role.
+func newChainApp(t *testing.T, role raidchainapp.Role) func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app := raidchainapp.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+		genesis := app.DefaultGenesis()
+		require.NoError(t, raidchainapp.SetGenesisRole(app.AppCodec(), genesis, role))
+		return app, genesis
+	}
+}
+
+// newCoordinator boots one metachain and one datachain sharing a coordinator clock.
+func newCoordinator(t *testing.T) (coord *ibctesting.Coordinator, metaChain, dataChain *ibctesting.TestChain) {
+	t.Helper()
+
+	coord = ibctesting.NewCustomAppCoordinator(t, 0, nil)
+	metaChain = ibctesting.NewCustomAppTestChain(t, coord, "meta-1", newChainApp(t, raidchainapp.RoleMetachain))
+	dataChain = ibctesting.NewCustomAppTestChain(t, coord, "data-1", newChainApp(t, raidchainapp.RoleDatachain))
+	coord.Chains[metaChain.ChainID] = metaChain
+	coord.Chains[dataChain.ChainID] = dataChain
+
+	return coord, metaChain, dataChain
+}
+
+// storeChunks writes chunks on a datachain with MsgCreateStoredChunk. They are created by the
+// sender account of metaChain, as the datachain only lets the metadata of their creator
+// reference chunks. It returns that account on dataChain.
+func storeChunks(t *testing.T, metaChain, dataChain *ibctesting.TestChain, chunks map[string][]byte) ibctesting.SenderAccount {
+	t.Helper()
+
+	owner := chunkOwner(t, metaChain, dataChain)
+	for index, data := range chunks {
+		_, err := dataChain.SendMsgsWithSender(owner, &datastoretypes.MsgCreateStoredChunk{
+			Creator: owner.SenderAccount.GetAddress().String(),
+			Index:   index,
+			Data:    data,
+		})
+		require.NoError(t, err)
+	}
+	return owner
+}
+
+// chunkOwner returns the account of the metaChain sender on dataChain, creating it with a
+// transfer from the dataChain sender the first time.
+func chunkOwner(t *testing.T, metaChain, dataChain *ibctesting.TestChain) ibctesting.SenderAccount {
+	t.Helper()
+
+	address := metaChain.SenderAccount.GetAddress()
+	accountKeeper := dataChain.App.(*raidchainapp.App).AuthKeeper
+	if !accountKeeper.HasAccount(dataChain.GetContext(), address) {
+		_, err := dataChain.SendMsgs(banktypes.NewMsgSend(dataChain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))))
+		require.NoError(t, err)
+	}
+	return ibctesting.SenderAccount{
+		SenderPrivKey: metaChain.SenderPrivKey,
+		SenderAccount: accountKeeper.GetAccount(dataChain.GetContext(), address),
+	}
+}
+
+// parseV2PacketFromEvents returns the IBC v2 packet a module sent while handling a tx.
+func parseV2PacketFromEvents(t *testing.T, events []abci.Event) channeltypesv2.Packet {
+	t.Helper()
+
+	for _, event := range events {
+		if event.Type != channeltypesv2.EventTypeSendPacket {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != channeltypesv2.AttributeKeyEncodedPacketHex {
+				continue
+			}
+			bz, err := hex.DecodeString(attr.Value)
+			require.NoError(t, err)
+
+			var packet channeltypesv2.Packet
+			require.NoError(t, proto.Unmarshal(bz, &packet))
+			return packet
+		}
+	}
+
+	t.Fatal("no v2 packet sent")
+	return channeltypesv2.Packet{}
+}
@@ -0,0 +1,390 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+)
+
+const (
+	defaultTxTimeout  = 20 * time.Second
+	txPollInterval    = 500 * time.Millisecond
+	validatorsPerPage = 100
+)
+
+// ChainClient talks to one chain through its CometBFT RPC endpoint. Queries go over ABCI, so
+// the chain's gRPC server is not needed.
+type ChainClient struct {
+	config    ChainConfig
+	clientCtx client.Context
+	factory   tx.Factory
+	rpc       *rpchttp.HTTP
+	txTimeout time.Duration
+	logger    log.Logger
+}
+
+// NewChainClient connects to the chain described by config and signs with config.Key from kr.
+// clientCtx must carry a codec and tx config that know the IBC core messages.
+func NewChainClient(clientCtx client.Context, config ChainConfig, kr keyring.Keyring, logger log.Logger) (*ChainClient, error) {
+	rpc, err := client.NewClientFromNode(config.RPCAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", config.RPCAddr, err)
+	}
+
+	record, err := kr.Key(config.Key)
+	if err != nil {
+		return nil, fmt.Errorf("key %s not found for %s: %w", config.Key, config.ChainID, err)
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	txTimeout := defaultTxTimeout
+	if config.Timeout != "" {
+		if txTimeout, err = time.ParseDuration(config.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid timeout for %s: %w", config.ChainID, err)
+		}
+	}
+
+	clientCtx = clientCtx.
+		WithClient(rpc).
+		WithChainID(config.ChainID).
+		WithKeyring(kr).
+		WithFromName(config.Key).
+		WithFromAddress(address).
+		WithBroadcastMode(flags.BroadcastSync)
+
+	factory := tx.Factory{}.
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithKeybase(kr).
+		WithFromName(config.Key).
+		WithChainID(config.ChainID).
+		WithGasAdjustment(config.GasAdjustment).
+		WithGasPrices(config.GasPrices).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	return &ChainClient{
+		config:    config,
+		clientCtx: clientCtx,
+		factory:   factory,
+		rpc:       rpc,
+		txTimeout: txTimeout,
+		logger:    logger.With("chain", config.ChainID),
+	}, nil
+}
+
+// ChainID returns the chain id of the chain.
+func (c *ChainClient) ChainID() string {
+	return c.config.ChainID
+}
+
+// Signer returns the address the relayer signs with on this chain.
+func (c *ChainClient) Signer() string {
+	return c.clientCtx.FromAddress.String()
+}
+
+// Height returns the IBC height of a block of this chain.
+func (c *ChainClient) Height(height int64) clienttypes.Height {
+	return clienttypes.NewHeight(clienttypes.ParseChainID(c.config.ChainID), uint64(height))
+}
+
+// LatestBlock returns the height and time of the latest committed block.
+func (c *ChainClient) LatestBlock(ctx context.Context) (int64, time.Time, error) {
+	status, err := c.rpc.Status(ctx)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, status.SyncInfo.LatestBlockTime, nil
+}
+
+// WaitForNextBlock waits until a block after the current latest one is committed.
+func (c *ChainClient) WaitForNextBlock(ctx context.Context) error {
+	height, _, err := c.LatestBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		latest, _, err := c.LatestBlock(ctx)
+		if err != nil {
+			return err
+		}
+		if latest > height {
+			return nil
+		}
+	}
+}
+
+// BlockEvents returns the tx and finalize block events of height.
+func (c *ChainClient) BlockEvents(ctx context.Context, height int64) ([]abci.Event, error) {
+	res, err := c.rpc.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]abci.Event, 0, len(res.FinalizeBlockEvents))
+	for _, txResult := range res.TxsResults {
+		if txResult.Code == 0 {
+			events = append(events, txResult.Events...)
+		}
+	}
+
+	return append(events, res.FinalizeBlockEvents...), nil
+}
+
+// QueryProof queries key from the IBC store together with a merkle proof. The proof is
+// generated against the app hash committed in the header at height, which is returned as the
+// proof height.
+func (c *ChainClient) QueryProof(ctx context.Context, key []byte, height int64) ([]byte, []byte, clienttypes.Height, error) {
+	res, err := c.rpc.ABCIQueryWithOptions(ctx, fmt.Sprintf("store/%s/key", ibcexported.StoreKey), key, rpcclient.ABCIQueryOptions{
+		Height: height - 1,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+	if !res.Response.IsOK() {
+		return nil, nil, clienttypes.Height{}, fmt.Errorf("query %x on %s failed: %s", key, c.config.ChainID, res.Response.Log)
+	}
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.Response.ProofOps)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+	proof, err := c.clientCtx.Codec.Marshal(&merkleProof)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+
+	return res.Response.Value, proof, c.Height(height), nil
+}
+
+// HasKey reports whether key is set in the IBC store at the latest height.
+func (c *ChainClient) HasKey(ctx context.Context, key []byte) (bool, error) {
+	res, err := c.rpc.ABCIQuery(ctx, fmt.Sprintf("store/%s/key", ibcexported.StoreKey), key)
+	if err != nil {
+		return false, err
+	}
+	if !res.Response.IsOK() {
+		return false, fmt.Errorf("query %x on %s failed: %s", key, c.config.ChainID, res.Response.Log)
+	}
+
+	return len(res.Response.Value) > 0, nil
+}
+
+// QueryClientState returns the tendermint client clientID on this chain.
+func (c *ChainClient) QueryClientState(ctx context.Context, clientID string) (*ibctm.ClientState, error) {
+	res, err := clienttypes.NewQueryClient(c.clientCtx).ClientState(ctx, &clienttypes.QueryClientStateRequest{ClientId: clientID})
+	if err != nil {
+		return nil, err
+	}
+
+	var clientState ibcexported.ClientState
+	if err := c.clientCtx.InterfaceRegistry.UnpackAny(res.ClientState, &clientState); err != nil {
+		return nil, err
+	}
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return nil, fmt.Errorf("client %s on %s is not a tendermint client", clientID, c.config.ChainID)
+	}
+
+	return tmClientState, nil
+}
+
+// UnbondingPeriod returns the staking unbonding period of the chain.
+func (c *ChainClient) UnbondingPeriod(ctx context.Context) (time.Duration, error) {
+	res, err := stakingtypes.NewQueryClient(c.clientCtx).Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Params.UnbondingTime, nil
+}
+
+// ClientAndConsensusState returns the state of a new tendermint client tracking this chain
+// from its latest block.
+func (c *ChainClient) ClientAndConsensusState(ctx context.Context) (*ibctm.ClientState, *ibctm.ConsensusState, error) {
+	height, _, err := c.LatestBlock(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	commit, err := c.rpc.Commit(ctx, &height)
+	if err != nil {
+		return nil, nil, err
+	}
+	unbondingPeriod, err := c.UnbondingPeriod(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientState := ibctm.NewClientState(
+		c.config.ChainID,
+		ibctm.DefaultTrustLevel,
+		unbondingPeriod*2/3,
+		unbondingPeriod,
+		maxClockDrift,
+		c.Height(height),
+		commitmenttypes.GetSDKSpecs(),
+		upgradePath,
+	)
+	consensusState := ibctm.NewConsensusState(
+		commit.Time,
+		commitmenttypes.NewMerkleRoot(commit.AppHash),
+		commit.NextValidatorsHash,
+	)
+
+	return clientState, consensusState, nil
+}
+
+// Header returns the header that moves a client of this chain from trusted to height.
+func (c *ChainClient) Header(ctx context.Context, height int64, trusted clienttypes.Height) (*ibctm.Header, error) {
+	commit, err := c.rpc.Commit(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := c.validatorSet(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	// the trusted consensus state commits to the validators of the block after it
+	trustedValidators, err := c.validatorSet(ctx, int64(trusted.RevisionHeight)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibctm.Header{
+		SignedHeader:      commit.SignedHeader.ToProto(),
+		ValidatorSet:      validators,
+		TrustedHeight:     trusted,
+		TrustedValidators: trustedValidators,
+	}, nil
+}
+
+func (c *ChainClient) validatorSet(ctx context.Context, height int64) (*cmtproto.ValidatorSet, error) {
+	var validators []*cmttypes.Validator
+	perPage := validatorsPerPage
+	for page := 1; ; page++ {
+		res, err := c.rpc.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(validators) >= res.Total || len(res.Validators) == 0 {
+			break
+		}
+	}
+
+	return cmttypes.NewValidatorSet(validators).ToProto()
+}
+
+// SendMsgs signs msgs in one tx, broadcasts it and waits until it is included in a block.
+// It returns the events of the tx.
+func (c *ChainClient) SendMsgs(ctx context.Context, msgs ...sdk.Msg) ([]abci.Event, error) {
+	factory, err := c.factory.Prepare(c.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	_, gas, err := tx.CalculateGas(c.clientCtx, factory, msgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate tx on %s: %w", c.config.ChainID, err)
+	}
+	factory = factory.WithGas(gas)
+
+	txBuilder, err := factory.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(ctx, factory, c.config.Key, txBuilder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := c.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.rpc.BroadcastTxSync(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("tx rejected by %s with code %d: %s", c.config.ChainID, res.Code, res.Log)
+	}
+
+	return c.waitForTx(ctx, res.Hash)
+}
+
+func (c *ChainClient) waitForTx(ctx context.Context, hash []byte) ([]abci.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.txTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("tx %X not included on %s: %w", hash, c.config.ChainID, ctx.Err())
+		case <-ticker.C:
+		}
+
+		res, err := c.rpc.Tx(ctx, hash, false)
+		if err != nil {
+			// not indexed yet
+			continue
+		}
+		if res.TxResult.Code != 0 {
+			return nil, fmt.Errorf("tx %X failed on %s with code %d: %s", hash, c.config.ChainID, res.TxResult.Code, res.TxResult.Log)
+		}
+		c.logger.Debug("tx included", "hash", fmt.Sprintf("%X", hash), "height", res.Height)
+
+		return res.TxResult.Events, nil
+	}
+}
+
+// eventAttribute returns the value of key in the first event of eventType.
+func eventAttribute(events []abci.Event, eventType, key string) (string, error) {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == key {
+				return attr.Value, nil
+			}
+		}
+	}
+
+	return "", errors.New("no " + key + " in " + eventType + " events")
+}
@@ -0,0 +1,118 @@
+// Package relayer is a small IBC relayer for the channels between the datachains and the
+// metachain. It only knows the datastore and metastore ports and keeps the go-relayer home
+// layout, so a home generated by `raidchaind testnet raidchain` also works with rly.
+package relayer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Chain is the cosmos chain entry of a go-relayer config.yaml.
+type Chain struct {
+	Type  string      `yaml:"type" json:"type"`
+	Value ChainConfig `yaml:"value" json:"value"`
+}
+
+// ChainConfig holds the endpoints, key and fee settings used to sign txs on one chain.
+type ChainConfig struct {
+	Key            string  `yaml:"key" json:"key"`
+	ChainID        string  `yaml:"chain-id" json:"chain-id"`
+	RPCAddr        string  `yaml:"rpc-addr" json:"rpc-addr"`
+	GRPCAddr       string  `yaml:"grpc-addr" json:"grpc-addr"`
+	AccountPrefix  string  `yaml:"account-prefix" json:"account-prefix"`
+	KeyringBackend string  `yaml:"keyring-backend" json:"keyring-backend"`
+	GasAdjustment  float64 `yaml:"gas-adjustment" json:"gas-adjustment"`
+	GasPrices      string  `yaml:"gas-prices" json:"gas-prices"`
+	Debug          bool    `yaml:"debug" json:"debug"`
+	Timeout        string  `yaml:"timeout" json:"timeout"`
+	OutputFormat   string  `yaml:"output-format" json:"output-format"`
+	SignMode       string  `yaml:"sign-mode" json:"sign-mode"`
+}
+
+// Path is a go-relayer path, in the format both config.yaml and `rly paths add --file` read.
+// Src is always a datachain and Dst the metachain.
+type Path struct {
+	Src    PathEnd `yaml:"src" json:"src"`
+	Dst    PathEnd `yaml:"dst" json:"dst"`
+	Filter Filter  `yaml:"src-channel-filter" json:"src-channel-filter"`
+}
+
+// PathEnd identifies one side of a path. The identifiers are filled in by Link.
+type PathEnd struct {
+	ChainID      string `yaml:"chain-id" json:"chain-id"`
+	ClientID     string `yaml:"client-id,omitempty" json:"client-id,omitempty"`
+	ConnectionID string `yaml:"connection-id,omitempty" json:"connection-id,omitempty"`
+	ChannelID    string `yaml:"channel-id,omitempty" json:"channel-id,omitempty"`
+}
+
+// Filter restricts the channels relayed on a path. The built-in relayer ignores it and relays
+// the channel recorded on the path ends.
+type Filter struct {
+	Rule        string   `yaml:"rule" json:"rule"`
+	ChannelList []string `yaml:"channel-list" json:"channel-list"`
+}
+
+// Config is the content of <home>/config/config.yaml.
+type Config struct {
+	Global struct {
+		APIListenAddr  string `yaml:"api-listen-addr"`
+		Timeout        string `yaml:"timeout"`
+		Memo           string `yaml:"memo"`
+		LightCacheSize int    `yaml:"light-cache-size"`
+	} `yaml:"global"`
+	Chains map[string]Chain `yaml:"chains"`
+	Paths  map[string]Path  `yaml:"paths"`
+}
+
+// ConfigPath returns the location of config.yaml in a relayer home.
+func ConfigPath(home string) string {
+	return filepath.Join(home, "config", "config.yaml")
+}
+
+// KeyringDir returns the directory holding the keyring of chainID in a relayer home.
+func KeyringDir(home, chainID string) string {
+	return filepath.Join(home, "keys", chainID)
+}
+
+// LoadConfig reads the config.yaml of a relayer home.
+func LoadConfig(home string) (*Config, error) {
+	bz, err := os.ReadFile(ConfigPath(home))
+	if err != nil {
+		return nil, err
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(bz, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", ConfigPath(home), err)
+	}
+
+	return &config, nil
+}
+
+// Save writes config to the config.yaml of a relayer home.
+func (c *Config) Save(home string) error {
+	bz, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(ConfigPath(home)), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(ConfigPath(home), bz, 0o600)
+}
+
+// ChainConfig returns the configuration of chainID.
+func (c *Config) ChainConfig(chainID string) (ChainConfig, error) {
+	chain, ok := c.Chains[chainID]
+	if !ok {
+		return ChainConfig{}, fmt.Errorf("chain %s not found in config", chainID)
+	}
+
+	return chain.Value, nil
+}
@@ -0,0 +1,244 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	metastoretypes "metachain/x/metastore/types"
+)
+
+const maxClockDrift = 10 * time.Second
+
+var (
+	upgradePath  = []string{upgradetypes.StoreKey, upgradetypes.KeyUpgradedIBCState}
+	commitPrefix = commitmenttypes.NewMerklePrefix([]byte(ibcexported.StoreKey))
+)
+
+// Link creates the clients, connection and channel of path between a datachain and the
+// metachain, filling in the identifiers of its ends as it goes. Identifiers already set on
+// path are reused, so a Link that failed half way can be resumed with the same path.
+func Link(ctx context.Context, datachain, metachain *ChainClient, path *Path) error {
+	src, dst := &path.Src, &path.Dst
+
+	var err error
+	if src.ClientID == "" {
+		if src.ClientID, err = createClient(ctx, datachain, metachain); err != nil {
+			return err
+		}
+	}
+	if dst.ClientID == "" {
+		if dst.ClientID, err = createClient(ctx, metachain, datachain); err != nil {
+			return err
+		}
+	}
+	if src.ConnectionID == "" || dst.ConnectionID == "" {
+		if src.ConnectionID, dst.ConnectionID, err = openConnection(ctx, datachain, metachain, path); err != nil {
+			return err
+		}
+	}
+	if src.ChannelID == "" || dst.ChannelID == "" {
+		if src.ChannelID, dst.ChannelID, err = openChannel(ctx, datachain, metachain, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createClient creates a tendermint client of counterparty on chain and returns its id.
+func createClient(ctx context.Context, chain, counterparty *ChainClient) (string, error) {
+	clientState, consensusState, err := counterparty.ClientAndConsensusState(ctx)
+	if err != nil {
+		return "", err
+	}
+	msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, chain.Signer())
+	if err != nil {
+		return "", err
+	}
+
+	events, err := chain.SendMsgs(ctx, msg)
+	if err != nil {
+		return "", fmt.Errorf("failed to create client of %s on %s: %w", counterparty.ChainID(), chain.ChainID(), err)
+	}
+	clientID, err := eventAttribute(events, clienttypes.EventTypeCreateClient, clienttypes.AttributeKeyClientID)
+	if err != nil {
+		return "", err
+	}
+	chain.logger.Info("created client", "client_id", clientID, "counterparty", counterparty.ChainID())
+
+	return clientID, nil
+}
+
+// openConnection runs the connection handshake, initiated by the datachain.
+func openConnection(ctx context.Context, datachain, metachain *ChainClient, path *Path) (string, string, error) {
+	src, dst := path.Src, path.Dst
+
+	events, err := datachain.SendMsgs(ctx, connectiontypes.NewMsgConnectionOpenInit(
+		src.ClientID, dst.ClientID, commitPrefix, connectiontypes.DefaultIBCVersion, 0, datachain.Signer(),
+	))
+	if err != nil {
+		return "", "", fmt.Errorf("connection open init failed: %w", err)
+	}
+	src.ConnectionID, err = eventAttribute(events, connectiontypes.EventTypeConnectionOpenInit, connectiontypes.AttributeKeyConnectionID)
+	if err != nil {
+		return "", "", err
+	}
+
+	events, err = sendWithProof(ctx, metachain, dst.ClientID, datachain, host.ConnectionKey(src.ConnectionID),
+		func(proof []byte, proofHeight clienttypes.Height) sdk.Msg {
+			return connectiontypes.NewMsgConnectionOpenTry(
+				dst.ClientID, src.ConnectionID, src.ClientID, commitPrefix, connectiontypes.GetCompatibleVersions(),
+				0, proof, proofHeight, metachain.Signer(),
+			)
+		})
+	if err != nil {
+		return "", "", fmt.Errorf("connection open try failed: %w", err)
+	}
+	dst.ConnectionID, err = eventAttribute(events, connectiontypes.EventTypeConnectionOpenTry, connectiontypes.AttributeKeyConnectionID)
+	if err != nil {
+		return "", "", err
+	}
+
+	_, err = sendWithProof(ctx, datachain, src.ClientID, metachain, host.ConnectionKey(dst.ConnectionID),
+		func(proof []byte, proofHeight clienttypes.Height) sdk.Msg {
+			return connectiontypes.NewMsgConnectionOpenAck(
+				src.ConnectionID, dst.ConnectionID, proof, proofHeight, connectiontypes.DefaultIBCVersion, datachain.Signer(),
+			)
+		})
+	if err != nil {
+		return "", "", fmt.Errorf("connection open ack failed: %w", err)
+	}
+
+	_, err = sendWithProof(ctx, metachain, dst.ClientID, datachain, host.ConnectionKey(src.ConnectionID),
+		func(proof []byte, proofHeight clienttypes.Height) sdk.Msg {
+			return connectiontypes.NewMsgConnectionOpenConfirm(dst.ConnectionID, proof, proofHeight, metachain.Signer())
+		})
+	if err != nil {
+		return "", "", fmt.Errorf("connection open confirm failed: %w", err)
+	}
+	datachain.logger.Info("opened connection", "connection_id", src.ConnectionID, "counterparty_connection_id", dst.ConnectionID)
+
+	return src.ConnectionID, dst.ConnectionID, nil
+}
+
+// openChannel runs the channel handshake between the datastore port of the datachain and the
+// metastore port of the metachain, initiated by the datachain.
+func openChannel(ctx context.Context, datachain, metachain *ChainClient, path *Path) (string, string, error) {
+	src, dst := path.Src, path.Dst
+	srcPort, dstPort := metastoretypes.DatastorePortID, metastoretypes.PortID
+
+	events, err := datachain.SendMsgs(ctx, channeltypes.NewMsgChannelOpenInit(
+		srcPort, metastoretypes.Version, channeltypes.UNORDERED, []string{src.ConnectionID}, dstPort, datachain.Signer(),
+	))
+	if err != nil {
+		return "", "", fmt.Errorf("channel open init failed: %w", err)
+	}
+	src.ChannelID, err = eventAttribute(events, channeltypes.EventTypeChannelOpenInit, channeltypes.AttributeKeyChannelID)
+	if err != nil {
+		return "", "", err
+	}
+
+	events, err = sendWithProof(ctx, metachain, dst.ClientID, datachain, host.ChannelKey(srcPort, src.ChannelID),
+		func(proof []byte, proofHeight clienttypes.Height) sdk.Msg {
+			return channeltypes.NewMsgChannelOpenTry(
+				dstPort, metastoretypes.Version, channeltypes.UNORDERED, []string{dst.ConnectionID},
+				srcPort, src.ChannelID, metastoretypes.Version, proof, proofHeight, metachain.Signer(),
+			)
+		})
+	if err != nil {
+		return "", "", fmt.Errorf("channel open try failed: %w", err)
+	}
+	dst.ChannelID, err = eventAttribute(events, channeltypes.EventTypeChannelOpenTry, channeltypes.AttributeKeyChannelID)
+	if err != nil {
+		return "", "", err
+	}
+
+	_, err = sendWithProof(ctx, datachain, src.ClientID, metachain, host.ChannelKey(dstPort, dst.ChannelID),
+		func(proof []byte, proofHeight clienttypes.Height) sdk.Msg {
+			return channeltypes.NewMsgChannelOpenAck(
+				srcPort, src.ChannelID, dst.ChannelID, metastoretypes.Version, proof, proofHeight, datachain.Signer(),
+			)
+		})
+	if err != nil {
+		return "", "", fmt.Errorf("channel open ack failed: %w", err)
+	}
+
+	_, err = sendWithProof(ctx, metachain, dst.ClientID, datachain, host.ChannelKey(srcPort, src.ChannelID),
+		func(proof []byte, proofHeight clienttypes.Height) sdk.Msg {
+			return channeltypes.NewMsgChannelOpenConfirm(dstPort, dst.ChannelID, proof, proofHeight, metachain.Signer())
+		})
+	if err != nil {
+		return "", "", fmt.Errorf("channel open confirm failed: %w", err)
+	}
+	datachain.logger.Info("opened channel", "channel_id", src.ChannelID, "counterparty_channel_id", dst.ChannelID)
+
+	return src.ChannelID, dst.ChannelID, nil
+}
+
+// sendWithProof proves key on source, then sends the msg built from the proof to target in
+// the same tx as the update of target's client of source.
+func sendWithProof(
+	ctx context.Context,
+	target *ChainClient,
+	clientID string,
+	source *ChainClient,
+	key []byte,
+	build func(proof []byte, proofHeight clienttypes.Height) sdk.Msg,
+) ([]abci.Event, error) {
+	// key was written by the last tx on source, whose state is committed by the next header
+	if err := source.WaitForNextBlock(ctx); err != nil {
+		return nil, err
+	}
+
+	height, msgs, err := clientUpdate(ctx, target, clientID, source)
+	if err != nil {
+		return nil, err
+	}
+	_, proof, proofHeight, err := source.QueryProof(ctx, key, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return target.SendMsgs(ctx, append(msgs, build(proof, proofHeight))...)
+}
+
+// clientUpdate returns the height of source at which target's client clientID can verify
+// proofs, with the MsgUpdateClient needed to get the client there, if any.
+func clientUpdate(ctx context.Context, target *ChainClient, clientID string, source *ChainClient) (int64, []sdk.Msg, error) {
+	latest, _, err := source.LatestBlock(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	clientState, err := target.QueryClientState(ctx, clientID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	trusted := clientState.LatestHeight
+	if int64(trusted.RevisionHeight) >= latest {
+		return int64(trusted.RevisionHeight), nil, nil
+	}
+
+	header, err := source.Header(ctx, latest, trusted)
+	if err != nil {
+		return 0, nil, err
+	}
+	msg, err := clienttypes.NewMsgUpdateClient(clientID, header, target.Signer())
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return latest, []sdk.Msg{msg}, nil
+}
@@ -0,0 +1,323 @@
+package relayer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	metastoretypes "metachain/x/metastore/types"
+)
+
+// endpoint is one end of a linked channel, seen from the chain it lives on.
+type endpoint struct {
+	chain        *ChainClient
+	portID       string
+	channelID    string
+	clientID     string
+	counterparty *endpoint
+}
+
+func endpointKey(chainID, portID, channelID string) string {
+	return chainID + "/" + portID + "/" + channelID
+}
+
+// pendingPacket is a packet waiting to be received, or, once ack is set, acknowledged on its
+// source chain.
+type pendingPacket struct {
+	source *endpoint
+	packet channeltypes.Packet
+	ack    []byte
+}
+
+// relayMsg is a msg waiting for a proof from the chain its endpoint's counterparty lives on.
+type relayMsg struct {
+	key      string
+	proofKey []byte
+	build    func(proof []byte, proofHeight clienttypes.Height) sdk.Msg
+}
+
+// Relayer relays the packets of linked paths. It follows the send_packet and
+// write_acknowledgement events of new blocks and retries failed packets on every poll.
+type Relayer struct {
+	endpoints    map[string]*endpoint
+	chains       map[string]*ChainClient
+	heights      map[string]int64
+	pending      map[string]*pendingPacket
+	pollInterval time.Duration
+	logger       log.Logger
+}
+
+// NewRelayer returns a relayer for paths, which must have been linked. chains holds a client
+// for every chain the paths refer to.
+func NewRelayer(chains map[string]*ChainClient, paths map[string]Path, pollInterval time.Duration, logger log.Logger) (*Relayer, error) {
+	r := &Relayer{
+		endpoints:    make(map[string]*endpoint),
+		chains:       chains,
+		heights:      make(map[string]int64),
+		pending:      make(map[string]*pendingPacket),
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+
+	for name, path := range paths {
+		if path.Src.ChannelID == "" || path.Dst.ChannelID == "" {
+			return nil, fmt.Errorf("path %s is not linked", name)
+		}
+		datachain, ok := chains[path.Src.ChainID]
+		if !ok {
+			return nil, fmt.Errorf("no client for chain %s of path %s", path.Src.ChainID, name)
+		}
+		metachain, ok := chains[path.Dst.ChainID]
+		if !ok {
+			return nil, fmt.Errorf("no client for chain %s of path %s", path.Dst.ChainID, name)
+		}
+
+		src := &endpoint{chain: datachain, portID: metastoretypes.DatastorePortID, channelID: path.Src.ChannelID, clientID: path.Src.ClientID}
+		dst := &endpoint{chain: metachain, portID: metastoretypes.PortID, channelID: path.Dst.ChannelID, clientID: path.Dst.ClientID}
+		src.counterparty, dst.counterparty = dst, src
+		r.endpoints[endpointKey(datachain.ChainID(), src.portID, src.channelID)] = src
+		r.endpoints[endpointKey(metachain.ChainID(), dst.portID, dst.channelID)] = dst
+	}
+
+	return r, nil
+}
+
+// Run relays until ctx is done. Packets sent before Run started are not picked up.
+func (r *Relayer) Run(ctx context.Context) error {
+	for chainID, chain := range r.chains {
+		height, _, err := chain.LatestBlock(ctx)
+		if err != nil {
+			return err
+		}
+		r.heights[chainID] = height - 1
+	}
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		for chainID, chain := range r.chains {
+			if err := r.scan(ctx, chain); err != nil {
+				r.logger.Error("failed to scan blocks", "chain", chainID, "err", err)
+			}
+		}
+		r.relayPending(ctx)
+	}
+}
+
+// scan collects the packets and acknowledgements written on chain since the last poll. The
+// latest block is left for the next poll: its state can only be proven once the header after
+// it exists.
+func (r *Relayer) scan(ctx context.Context, chain *ChainClient) error {
+	latest, _, err := chain.LatestBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	for height := r.heights[chain.ChainID()] + 1; height < latest; height++ {
+		events, err := chain.BlockEvents(ctx, height)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			r.handleEvent(chain, event)
+		}
+		r.heights[chain.ChainID()] = height
+	}
+
+	return nil
+}
+
+func (r *Relayer) handleEvent(chain *ChainClient, event abci.Event) {
+	switch event.Type {
+	case channeltypes.EventTypeSendPacket:
+		packet, _, err := parsePacket(event)
+		if err != nil {
+			r.logger.Error("failed to parse packet", "chain", chain.ChainID(), "err", err)
+			return
+		}
+		source, ok := r.endpoints[endpointKey(chain.ChainID(), packet.SourcePort, packet.SourceChannel)]
+		if !ok {
+			return
+		}
+		r.pending[packetKey(source, packet.Sequence)] = &pendingPacket{source: source, packet: packet}
+
+	case channeltypes.EventTypeWriteAck:
+		packet, ack, err := parsePacket(event)
+		if err != nil {
+			r.logger.Error("failed to parse acknowledgement", "chain", chain.ChainID(), "err", err)
+			return
+		}
+		destination, ok := r.endpoints[endpointKey(chain.ChainID(), packet.DestinationPort, packet.DestinationChannel)]
+		if !ok {
+			return
+		}
+		r.pending[packetKey(destination.counterparty, packet.Sequence)] = &pendingPacket{source: destination.counterparty, packet: packet, ack: ack}
+	}
+}
+
+func packetKey(source *endpoint, sequence uint64) string {
+	return endpointKey(source.chain.ChainID(), source.portID, source.channelID) + "/" + strconv.FormatUint(sequence, 10)
+}
+
+// relayPending turns every pending packet into a recv, ack or timeout msg and sends them in
+// one tx per target endpoint, behind an update of the target's client.
+func (r *Relayer) relayPending(ctx context.Context) {
+	batches := make(map[*endpoint][]relayMsg)
+	for key, pending := range r.pending {
+		target, msg, done, err := r.relayMsg(ctx, pending)
+		if err != nil {
+			r.logger.Error("failed to prepare packet", "packet", key, "err", err)
+			continue
+		}
+		if done {
+			delete(r.pending, key)
+			continue
+		}
+		msg.key = key
+		batches[target] = append(batches[target], msg)
+	}
+
+	for target, msgs := range batches {
+		if err := r.send(ctx, target, msgs); err != nil {
+			r.logger.Error("failed to relay packets", "chain", target.chain.ChainID(), "packets", len(msgs), "err", err)
+			continue
+		}
+		for _, msg := range msgs {
+			delete(r.pending, msg.key)
+		}
+		r.logger.Info("relayed packets", "chain", target.chain.ChainID(), "channel", target.channelID, "packets", len(msgs))
+	}
+}
+
+// relayMsg decides what to do with a pending packet. It returns the endpoint to send the msg
+// to, or done when the packet needs nothing more.
+func (r *Relayer) relayMsg(ctx context.Context, pending *pendingPacket) (*endpoint, relayMsg, bool, error) {
+	source, destination, packet := pending.source, pending.source.counterparty, pending.packet
+
+	if pending.ack != nil {
+		committed, err := source.chain.HasKey(ctx, host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+		if err != nil || !committed {
+			return nil, relayMsg{}, !committed, err
+		}
+
+		return source, relayMsg{
+			proofKey: host.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence),
+			build: func(proof []byte, proofHeight clienttypes.Height) sdk.Msg {
+				return channeltypes.NewMsgAcknowledgement(packet, pending.ack, proof, proofHeight, source.chain.Signer())
+			},
+		}, false, nil
+	}
+
+	received, err := destination.chain.HasKey(ctx, host.PacketReceiptKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+	if err != nil {
+		return nil, relayMsg{}, false, err
+	}
+	if received {
+		// the acknowledgement is picked up from the destination's write_acknowledgement event
+		return nil, relayMsg{}, true, nil
+	}
+
+	latest, latestTime, err := destination.chain.LatestBlock(ctx)
+	if err != nil {
+		return nil, relayMsg{}, false, err
+	}
+	timedOut := (!packet.TimeoutHeight.IsZero() && !destination.chain.Height(latest).LT(packet.TimeoutHeight)) ||
+		(packet.TimeoutTimestamp != 0 && uint64(latestTime.UnixNano()) >= packet.TimeoutTimestamp)
+	if timedOut {
+		committed, err := source.chain.HasKey(ctx, host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+		if err != nil || !committed {
+			return nil, relayMsg{}, !committed, err
+		}
+
+		return source, relayMsg{
+			proofKey: host.PacketReceiptKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence),
+			build: func(proof []byte, proofHeight clienttypes.Height) sdk.Msg {
+				return channeltypes.NewMsgTimeout(packet, packet.Sequence, proof, proofHeight, source.chain.Signer())
+			},
+		}, false, nil
+	}
+
+	return destination, relayMsg{
+		proofKey: host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence),
+		build: func(proof []byte, proofHeight clienttypes.Height) sdk.Msg {
+			return channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, destination.chain.Signer())
+		},
+	}, false, nil
+}
+
+// send proves every msg on the counterparty of target and sends them to target in one tx.
+func (r *Relayer) send(ctx context.Context, target *endpoint, msgs []relayMsg) error {
+	counterparty := target.counterparty.chain
+
+	height, sdkMsgs, err := clientUpdate(ctx, target.chain, target.clientID, counterparty)
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		_, proof, proofHeight, err := counterparty.QueryProof(ctx, msg.proofKey, height)
+		if err != nil {
+			return err
+		}
+		sdkMsgs = append(sdkMsgs, msg.build(proof, proofHeight))
+	}
+
+	_, err = target.chain.SendMsgs(ctx, sdkMsgs...)
+	return err
+}
+
+// parsePacket reads a packet, and the acknowledgement of write_acknowledgement events, from
+// the attributes of a packet event.
+func parsePacket(event abci.Event) (channeltypes.Packet, []byte, error) {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+
+	var (
+		packet channeltypes.Packet
+		err    error
+	)
+	packet.SourcePort = attrs[channeltypes.AttributeKeySrcPort]
+	packet.SourceChannel = attrs[channeltypes.AttributeKeySrcChannel]
+	packet.DestinationPort = attrs[channeltypes.AttributeKeyDstPort]
+	packet.DestinationChannel = attrs[channeltypes.AttributeKeyDstChannel]
+	if packet.Sequence, err = strconv.ParseUint(attrs[channeltypes.AttributeKeySequence], 10, 64); err != nil {
+		return packet, nil, fmt.Errorf("invalid packet sequence: %w", err)
+	}
+	if packet.Data, err = hex.DecodeString(attrs[channeltypes.AttributeKeyDataHex]); err != nil {
+		return packet, nil, fmt.Errorf("invalid packet data: %w", err)
+	}
+	if packet.TimeoutHeight, err = clienttypes.ParseHeight(attrs[channeltypes.AttributeKeyTimeoutHeight]); err != nil {
+		return packet, nil, fmt.Errorf("invalid packet timeout height: %w", err)
+	}
+	if packet.TimeoutTimestamp, err = strconv.ParseUint(attrs[channeltypes.AttributeKeyTimeoutTimestamp], 10, 64); err != nil {
+		return packet, nil, fmt.Errorf("invalid packet timeout timestamp: %w", err)
+	}
+
+	if event.Type != channeltypes.EventTypeWriteAck {
+		return packet, nil, nil
+	}
+	ack, err := hex.DecodeString(attrs[channeltypes.AttributeKeyAckHex])
+	if err != nil {
+		return packet, nil, fmt.Errorf("invalid packet acknowledgement: %w", err)
+	}
+
+	return packet, ack, nil
+}
@@ -0,0 +1,69 @@
+package relayer
+
+import (
+	"encoding/hex"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+func TestParsePacket(t *testing.T) {
+	packet := channeltypes.NewPacket([]byte("data"), 3, "datastore", "channel-0", "metastore", "channel-1", clienttypes.NewHeight(0, 20), 1_000)
+	ack := []byte(`{"result":"AQ=="}`)
+
+	packetEvent := func(eventType string, extra ...abci.EventAttribute) abci.Event {
+		return abci.Event{
+			Type: eventType,
+			Attributes: append([]abci.EventAttribute{
+				{Key: channeltypes.AttributeKeyDataHex, Value: hex.EncodeToString(packet.Data)},
+				{Key: channeltypes.AttributeKeyTimeoutHeight, Value: packet.TimeoutHeight.String()},
+				{Key: channeltypes.AttributeKeyTimeoutTimestamp, Value: "1000"},
+				{Key: channeltypes.AttributeKeySequence, Value: "3"},
+				{Key: channeltypes.AttributeKeySrcPort, Value: packet.SourcePort},
+				{Key: channeltypes.AttributeKeySrcChannel, Value: packet.SourceChannel},
+				{Key: channeltypes.AttributeKeyDstPort, Value: packet.DestinationPort},
+				{Key: channeltypes.AttributeKeyDstChannel, Value: packet.DestinationChannel},
+			}, extra...),
+		}
+	}
+
+	tests := []struct {
+		name  string
+		event abci.Event
+		ack   []byte
+		err   bool
+	}{
+		{
+			name:  "send packet",
+			event: packetEvent(channeltypes.EventTypeSendPacket),
+		}, {
+			name:  "write acknowledgement",
+			event: packetEvent(channeltypes.EventTypeWriteAck, abci.EventAttribute{Key: channeltypes.AttributeKeyAckHex, Value: hex.EncodeToString(ack)}),
+			ack:   ack,
+		}, {
+			name:  "invalid sequence",
+			event: packetEvent(channeltypes.EventTypeSendPacket, abci.EventAttribute{Key: channeltypes.AttributeKeySequence, Value: "three"}),
+			err:   true,
+		}, {
+			name:  "invalid acknowledgement",
+			event: packetEvent(channeltypes.EventTypeWriteAck, abci.EventAttribute{Key: channeltypes.AttributeKeyAckHex, Value: "zz"}),
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPacket, gotAck, err := parsePacket(tt.event)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, packet, gotPacket)
+			require.Equal(t, tt.ack, gotAck)
+		})
+	}
+}
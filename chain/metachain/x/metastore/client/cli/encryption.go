@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"metachain/x/metastore/types"
+)
+
+const (
+	flagKeyFile = "key-file"
+	flagChannel = "channel"
+)
+
+// NewEncryptionCmd returns the commands managing the keys of private resources and decrypting them.
+func NewEncryptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "encryption",
+		Short:                      "Encryption keys and decryption of private resources",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(CmdEncryptionKeygen())
+	cmd.AddCommand(CmdDecryptChunks())
+
+	return cmd
+}
+
+// CmdEncryptionKeygen returns the command creating the X25519 key pair of a recipient.
+func CmdEncryptionKeygen() *cobra.Command {
+	return &cobra.Command{
+		Use:   "keygen [key-file]",
+		Short: "Create an X25519 key pair, write the private key to key-file and print the public key",
+		Long: `keygen writes a new hex X25519 private key to key-file, which must not exist yet, and prints
+the public key. Uploads encrypted with --encrypt-to set to the public key can be decrypted with
+key-file.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := ecdh.X25519().GenerateKey(rand.Reader)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.OpenFile(args[0], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintln(f, hex.EncodeToString(key.Bytes())); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+
+			cmd.Println(hex.EncodeToString(key.PublicKey().Bytes()))
+			return nil
+		},
+	}
+}
+
+// CmdDecryptChunks returns the command decrypting the chunks of a private resource from the chunk
+// cache.
+func CmdDecryptChunks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrypt [url] [index]...",
+		Short: "Decrypt cached chunks of a private resource and write them out in order",
+		Long: `decrypt unwraps the data key of the resource stored at url with the private key in
+--key-file, decrypts the given chunks, retrieved over --channel into the chunk cache beforehand
+with retrieve-chunks --cache-bytes, and writes their data in argument order to --output-document
+or stdout.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyFile, err := cmd.Flags().GetString(flagKeyFile)
+			if err != nil {
+				return err
+			}
+			channel, err := cmd.Flags().GetString(flagChannel)
+			if err != nil {
+				return err
+			}
+			key, err := readEncryptionKey(keyFile)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			metaRes, err := queryClient.GetStoredMeta(cmd.Context(), &types.QueryGetStoredMetaRequest{Index: args[0]})
+			if err != nil {
+				return err
+			}
+			if metaRes.StoredMeta.Encryption == nil {
+				return fmt.Errorf("%s is not encrypted", args[0])
+			}
+			dataKey, err := metaRes.StoredMeta.Encryption.UnwrapDataKey(key)
+			if err != nil {
+				return err
+			}
+
+			var out bytes.Buffer
+			for _, index := range args[1:] {
+				chunkRes, err := queryClient.GetCachedChunk(cmd.Context(), &types.QueryGetCachedChunkRequest{Index: index, ChannelId: channel})
+				if err != nil {
+					return fmt.Errorf("chunk %s: %w", index, err)
+				}
+				data, err := types.DecryptChunk(dataKey, index, chunkRes.CachedChunk.Data)
+				if err != nil {
+					return err
+				}
+				out.Write(data)
+			}
+
+			outputDocument, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if err != nil {
+				return err
+			}
+			if outputDocument == "" {
+				_, err = io.Copy(cmd.OutOrStdout(), &out)
+				return err
+			}
+			return os.WriteFile(outputDocument, out.Bytes(), 0o600)
+		},
+	}
+
+	cmd.Flags().String(flagKeyFile, "", "File holding the hex X25519 private key of the recipient")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the decrypted data to the given file instead of STDOUT")
+	cmd.Flags().String(flagChannel, "", "Channel, or IBC v2 client, the chunks were retrieved over")
+	_ = cmd.MarkFlagRequired(flagKeyFile)
+	_ = cmd.MarkFlagRequired(flagChannel)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readEncryptionKey reads a hex X25519 private key written by keygen.
+func readEncryptionKey(path string) (*ecdh.PrivateKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid key file %s: %w", path, err)
+	}
+	return ecdh.X25519().NewPrivateKey(raw)
+}
+
+// parseEncryptionPublicKey parses a hex X25519 public key printed by keygen.
+func parseEncryptionPublicKey(s string) (*ecdh.PublicKey, error) {
+	raw, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key %q: %w", s, err)
+	}
+	return ecdh.X25519().NewPublicKey(raw)
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	// "github.com/cosmos/cosmos-sdk/client/flags"
+
+	"chunks"
 	"metachain/x/metastore/types"
 )
 
@@ -25,6 +27,10 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 	cmd.AddCommand(CmdSendMetadata())
+	cmd.AddCommand(CmdUploadChunks())
+	cmd.AddCommand(CmdRetrieveChunks())
+	cmd.AddCommand(chunks.CmdGrantUploader(types.UploaderMsgTypeURLs(), "upload chunks and metadata", "raidchaind tx metastore upload-chunks [url] [connection-id:index:data]..."))
+	cmd.AddCommand(chunks.CmdRevokeUploader(types.UploaderMsgTypeURLs()))
 
 	// this line is used by starport scaffolding # 1
 
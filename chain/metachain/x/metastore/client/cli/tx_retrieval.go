@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channelutils "github.com/cosmos/ibc-go/v10/modules/core/04-channel/client/utils"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/spf13/cobra"
+
+	"metachain/x/metastore/types"
+)
+
+// CmdRetrieveChunks returns the command requesting chunk data from a datachain over IBC.
+func CmdRetrieveChunks() *cobra.Command {
+	flagPacketTimeoutTimestamp := "packet-timeout-timestamp"
+	flagCacheBytes := "cache-bytes"
+
+	cmd := &cobra.Command{
+		Use:   "retrieve-chunks [src-port] [src-channel] [indexes]",
+		Short: "Retrieve chunk data from a datachain over IBC",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+			argIndexes := strings.Split(args[2], listSeparator)
+
+			cacheBytes, err := cmd.Flags().GetUint64(flagCacheBytes)
+			if err != nil {
+				return err
+			}
+
+			// Get the relative timeout timestamp
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			consensusStateAny, err := channelutils.QueryChannelConsensusState(clientCtx, srcPort, srcChannel, clienttypes.Height{}, false)
+			if err != nil {
+				return err
+			}
+
+			var consensusState exported.ConsensusState
+			if err := clientCtx.InterfaceRegistry.UnpackAny(consensusStateAny.GetConsensusState(), &consensusState); err != nil {
+				return err
+			}
+
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp //nolint:staticcheck // client side
+			}
+
+			msg := types.NewMsgRetrieveChunks(creator, srcPort, srcChannel, timeoutTimestamp, argIndexes, cacheBytes)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	cmd.Flags().Uint64(flagCacheBytes, 0, "Bytes of the retrieved chunks to keep in the metachain chunk cache, paid for in gas")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
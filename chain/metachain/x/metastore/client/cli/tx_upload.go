@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"crypto/ecdh"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"metachain/x/metastore/types"
+)
+
+// CmdUploadChunks returns the command uploading chunks to datachains through interchain accounts.
+// Each chunk is given as connection-id:index:data.
+func CmdUploadChunks() *cobra.Command {
+	flagPacketTimeoutTimestamp := "packet-timeout-timestamp"
+	flagEncryptTo := "encrypt-to"
+
+	cmd := &cobra.Command{
+		Use:   "upload-chunks [url] [connection-id:index:data]...",
+		Short: "Store chunks on datachains through interchain accounts and record their metadata",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argUrl := args[0]
+
+			chunks := make([]types.ChunkUpload, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				parts := strings.SplitN(arg, ":", 3)
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid chunk %q, expected connection-id:index:data", arg)
+				}
+				chunks = append(chunks, types.ChunkUpload{
+					ConnectionId: parts[0],
+					Index:        parts[1],
+					Data:         []byte(parts[2]),
+				})
+			}
+
+			recipients, err := cmd.Flags().GetStringSlice(flagEncryptTo)
+			if err != nil {
+				return err
+			}
+			var encryption *types.Encryption
+			if len(recipients) > 0 {
+				if encryption, err = encryptChunks(chunks, recipients); err != nil {
+					return err
+				}
+			}
+
+			// Get the relative timeout timestamp
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			// interchain account packets need an absolute timeout
+			timeoutTimestamp += uint64(time.Now().UnixNano())
+
+			msg := types.NewMsgUploadChunks(creator, argUrl, chunks, timeoutTimestamp)
+			msg.Encryption = encryption
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	cmd.Flags().StringSlice(flagEncryptTo, nil, "Encrypt the chunks for the given hex X25519 public keys (see the encryption keygen command)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// encryptChunks replaces the data of chunks with its ciphertext under a new data key, and returns
+// the data key wrapped for recipients.
+func encryptChunks(chunks []types.ChunkUpload, recipients []string) (*types.Encryption, error) {
+	keys := make([]*ecdh.PublicKey, 0, len(recipients))
+	for _, recipient := range recipients {
+		key, err := parseEncryptionPublicKey(recipient)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	dataKey, err := types.NewDataKey()
+	if err != nil {
+		return nil, err
+	}
+	for i := range chunks {
+		if chunks[i].Data, err = types.EncryptChunk(dataKey, chunks[i].Index, chunks[i].Data); err != nil {
+			return nil, err
+		}
+	}
+
+	return types.NewEncryption(dataKey, keys)
+}
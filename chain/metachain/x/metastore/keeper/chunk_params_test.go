@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"chunks"
+
+	"metachain/x/metastore/types"
+)
+
+func TestChunkParams(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(2, 8, true, types.DefaultChunkCacheTTL)))
+
+	stores := chunks.Stores{{MsgBytes: types.MsgChunkBytes, Params: f.keeper.ChunkParams}}
+	upload := &types.MsgUploadChunks{Url: "example.com/a", Chunks: []types.ChunkUpload{
+		{ConnectionId: "connection-0", Data: []byte("abc")},
+		{ConnectionId: "connection-1", Data: []byte("de")},
+	}}
+	move := &types.MsgMoveFragments{Moves: []types.FragmentMove{{Data: []byte("f")}}}
+
+	usage, err := stores.TxUsage(f.ctx, []sdk.Msg{upload, move, &types.MsgSendMetadata{}})
+	require.NoError(t, err)
+	require.Equal(t, chunks.Usage{Bytes: 6, Gas: 12, MaxBlockBytes: 8}, usage)
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"metachain/x/metastore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetDatachain returns the datachain behind connectionID. Datachains that never drained are
+// active.
+func (k Keeper) GetDatachain(ctx context.Context, connectionID string) (types.Datachain, error) {
+	datachain, err := k.Datachains.Get(ctx, connectionID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Datachain{ConnectionId: connectionID}, nil
+	}
+	return datachain, err
+}
+
+// requireActive fails unless the datachain behind connectionID takes new chunks.
+func (k Keeper) requireActive(ctx context.Context, connectionID string) error {
+	datachain, err := k.GetDatachain(ctx, connectionID)
+	if err != nil {
+		return err
+	}
+	if !datachain.Active() {
+		return errorsmod.Wrapf(types.ErrDatachainNotActive, "datachain %s is %s", connectionID, datachain.Status)
+	}
+	return nil
+}
+
+// channelConnection returns the connection of the channel portID/channelID, if it exists.
+func (k Keeper) channelConnection(ctx context.Context, portID, channelID string) (string, bool) {
+	channel, found := k.ibcKeeperFn().ChannelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), portID, channelID)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return "", false
+	}
+	return channel.ConnectionHops[0], true
+}
+
+// CountDatachainFragments returns the number of chunks manifests reference on the datachain
+// behind connectionID.
+func (k Keeper) CountDatachainFragments(ctx context.Context, connectionID string) (uint64, error) {
+	iter, err := k.StoredMetaByDatachain.Iterate(ctx, collections.NewPrefixedTripleRange[string, string, string](connectionID))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var n uint64
+	for ; iter.Valid(); iter.Next() {
+		n++
+	}
+	return n, nil
+}
+
+// retireDrained retires the draining datachains among connections no manifest references
+// anymore.
+func (k Keeper) retireDrained(ctx context.Context, connections []string) error {
+	slices.Sort(connections)
+	for _, connectionID := range slices.Compact(connections) {
+		datachain, err := k.GetDatachain(ctx, connectionID)
+		if err != nil {
+			return err
+		}
+		if datachain.Status != types.DatachainStatus_DATACHAIN_STATUS_DRAINING {
+			continue
+		}
+		if err := k.retireIfDrained(ctx, datachain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retireIfDrained retires the draining datachain once it holds no referenced chunk.
+func (k Keeper) retireIfDrained(ctx context.Context, datachain types.Datachain) error {
+	iter, err := k.StoredMetaByDatachain.Iterate(ctx, collections.NewPrefixedTripleRange[string, string, string](datachain.ConnectionId))
+	if err != nil {
+		return err
+	}
+	referenced := iter.Valid()
+	iter.Close()
+	if referenced {
+		return nil
+	}
+
+	datachain.Status = types.DatachainStatus_DATACHAIN_STATUS_RETIRED
+	if err := k.Datachains.Set(ctx, datachain.ConnectionId, datachain); err != nil {
+		return err
+	}
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDatachainRetired{
+		ConnectionId: datachain.ConnectionId,
+	})
+}
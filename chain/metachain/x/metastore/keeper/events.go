@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"metachain/x/metastore/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// emitStoredMetaSet emits the typed event of storing meta, EventStoredMetaUpdated when it replaced
+// an entry and EventStoredMetaCreated otherwise.
+func emitStoredMetaSet(ctx context.Context, meta types.StoredMeta, replaced bool) error {
+	var size uint64
+	for _, chunk := range meta.Chunks {
+		size += chunk.Size_
+	}
+
+	eventManager := sdk.UnwrapSDKContext(ctx).EventManager()
+	if replaced {
+		return eventManager.EmitTypedEvent(&types.EventStoredMetaUpdated{
+			Index:   meta.Index,
+			Creator: meta.Creator,
+			Url:     meta.Url,
+			Size_:   size,
+			Chunks:  meta.Chunks,
+		})
+	}
+	return eventManager.EmitTypedEvent(&types.EventStoredMetaCreated{
+		Index:   meta.Index,
+		Creator: meta.Creator,
+		Url:     meta.Url,
+		Size_:   size,
+		Chunks:  meta.Chunks,
+	})
+}
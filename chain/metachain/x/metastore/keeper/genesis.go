@@ -15,7 +15,12 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		return err
 	}
 	for _, elem := range genState.StoredMetaMap {
-		if err := k.StoredMeta.Set(ctx, elem.Index, elem); err != nil {
+		if err := k.SetStoredMeta(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.Datachains {
+		if err := k.Datachains.Set(ctx, elem.ConnectionId, elem); err != nil {
 			return err
 		}
 	}
@@ -42,6 +47,12 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.Datachains.Walk(ctx, nil, func(_ string, val types.Datachain) (stop bool, err error) {
+		genesis.Datachains = append(genesis.Datachains, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
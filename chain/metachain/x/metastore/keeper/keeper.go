@@ -25,10 +25,38 @@ type Keeper struct {
 
 	Port collections.Item[string]
 
-	ibcKeeperFn func() *ibckeeper.Keeper
+	ibcKeeperFn           func() *ibckeeper.Keeper
+	icaControllerKeeperFn func() types.ICAControllerKeeper
 
 	bankKeeper types.BankKeeper
 	StoredMeta collections.Map[string, types.StoredMeta]
+	// StoredMetaByFragment indexes StoredMeta by (chunk index, stored meta index) for each chunk
+	// it lists. It is kept by SetStoredMeta and RemoveStoredMeta.
+	StoredMetaByFragment collections.KeySet[collections.Pair[string, string]]
+	// StoredMetaByDatachain indexes StoredMeta by (connection id, chunk index, stored meta index)
+	// for each chunk whose datachain it knows. It is kept along with StoredMetaByFragment.
+	StoredMetaByDatachain collections.KeySet[collections.Triple[string, string, string]]
+	// Datachains holds the datachains that are draining or retired, by connection id.
+	Datachains    collections.Map[string, types.Datachain]
+	PendingUpload collections.Map[string, types.PendingUpload]
+	// UploadPacket maps (port, channel, sequence) of an in-flight interchain
+	// account packet to the url of the upload it belongs to.
+	UploadPacket collections.Map[collections.Triple[string, string, uint64], string]
+	// MigrationPacket maps (port, channel, sequence) of an in-flight interchain account packet to
+	// the fragment migration it carries.
+	MigrationPacket collections.Map[collections.Triple[string, string, uint64], types.FragmentMigration]
+	// PendingMove tracks the fragments MsgMoveFragments copies, by manifest url.
+	PendingMove collections.Map[string, types.PendingMove]
+	// MovePacket maps (port, channel, sequence) of an in-flight interchain account packet to the
+	// pending move it belongs to.
+	MovePacket collections.Map[collections.Triple[string, string, uint64], types.MovePacketRef]
+	// MoveSeq numbers the moves, so packets of a failed move are not counted for the next one.
+	MoveSeq collections.Sequence
+	// CachedChunk keeps chunk data retrieved with MsgRetrieveChunks, by (channel id, chunk index).
+	CachedChunk collections.Map[collections.Pair[string, string], types.CachedChunk]
+	// CachedChunkExpiry indexes CachedChunk by (expiry height, channel id, chunk index), for
+	// pruning.
+	CachedChunkExpiry collections.KeySet[collections.Triple[int64, string, string]]
 }
 
 func NewKeeper(
@@ -37,6 +65,7 @@ func NewKeeper(
 	addressCodec address.Codec,
 	authority []byte,
 	ibcKeeperFn func() *ibckeeper.Keeper,
+	icaControllerKeeperFn func() types.ICAControllerKeeper,
 
 	bankKeeper types.BankKeeper,
 ) Keeper {
@@ -52,11 +81,31 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
-		bankKeeper:  bankKeeper,
-		ibcKeeperFn: ibcKeeperFn,
-		Port:        collections.NewItem(sb, types.PortKey, "port", collections.StringValue),
-		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		StoredMeta:  collections.NewMap(sb, types.StoredMetaKey, "storedMeta", collections.StringKey, codec.CollValue[types.StoredMeta](cdc))}
+		bankKeeper:            bankKeeper,
+		ibcKeeperFn:           ibcKeeperFn,
+		icaControllerKeeperFn: icaControllerKeeperFn,
+		Port:                  collections.NewItem(sb, types.PortKey, "port", collections.StringValue),
+		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		StoredMeta:            collections.NewMap(sb, types.StoredMetaKey, "storedMeta", collections.StringKey, codec.CollValue[types.StoredMeta](cdc)),
+		StoredMetaByFragment: collections.NewKeySet(sb, types.StoredMetaByFragmentKey, "storedMetaByFragment",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		StoredMetaByDatachain: collections.NewKeySet(sb, types.StoredMetaByDatachainKey, "storedMetaByDatachain",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey)),
+		Datachains:    collections.NewMap(sb, types.DatachainKey, "datachains", collections.StringKey, codec.CollValue[types.Datachain](cdc)),
+		PendingUpload: collections.NewMap(sb, types.PendingUploadKey, "pendingUpload", collections.StringKey, codec.CollValue[types.PendingUpload](cdc)),
+		UploadPacket: collections.NewMap(sb, types.UploadPacketKey, "uploadPacket",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), collections.StringValue),
+		MigrationPacket: collections.NewMap(sb, types.MigrationPacketKey, "migrationPacket",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), codec.CollValue[types.FragmentMigration](cdc)),
+		PendingMove: collections.NewMap(sb, types.PendingMoveKey, "pendingMove", collections.StringKey, codec.CollValue[types.PendingMove](cdc)),
+		MovePacket: collections.NewMap(sb, types.MovePacketKey, "movePacket",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), codec.CollValue[types.MovePacketRef](cdc)),
+		MoveSeq: collections.NewSequence(sb, types.MoveSeqKey, "moveSeq"),
+		CachedChunk: collections.NewMap(sb, types.CachedChunkKey, "cachedChunk",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.CachedChunk](cdc)),
+		CachedChunkExpiry: collections.NewKeySet(sb, types.CachedChunkExpiryKey, "cachedChunkExpiry",
+			collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey)),
+	}
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -15,7 +15,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	ibctypes "github.com/cosmos/ibc-go/v10/modules/core/types"
 
@@ -28,6 +31,7 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	icaKeeper    *mockICAControllerKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -42,6 +46,7 @@ func initFixture(t *testing.T) *fixture {
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 	mockUpgradeKeeper := newMockUpgradeKeeper()
+	icaKeeper := newMockICAControllerKeeper()
 
 	k := keeper.NewKeeper(
 		storeService,
@@ -51,6 +56,9 @@ func initFixture(t *testing.T) *fixture {
 		func() *ibckeeper.Keeper {
 			return ibckeeper.NewKeeper(encCfg.Codec, storeService, newMockParams(), mockUpgradeKeeper, authority.String())
 		},
+		func() types.ICAControllerKeeper {
+			return icaKeeper
+		},
 		nil,
 	)
 
@@ -63,7 +71,24 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		icaKeeper:    icaKeeper,
+	}
+}
+
+// typedEvents returns the typed events emitted on ctx, in order.
+func typedEvents(t *testing.T, ctx context.Context) []proto.Message {
+	t.Helper()
+
+	var events []proto.Message
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			// not a typed event
+			continue
+		}
+		events = append(events, msg)
 	}
+	return events
 }
 
 type mockUpgradeKeeper struct {
@@ -92,3 +117,39 @@ func newMockParams() *mockParams {
 
 func (mockParams) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
 }
+
+type mockICAControllerKeeper struct {
+	// accounts maps connectionID/portID to the interchain account address
+	accounts map[string]string
+	sent     []icatypes.InterchainAccountPacketData
+}
+
+func newMockICAControllerKeeper() *mockICAControllerKeeper {
+	return &mockICAControllerKeeper{accounts: make(map[string]string)}
+}
+
+func (m *mockICAControllerKeeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string, ordering channeltypes.Order) error {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+	m.accounts[connectionID+"/"+portID] = "ica-" + connectionID
+	return nil
+}
+
+func (m *mockICAControllerKeeper) GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	address, found := m.accounts[connectionID+"/"+portID]
+	return address, found
+}
+
+func (m *mockICAControllerKeeper) GetOpenActiveChannel(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	if _, found := m.accounts[connectionID+"/"+portID]; !found {
+		return "", false
+	}
+	return "channel-" + connectionID, true
+}
+
+func (m *mockICAControllerKeeper) SendTx(ctx sdk.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
+	m.sent = append(m.sent, icaPacketData)
+	return uint64(len(m.sent)), nil
+}
@@ -4,11 +4,12 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
-	"fmt"
 
 	"metachain/x/metastore/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -41,51 +42,154 @@ func (k Keeper) OnRecvMetadataPacket(ctx context.Context, packet channeltypes.Pa
 	return packetAck, errors.New("metastore module is not supposed to receive metadata packets")
 }
 
-// OnAcknowledgementMetadataPacket responds to the success or failure of a packet acknowledgement.
+// OnAcknowledgementMetadataPacket stores the metadata entry the datachain attested. An error ack,
+// or an ack the attestation cannot be read from, stores nothing; the packet is still cleared, so
+// the relayer does not keep failing to deliver it.
 func (k Keeper) OnAcknowledgementMetadataPacket(ctx context.Context, packet channeltypes.Packet, data types.MetadataPacketData, ack channeltypes.Acknowledgement) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	fmt.Printf("metachain [DEBUG]: OnAcknowledgementPacket received ack: %v\n", ack)
 
-	switch dispatchedAck := ack.Response.(type) {
-	case *channeltypes.Acknowledgement_Error:
-		fmt.Printf("metachain [DEBUG]: Acknowledgement is an ERROR: %s\n", dispatchedAck.Error)
+	dispatchedAck, ok := ack.Response.(*channeltypes.Acknowledgement_Result)
+	if !ok {
+		emitMetadataFailed(sdkCtx, data.Url, ack.GetError())
 		return nil
+	}
 
-	case *channeltypes.Acknowledgement_Result:
-		fmt.Println("metachain [DEBUG]: Acknowledgement is a SUCCESS.")
+	// Decode the packet acknowledgment from datachain
+	var packetAck types.MetadataPacketAck
+	if err := k.cdc.Unmarshal(dispatchedAck.Result, &packetAck); err != nil {
+		return k.rejectMetadataAck(sdkCtx, packet, data, errorsmod.Wrapf(types.ErrInvalidAck, "cannot unmarshal acknowledgment: %s", err))
+	}
+	if err := validateMetadataPacketAck(packetAck, data); err != nil {
+		return k.rejectMetadataAck(sdkCtx, packet, data, err)
+	}
 
-		// Decode the packet acknowledgment from datachain
-		var packetAck types.MetadataPacketAck
-		if err := k.cdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
-			fmt.Printf("metachain [ERROR]: cannot unmarshal acknowledgment: %s\n", err.Error())
-			return errors.New("cannot unmarshal acknowledgment")
-		}
+	// The core logic: if the acknowledgement is successful, store the metadata.
+	storedMeta := k.metadataRoute(sdkCtx, packet, data)
+	storedMeta.Creator = data.Creator    // Use the Creator from the original packet data.
+	storedMeta.Chunks = packetAck.Chunks // Record what the datachain attested for each address.
 
-		// --- ★★★ ここが最重要修正点 ★★★ ---
-		// The core logic: if the acknowledgement is successful, store the metadata.
-		fmt.Println("metachain [DEBUG]: Storing metadata...")
-		storedMeta := types.StoredMeta{
-			Index:   data.Url, // Use the URL as the primary key/index for the stored data.
-			Url:     data.Url,
-			Creator: data.Creator, // Use the Creator from the original packet data.
-		}
+	// the url may have been taken by another account while the packet was in flight
+	previous, err := k.StoredMeta.Get(sdkCtx, storedMeta.Index)
+	replaced := err == nil
+	if replaced && previous.Creator != data.Creator {
+		return k.rejectMetadataAck(sdkCtx, packet, data, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "url %s is owned by %s", data.Url, previous.Creator))
+	}
+	if !replaced && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
 
-		// Use the StoredMeta field from the keeper to call the Set method.
-		if err := k.StoredMeta.Set(sdkCtx, storedMeta.Index, storedMeta); err != nil {
+	// Store the entry along with its fragment index.
+	if err := k.SetStoredMeta(sdkCtx, storedMeta); err != nil {
+		return err
+	}
+	if replaced {
+		if err := k.releaseReplacedChunks(sdkCtx, previous, storedMeta); err != nil {
 			return err
 		}
+	}
+	return emitStoredMetaSet(sdkCtx, storedMeta, replaced)
+}
+
+// metadataRoute returns the entry of the url of data without chunks, with the route of packet
+// so deleting the entry can release the chunks again. IBC v2 packets carry client identifiers
+// in place of channel identifiers.
+func (k Keeper) metadataRoute(ctx context.Context, packet channeltypes.Packet, data types.MetadataPacketData) types.StoredMeta {
+	storedMeta := types.StoredMeta{
+		Index: data.Url, // Use the URL as the primary key/index for the stored data.
+		Url:   data.Url,
+	}
+	if channeltypes.IsValidChannelID(packet.SourceChannel) {
+		storedMeta.ChannelId = packet.SourceChannel
+		storedMeta.ConnectionId, _ = k.channelConnection(ctx, packet.SourcePort, packet.SourceChannel)
+	} else {
+		storedMeta.ClientId = packet.SourceChannel
+	}
+	return storedMeta
+}
+
+// rejectMetadataAck drops an ack whose attestation cannot be used. The datachain referenced the
+// chunks for the entry when it acked, so the references the entry of the url does not hold
+// through the same route are released again.
+func (k Keeper) rejectMetadataAck(ctx sdk.Context, packet channeltypes.Packet, data types.MetadataPacketData, ackErr error) error {
+	emitMetadataFailed(ctx, data.Url, ackErr.Error())
+
+	route := k.metadataRoute(ctx, packet, data)
+	held := make(map[string]bool)
+	existing, err := k.StoredMeta.Get(ctx, data.Url)
+	if err == nil && existing.ChannelId == route.ChannelId && existing.ClientId == route.ClientId {
+		for _, chunk := range existing.Chunks {
+			held[chunk.Index] = true
+		}
+	} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	var released []string
+	for _, address := range data.Addresses {
+		if !held[address] {
+			released = append(released, address)
+		}
+	}
 
-		fmt.Printf("metachain [SUCCESS]: Stored metadata for URL: %s\n", data.Url)
-		// --- ★★★ 修正ここまで ★★★ ---
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.releaseChunks(cacheCtx, route, released); err != nil {
+		ctx.Logger().Error("failed to release the chunks of a rejected metadata ack", "url", data.Url, "error", err)
+		return nil
+	}
+	write()
+	return nil
+}
 
+// RequireUrlOwner fails when url has an entry created by an account other than creator, so
+// nobody can replace the entry of another account and release its chunks.
+func (k Keeper) RequireUrlOwner(ctx context.Context, url, creator string) error {
+	existing, err := k.StoredMeta.Get(ctx, url)
+	if errors.Is(err, collections.ErrNotFound) {
 		return nil
-	default:
-		return errors.New("invalid acknowledgment format")
 	}
+	if err != nil {
+		return err
+	}
+	if existing.Creator != creator {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "url %s is owned by %s", url, existing.Creator)
+	}
+	return nil
 }
 
-// OnTimeoutMetadataPacket responds to a packet timeout.
+// OnTimeoutMetadataPacket responds to a packet timeout. The datachain never took references for
+// the entry, so there is nothing to release.
 func (k Keeper) OnTimeoutMetadataPacket(ctx context.Context, packet channeltypes.Packet, data types.MetadataPacketData) error {
-	// TODO: logic for packet timeout
+	emitMetadataFailed(sdk.UnwrapSDKContext(ctx), data.Url, "packet timed out")
+	return nil
+}
+
+func emitMetadataFailed(ctx sdk.Context, url string, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMetadataPacket,
+			sdk.NewAttribute(types.AttributeKeyUrl, url),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, "false"),
+			sdk.NewAttribute(types.AttributeKeyAckError, reason),
+		),
+	)
+}
+
+// validateMetadataPacketAck checks that the ack uses a known encoding version and attests
+// every requested address, in request order.
+func validateMetadataPacketAck(packetAck types.MetadataPacketAck, data types.MetadataPacketData) error {
+	if packetAck.Version != types.AckVersion {
+		return errorsmod.Wrapf(types.ErrInvalidAck, "unsupported ack version %d, expected %d", packetAck.Version, types.AckVersion)
+	}
+	if len(packetAck.Chunks) != len(data.Addresses) {
+		return errorsmod.Wrapf(types.ErrInvalidAck, "ack attests %d chunks, %d were requested", len(packetAck.Chunks), len(data.Addresses))
+	}
+	for i, chunk := range packetAck.Chunks {
+		if chunk.Index != data.Addresses[i] {
+			return errorsmod.Wrapf(types.ErrInvalidAck, "ack attests chunk %s at position %d, expected %s", chunk.Index, i, data.Addresses[i])
+		}
+		if len(chunk.Hash) != sha256.Size {
+			return errorsmod.Wrapf(types.ErrInvalidAck, "invalid hash length %d for chunk %s", len(chunk.Hash), chunk.Index)
+		}
+	}
+
 	return nil
 }
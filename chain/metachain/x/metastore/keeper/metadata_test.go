@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"metachain/x/metastore/keeper"
+	"metachain/x/metastore/types"
+)
+
+func TestOnAcknowledgementMetadataPacket(t *testing.T) {
+	data := types.MetadataPacketData{Url: "HelloWorld.com", Addresses: []string{"idx0", "idx1"}, Creator: "creator"}
+	hash0, hash1 := sha256.Sum256([]byte("hello")), sha256.Sum256([]byte("world"))
+	chunks := []types.VerifiedChunk{
+		{Index: "idx0", Size_: 5, Hash: hash0[:], Height: 7},
+		{Index: "idx1", Size_: 5, Hash: hash1[:], Height: 7},
+	}
+	resultAck := func(t *testing.T, packetAck types.MetadataPacketAck) channeltypes.Acknowledgement {
+		t.Helper()
+		bz, err := packetAck.Marshal()
+		require.NoError(t, err)
+		return channeltypes.NewResultAcknowledgement(bz)
+	}
+
+	tests := []struct {
+		name   string
+		ack    func(t *testing.T) channeltypes.Acknowledgement
+		stored bool
+		// reason is the error of the metadata_packet event of an ack that stores nothing
+		reason string
+	}{
+		{
+			name: "attested",
+			ack: func(t *testing.T) channeltypes.Acknowledgement {
+				return resultAck(t, types.MetadataPacketAck{Version: types.AckVersion, Chunks: chunks})
+			},
+			stored: true,
+		}, {
+			name: "error ack",
+			ack: func(t *testing.T) channeltypes.Acknowledgement {
+				return channeltypes.NewErrorAcknowledgement(errors.New("chunk not found"))
+			},
+			reason: "ABCI code",
+		}, {
+			name: "json result",
+			ack: func(t *testing.T) channeltypes.Acknowledgement {
+				return channeltypes.NewResultAcknowledgement([]byte("{}"))
+			},
+			reason: "cannot unmarshal acknowledgment",
+		}, {
+			name: "unknown version",
+			ack: func(t *testing.T) channeltypes.Acknowledgement {
+				return resultAck(t, types.MetadataPacketAck{Version: types.AckVersion + 1, Chunks: chunks})
+			},
+			reason: "unsupported ack version",
+		}, {
+			name: "missing chunk",
+			ack: func(t *testing.T) channeltypes.Acknowledgement {
+				return resultAck(t, types.MetadataPacketAck{Version: types.AckVersion, Chunks: chunks[:1]})
+			},
+			reason: "ack attests 1 chunks, 2 were requested",
+		}, {
+			name: "reordered chunks",
+			ack: func(t *testing.T) channeltypes.Acknowledgement {
+				return resultAck(t, types.MetadataPacketAck{Version: types.AckVersion, Chunks: []types.VerifiedChunk{chunks[1], chunks[0]}})
+			},
+			reason: "ack attests chunk idx1 at position 0, expected idx0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := initFixture(t)
+
+			// acks are cleared even when they store nothing, so relayers are not stuck with them
+			require.NoError(t, f.keeper.OnAcknowledgementMetadataPacket(f.ctx, channeltypes.Packet{SourceChannel: "channel-0"}, data, tt.ack(t)))
+			if tt.reason != "" {
+				var reasons []string
+				for _, event := range sdk.UnwrapSDKContext(f.ctx).EventManager().Events() {
+					if event.Type != types.EventTypeMetadataPacket {
+						continue
+					}
+					reason, _ := event.GetAttribute(types.AttributeKeyAckError)
+					reasons = append(reasons, reason.Value)
+				}
+				require.Len(t, reasons, 1)
+				require.Contains(t, reasons[0], tt.reason)
+			}
+
+			meta, err := f.keeper.StoredMeta.Get(f.ctx, data.Url)
+			if !tt.stored {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, types.StoredMeta{Index: data.Url, Url: data.Url, Creator: data.Creator, Chunks: chunks, ChannelId: "channel-0"}, meta)
+		})
+	}
+}
+
+func TestMetadataUrlTakeover(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	owner, err := f.addressCodec.BytesToString([]byte("owner_______________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("other_______________________"))
+	require.NoError(t, err)
+
+	hash := sha256.Sum256([]byte("hello"))
+	chunk := types.VerifiedChunk{Index: "idx0", Size_: 5, Hash: hash[:], Height: 7}
+	owned := types.StoredMeta{Index: "HelloWorld.com", Url: "HelloWorld.com", Creator: owner, Chunks: []types.VerifiedChunk{chunk}, ChannelId: "channel-0"}
+	require.NoError(t, f.keeper.SetStoredMeta(f.ctx, owned))
+
+	_, err = srv.SendMetadata(f.ctx, &types.MsgSendMetadata{Creator: other, Url: owned.Url, Addresses: []string{"idx0"}, Port: "port", ChannelID: "channel-0", TimeoutTimestamp: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// a packet sent before the url was taken is not stored either
+	bz, err := (&types.MetadataPacketAck{Version: types.AckVersion, Chunks: []types.VerifiedChunk{chunk}}).Marshal()
+	require.NoError(t, err)
+	data := types.MetadataPacketData{Url: owned.Url, Addresses: []string{"idx0"}, Creator: other}
+	require.NoError(t, f.keeper.OnAcknowledgementMetadataPacket(f.ctx, channeltypes.Packet{SourceChannel: "channel-0"}, data, channeltypes.NewResultAcknowledgement(bz)))
+
+	meta, err := f.keeper.StoredMeta.Get(f.ctx, owned.Url)
+	require.NoError(t, err)
+	require.Equal(t, owned, meta)
+	var reasons []string
+	for _, event := range sdk.UnwrapSDKContext(f.ctx).EventManager().Events() {
+		if event.Type == types.EventTypeMetadataPacket {
+			reason, _ := event.GetAttribute(types.AttributeKeyAckError)
+			reasons = append(reasons, reason.Value)
+		}
+	}
+	require.Len(t, reasons, 1)
+	require.Contains(t, reasons[0], "owned by "+owner)
+}
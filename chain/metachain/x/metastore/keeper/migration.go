@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"metachain/x/metastore/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// OnAcknowledgementMigrationPacket points the manifest to the copy of a fragment once the
+// datachain it was written to acknowledges it. Packets that carry no migration are ignored.
+func (k Keeper) OnAcknowledgementMigrationPacket(ctx context.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	migration, found, err := k.takeMigrationPacket(ctx, packet)
+	if err != nil || !found {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPacketAcknowledged{
+		PacketType:    types.EventTypeFragmentMigration,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+		Success:       ack.Success(),
+		Error:         ack.GetError(),
+	}); err != nil {
+		return err
+	}
+
+	if !ack.Success() {
+		return emitMigrationFailed(ctx, migration, ack.GetError())
+	}
+	return k.completeMigration(ctx, migration)
+}
+
+// OnTimeoutMigrationPacket leaves the fragment on its draining datachain.
+func (k Keeper) OnTimeoutMigrationPacket(ctx context.Context, packet channeltypes.Packet) error {
+	migration, found, err := k.takeMigrationPacket(ctx, packet)
+	if err != nil || !found {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPacketTimedOut{
+		PacketType:    types.EventTypeFragmentMigration,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+	}); err != nil {
+		return err
+	}
+
+	return emitMigrationFailed(ctx, migration, "packet timed out")
+}
+
+// completeMigration records that the fragment lives on the datachain it was copied to, unless
+// the manifest changed since the copy was sent.
+func (k Keeper) completeMigration(ctx context.Context, migration types.FragmentMigration) error {
+	reason, err := k.applyFragmentMoves(ctx, migration.Url, []types.FragmentMigration{migration})
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return emitMigrationFailed(ctx, migration, reason)
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFragmentMigrated{
+		Url:              migration.Url,
+		Index:            migration.Index,
+		FromConnectionId: migration.FromConnectionId,
+		ToConnectionId:   migration.ToConnectionId,
+	})
+}
+
+// applyFragmentMoves points the manifest of url to the copies of its fragments in a single
+// update. If the manifest is gone or no longer lists one of the fragments on the datachain it was
+// copied from, nothing changes and the reason is returned.
+func (k Keeper) applyFragmentMoves(ctx context.Context, url string, moves []types.FragmentMigration) (string, error) {
+	meta, err := k.StoredMeta.Get(ctx, url)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "manifest removed", nil
+	} else if err != nil {
+		return "", err
+	}
+	for _, move := range moves {
+		fragment, found := meta.DatachainFragment(move.Index)
+		if !found || fragment.ConnectionId != move.FromConnectionId {
+			return "fragment moved", nil
+		}
+	}
+
+	var released []string
+	for _, move := range moves {
+		if verified, _ := meta.MoveFragment(move.Index, move.ToConnectionId); verified {
+			released = append(released, move.Index)
+		}
+
+		// count the fragments leaving draining datachains before storing the manifest, which may
+		// retire them
+		from, err := k.GetDatachain(ctx, move.FromConnectionId)
+		if err != nil {
+			return "", err
+		}
+		if from.Status == types.DatachainStatus_DATACHAIN_STATUS_DRAINING {
+			from.MigratedFragments++
+			if err := k.Datachains.Set(ctx, from.ConnectionId, from); err != nil {
+				return "", err
+			}
+		}
+	}
+	if err := k.SetStoredMeta(ctx, meta); err != nil {
+		return "", err
+	}
+
+	// Verified chunks no longer need the reference the datachain of the entry's channel keeps for
+	// it. A draining datachain may be unreachable by now, in which case it keeps the reference
+	// until it is shut down.
+	if len(released) > 0 {
+		cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+		if err := k.releaseChunks(cacheCtx, meta, released); err != nil {
+			cacheCtx.Logger().Error("failed to release moved chunks", "url", url, "indexes", released, "error", err)
+		} else {
+			write()
+		}
+	}
+
+	return "", nil
+}
+
+// takeMigrationPacket resolves and forgets the fragment migration of an interchain account
+// packet.
+func (k Keeper) takeMigrationPacket(ctx context.Context, packet channeltypes.Packet) (types.FragmentMigration, bool, error) {
+	key := collections.Join3(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	migration, err := k.MigrationPacket.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.FragmentMigration{}, false, nil
+		}
+		return types.FragmentMigration{}, false, err
+	}
+
+	if err := k.MigrationPacket.Remove(ctx, key); err != nil {
+		return types.FragmentMigration{}, false, err
+	}
+
+	return migration, true, nil
+}
+
+func emitMigrationFailed(ctx context.Context, migration types.FragmentMigration, reason string) error {
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFragmentMigrationFailed{
+		Url:              migration.Url,
+		Index:            migration.Index,
+		FromConnectionId: migration.FromConnectionId,
+		ToConnectionId:   migration.ToConnectionId,
+		Reason:           reason,
+	})
+}
+
+// OnAcknowledgementMovePacket settles the copies one datachain made for a MsgMoveFragments. The
+// manifest points to all the copies once the last outstanding datachain acknowledges; an error
+// ack fails the whole move. Acks of a move that already failed are ignored, even when a later
+// move of the same manifest is pending.
+func (k Keeper) OnAcknowledgementMovePacket(ctx context.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	ref, found, err := k.takeMovePacket(ctx, packet)
+	if err != nil || !found {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPacketAcknowledged{
+		PacketType:    types.EventTypeFragmentMove,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+		Success:       ack.Success(),
+		Error:         ack.GetError(),
+	}); err != nil {
+		return err
+	}
+
+	if !ack.Success() {
+		return k.failMove(ctx, ref, ack.GetError())
+	}
+
+	pending, found, err := k.pendingMove(ctx, ref)
+	if err != nil || !found {
+		return err
+	}
+	if pending.Outstanding > 1 {
+		pending.Outstanding--
+		return k.PendingMove.Set(ctx, pending.Url, pending)
+	}
+
+	if err := k.PendingMove.Remove(ctx, pending.Url); err != nil {
+		return err
+	}
+	reason, err := k.applyFragmentMoves(ctx, pending.Url, pending.Moves)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return emitMoveFailed(ctx, pending.Url, reason)
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFragmentsMoved{
+		Url:   pending.Url,
+		Moves: pending.Moves,
+	})
+}
+
+// OnTimeoutMovePacket fails the move the timed out packet belongs to.
+func (k Keeper) OnTimeoutMovePacket(ctx context.Context, packet channeltypes.Packet) error {
+	ref, found, err := k.takeMovePacket(ctx, packet)
+	if err != nil || !found {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPacketTimedOut{
+		PacketType:    types.EventTypeFragmentMove,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+	}); err != nil {
+		return err
+	}
+
+	return k.failMove(ctx, ref, "packet timed out")
+}
+
+// takeMovePacket resolves and forgets the pending move of an interchain account packet.
+func (k Keeper) takeMovePacket(ctx context.Context, packet channeltypes.Packet) (types.MovePacketRef, bool, error) {
+	key := collections.Join3(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	ref, err := k.MovePacket.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.MovePacketRef{}, false, nil
+		}
+		return types.MovePacketRef{}, false, err
+	}
+
+	if err := k.MovePacket.Remove(ctx, key); err != nil {
+		return types.MovePacketRef{}, false, err
+	}
+
+	return ref, true, nil
+}
+
+// pendingMove returns the pending move ref belongs to. It is not found once the move failed
+// through another datachain, even if another move of the manifest was submitted since.
+func (k Keeper) pendingMove(ctx context.Context, ref types.MovePacketRef) (types.PendingMove, bool, error) {
+	pending, err := k.PendingMove.Get(ctx, ref.Url)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PendingMove{}, false, nil
+	} else if err != nil {
+		return types.PendingMove{}, false, err
+	}
+	if pending.Id != ref.MoveId {
+		return types.PendingMove{}, false, nil
+	}
+	return pending, true, nil
+}
+
+// failMove drops the pending move, leaving the manifest as it was. Copies other datachains
+// already wrote are left in place.
+func (k Keeper) failMove(ctx context.Context, ref types.MovePacketRef, reason string) error {
+	// a move another datachain already failed is not failed again
+	_, found, err := k.pendingMove(ctx, ref)
+	if err != nil || !found {
+		return err
+	}
+	if err := k.PendingMove.Remove(ctx, ref.Url); err != nil {
+		return err
+	}
+	return emitMoveFailed(ctx, ref.Url, reason)
+}
+
+func emitMoveFailed(ctx context.Context, url string, reason string) error {
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFragmentMoveFailed{
+		Url:    url,
+		Reason: reason,
+	})
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"metachain/x/metastore/types"
+)
+
+// Migrator migrates the metastore state between consensus versions.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator of the state of keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the state of the first release: its empty params are given their
+// defaults, and the metadata is indexed by the chunks it lists.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.Params.Set(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+	return m.reindexFragments(ctx)
+}
+
+// reindexFragments rebuilds StoredMetaByFragment and StoredMetaByDatachain from the stored
+// metadata.
+func (m Migrator) reindexFragments(ctx context.Context) error {
+	if err := m.keeper.StoredMetaByFragment.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := m.keeper.StoredMetaByDatachain.Clear(ctx, nil); err != nil {
+		return err
+	}
+	return m.keeper.StoredMeta.Walk(ctx, nil, func(_ string, meta types.StoredMeta) (bool, error) {
+		return false, m.keeper.indexFragments(ctx, meta)
+	})
+}
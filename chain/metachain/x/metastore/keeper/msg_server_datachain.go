@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+
+	"metachain/x/metastore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+)
+
+func (k msgServer) DrainDatachain(ctx context.Context, msg *types.MsgDrainDatachain) (*types.MsgDrainDatachainResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+	if msg.ConnectionId == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid connection id")
+	}
+
+	datachain, err := k.GetDatachain(ctx, msg.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	if !datachain.Active() {
+		return nil, errorsmod.Wrapf(types.ErrDatachainNotActive, "datachain %s is already %s", msg.ConnectionId, datachain.Status)
+	}
+	fragments, err := k.CountDatachainFragments(ctx, msg.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	datachain = types.Datachain{
+		ConnectionId:   msg.ConnectionId,
+		Status:         types.DatachainStatus_DATACHAIN_STATUS_DRAINING,
+		DrainFragments: fragments,
+	}
+	if err := k.Datachains.Set(ctx, datachain.ConnectionId, datachain); err != nil {
+		return nil, err
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDatachainDrainStarted{
+		ConnectionId: datachain.ConnectionId,
+		Fragments:    fragments,
+	}); err != nil {
+		return nil, err
+	}
+
+	// a datachain nothing references is retired right away
+	if err := k.retireIfDrained(ctx, datachain); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDrainDatachainResponse{}, nil
+}
+
+func (k msgServer) MigrateFragment(ctx context.Context, msg *types.MsgMigrateFragment) (*types.MsgMigrateFragmentResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if msg.Url == "" || msg.Index == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fragment needs a url and an index")
+	}
+	if msg.ConnectionId == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid connection id")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+
+	meta, err := k.StoredMeta.Get(ctx, msg.Url)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "url %s", msg.Url)
+	} else if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.requireManifestOwner(meta, msg.Creator); err != nil {
+		return nil, err
+	}
+	fragment, found := meta.DatachainFragment(msg.Index)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFragment, "%s lists no chunk %s on a known datachain", msg.Url, msg.Index)
+	}
+	from, err := k.GetDatachain(ctx, fragment.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	if from.Status != types.DatachainStatus_DATACHAIN_STATUS_DRAINING {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFragment, "chunk %s is on %s, which is %s", msg.Index, from.ConnectionId, from.Status)
+	}
+	if err := k.requireActive(ctx, msg.ConnectionId); err != nil {
+		return nil, err
+	}
+	if err := requireStripeSpread(meta, msg.Index, msg.ConnectionId); err != nil {
+		return nil, err
+	}
+	// the copy must be the very chunk the manifest recorded
+	if hash := sha256.Sum256(msg.Data); !bytes.Equal(hash[:], fragment.Hash) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFragment, "data does not match the hash recorded for chunk %s", msg.Index)
+	}
+
+	chunk := types.ChunkUpload{ConnectionId: msg.ConnectionId, Index: msg.Index, Data: msg.Data}
+	channelID, sequence, err := k.TransmitChunkWrites(ctx, msg.Creator, msg.ConnectionId, []types.ChunkUpload{chunk}, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	migration := types.FragmentMigration{
+		Url:              msg.Url,
+		Index:            msg.Index,
+		FromConnectionId: fragment.ConnectionId,
+		ToConnectionId:   msg.ConnectionId,
+	}
+	if err := k.MigrationPacket.Set(ctx, collections.Join3(portID, channelID, sequence), migration); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgMigrateFragmentResponse{}, nil
+}
+
+func (k msgServer) MoveFragments(ctx context.Context, msg *types.MsgMoveFragments) (*types.MsgMoveFragmentsResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if msg.Url == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid url")
+	}
+	if len(msg.Moves) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no fragments to move")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+
+	meta, err := k.StoredMeta.Get(ctx, msg.Url)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "url %s", msg.Url)
+	} else if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.requireManifestOwner(meta, msg.Creator); err != nil {
+		return nil, err
+	}
+	if ok, err := k.PendingMove.Has(ctx, msg.Url); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if ok {
+		return nil, errorsmod.Wrapf(types.ErrMoveInProgress, "url %s", msg.Url)
+	}
+
+	// Group the copies by datachain, keeping the order connections first appear in. moved is
+	// the manifest as it will be once every copy is acknowledged.
+	moved := meta
+	moved.UploadedChunks = slices.Clone(meta.UploadedChunks)
+	var connections []string
+	byConnection := make(map[string][]types.ChunkUpload)
+	moves := make([]types.FragmentMigration, 0, len(msg.Moves))
+	seen := make(map[string]bool, len(msg.Moves))
+	for _, move := range msg.Moves {
+		if seen[move.Index] {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFragment, "chunk %s is moved twice", move.Index)
+		}
+		seen[move.Index] = true
+
+		fragment, found := meta.DatachainFragment(move.Index)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFragment, "%s lists no chunk %s on a known datachain", msg.Url, move.Index)
+		}
+		if move.ConnectionId == fragment.ConnectionId {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFragment, "chunk %s is already on %s", move.Index, move.ConnectionId)
+		}
+		if hash := sha256.Sum256(move.Data); !bytes.Equal(hash[:], fragment.Hash) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFragment, "data does not match the hash recorded for chunk %s", move.Index)
+		}
+		if _, ok := byConnection[move.ConnectionId]; !ok {
+			if err := k.requireActive(ctx, move.ConnectionId); err != nil {
+				return nil, err
+			}
+			connections = append(connections, move.ConnectionId)
+		}
+
+		byConnection[move.ConnectionId] = append(byConnection[move.ConnectionId], types.ChunkUpload{
+			ConnectionId: move.ConnectionId,
+			Index:        move.Index,
+			Data:         move.Data,
+		})
+		moves = append(moves, types.FragmentMigration{
+			Url:              msg.Url,
+			Index:            move.Index,
+			FromConnectionId: fragment.ConnectionId,
+			ToConnectionId:   move.ConnectionId,
+		})
+		moved.MoveFragment(move.Index, move.ConnectionId)
+	}
+	for _, move := range msg.Moves {
+		if err := requireStripeSpread(moved, move.Index, move.ConnectionId); err != nil {
+			return nil, err
+		}
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	moveID, err := k.MoveSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	ref := types.MovePacketRef{Url: msg.Url, MoveId: moveID}
+	for _, connectionID := range connections {
+		channelID, sequence, err := k.TransmitChunkWrites(ctx, msg.Creator, connectionID, byConnection[connectionID], msg.TimeoutTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.MovePacket.Set(ctx, collections.Join3(portID, channelID, sequence), ref); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+
+	pending := types.PendingMove{
+		Url:         msg.Url,
+		Creator:     msg.Creator,
+		Moves:       moves,
+		Outstanding: uint32(len(connections)),
+		Id:          moveID,
+	}
+	if err := k.PendingMove.Set(ctx, pending.Url, pending); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgMoveFragmentsResponse{}, nil
+}
+
+// requireManifestOwner fails unless signer created meta or is the governance authority, so
+// nobody can migrate or move the fragments of another account.
+func (k Keeper) requireManifestOwner(meta types.StoredMeta, signer string) error {
+	if signer == meta.Creator {
+		return nil
+	}
+	if bz, err := k.addressCodec.StringToBytes(signer); err == nil && bytes.Equal(bz, k.GetAuthority()) {
+		return nil
+	}
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s does not own %s", signer, meta.Index)
+}
+
+// requireStripeSpread fails if the chunk index of meta would be on the datachain behind
+// connectionID along with another chunk of its stripe, which would then be lost together.
+func requireStripeSpread(meta types.StoredMeta, index, connectionID string) error {
+	if meta.StripeConnections(index)[connectionID] {
+		return errorsmod.Wrapf(types.ErrInvalidFragment, "chunk %s would share %s with another chunk of its stripe", index, connectionID)
+	}
+	return nil
+}
@@ -0,0 +1,346 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"metachain/x/metastore/keeper"
+	"metachain/x/metastore/types"
+)
+
+// drainFixture stores an uploaded entry with a chunk on connection-0 and one on connection-1, and
+// an entry verified over a channel to connection-0.
+func drainFixture(t *testing.T) (*fixture, string) {
+	t.Helper()
+	f := initFixture(t)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SetStoredMeta(f.ctx, types.StoredMeta{
+		Index:   "a.com",
+		Url:     "a.com",
+		Creator: creator,
+		Indexes: []string{"a-0", "a-1"},
+		UploadedChunks: []types.UploadedChunk{
+			uploadedChunk("connection-0", "a-0", "hello"),
+			uploadedChunk("connection-1", "a-1", "world"),
+		},
+	}))
+	logoHash := sha256.Sum256([]byte("logo"))
+	require.NoError(t, f.keeper.SetStoredMeta(f.ctx, types.StoredMeta{
+		Index:        "b.com",
+		Url:          "b.com",
+		Creator:      creator,
+		Chunks:       []types.VerifiedChunk{{Index: "b-0", Size_: 4, Hash: logoHash[:], Height: 3}},
+		ChannelId:    "channel-0",
+		ConnectionId: "connection-0",
+	}))
+
+	return f, creator
+}
+
+func uploadedChunk(connectionID, index, data string) types.UploadedChunk {
+	hash := sha256.Sum256([]byte(data))
+	return types.UploadedChunk{ConnectionId: connectionID, Index: index, Size_: uint64(len(data)), Hash: hash[:]}
+}
+
+func TestDrainDatachain(t *testing.T) {
+	f, creator := drainFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	_, err = srv.DrainDatachain(f.ctx, &types.MsgDrainDatachain{Authority: creator, ConnectionId: "connection-0"})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = srv.DrainDatachain(f.ctx, &types.MsgDrainDatachain{Authority: authority, ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	res, err := qs.GetDatachain(f.ctx, &types.QueryGetDatachainRequest{ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	require.Equal(t, types.Datachain{ConnectionId: "connection-0", Status: types.DatachainStatus_DATACHAIN_STATUS_DRAINING, DrainFragments: 2}, res.Datachain)
+	require.Equal(t, uint64(2), res.Fragments)
+
+	fragments, err := qs.ListDatachainFragments(f.ctx, &types.QueryDatachainFragmentsRequest{ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	require.Equal(t, []types.DatachainFragment{
+		{ConnectionId: "connection-0", Index: "a-0", Url: "a.com"},
+		{ConnectionId: "connection-0", Index: "b-0", Url: "b.com"},
+	}, fragments.Fragments)
+
+	_, err = srv.DrainDatachain(f.ctx, &types.MsgDrainDatachain{Authority: authority, ConnectionId: "connection-0"})
+	require.ErrorIs(t, err, types.ErrDatachainNotActive)
+
+	// the draining datachain is read-only
+	_, err = srv.RegisterDatachainAccount(f.ctx, &types.MsgRegisterDatachainAccount{Creator: creator, ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	_, err = srv.UploadChunks(f.ctx, &types.MsgUploadChunks{
+		Creator:          creator,
+		Url:              "c.com",
+		Chunks:           []types.ChunkUpload{{ConnectionId: "connection-0", Index: "c-0", Data: []byte("c")}},
+		TimeoutTimestamp: 100,
+	})
+	require.ErrorIs(t, err, types.ErrDatachainNotActive)
+
+	// removing the last entry referencing it retires it
+	require.NoError(t, f.keeper.RemoveStoredMeta(f.ctx, "b.com"))
+	require.NoError(t, f.keeper.RemoveStoredMeta(f.ctx, "a.com"))
+	res, err = qs.GetDatachain(f.ctx, &types.QueryGetDatachainRequest{ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	require.Equal(t, types.DatachainStatus_DATACHAIN_STATUS_RETIRED, res.Datachain.Status)
+	require.Zero(t, res.Fragments)
+
+	// a datachain nothing references retires right away
+	_, err = srv.DrainDatachain(f.ctx, &types.MsgDrainDatachain{Authority: authority, ConnectionId: "connection-9"})
+	require.NoError(t, err)
+	all, err := qs.ListDatachains(f.ctx, &types.QueryAllDatachainRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Datachain{
+		{ConnectionId: "connection-0", Status: types.DatachainStatus_DATACHAIN_STATUS_RETIRED, DrainFragments: 2},
+		{ConnectionId: "connection-9", Status: types.DatachainStatus_DATACHAIN_STATUS_RETIRED},
+	}, all.Datachains)
+
+	require.Equal(t, []proto.Message{
+		&types.EventDatachainDrainStarted{ConnectionId: "connection-0", Fragments: 2},
+		&types.EventDatachainRetired{ConnectionId: "connection-0"},
+		&types.EventDatachainDrainStarted{ConnectionId: "connection-9"},
+		&types.EventDatachainRetired{ConnectionId: "connection-9"},
+	}, typedEvents(t, f.ctx))
+}
+
+func TestUpdateStoredMetaKeepsFragments(t *testing.T) {
+	f, creator := drainFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	_, err = srv.DrainDatachain(f.ctx, &types.MsgDrainDatachain{Authority: authority, ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	before, err := f.keeper.StoredMeta.Get(f.ctx, "a.com")
+	require.NoError(t, err)
+
+	_, err = srv.UpdateStoredMeta(f.ctx, &types.MsgUpdateStoredMeta{Creator: creator, Index: "a.com", Url: "www.a.com"})
+	require.NoError(t, err)
+
+	after, err := f.keeper.StoredMeta.Get(f.ctx, "a.com")
+	require.NoError(t, err)
+	before.Url = "www.a.com"
+	require.Equal(t, before, after)
+
+	// the datachain still holds a fragment of the entry, so it is neither retired nor counted out
+	res, err := qs.GetDatachain(f.ctx, &types.QueryGetDatachainRequest{ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	require.Equal(t, types.DatachainStatus_DATACHAIN_STATUS_DRAINING, res.Datachain.Status)
+	require.Equal(t, uint64(2), res.Fragments)
+	fragments, err := qs.ListDatachainFragments(f.ctx, &types.QueryDatachainFragmentsRequest{ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	require.Equal(t, []types.DatachainFragment{
+		{ConnectionId: "connection-0", Index: "a-0", Url: "a.com"},
+		{ConnectionId: "connection-0", Index: "b-0", Url: "b.com"},
+	}, fragments.Fragments)
+}
+
+func TestMigrateFragment(t *testing.T) {
+	f, creator := drainFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = srv.DrainDatachain(f.ctx, &types.MsgDrainDatachain{Authority: authority, ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	_, err = srv.RegisterDatachainAccount(f.ctx, &types.MsgRegisterDatachainAccount{Creator: creator, ConnectionId: "connection-2"})
+	require.NoError(t, err)
+	portID, err := icatypes.NewControllerPortID(creator)
+	require.NoError(t, err)
+
+	migrate := func(url, index, connectionID, data string) error {
+		_, err := srv.MigrateFragment(f.ctx, &types.MsgMigrateFragment{
+			Creator:          creator,
+			Url:              url,
+			Index:            index,
+			ConnectionId:     connectionID,
+			Data:             []byte(data),
+			TimeoutTimestamp: 100,
+		})
+		return err
+	}
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{SourcePort: portID, SourceChannel: "channel-connection-2", Sequence: sequence}
+	}
+
+	require.ErrorIs(t, migrate("x.com", "a-0", "connection-2", "hello"), sdkerrors.ErrKeyNotFound)
+	require.ErrorIs(t, migrate("a.com", "a-9", "connection-2", "hello"), types.ErrInvalidFragment)
+	// only fragments of draining datachains move
+	require.ErrorIs(t, migrate("a.com", "a-1", "connection-2", "world"), types.ErrInvalidFragment)
+	require.ErrorIs(t, migrate("a.com", "a-0", "connection-0", "hello"), types.ErrDatachainNotActive)
+	// the copy must hash to the recorded chunk
+	require.ErrorIs(t, migrate("a.com", "a-0", "connection-2", "jello"), types.ErrInvalidFragment)
+	// a-1 of the same stripe is on connection-1 already
+	require.ErrorIs(t, migrate("a.com", "a-0", "connection-1", "hello"), types.ErrInvalidFragment)
+	// only the owner of the manifest moves its fragments
+	other, err := f.addressCodec.BytesToString([]byte("other_______________________"))
+	require.NoError(t, err)
+	_, err = srv.MigrateFragment(f.ctx, &types.MsgMigrateFragment{Creator: other, Url: "a.com", Index: "a-0", ConnectionId: "connection-2", Data: []byte("hello"), TimeoutTimestamp: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Empty(t, f.icaKeeper.sent)
+
+	// a failed copy leaves the manifest as it was
+	require.NoError(t, migrate("a.com", "a-0", "connection-2", "hello"))
+	require.Len(t, f.icaKeeper.sent, 1)
+	require.NoError(t, f.keeper.OnTimeoutMigrationPacket(f.ctx, packet(1)))
+	meta, err := f.keeper.StoredMeta.Get(f.ctx, "a.com")
+	require.NoError(t, err)
+	require.Equal(t, "connection-0", meta.UploadedChunks[0].ConnectionId)
+
+	require.NoError(t, migrate("a.com", "a-0", "connection-2", "hello"))
+	var cosmosTx icatypes.CosmosTx
+	require.NoError(t, cosmosTx.Unmarshal(f.icaKeeper.sent[1].Data))
+	var write types.ChunkWrite
+	require.NoError(t, write.Unmarshal(cosmosTx.Messages[0].Value))
+	require.Equal(t, types.ChunkWrite{Creator: "ica-connection-2", Index: "a-0", Data: []byte("hello")}, write)
+
+	// the upload packet handler leaves migration packets alone
+	require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(2), channeltypes.NewResultAcknowledgement([]byte{1})))
+	require.NoError(t, f.keeper.OnAcknowledgementMigrationPacket(f.ctx, packet(2), channeltypes.NewResultAcknowledgement([]byte{1})))
+	meta, err = f.keeper.StoredMeta.Get(f.ctx, "a.com")
+	require.NoError(t, err)
+	require.Equal(t, []types.UploadedChunk{
+		uploadedChunk("connection-2", "a-0", "hello"),
+		uploadedChunk("connection-1", "a-1", "world"),
+	}, meta.UploadedChunks)
+
+	// a verified chunk moves to the interchain account copy
+	require.NoError(t, migrate("b.com", "b-0", "connection-2", "logo"))
+	require.NoError(t, f.keeper.OnAcknowledgementMigrationPacket(f.ctx, packet(3), channeltypes.NewResultAcknowledgement([]byte{1})))
+	meta, err = f.keeper.StoredMeta.Get(f.ctx, "b.com")
+	require.NoError(t, err)
+	require.Equal(t, []types.UploadedChunk{uploadedChunk("connection-2", "b-0", "logo")}, meta.UploadedChunks)
+	has, err := f.keeper.StoredMetaByDatachain.Has(f.ctx, collections.Join3("connection-2", "b-0", "b.com"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// with nothing left on it, the datachain is retired
+	datachain, err := f.keeper.GetDatachain(f.ctx, "connection-0")
+	require.NoError(t, err)
+	require.Equal(t, types.Datachain{
+		ConnectionId:      "connection-0",
+		Status:            types.DatachainStatus_DATACHAIN_STATUS_RETIRED,
+		DrainFragments:    2,
+		MigratedFragments: 2,
+	}, datachain)
+
+	events := typedEvents(t, f.ctx)
+	require.Contains(t, events, &types.EventFragmentMigrationFailed{Url: "a.com", Index: "a-0", FromConnectionId: "connection-0", ToConnectionId: "connection-2", Reason: "packet timed out"})
+	require.Contains(t, events, &types.EventFragmentMigrated{Url: "b.com", Index: "b-0", FromConnectionId: "connection-0", ToConnectionId: "connection-2"})
+	require.Equal(t, &types.EventDatachainRetired{ConnectionId: "connection-0"}, events[len(events)-2])
+}
+
+func TestMoveFragments(t *testing.T) {
+	f, creator := drainFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	for _, connectionID := range []string{"connection-0", "connection-1", "connection-2", "connection-3"} {
+		_, err := srv.RegisterDatachainAccount(f.ctx, &types.MsgRegisterDatachainAccount{Creator: creator, ConnectionId: connectionID})
+		require.NoError(t, err)
+	}
+	portID, err := icatypes.NewControllerPortID(creator)
+	require.NoError(t, err)
+
+	move := func(moves ...types.FragmentMove) error {
+		_, err := srv.MoveFragments(f.ctx, &types.MsgMoveFragments{Creator: creator, Url: "a.com", Moves: moves, TimeoutTimestamp: 100})
+		return err
+	}
+	ack := func(connectionID string, sequence uint64, ack channeltypes.Acknowledgement) {
+		t.Helper()
+		packet := channeltypes.Packet{SourcePort: portID, SourceChannel: "channel-" + connectionID, Sequence: sequence}
+		require.NoError(t, f.keeper.OnAcknowledgementMovePacket(f.ctx, packet, ack))
+	}
+	uploadedChunks := func() []types.UploadedChunk {
+		t.Helper()
+		meta, err := f.keeper.StoredMeta.Get(f.ctx, "a.com")
+		require.NoError(t, err)
+		return meta.UploadedChunks
+	}
+	success := channeltypes.NewResultAcknowledgement([]byte{1})
+
+	require.ErrorIs(t, move(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, move(types.FragmentMove{Index: "a-0", ConnectionId: "connection-2", Data: []byte("jello")}), types.ErrInvalidFragment)
+	require.ErrorIs(t, move(types.FragmentMove{Index: "a-0", ConnectionId: "connection-0", Data: []byte("hello")}), types.ErrInvalidFragment)
+	require.ErrorIs(t, move(
+		types.FragmentMove{Index: "a-0", ConnectionId: "connection-2", Data: []byte("hello")},
+		types.FragmentMove{Index: "a-0", ConnectionId: "connection-3", Data: []byte("hello")},
+	), types.ErrInvalidFragment)
+	// the chunks of a stripe stay on distinct datachains, also when several of them move
+	require.ErrorIs(t, move(types.FragmentMove{Index: "a-0", ConnectionId: "connection-1", Data: []byte("hello")}), types.ErrInvalidFragment)
+	require.ErrorIs(t, move(
+		types.FragmentMove{Index: "a-0", ConnectionId: "connection-2", Data: []byte("hello")},
+		types.FragmentMove{Index: "a-1", ConnectionId: "connection-2", Data: []byte("world")},
+	), types.ErrInvalidFragment)
+	// and only the owner of the manifest moves them
+	other, err := f.addressCodec.BytesToString([]byte("other_______________________"))
+	require.NoError(t, err)
+	_, err = srv.MoveFragments(f.ctx, &types.MsgMoveFragments{Creator: other, Url: "a.com", Moves: []types.FragmentMove{{Index: "a-0", ConnectionId: "connection-2", Data: []byte("hello")}}, TimeoutTimestamp: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Empty(t, f.icaKeeper.sent)
+
+	// the manifest changes once both datachains acknowledge their copy
+	require.NoError(t, move(
+		types.FragmentMove{Index: "a-0", ConnectionId: "connection-2", Data: []byte("hello")},
+		types.FragmentMove{Index: "a-1", ConnectionId: "connection-3", Data: []byte("world")},
+	))
+	require.ErrorIs(t, move(types.FragmentMove{Index: "a-0", ConnectionId: "connection-3", Data: []byte("hello")}), types.ErrMoveInProgress)
+	require.Len(t, f.icaKeeper.sent, 2)
+	ack("connection-2", 1, success)
+	require.Equal(t, "connection-0", uploadedChunks()[0].ConnectionId)
+	ack("connection-3", 2, success)
+	moved := []types.UploadedChunk{
+		uploadedChunk("connection-2", "a-0", "hello"),
+		uploadedChunk("connection-3", "a-1", "world"),
+	}
+	require.Equal(t, moved, uploadedChunks())
+
+	// one failed copy leaves the manifest as it was
+	require.NoError(t, move(
+		types.FragmentMove{Index: "a-0", ConnectionId: "connection-0", Data: []byte("hello")},
+		types.FragmentMove{Index: "a-1", ConnectionId: "connection-1", Data: []byte("world")},
+	))
+	ack("connection-0", 3, channeltypes.NewErrorAcknowledgement(types.ErrPacketFailed))
+
+	// and the late ack of its other copy does not count for the next move of the manifest
+	require.NoError(t, move(types.FragmentMove{Index: "a-0", ConnectionId: "connection-1", Data: []byte("hello")}))
+	ack("connection-1", 4, success)
+	require.Equal(t, moved, uploadedChunks())
+	pending, err := f.keeper.PendingMove.Get(f.ctx, "a.com")
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), pending.Outstanding)
+
+	// so does a manifest that changed meanwhile
+	meta, err := f.keeper.StoredMeta.Get(f.ctx, "a.com")
+	require.NoError(t, err)
+	meta.UploadedChunks[0].ConnectionId = "connection-3"
+	require.NoError(t, f.keeper.SetStoredMeta(f.ctx, meta))
+	ack("connection-1", 5, success)
+	require.Equal(t, "connection-3", uploadedChunks()[0].ConnectionId)
+
+	var results []proto.Message
+	for _, event := range typedEvents(t, f.ctx) {
+		switch event.(type) {
+		case *types.EventFragmentsMoved, *types.EventFragmentMoveFailed:
+			results = append(results, event)
+		}
+	}
+	require.Equal(t, []proto.Message{
+		&types.EventFragmentsMoved{Url: "a.com", Moves: []types.FragmentMigration{
+			{Url: "a.com", Index: "a-0", FromConnectionId: "connection-0", ToConnectionId: "connection-2"},
+			{Url: "a.com", Index: "a-1", FromConnectionId: "connection-1", ToConnectionId: "connection-3"},
+		}},
+		&types.EventFragmentMoveFailed{Url: "a.com", Reason: "ABCI code: 1504: error handling packet: see events for details"},
+		&types.EventFragmentMoveFailed{Url: "a.com", Reason: "fragment moved"},
+	}, results)
+}
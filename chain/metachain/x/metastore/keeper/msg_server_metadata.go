@@ -29,6 +29,17 @@ func (k msgServer) SendMetadata(ctx context.Context, msg *types.MsgSendMetadata)
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
 	}
 
+	if err := k.RequireUrlOwner(ctx, msg.Url, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// chunks on draining and retired datachains take no new references
+	if connectionID, found := k.channelConnection(ctx, msg.Port, msg.ChannelID); found {
+		if err := k.requireActive(ctx, connectionID); err != nil {
+			return nil, err
+		}
+	}
+
 	// ★★★ ここからが修正箇所です ★★★
 
 	// Construct the packet with the Creator field
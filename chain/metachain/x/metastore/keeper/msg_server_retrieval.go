@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"metachain/x/metastore/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+)
+
+func (k msgServer) RetrieveChunks(ctx context.Context, msg *types.MsgRetrieveChunks) (*types.MsgRetrieveChunksResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if msg.Port == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	if len(msg.Indexes) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "indexes cannot be empty")
+	}
+	for _, index := range msg.Indexes {
+		if index == "" {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid chunk index")
+		}
+	}
+
+	if msg.CacheBytes > 0 {
+		if err := k.chargeChunkCache(ctx, msg.CacheBytes, len(msg.Indexes)); err != nil {
+			return nil, err
+		}
+	}
+
+	packet := types.ChunkRetrievalPacketData{
+		Indexes:    msg.Indexes,
+		Requester:  msg.Creator,
+		CacheBytes: msg.CacheBytes,
+	}
+
+	sequence, err := k.TransmitChunkRetrievalPacket(
+		ctx,
+		packet,
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetrieveChunksResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"metachain/x/metastore/keeper"
+	"metachain/x/metastore/types"
+)
+
+func TestMsgServerRetrieveChunks(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		msg  types.MsgRetrieveChunks
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.MsgRetrieveChunks{Creator: "invalid address", Port: "port", ChannelID: "channel-0", TimeoutTimestamp: 100, Indexes: []string{"idx0"}},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid port",
+			msg:  types.MsgRetrieveChunks{Creator: creator, ChannelID: "channel-0", TimeoutTimestamp: 100, Indexes: []string{"idx0"}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid channel",
+			msg:  types.MsgRetrieveChunks{Creator: creator, Port: "port", TimeoutTimestamp: 100, Indexes: []string{"idx0"}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid timeout",
+			msg:  types.MsgRetrieveChunks{Creator: creator, Port: "port", ChannelID: "channel-0", Indexes: []string{"idx0"}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no indexes",
+			msg:  types.MsgRetrieveChunks{Creator: creator, Port: "port", ChannelID: "channel-0", TimeoutTimestamp: 100},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty index",
+			msg:  types.MsgRetrieveChunks{Creator: creator, Port: "port", ChannelID: "channel-0", TimeoutTimestamp: 100, Indexes: []string{"idx0", ""}},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err = srv.RetrieveChunks(f.ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	t.Run("cache gas", func(t *testing.T) {
+		// the creator pays for the cache writes the ack makes, before the packet is sent
+		gasFor := func(cacheBytes uint64) uint64 {
+			t.Helper()
+			ctx := sdk.UnwrapSDKContext(f.ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+			msg := types.MsgRetrieveChunks{Creator: creator, Port: "port", ChannelID: "channel-0", TimeoutTimestamp: 100, Indexes: []string{"idx0", "idx1"}, CacheBytes: cacheBytes}
+			_, err := srv.RetrieveChunks(ctx, &msg)
+			require.ErrorIs(t, err, channeltypes.ErrChannelNotFound)
+			return ctx.GasMeter().GasConsumed()
+		}
+		require.Equal(t, 100*(types.DefaultChunkGasPerByte+storetypes.KVGasConfig().WriteCostPerByte), gasFor(200)-gasFor(100))
+	})
+
+	t.Run("cache disabled", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.ChunkCacheTtl = 0
+		require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+		msg := types.MsgRetrieveChunks{Creator: creator, Port: "port", ChannelID: "channel-0", TimeoutTimestamp: 100, Indexes: []string{"idx0"}, CacheBytes: 5}
+		_, err := srv.RetrieveChunks(f.ctx, &msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+}
+
+func TestOnAcknowledgementChunkRetrievalPacket(t *testing.T) {
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: "channel-0", Sequence: 1}
+	chunks := []types.RetrievedChunk{
+		{Index: "idx0", Data: []byte("hello"), Height: 7},
+		{Index: "idx1", Data: []byte("world"), Height: 7},
+	}
+	resultAck := func(t *testing.T, chunks []types.RetrievedChunk) channeltypes.Acknowledgement {
+		t.Helper()
+		packetAck := types.ChunkRetrievalPacketAck{Version: types.AckVersion, Chunks: chunks}
+		bz, err := packetAck.Marshal()
+		require.NoError(t, err)
+		return channeltypes.NewResultAcknowledgement(bz)
+	}
+	retrievalEvents := func(ctx sdk.Context) (events []sdk.Event) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeChunkRetrieval {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	t.Run("cache", func(t *testing.T) {
+		f := initFixture(t)
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+		data := types.ChunkRetrievalPacketData{Indexes: []string{"idx0", "idx1"}, CacheBytes: 10}
+
+		require.NoError(t, f.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, packet, data, resultAck(t, chunks)))
+
+		cached, err := f.keeper.CachedChunk.Get(ctx, collections.Join("channel-0", "idx1"))
+		require.NoError(t, err)
+		require.Equal(t, types.CachedChunk{Index: "idx1", Data: []byte("world"), Height: 7, ChannelId: "channel-0", Expires: 10 + int64(types.DefaultChunkCacheTTL)}, cached)
+		require.Len(t, retrievalEvents(ctx), 2)
+	})
+
+	t.Run("cache bytes", func(t *testing.T) {
+		f := initFixture(t)
+		ctx := sdk.UnwrapSDKContext(f.ctx)
+		data := types.ChunkRetrievalPacketData{Indexes: []string{"idx0", "idx1"}, CacheBytes: 9}
+
+		require.NoError(t, f.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, packet, data, resultAck(t, chunks)))
+
+		// only the chunks within the bytes the requester paid for are cached
+		has, err := f.keeper.CachedChunk.Has(ctx, collections.Join("channel-0", "idx0"))
+		require.NoError(t, err)
+		require.True(t, has)
+		has, err = f.keeper.CachedChunk.Has(ctx, collections.Join("channel-0", "idx1"))
+		require.NoError(t, err)
+		require.False(t, has)
+
+		var cached []bool
+		for _, event := range typedEvents(t, ctx) {
+			if retrieved, ok := event.(*types.EventChunkRetrieved); ok {
+				require.Equal(t, "channel-0", retrieved.ChannelId)
+				cached = append(cached, retrieved.Cached)
+			}
+		}
+		require.Equal(t, []bool{true, false}, cached)
+	})
+
+	t.Run("cache by channel", func(t *testing.T) {
+		f := initFixture(t)
+		ctx := sdk.UnwrapSDKContext(f.ctx)
+		data := types.ChunkRetrievalPacketData{Indexes: []string{"idx0", "idx1"}, CacheBytes: 10}
+		other := packet
+		other.SourceChannel = "channel-1"
+		otherChunks := []types.RetrievedChunk{
+			{Index: "idx0", Data: []byte("other"), Height: 9},
+			{Index: "idx1", Data: []byte("chain"), Height: 9},
+		}
+
+		require.NoError(t, f.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, packet, data, resultAck(t, chunks)))
+		require.NoError(t, f.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, other, data, resultAck(t, otherChunks)))
+
+		// the same index on another datachain is a different chunk
+		cached, err := f.keeper.CachedChunk.Get(ctx, collections.Join("channel-0", "idx0"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("hello"), cached.Data)
+		cached, err = f.keeper.CachedChunk.Get(ctx, collections.Join("channel-1", "idx0"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("other"), cached.Data)
+	})
+
+	t.Run("cache expiry", func(t *testing.T) {
+		f := initFixture(t)
+		params := types.DefaultParams()
+		params.ChunkCacheTtl = 5
+		require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+		data := types.ChunkRetrievalPacketData{Indexes: []string{"idx0", "idx1"}, CacheBytes: 10}
+		require.NoError(t, f.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, packet, data, resultAck(t, chunks)))
+
+		// retrieving idx1 again keeps it for another ttl
+		data = types.ChunkRetrievalPacketData{Indexes: []string{"idx1"}, CacheBytes: 10}
+		require.NoError(t, f.keeper.OnAcknowledgementChunkRetrievalPacket(ctx.WithBlockHeight(12), packet, data, resultAck(t, chunks[1:])))
+
+		cachedIndexes := func(height int64) (indexes []string) {
+			t.Helper()
+			ctx := ctx.WithBlockHeight(height)
+			require.NoError(t, f.keeper.PruneChunkCache(ctx))
+			require.NoError(t, f.keeper.CachedChunk.Walk(ctx, nil, func(key collections.Pair[string, string], _ types.CachedChunk) (bool, error) {
+				indexes = append(indexes, key.K2())
+				return false, nil
+			}))
+			return indexes
+		}
+		require.Equal(t, []string{"idx0", "idx1"}, cachedIndexes(14))
+		require.Equal(t, []string{"idx1"}, cachedIndexes(15))
+		require.Empty(t, cachedIndexes(17))
+
+		iter, err := f.keeper.CachedChunkExpiry.Iterate(ctx, nil)
+		require.NoError(t, err)
+		expiries, err := iter.Keys()
+		require.NoError(t, err)
+		require.Empty(t, expiries)
+	})
+
+	t.Run("events only", func(t *testing.T) {
+		f := initFixture(t)
+		ctx := sdk.UnwrapSDKContext(f.ctx)
+		data := types.ChunkRetrievalPacketData{Indexes: []string{"idx0", "idx1"}}
+
+		require.NoError(t, f.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, packet, data, resultAck(t, chunks)))
+
+		has, err := f.keeper.CachedChunk.Has(ctx, collections.Join("channel-0", "idx0"))
+		require.NoError(t, err)
+		require.False(t, has)
+
+		// events report the chunks without their data
+		events := retrievalEvents(ctx)
+		require.Len(t, events, 2)
+		size, found := events[0].GetAttribute(types.AttributeKeySize)
+		require.True(t, found)
+		require.Equal(t, "5", size.Value)
+		for _, attr := range events[0].Attributes {
+			require.NotContains(t, attr.Value, "aGVsbG8=")
+		}
+	})
+
+	t.Run("error ack", func(t *testing.T) {
+		f := initFixture(t)
+		ctx := sdk.UnwrapSDKContext(f.ctx)
+		data := types.ChunkRetrievalPacketData{Indexes: []string{"idx0"}, CacheBytes: 10}
+
+		require.NoError(t, f.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, packet, data, channeltypes.NewErrorAcknowledgement(errors.New("chunk not found"))))
+
+		has, err := f.keeper.CachedChunk.Has(ctx, collections.Join("channel-0", "idx0"))
+		require.NoError(t, err)
+		require.False(t, has)
+		require.Len(t, retrievalEvents(ctx), 1)
+	})
+
+	// acks that cannot be read retrieve nothing, but are cleared so relayers are not stuck with them
+	for _, tt := range []struct {
+		name   string
+		ack    channeltypes.Acknowledgement
+		reason string
+	}{
+		{name: "json result", ack: channeltypes.NewResultAcknowledgement([]byte("{}")), reason: "cannot unmarshal acknowledgment"},
+		{name: "chunks out of order", ack: resultAck(t, chunks), reason: "ack returns chunk idx0 at position 0, expected idx1"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			f := initFixture(t)
+			ctx := sdk.UnwrapSDKContext(f.ctx)
+			data := types.ChunkRetrievalPacketData{Indexes: []string{"idx1", "idx0"}, CacheBytes: 10}
+
+			require.NoError(t, f.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, packet, data, tt.ack))
+
+			has, err := f.keeper.CachedChunk.Has(ctx, collections.Join("channel-0", "idx0"))
+			require.NoError(t, err)
+			require.False(t, has)
+			events := retrievalEvents(ctx)
+			require.Len(t, events, 1)
+			reason, found := events[0].GetAttribute(types.AttributeKeyAckError)
+			require.True(t, found)
+			require.Contains(t, reason.Value, tt.reason)
+		})
+	}
+}
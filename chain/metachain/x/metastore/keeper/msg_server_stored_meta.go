@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -31,7 +32,10 @@ func (k msgServer) CreateStoredMeta(ctx context.Context, msg *types.MsgCreateSto
 		Url:     msg.Url,
 	}
 
-	if err := k.StoredMeta.Set(ctx, storedMeta.Index, storedMeta); err != nil {
+	if err := k.SetStoredMeta(ctx, storedMeta); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := emitStoredMetaSet(ctx, storedMeta, false); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
@@ -58,15 +62,17 @@ func (k msgServer) UpdateStoredMeta(ctx context.Context, msg *types.MsgUpdateSto
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	var storedMeta = types.StoredMeta{
-		Creator: msg.Creator,
-		Index:   msg.Index,
-		Url:     msg.Url,
-	}
+	// only the url is the owner's to change; the chunks, their route and placement, and the
+	// recipients they are encrypted to come from the upload and the datachain acks
+	storedMeta := val
+	storedMeta.Url = msg.Url
 
-	if err := k.StoredMeta.Set(ctx, storedMeta.Index, storedMeta); err != nil {
+	if err := k.SetStoredMeta(ctx, storedMeta); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update storedMeta")
 	}
+	if err := emitStoredMetaSet(ctx, storedMeta, true); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
 
 	return &types.MsgUpdateStoredMetaResponse{}, nil
 }
@@ -91,9 +97,28 @@ func (k msgServer) DeleteStoredMeta(ctx context.Context, msg *types.MsgDeleteSto
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	if err := k.StoredMeta.Remove(ctx, msg.Index); err != nil {
+	if err := k.RemoveStoredMeta(ctx, msg.Index); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove storedMeta")
 	}
 
+	indexes := make([]string, 0, len(val.Chunks))
+	for _, chunk := range val.Chunks {
+		indexes = append(indexes, chunk.Index)
+	}
+	if err := k.releaseChunks(ctx, val, indexes); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to release chunks")
+	}
+	if err := k.deleteFragments(ctx, val.Creator, val.Index, val.UploadedChunks); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to delete uploaded chunks")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventStoredMetaDeleted{
+		Index:   val.Index,
+		Creator: val.Creator,
+		Url:     val.Url,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
 	return &types.MsgDeleteStoredMetaResponse{}, nil
 }
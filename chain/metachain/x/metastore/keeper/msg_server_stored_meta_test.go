@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"errors"
 	"strconv"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 
 	"metachain/x/metastore/keeper"
@@ -93,6 +96,35 @@ func TestStoredMetaMsgServerUpdate(t *testing.T) {
 	}
 }
 
+func TestStoredMetaMsgServerUpdateKeepsPlacement(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	// the repair job runs the recorded policy again, so an owner update must not drop it
+	placement := &types.Placement{
+		Policy:      "spread-stripes",
+		StripeWidth: 2,
+		Datachains:  []types.DatachainLoad{{ConnectionId: "connection-0"}, {ConnectionId: "connection-1"}},
+		ChunkSizes:  []uint64{5, 5},
+	}
+	require.NoError(t, f.keeper.SetStoredMeta(f.ctx, types.StoredMeta{
+		Index:     "a.com",
+		Url:       "a.com",
+		Creator:   creator,
+		Indexes:   []string{"a-0", "a-1"},
+		Placement: placement,
+	}))
+
+	_, err = srv.UpdateStoredMeta(f.ctx, &types.MsgUpdateStoredMeta{Creator: creator, Index: "a.com", Url: "www.a.com"})
+	require.NoError(t, err)
+	meta, err := f.keeper.StoredMeta.Get(f.ctx, "a.com")
+	require.NoError(t, err)
+	require.Equal(t, "www.a.com", meta.Url)
+	require.Equal(t, placement, meta.Placement)
+}
+
 func TestStoredMetaMsgServerDelete(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
@@ -155,3 +187,64 @@ func TestStoredMetaMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestOnAcknowledgementChunkReleasePacket(t *testing.T) {
+	data := types.ChunkReleasePacketData{Url: "HelloWorld.com", Addresses: []string{"idx0", "idx1"}}
+	resultAck := func(t *testing.T, packetAck types.ChunkReleasePacketAck) channeltypes.Acknowledgement {
+		t.Helper()
+		bz, err := packetAck.Marshal()
+		require.NoError(t, err)
+		return channeltypes.NewResultAcknowledgement(bz)
+	}
+
+	tests := []struct {
+		name string
+		ack  channeltypes.Acknowledgement
+		// reason is the error of the chunk_release event of an ack that released nothing
+		reason string
+	}{
+		{
+			name: "released",
+			ack:  resultAck(t, types.ChunkReleasePacketAck{Version: types.AckVersion, Released: []string{"idx0"}}),
+		}, {
+			name:   "error ack",
+			ack:    channeltypes.NewErrorAcknowledgement(errors.New("chunk not found")),
+			reason: "ABCI code",
+		}, {
+			name:   "json result",
+			ack:    channeltypes.NewResultAcknowledgement([]byte("{}")),
+			reason: "cannot unmarshal acknowledgment",
+		}, {
+			name:   "unknown version",
+			ack:    resultAck(t, types.ChunkReleasePacketAck{Version: types.AckVersion + 1}),
+			reason: "unsupported ack version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := initFixture(t)
+			ctx := sdk.UnwrapSDKContext(f.ctx)
+
+			// acks are cleared even when they release nothing, so relayers are not stuck with them
+			require.NoError(t, f.keeper.OnAcknowledgementChunkReleasePacket(ctx, channeltypes.Packet{SourceChannel: "channel-0"}, data, tt.ack))
+
+			var events []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeChunkRelease {
+					events = append(events, event)
+				}
+			}
+			require.Len(t, events, 1)
+			success, _ := events[0].GetAttribute(types.AttributeKeyAckSuccess)
+			if tt.reason == "" {
+				require.Equal(t, "true", success.Value)
+				released, _ := events[0].GetAttribute(types.AttributeKeyIndex)
+				require.Equal(t, "idx0", released.Value)
+				return
+			}
+			require.Equal(t, "false", success.Value)
+			reason, _ := events[0].GetAttribute(types.AttributeKeyAckError)
+			require.Contains(t, reason.Value, tt.reason)
+		})
+	}
+}
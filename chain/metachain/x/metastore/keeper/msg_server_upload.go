@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"metachain/x/metastore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+func (k msgServer) RegisterDatachainAccount(ctx context.Context, msg *types.MsgRegisterDatachainAccount) (*types.MsgRegisterDatachainAccountResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if msg.ConnectionId == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid connection id")
+	}
+
+	// An empty version lets the controller build the default ICS-27 metadata for the connection.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.icaControllerKeeperFn().RegisterInterchainAccount(sdkCtx, msg.ConnectionId, msg.Creator, "", channeltypes.UNORDERED); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterDatachainAccountResponse{}, nil
+}
+
+func (k msgServer) UploadChunks(ctx context.Context, msg *types.MsgUploadChunks) (*types.MsgUploadChunksResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if msg.Url == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid url")
+	}
+	if len(msg.Chunks) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no chunks to upload")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	if msg.Encryption != nil {
+		if err := msg.Encryption.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if msg.Placement != nil {
+		if err := msg.Placement.Validate(msg.Chunks); err != nil {
+			return nil, err
+		}
+	}
+
+	// the creator may upload over its own url, whose manifest is only replaced once the
+	// datachains acknowledged the new chunks
+	if err := k.RequireUrlOwner(ctx, msg.Url, msg.Creator); err != nil {
+		return nil, err
+	}
+	if ok, err := k.PendingUpload.Has(ctx, msg.Url); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if ok {
+		return nil, errorsmod.Wrapf(types.ErrUploadInProgress, "url %s", msg.Url)
+	}
+
+	// Group the chunks by datachain, keeping the order connections first appear in.
+	var connections []string
+	byConnection := make(map[string][]types.ChunkUpload)
+	indexes := make([]string, 0, len(msg.Chunks))
+	seen := make(map[string]bool, len(msg.Chunks))
+	for _, chunk := range msg.Chunks {
+		if chunk.ConnectionId == "" || chunk.Index == "" {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chunk needs a connection id and an index")
+		}
+		if seen[chunk.Index] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate chunk index %s", chunk.Index)
+		}
+		seen[chunk.Index] = true
+		if _, ok := byConnection[chunk.ConnectionId]; !ok {
+			// draining and retired datachains are read-only
+			if err := k.requireActive(ctx, chunk.ConnectionId); err != nil {
+				return nil, err
+			}
+			connections = append(connections, chunk.ConnectionId)
+		}
+		byConnection[chunk.ConnectionId] = append(byConnection[chunk.ConnectionId], chunk)
+		indexes = append(indexes, chunk.Index)
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	for _, connectionID := range connections {
+		channelID, sequence, err := k.TransmitChunkWrites(ctx, msg.Creator, connectionID, byConnection[connectionID], msg.TimeoutTimestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.UploadPacket.Set(ctx, collections.Join3(portID, channelID, sequence), msg.Url); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+
+	uploaded := make([]types.UploadedChunk, 0, len(msg.Chunks))
+	for _, chunk := range msg.Chunks {
+		hash := sha256.Sum256(chunk.Data)
+		uploaded = append(uploaded, types.UploadedChunk{
+			ConnectionId: chunk.ConnectionId,
+			Index:        chunk.Index,
+			Size_:        uint64(len(chunk.Data)),
+			Hash:         hash[:],
+		})
+	}
+
+	var upload = types.PendingUpload{
+		Url:         msg.Url,
+		Creator:     msg.Creator,
+		Indexes:     indexes,
+		Outstanding: uint32(len(connections)),
+		Encryption:  msg.Encryption,
+		Placement:   msg.Placement,
+		Chunks:      uploaded,
+	}
+	if err := k.PendingUpload.Set(ctx, upload.Url, upload); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	event := &types.EventUploadStarted{
+		Url:     msg.Url,
+		Creator: msg.Creator,
+		Chunks:  uploaded,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgUploadChunksResponse{}, nil
+}
@@ -0,0 +1,535 @@
+package keeper_test
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"metachain/x/metastore/keeper"
+	"metachain/x/metastore/types"
+)
+
+func TestMsgServerRegisterDatachainAccount(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		msg  types.MsgRegisterDatachainAccount
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.MsgRegisterDatachainAccount{Creator: "invalid address", ConnectionId: "connection-0"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid connection",
+			msg:  types.MsgRegisterDatachainAccount{Creator: creator},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg:  types.MsgRegisterDatachainAccount{Creator: creator, ConnectionId: "connection-0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err = srv.RegisterDatachainAccount(f.ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	portID, err := icatypes.NewControllerPortID(creator)
+	require.NoError(t, err)
+	_, found := f.icaKeeper.GetInterchainAccountAddress(sdk.UnwrapSDKContext(f.ctx), "connection-0", portID)
+	require.True(t, found)
+}
+
+func TestMsgServerUploadChunks(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	chunks := []types.ChunkUpload{
+		{ConnectionId: "connection-0", Index: "idx0", Data: []byte("hello")},
+		{ConnectionId: "connection-1", Index: "idx1", Data: []byte("world")},
+	}
+	require.NoError(t, f.keeper.SetStoredMeta(f.ctx, types.StoredMeta{Index: "taken", Url: "taken", Creator: "other"}))
+
+	tests := []struct {
+		name string
+		msg  types.MsgUploadChunks
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.MsgUploadChunks{Creator: "invalid address", Url: "url", Chunks: chunks, TimeoutTimestamp: 100},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid url",
+			msg:  types.MsgUploadChunks{Creator: creator, Chunks: chunks, TimeoutTimestamp: 100},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no chunks",
+			msg:  types.MsgUploadChunks{Creator: creator, Url: "url", TimeoutTimestamp: 100},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid timeout",
+			msg:  types.MsgUploadChunks{Creator: creator, Url: "url", Chunks: chunks},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing chunk index",
+			msg: types.MsgUploadChunks{Creator: creator, Url: "url", TimeoutTimestamp: 100,
+				Chunks: []types.ChunkUpload{{ConnectionId: "connection-0"}}},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate chunk index",
+			msg: types.MsgUploadChunks{Creator: creator, Url: "url", TimeoutTimestamp: 100,
+				Chunks: []types.ChunkUpload{chunks[0], {ConnectionId: "connection-1", Index: "idx0", Data: []byte("again")}}},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid encryption",
+			msg: types.MsgUploadChunks{Creator: creator, Url: "url", Chunks: chunks, TimeoutTimestamp: 100,
+				Encryption: &types.Encryption{Scheme: types.EncryptionScheme}},
+			err: types.ErrInvalidEncryption,
+		}, {
+			name: "chunk outside the placement",
+			msg: types.MsgUploadChunks{Creator: creator, Url: "url", Chunks: chunks, TimeoutTimestamp: 100,
+				Placement: &types.Placement{Policy: "round-robin", Datachains: []types.DatachainLoad{{ConnectionId: "connection-0"}}}},
+			err: types.ErrInvalidPlacement,
+		}, {
+			name: "url of another account",
+			msg:  types.MsgUploadChunks{Creator: creator, Url: "taken", Chunks: chunks, TimeoutTimestamp: 100},
+			err:  sdkerrors.ErrUnauthorized,
+		}, {
+			name: "account not registered",
+			msg:  types.MsgUploadChunks{Creator: creator, Url: "url", Chunks: chunks, TimeoutTimestamp: 100},
+			err:  types.ErrAccountNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err = srv.UploadChunks(f.ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestUploadChunksAcknowledgement(t *testing.T) {
+	creatorBytes := []byte("signerAddr__________________")
+	chunks := []types.ChunkUpload{
+		{ConnectionId: "connection-0", Index: "idx0", Data: []byte("hello")},
+		{ConnectionId: "connection-1", Index: "idx1", Data: []byte("world")},
+		{ConnectionId: "connection-0", Index: "idx2", Data: []byte("!")},
+	}
+
+	// upload registers accounts on both connections and dispatches the chunks
+	upload := func(t *testing.T, f *fixture) (string, string) {
+		t.Helper()
+		srv := keeper.NewMsgServerImpl(f.keeper)
+		creator, err := f.addressCodec.BytesToString(creatorBytes)
+		require.NoError(t, err)
+		for _, connectionID := range []string{"connection-0", "connection-1"} {
+			_, err = srv.RegisterDatachainAccount(f.ctx, &types.MsgRegisterDatachainAccount{Creator: creator, ConnectionId: connectionID})
+			require.NoError(t, err)
+		}
+		_, err = srv.UploadChunks(f.ctx, &types.MsgUploadChunks{Creator: creator, Url: "HelloWorld.com", Chunks: chunks, TimeoutTimestamp: 100})
+		require.NoError(t, err)
+
+		portID, err := icatypes.NewControllerPortID(creator)
+		require.NoError(t, err)
+		return creator, portID
+	}
+	packet := func(portID, connectionID string, sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{SourcePort: portID, SourceChannel: "channel-" + connectionID, Sequence: sequence}
+	}
+	// deletes decodes the chunk deletes of the ICA tx sent after the two uploads
+	deletes := func(t *testing.T, f *fixture, i int) []types.ChunkDelete {
+		t.Helper()
+		var cosmosTx icatypes.CosmosTx
+		require.NoError(t, cosmosTx.Unmarshal(f.icaKeeper.sent[2+i].Data))
+		var deletes []types.ChunkDelete
+		for _, msg := range cosmosTx.Messages {
+			require.Equal(t, types.DatastoreDeleteStoredChunkTypeURL, msg.TypeUrl)
+			var chunkDelete types.ChunkDelete
+			require.NoError(t, chunkDelete.Unmarshal(msg.Value))
+			deletes = append(deletes, chunkDelete)
+		}
+		return deletes
+	}
+
+	t.Run("dispatch", func(t *testing.T) {
+		f := initFixture(t)
+		upload(t, f)
+
+		// one ICA tx per datachain, chunks grouped by connection
+		require.Len(t, f.icaKeeper.sent, 2)
+		var cosmosTx icatypes.CosmosTx
+		require.NoError(t, cosmosTx.Unmarshal(f.icaKeeper.sent[0].Data))
+		require.Len(t, cosmosTx.Messages, 2)
+		require.Equal(t, types.DatastoreCreateStoredChunkTypeURL, cosmosTx.Messages[0].TypeUrl)
+
+		var write types.ChunkWrite
+		require.NoError(t, write.Unmarshal(cosmosTx.Messages[1].Value))
+		require.Equal(t, types.ChunkWrite{Creator: "ica-connection-0", Index: "idx2", Data: []byte("!")}, write)
+
+		pending, err := f.keeper.PendingUpload.Get(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.Equal(t, uint32(2), pending.Outstanding)
+		require.Equal(t, []string{"idx0", "idx1", "idx2"}, pending.Indexes)
+
+		// the same url cannot be uploaded twice while in flight
+		srv := keeper.NewMsgServerImpl(f.keeper)
+		_, err = srv.UploadChunks(f.ctx, &types.MsgUploadChunks{Creator: pending.Creator, Url: "HelloWorld.com", Chunks: chunks, TimeoutTimestamp: 100})
+		require.ErrorIs(t, err, types.ErrUploadInProgress)
+	})
+
+	t.Run("all acks succeed", func(t *testing.T) {
+		f := initFixture(t)
+		creator, portID := upload(t, f)
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewResultAcknowledgement([]byte{1})))
+		has, err := f.keeper.StoredMeta.Has(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.False(t, has)
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-1", 2), channeltypes.NewResultAcknowledgement([]byte{1})))
+		started := &types.EventUploadStarted{Url: "HelloWorld.com", Creator: creator}
+		for _, chunk := range chunks {
+			hash := sha256.Sum256(chunk.Data)
+			started.Chunks = append(started.Chunks, types.UploadedChunk{ConnectionId: chunk.ConnectionId, Index: chunk.Index, Size_: uint64(len(chunk.Data)), Hash: hash[:]})
+		}
+
+		// the manifest records the datachain and hash of every chunk
+		meta, err := f.keeper.StoredMeta.Get(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.Equal(t, types.StoredMeta{Index: "HelloWorld.com", Url: "HelloWorld.com", Creator: creator, Indexes: []string{"idx0", "idx1", "idx2"}, UploadedChunks: started.Chunks}, meta)
+		has, err = f.keeper.StoredMetaByFragment.Has(f.ctx, collections.Join("idx2", "HelloWorld.com"))
+		require.NoError(t, err)
+		require.True(t, has)
+		has, err = f.keeper.StoredMetaByDatachain.Has(f.ctx, collections.Join3("connection-1", "idx1", "HelloWorld.com"))
+		require.NoError(t, err)
+		require.True(t, has)
+		require.Equal(t, []proto.Message{
+			started,
+			&types.EventPacketAcknowledged{PacketType: types.EventTypeChunkUpload, SourceChannel: "channel-connection-0", Sequence: 1, Success: true},
+			&types.EventPacketAcknowledged{PacketType: types.EventTypeChunkUpload, SourceChannel: "channel-connection-1", Sequence: 2, Success: true},
+			&types.EventStoredMetaCreated{Index: "HelloWorld.com", Creator: creator, Url: "HelloWorld.com", Chunks: []types.VerifiedChunk{}},
+		}, typedEvents(t, f.ctx))
+
+		has, err = f.keeper.PendingUpload.Has(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+
+	t.Run("error ack fails the upload", func(t *testing.T) {
+		f := initFixture(t)
+		creator, portID := upload(t, f)
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewErrorAcknowledgement(errors.New("index already set"))))
+		// the late success from the other datachain must not store the metadata
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-1", 2), channeltypes.NewResultAcknowledgement([]byte{1})))
+
+		var failed []proto.Message
+		for _, event := range typedEvents(t, f.ctx) {
+			if _, ok := event.(*types.EventUploadFailed); ok {
+				failed = append(failed, event)
+			}
+		}
+		require.Len(t, failed, 1)
+		require.Equal(t, "HelloWorld.com", failed[0].(*types.EventUploadFailed).Url)
+		require.Equal(t, creator, failed[0].(*types.EventUploadFailed).Creator)
+
+		// the other datachain stored its chunk, which is deleted again
+		require.Len(t, f.icaKeeper.sent, 3)
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-1", Index: "idx1"}}, deletes(t, f, 0))
+
+		has, err := f.keeper.StoredMeta.Has(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.False(t, has)
+		has, err = f.keeper.PendingUpload.Has(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+
+	t.Run("timeout fails the upload", func(t *testing.T) {
+		f := initFixture(t)
+		creator, portID := upload(t, f)
+
+		require.NoError(t, f.keeper.OnTimeoutUploadPacket(f.ctx, packet(portID, "connection-1", 2)))
+		events := typedEvents(t, f.ctx)
+		require.Equal(t, []proto.Message{
+			&types.EventPacketTimedOut{PacketType: types.EventTypeChunkUpload, SourceChannel: "channel-connection-1", Sequence: 2},
+			&types.EventUploadFailed{Url: "HelloWorld.com", Creator: creator, Reason: "packet timed out"},
+		}, events[len(events)-2:])
+
+		// the upload is kept until the other datachain settles
+		pending, err := f.keeper.PendingUpload.Get(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.True(t, pending.Failed)
+		require.Equal(t, uint32(1), pending.Outstanding)
+		require.Len(t, f.icaKeeper.sent, 2)
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewResultAcknowledgement([]byte{1})))
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-0", Index: "idx0"}, {Creator: "ica-connection-0", Index: "idx2"}}, deletes(t, f, 0))
+		has, err := f.keeper.PendingUpload.Has(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.False(t, has)
+		has, err = f.keeper.StoredMeta.Has(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+
+	t.Run("failure after a success deletes the stored chunks", func(t *testing.T) {
+		f := initFixture(t)
+		_, portID := upload(t, f)
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewResultAcknowledgement([]byte{1})))
+		require.NoError(t, f.keeper.OnTimeoutUploadPacket(f.ctx, packet(portID, "connection-1", 2)))
+
+		require.Len(t, f.icaKeeper.sent, 3)
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-0", Index: "idx0"}, {Creator: "ica-connection-0", Index: "idx2"}}, deletes(t, f, 0))
+		has, err := f.keeper.PendingUpload.Has(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+
+	t.Run("url taken by another account", func(t *testing.T) {
+		f := initFixture(t)
+		creator, portID := upload(t, f)
+
+		// another account stored metadata under the url while the chunks were in flight
+		taken := types.StoredMeta{Index: "HelloWorld.com", Url: "HelloWorld.com", Creator: "other"}
+		require.NoError(t, f.keeper.SetStoredMeta(f.ctx, taken))
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewResultAcknowledgement([]byte{1})))
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-1", 2), channeltypes.NewResultAcknowledgement([]byte{1})))
+
+		meta, err := f.keeper.StoredMeta.Get(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.Equal(t, taken, meta)
+		events := typedEvents(t, f.ctx)
+		require.Equal(t, &types.EventUploadFailed{Url: "HelloWorld.com", Creator: creator, Reason: "url taken by another account"}, events[len(events)-1])
+		require.Len(t, f.icaKeeper.sent, 4)
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-0", Index: "idx0"}, {Creator: "ica-connection-0", Index: "idx2"}}, deletes(t, f, 0))
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-1", Index: "idx1"}}, deletes(t, f, 1))
+	})
+
+	t.Run("overwrite replaces the manifest once acknowledged", func(t *testing.T) {
+		f := initFixture(t)
+		creator, err := f.addressCodec.BytesToString(creatorBytes)
+		require.NoError(t, err)
+		previous := types.StoredMeta{Index: "HelloWorld.com", Url: "HelloWorld.com", Creator: creator, Indexes: []string{"old0"},
+			UploadedChunks: []types.UploadedChunk{{ConnectionId: "connection-0", Index: "old0", Size_: 3}}}
+		require.NoError(t, f.keeper.SetStoredMeta(f.ctx, previous))
+		_, portID := upload(t, f)
+
+		// the previous manifest is served while the chunks are in flight
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewResultAcknowledgement([]byte{1})))
+		meta, err := f.keeper.StoredMeta.Get(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.Equal(t, previous, meta)
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-1", 2), channeltypes.NewResultAcknowledgement([]byte{1})))
+		meta, err = f.keeper.StoredMeta.Get(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.Equal(t, []string{"idx0", "idx1", "idx2"}, meta.Indexes)
+		has, err := f.keeper.StoredMetaByFragment.Has(f.ctx, collections.Join("old0", "HelloWorld.com"))
+		require.NoError(t, err)
+		require.False(t, has)
+		// the chunk only the previous manifest listed is deleted from its datachain
+		require.Len(t, f.icaKeeper.sent, 3)
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-0", Index: "old0"}}, deletes(t, f, 0))
+	})
+
+	t.Run("overwrite keeps the chunks the manifest still lists", func(t *testing.T) {
+		f := initFixture(t)
+		creator, err := f.addressCodec.BytesToString(creatorBytes)
+		require.NoError(t, err)
+		previous := types.StoredMeta{Index: "HelloWorld.com", Url: "HelloWorld.com", Creator: creator, Indexes: []string{"idx0"},
+			UploadedChunks: []types.UploadedChunk{{ConnectionId: "connection-0", Index: "idx0", Size_: 3}}}
+		require.NoError(t, f.keeper.SetStoredMeta(f.ctx, previous))
+		_, portID := upload(t, f)
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewResultAcknowledgement([]byte{1})))
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-1", 2), channeltypes.NewResultAcknowledgement([]byte{1})))
+		require.Len(t, f.icaKeeper.sent, 2)
+	})
+
+	t.Run("delete removes the uploaded chunks", func(t *testing.T) {
+		f := initFixture(t)
+		creator, portID := upload(t, f)
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewResultAcknowledgement([]byte{1})))
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-1", 2), channeltypes.NewResultAcknowledgement([]byte{1})))
+
+		srv := keeper.NewMsgServerImpl(f.keeper)
+		_, err := srv.DeleteStoredMeta(f.ctx, &types.MsgDeleteStoredMeta{Creator: creator, Index: "HelloWorld.com"})
+		require.NoError(t, err)
+
+		// one delete per datachain, through the account that wrote the chunks
+		require.Len(t, f.icaKeeper.sent, 4)
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-0", Index: "idx0"}, {Creator: "ica-connection-0", Index: "idx2"}}, deletes(t, f, 0))
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-1", Index: "idx1"}}, deletes(t, f, 1))
+	})
+
+	t.Run("delete keeps chunks another manifest lists", func(t *testing.T) {
+		f := initFixture(t)
+		creator, portID := upload(t, f)
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewResultAcknowledgement([]byte{1})))
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-1", 2), channeltypes.NewResultAcknowledgement([]byte{1})))
+		require.NoError(t, f.keeper.SetStoredMeta(f.ctx, types.StoredMeta{Index: "Copy.com", Url: "Copy.com", Creator: creator, Indexes: []string{"idx1"},
+			UploadedChunks: []types.UploadedChunk{{ConnectionId: "connection-1", Index: "idx1", Size_: 3}}}))
+
+		srv := keeper.NewMsgServerImpl(f.keeper)
+		_, err := srv.DeleteStoredMeta(f.ctx, &types.MsgDeleteStoredMeta{Creator: creator, Index: "HelloWorld.com"})
+		require.NoError(t, err)
+
+		require.Len(t, f.icaKeeper.sent, 3)
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-0", Index: "idx0"}, {Creator: "ica-connection-0", Index: "idx2"}}, deletes(t, f, 0))
+	})
+
+	t.Run("failed overwrite keeps the previous manifest", func(t *testing.T) {
+		f := initFixture(t)
+		creator, err := f.addressCodec.BytesToString(creatorBytes)
+		require.NoError(t, err)
+		previous := types.StoredMeta{Index: "HelloWorld.com", Url: "HelloWorld.com", Creator: creator, Indexes: []string{"old0"},
+			UploadedChunks: []types.UploadedChunk{{ConnectionId: "connection-0", Index: "old0", Size_: 3}}}
+		require.NoError(t, f.keeper.SetStoredMeta(f.ctx, previous))
+		_, portID := upload(t, f)
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 1), channeltypes.NewResultAcknowledgement([]byte{1})))
+		require.NoError(t, f.keeper.OnTimeoutUploadPacket(f.ctx, packet(portID, "connection-1", 2)))
+
+		meta, err := f.keeper.StoredMeta.Get(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.Equal(t, previous, meta)
+		// only the chunks of the failed upload are deleted
+		require.Equal(t, []types.ChunkDelete{{Creator: "ica-connection-0", Index: "idx0"}, {Creator: "ica-connection-0", Index: "idx2"}}, deletes(t, f, 0))
+	})
+
+	t.Run("untracked packet is ignored", func(t *testing.T) {
+		f := initFixture(t)
+		_, portID := upload(t, f)
+
+		require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet(portID, "connection-0", 42), channeltypes.NewResultAcknowledgement([]byte{1})))
+
+		pending, err := f.keeper.PendingUpload.Get(f.ctx, "HelloWorld.com")
+		require.NoError(t, err)
+		require.Equal(t, uint32(2), pending.Outstanding)
+	})
+}
+
+func TestUploadChunksEncryption(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	_, err = srv.RegisterDatachainAccount(f.ctx, &types.MsgRegisterDatachainAccount{Creator: creator, ConnectionId: "connection-0"})
+	require.NoError(t, err)
+
+	recipient, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	dataKey, err := types.NewDataKey()
+	require.NoError(t, err)
+	encryption, err := types.NewEncryption(dataKey, []*ecdh.PublicKey{recipient.PublicKey()})
+	require.NoError(t, err)
+	sealed, err := types.EncryptChunk(dataKey, "idx0", []byte("private"))
+	require.NoError(t, err)
+
+	_, err = srv.UploadChunks(f.ctx, &types.MsgUploadChunks{
+		Creator:          creator,
+		Url:              "private.example",
+		Chunks:           []types.ChunkUpload{{ConnectionId: "connection-0", Index: "idx0", Data: sealed}},
+		TimeoutTimestamp: 100,
+		Encryption:       encryption,
+	})
+	require.NoError(t, err)
+
+	// datachains store, and hash, the ciphertext
+	var cosmosTx icatypes.CosmosTx
+	require.NoError(t, cosmosTx.Unmarshal(f.icaKeeper.sent[0].Data))
+	var write types.ChunkWrite
+	require.NoError(t, write.Unmarshal(cosmosTx.Messages[0].Value))
+	require.Equal(t, sealed, write.Data)
+
+	portID, err := icatypes.NewControllerPortID(creator)
+	require.NoError(t, err)
+	packet := channeltypes.Packet{SourcePort: portID, SourceChannel: "channel-connection-0", Sequence: 1}
+	require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1})))
+
+	meta, err := f.keeper.StoredMeta.Get(f.ctx, "private.example")
+	require.NoError(t, err)
+	require.Equal(t, encryption, meta.Encryption)
+
+	unwrapped, err := meta.Encryption.UnwrapDataKey(recipient)
+	require.NoError(t, err)
+	data, err := types.DecryptChunk(unwrapped, "idx0", write.Data)
+	require.NoError(t, err)
+	require.Equal(t, []byte("private"), data)
+}
+
+func TestUploadChunksPlacement(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	_, err = srv.RegisterDatachainAccount(f.ctx, &types.MsgRegisterDatachainAccount{Creator: creator, ConnectionId: "connection-0"})
+	require.NoError(t, err)
+
+	placement := &types.Placement{
+		Policy:      "least-used-bytes",
+		StripeWidth: 1,
+		Datachains: []types.DatachainLoad{
+			{ConnectionId: "connection-0", ChainId: "data-0", Chunks: 1, Bytes: 10, LatestBlockTime: time.Unix(100, 0).UTC()},
+			{ConnectionId: "connection-1", ChainId: "data-1", Chunks: 2, Bytes: 20, LatestBlockTime: time.Unix(100, 0).UTC()},
+		},
+		ChunkSizes: []uint64{5},
+	}
+	_, err = srv.UploadChunks(f.ctx, &types.MsgUploadChunks{
+		Creator:          creator,
+		Url:              "placed.example",
+		Chunks:           []types.ChunkUpload{{ConnectionId: "connection-0", Index: "idx0", Data: []byte("hello")}},
+		TimeoutTimestamp: 100,
+		Placement:        placement,
+	})
+	require.NoError(t, err)
+
+	portID, err := icatypes.NewControllerPortID(creator)
+	require.NoError(t, err)
+	packet := channeltypes.Packet{SourcePort: portID, SourceChannel: "channel-connection-0", Sequence: 1}
+	require.NoError(t, f.keeper.OnAcknowledgementUploadPacket(f.ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1})))
+
+	// the manifest keeps the policy and its inputs
+	meta, err := f.keeper.StoredMeta.Get(f.ctx, "placed.example")
+	require.NoError(t, err)
+	require.Equal(t, placement, meta.Placement)
+
+	// recorded sizes must be those of the chunks
+	placement.ChunkSizes = []uint64{4}
+	_, err = srv.UploadChunks(f.ctx, &types.MsgUploadChunks{
+		Creator:          creator,
+		Url:              "resized.example",
+		Chunks:           []types.ChunkUpload{{ConnectionId: "connection-0", Index: "idx1", Data: []byte("hello")}},
+		TimeoutTimestamp: 100,
+		Placement:        placement,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidPlacement)
+}
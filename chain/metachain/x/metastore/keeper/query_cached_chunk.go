@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"metachain/x/metastore/types"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetCachedChunk(ctx context.Context, req *types.QueryGetCachedChunkRequest) (*types.QueryGetCachedChunkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.CachedChunk.Get(ctx, collections.Join(req.ChannelId, req.Index))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetCachedChunkResponse{CachedChunk: val}, nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"metachain/x/metastore/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetDatachain(ctx context.Context, req *types.QueryGetDatachainRequest) (*types.QueryGetDatachainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ConnectionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "connection id cannot be empty")
+	}
+
+	datachain, err := q.k.GetDatachain(ctx, req.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	fragments, err := q.k.CountDatachainFragments(ctx, req.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetDatachainResponse{Datachain: datachain, Fragments: fragments}, nil
+}
+
+func (q queryServer) ListDatachains(ctx context.Context, req *types.QueryAllDatachainRequest) (*types.QueryAllDatachainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	datachains, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Datachains,
+		req.Pagination,
+		func(_ string, value types.Datachain) (types.Datachain, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDatachainResponse{Datachains: datachains, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListDatachainFragments(ctx context.Context, req *types.QueryDatachainFragmentsRequest) (*types.QueryDatachainFragmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ConnectionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "connection id cannot be empty")
+	}
+
+	fragments, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.StoredMetaByDatachain,
+		req.Pagination,
+		func(key collections.Triple[string, string, string], _ collections.NoValue) (types.DatachainFragment, error) {
+			return types.DatachainFragment{ConnectionId: key.K1(), Index: key.K2(), Url: key.K3()}, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[string, string, string]]) {
+			prefix := collections.TriplePrefix[string, string, string](req.ConnectionId)
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDatachainFragmentsResponse{Fragments: fragments, Pagination: pageRes}, nil
+}
@@ -48,3 +48,51 @@ func (q queryServer) GetStoredMeta(ctx context.Context, req *types.QueryGetStore
 
 	return &types.QueryGetStoredMetaResponse{StoredMeta: val}, nil
 }
+
+func (q queryServer) ListStoredMetaByPrefix(ctx context.Context, req *types.QueryStoredMetaByPrefixRequest) (*types.QueryStoredMetaByPrefixResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// string keys are stored as is, so the entries under a prefix form one contiguous range
+	storedMetas, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.StoredMeta,
+		req.Pagination,
+		func(_ string, value types.StoredMeta) (types.StoredMeta, error) {
+			return value, nil
+		},
+		func(o *query.CollectionsPaginateOptions[string]) {
+			o.Prefix = &req.Prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStoredMetaByPrefixResponse{StoredMeta: storedMetas, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListStoredMetaByFragment(ctx context.Context, req *types.QueryStoredMetaByFragmentRequest) (*types.QueryStoredMetaByFragmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Fragment == "" {
+		return nil, status.Error(codes.InvalidArgument, "fragment cannot be empty")
+	}
+
+	storedMetas, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.StoredMetaByFragment,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.StoredMeta, error) {
+			return q.k.StoredMeta.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Fragment),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStoredMetaByFragmentResponse{StoredMeta: storedMetas, Pagination: pageRes}, nil
+}
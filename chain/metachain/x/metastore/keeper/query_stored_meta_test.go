@@ -119,3 +119,90 @@ func TestStoredMetaQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestStoredMetaQueryByPrefix(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	for _, index := range []string{"example.com/about", "example.com/blog/a", "example.com/blog/b", "example.com/blog/c", "example.com/bloggers", "example.org/blog/a"} {
+		require.NoError(t, f.keeper.SetStoredMeta(f.ctx, types.StoredMeta{Index: index, Url: index}))
+	}
+
+	indexes := func(resp *types.QueryStoredMetaByPrefixResponse) []string {
+		var indexes []string
+		for _, meta := range resp.StoredMeta {
+			indexes = append(indexes, meta.Index)
+		}
+		return indexes
+	}
+
+	resp, err := qs.ListStoredMetaByPrefix(f.ctx, &types.QueryStoredMetaByPrefixRequest{Prefix: "example.com/blog/", Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"example.com/blog/a", "example.com/blog/b", "example.com/blog/c"}, indexes(resp))
+	require.Equal(t, uint64(3), resp.Pagination.Total)
+
+	// pages stay within the prefix
+	var (
+		next  []byte
+		paged []string
+	)
+	for {
+		resp, err := qs.ListStoredMetaByPrefix(f.ctx, &types.QueryStoredMetaByPrefixRequest{Prefix: "example.com/blog", Pagination: &query.PageRequest{Key: next, Limit: 3}})
+		require.NoError(t, err)
+		paged = append(paged, indexes(resp)...)
+		if next = resp.Pagination.NextKey; next == nil {
+			break
+		}
+	}
+	require.Equal(t, []string{"example.com/blog/a", "example.com/blog/b", "example.com/blog/c", "example.com/bloggers"}, paged)
+
+	resp, err = qs.ListStoredMetaByPrefix(f.ctx, &types.QueryStoredMetaByPrefixRequest{Prefix: "example.net/"})
+	require.NoError(t, err)
+	require.Empty(t, resp.StoredMeta)
+
+	_, err = qs.ListStoredMetaByPrefix(f.ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestStoredMetaQueryByFragment(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	// verified chunks and uploaded chunks are both indexed
+	a := types.StoredMeta{Index: "a", Url: "a", Creator: creator, Chunks: []types.VerifiedChunk{{Index: "idx0"}, {Index: "idx1"}}}
+	b := types.StoredMeta{Index: "b", Url: "b", Creator: creator, Indexes: []string{"idx1"}}
+	c := types.StoredMeta{Index: "c", Url: "c", Creator: creator, Chunks: []types.VerifiedChunk{{Index: "idx10"}}}
+	for _, meta := range []types.StoredMeta{a, b, c} {
+		require.NoError(t, f.keeper.SetStoredMeta(f.ctx, meta))
+	}
+
+	byFragment := func(fragment string) []types.StoredMeta {
+		t.Helper()
+		resp, err := qs.ListStoredMetaByFragment(f.ctx, &types.QueryStoredMetaByFragmentRequest{Fragment: fragment})
+		require.NoError(t, err)
+		return resp.StoredMeta
+	}
+	require.Equal(t, []types.StoredMeta{a}, byFragment("idx0"))
+	require.Equal(t, []types.StoredMeta{a, b}, byFragment("idx1"))
+	require.Equal(t, []types.StoredMeta{c}, byFragment("idx10"))
+	require.Empty(t, byFragment("idx2"))
+
+	// updating keeps the chunks listed, deleting drops them
+	_, err = srv.UpdateStoredMeta(f.ctx, &types.MsgUpdateStoredMeta{Creator: creator, Index: "a", Url: "a2"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a2", "b"}, []string{byFragment("idx1")[0].Url, byFragment("idx1")[1].Url})
+	_, err = srv.DeleteStoredMeta(f.ctx, &types.MsgDeleteStoredMeta{Creator: creator, Index: "a"})
+	require.NoError(t, err)
+	require.Empty(t, byFragment("idx0"))
+	require.Equal(t, []types.StoredMeta{b}, byFragment("idx1"))
+
+	// replacing an entry drops the chunks it no longer lists
+	require.NoError(t, f.keeper.SetStoredMeta(f.ctx, types.StoredMeta{Index: "c", Url: "c", Creator: creator, Chunks: []types.VerifiedChunk{{Index: "idx11"}}}))
+	require.Empty(t, byFragment("idx10"))
+	require.Len(t, byFragment("idx11"), 1)
+
+	_, err = qs.ListStoredMetaByFragment(f.ctx, &types.QueryStoredMetaByFragmentRequest{})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "fragment cannot be empty"))
+}
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"metachain/x/metastore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+)
+
+// TransmitChunkReleasePacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitChunkReleasePacket(
+	ctx context.Context,
+	packetData types.ChunkReleasePacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.ibcKeeperFn().ChannelKeeper.SendPacket(sdkCtx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// TransmitChunkReleasePacketV2 sends the packet over IBC v2 from the given client. The module
+// account signs the send, as release packets are not requested by any user.
+func (k Keeper) TransmitChunkReleasePacketV2(
+	ctx context.Context,
+	packetData types.ChunkReleasePacketData,
+	sourceClient string,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	payload := channeltypesv2.NewPayload(types.PortID, types.DatastorePortID, types.Version, types.EncodingProtobuf, packetBytes)
+	signer := authtypes.NewModuleAddress(types.ModuleName).String()
+	res, err := k.ibcKeeperFn().ChannelKeeperV2.SendPacket(ctx, channeltypesv2.NewMsgSendPacket(sourceClient, timeoutTimestamp, signer, payload))
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Sequence, nil
+}
+
+// releaseChunks tells the datachain that verified storedMeta that the entry no longer
+// references the given chunks. Entries that were never verified over IBC have nothing to release.
+func (k Keeper) releaseChunks(ctx context.Context, storedMeta types.StoredMeta, indexes []string) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	// the datachain keys references by the url it verified, which is the entry index
+	packetData := types.ChunkReleasePacketData{Url: storedMeta.Index, Addresses: indexes}
+	timeout := sdk.UnwrapSDKContext(ctx).BlockTime().Add(types.ReleasePacketTimeout)
+
+	switch {
+	case storedMeta.ChannelId != "":
+		port, err := k.Port.Get(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = k.TransmitChunkReleasePacket(ctx, packetData, port, storedMeta.ChannelId, clienttypes.ZeroHeight(), uint64(timeout.UnixNano()))
+		return err
+	case storedMeta.ClientId != "":
+		_, err := k.TransmitChunkReleasePacketV2(ctx, packetData, storedMeta.ClientId, uint64(timeout.Unix()))
+		return err
+	default:
+		return nil
+	}
+}
+
+// releaseReplacedChunks releases what previous referenced once next replaces it, and deletes the
+// chunks previous uploaded that no entry lists any more. Chunks next still holds through the same
+// channel or client keep their reference. next must be stored already.
+func (k Keeper) releaseReplacedChunks(ctx context.Context, previous, next types.StoredMeta) error {
+	sameRoute := previous.ChannelId == next.ChannelId && previous.ClientId == next.ClientId
+
+	kept := make(map[string]bool, len(next.Chunks))
+	if sameRoute {
+		for _, chunk := range next.Chunks {
+			kept[chunk.Index] = true
+		}
+	}
+
+	var released []string
+	for _, chunk := range previous.Chunks {
+		if !kept[chunk.Index] {
+			released = append(released, chunk.Index)
+		}
+	}
+
+	if err := k.releaseChunks(ctx, previous, released); err != nil {
+		return err
+	}
+	return k.deleteFragments(ctx, previous.Creator, previous.Index, previous.UploadedChunks)
+}
+
+// deleteFragments deletes chunks the interchain accounts of owner wrote for the entry under url,
+// in one tx per datachain, once no entry lists them on their datachain any more. A datachain the
+// delete cannot be sent to keeps its chunks, the others still delete theirs.
+func (k Keeper) deleteFragments(ctx context.Context, owner, url string, chunks []types.UploadedChunk) error {
+	var connections []string
+	byConnection := make(map[string][]string)
+	for _, chunk := range chunks {
+		listed, err := k.fragmentListed(ctx, chunk.ConnectionId, chunk.Index)
+		if err != nil {
+			return err
+		}
+		if listed {
+			continue
+		}
+		if _, ok := byConnection[chunk.ConnectionId]; !ok {
+			connections = append(connections, chunk.ConnectionId)
+		}
+		byConnection[chunk.ConnectionId] = append(byConnection[chunk.ConnectionId], chunk.Index)
+	}
+
+	for _, connectionID := range connections {
+		k.transmitFragmentDeletes(ctx, owner, url, connectionID, byConnection[connectionID])
+	}
+	return nil
+}
+
+// fragmentListed reports whether an entry lists the chunk index on the datachain behind
+// connectionID.
+func (k Keeper) fragmentListed(ctx context.Context, connectionID, index string) (bool, error) {
+	iter, err := k.StoredMetaByDatachain.Iterate(ctx, collections.NewSuperPrefixedTripleRange[string, string, string](connectionID, index))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
+
+// transmitFragmentDeletes deletes the chunks indexes of url from the datachain behind
+// connectionID, through the interchain account of owner that wrote them. Failing to send the
+// delete only leaves the chunks on the datachain, so it is logged instead of failing the caller.
+func (k Keeper) transmitFragmentDeletes(ctx context.Context, owner, url, connectionID string, indexes []string) {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	timeout := cacheCtx.BlockTime().Add(types.ReleasePacketTimeout)
+	if _, _, err := k.TransmitChunkDeletes(cacheCtx, owner, connectionID, indexes, uint64(timeout.UnixNano())); err != nil {
+		cacheCtx.Logger().Error("failed to delete chunks", "url", url, "connection", connectionID, "error", err)
+		return
+	}
+	write()
+}
+
+// releaseReplaced releases what previous referenced once next replaced it, from an
+// acknowledgement. A datachain the release cannot be sent to keeps its references, and the
+// acknowledgement still succeeds.
+func (k Keeper) releaseReplaced(ctx context.Context, previous, next types.StoredMeta) {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.releaseReplacedChunks(cacheCtx, previous, next); err != nil {
+		cacheCtx.Logger().Error("failed to release replaced chunks", "url", previous.Index, "error", err)
+		return
+	}
+	write()
+}
+
+// OnRecvChunkReleasePacket rejects release packets, only datachains hold chunk references.
+func (k Keeper) OnRecvChunkReleasePacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkReleasePacketData) (packetAck types.ChunkReleasePacketAck, err error) {
+	return packetAck, errors.New("metastore module is not supposed to receive release packets")
+}
+
+// OnAcknowledgementChunkReleasePacket emits a chunk_release event with the addresses the
+// datachain released. An ack that cannot be read is reported as a failed release and cleared,
+// so relayers are not stuck with it.
+func (k Keeper) OnAcknowledgementChunkReleasePacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkReleasePacketData, ack channeltypes.Acknowledgement) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	dispatchedAck, ok := ack.Response.(*channeltypes.Acknowledgement_Result)
+	if !ok {
+		emitChunkReleaseFailed(sdkCtx, data.Url, ack.GetError())
+		return nil
+	}
+
+	var packetAck types.ChunkReleasePacketAck
+	if err := k.cdc.Unmarshal(dispatchedAck.Result, &packetAck); err != nil {
+		emitChunkReleaseFailed(sdkCtx, data.Url, errorsmod.Wrapf(types.ErrInvalidAck, "cannot unmarshal acknowledgment: %s", err).Error())
+		return nil
+	}
+	if packetAck.Version != types.AckVersion {
+		emitChunkReleaseFailed(sdkCtx, data.Url, errorsmod.Wrapf(types.ErrInvalidAck, "unsupported ack version %d, expected %d", packetAck.Version, types.AckVersion).Error())
+		return nil
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChunkRelease,
+			sdk.NewAttribute(types.AttributeKeyUrl, data.Url),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, "true"),
+			sdk.NewAttribute(types.AttributeKeyIndex, strings.Join(packetAck.Released, ",")),
+		),
+	)
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventChunksReleased{
+		Url:      data.Url,
+		Released: packetAck.Released,
+	})
+}
+
+// OnTimeoutChunkReleasePacket responds to a packet timeout. The datachain keeps its references,
+// so the chunks are not pruned.
+func (k Keeper) OnTimeoutChunkReleasePacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkReleasePacketData) error {
+	emitChunkReleaseFailed(sdk.UnwrapSDKContext(ctx), data.Url, "packet timed out")
+	return nil
+}
+
+// emitChunkReleaseFailed emits the chunk_release event of a release the datachain did not
+// confirm; it keeps its references to the chunks of url.
+func emitChunkReleaseFailed(ctx sdk.Context, url string, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChunkRelease,
+			sdk.NewAttribute(types.AttributeKeyUrl, url),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, "false"),
+			sdk.NewAttribute(types.AttributeKeyAckError, reason),
+		),
+	)
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"metachain/x/metastore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// TransmitChunkRetrievalPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitChunkRetrievalPacket(
+	ctx context.Context,
+	packetData types.ChunkRetrievalPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.ibcKeeperFn().ChannelKeeper.SendPacket(sdkCtx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvChunkRetrievalPacket rejects retrieval packets, only datachains serve chunk data.
+func (k Keeper) OnRecvChunkRetrievalPacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkRetrievalPacketData) (packetAck types.ChunkRetrievalPacketAck, err error) {
+	return packetAck, errors.New("metastore module is not supposed to receive retrieval packets")
+}
+
+// OnAcknowledgementChunkRetrievalPacket emits one chunk_retrieval event per returned chunk and
+// keeps the chunks in the cache up to the cache bytes the requester paid for. An ack that cannot
+// be read retrieves nothing, but is still cleared so relayers are not stuck with it.
+func (k Keeper) OnAcknowledgementChunkRetrievalPacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkRetrievalPacketData, ack channeltypes.Acknowledgement) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	dispatchedAck, ok := ack.Response.(*channeltypes.Acknowledgement_Result)
+	if !ok {
+		emitChunkRetrievalFailed(sdkCtx, ack.GetError())
+		return nil
+	}
+
+	var packetAck types.ChunkRetrievalPacketAck
+	if err := k.cdc.Unmarshal(dispatchedAck.Result, &packetAck); err != nil {
+		emitChunkRetrievalFailed(sdkCtx, errorsmod.Wrapf(types.ErrInvalidAck, "cannot unmarshal acknowledgment: %s", err).Error())
+		return nil
+	}
+	if err := validateChunkRetrievalPacketAck(packetAck, data); err != nil {
+		emitChunkRetrievalFailed(sdkCtx, err.Error())
+		return nil
+	}
+
+	var ttl uint64
+	if data.CacheBytes > 0 {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		ttl = params.ChunkCacheTtl
+	}
+	// the requester paid for the cache writes with its request, the relayer does not
+	cacheCtx := sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	cacheBytes := data.CacheBytes
+
+	for _, chunk := range packetAck.Chunks {
+		size := uint64(len(chunk.Data))
+		cached := ttl > 0 && size <= cacheBytes
+		if cached {
+			cacheBytes -= size
+			if err := k.cacheChunk(cacheCtx, types.CachedChunk{
+				Index:     chunk.Index,
+				Data:      chunk.Data,
+				Height:    chunk.Height,
+				ChannelId: packet.SourceChannel,
+				Expires:   sdkCtx.BlockHeight() + int64(ttl),
+			}); err != nil {
+				return err
+			}
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChunkRetrieval,
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, "true"),
+				sdk.NewAttribute(types.AttributeKeyIndex, chunk.Index),
+				sdk.NewAttribute(types.AttributeKeySize, fmt.Sprintf("%d", size)),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", chunk.Height)),
+			),
+		)
+
+		hash := sha256.Sum256(chunk.Data)
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventChunkRetrieved{
+			Index:     chunk.Index,
+			Size_:     size,
+			Hash:      hash[:],
+			Height:    chunk.Height,
+			Cached:    cached,
+			ChannelId: packet.SourceChannel,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chargeChunkCache consumes the gas of caching cacheBytes of chunk data in as many entries as
+// there are chunks, for the requester of a retrieval to pay the writes its ack makes.
+func (k Keeper) chargeChunkCache(ctx context.Context, cacheBytes uint64, chunks int) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.ChunkCacheTtl == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the chunk cache is disabled")
+	}
+
+	gasConfig := storetypes.KVGasConfig()
+	hi, gas := bits.Mul64(cacheBytes, params.ChunkGasPerByte+gasConfig.WriteCostPerByte)
+	flat := uint64(chunks) * gasConfig.WriteCostFlat
+	if hi != 0 || gas+flat < gas {
+		return errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "caching %d bytes of chunk data", cacheBytes)
+	}
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(gas+flat, "chunk cache")
+	return nil
+}
+
+// cacheChunk keeps chunk in the cache until its expiry height, replacing the copy retrieved
+// earlier over the same channel.
+func (k Keeper) cacheChunk(ctx context.Context, chunk types.CachedChunk) error {
+	key := collections.Join(chunk.ChannelId, chunk.Index)
+	previous, err := k.CachedChunk.Get(ctx, key)
+	switch {
+	case err == nil:
+		if err := k.CachedChunkExpiry.Remove(ctx, collections.Join3(previous.Expires, previous.ChannelId, previous.Index)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.CachedChunk.Set(ctx, key, chunk); err != nil {
+		return err
+	}
+	return k.CachedChunkExpiry.Set(ctx, collections.Join3(chunk.Expires, chunk.ChannelId, chunk.Index))
+}
+
+// PruneChunkCache removes the cached chunks expiring at or before the current block height.
+func (k Keeper) PruneChunkCache(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	iter, err := k.CachedChunkExpiry.Iterate(ctx, collections.NewPrefixUntilTripleRange[int64, string, string](height))
+	if err != nil {
+		return err
+	}
+	expired, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.CachedChunk.Remove(ctx, collections.Join(key.K2(), key.K3())); err != nil {
+			return err
+		}
+		if err := k.CachedChunkExpiry.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnTimeoutChunkRetrievalPacket responds to a packet timeout.
+func (k Keeper) OnTimeoutChunkRetrievalPacket(ctx context.Context, packet channeltypes.Packet, data types.ChunkRetrievalPacketData) error {
+	emitChunkRetrievalFailed(sdk.UnwrapSDKContext(ctx), "packet timed out")
+	return nil
+}
+
+// emitChunkRetrievalFailed emits the chunk_retrieval event of a retrieval that returned nothing.
+func emitChunkRetrievalFailed(ctx sdk.Context, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChunkRetrieval,
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, "false"),
+			sdk.NewAttribute(types.AttributeKeyAckError, reason),
+		),
+	)
+}
+
+// validateChunkRetrievalPacketAck checks that the ack uses a known encoding version and returns
+// every requested chunk, in request order.
+func validateChunkRetrievalPacketAck(packetAck types.ChunkRetrievalPacketAck, data types.ChunkRetrievalPacketData) error {
+	if packetAck.Version != types.AckVersion {
+		return errorsmod.Wrapf(types.ErrInvalidAck, "unsupported ack version %d, expected %d", packetAck.Version, types.AckVersion)
+	}
+	if len(packetAck.Chunks) != len(data.Indexes) {
+		return errorsmod.Wrapf(types.ErrInvalidAck, "ack returns %d chunks, %d were requested", len(packetAck.Chunks), len(data.Indexes))
+	}
+	for i, chunk := range packetAck.Chunks {
+		if chunk.Index != data.Indexes[i] {
+			return errorsmod.Wrapf(types.ErrInvalidAck, "ack returns chunk %s at position %d, expected %s", chunk.Index, i, data.Indexes[i])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"metachain/x/metastore/types"
+)
+
+// SetStoredMeta stores meta and indexes it by the chunks it lists, in place of the entry it
+// replaces. A draining datachain the replaced entry was the last to reference is retired.
+func (k Keeper) SetStoredMeta(ctx context.Context, meta types.StoredMeta) error {
+	connections, err := k.removeFragments(ctx, meta.Index)
+	if err != nil {
+		return err
+	}
+	if err := k.StoredMeta.Set(ctx, meta.Index, meta); err != nil {
+		return err
+	}
+	if err := k.indexFragments(ctx, meta); err != nil {
+		return err
+	}
+	return k.retireDrained(ctx, connections)
+}
+
+// RemoveStoredMeta removes the entry stored under index and its fragment index entries.
+func (k Keeper) RemoveStoredMeta(ctx context.Context, index string) error {
+	connections, err := k.removeFragments(ctx, index)
+	if err != nil {
+		return err
+	}
+	if err := k.StoredMeta.Remove(ctx, index); err != nil {
+		return err
+	}
+	return k.retireDrained(ctx, connections)
+}
+
+// indexFragments adds the fragment index entries of meta.
+func (k Keeper) indexFragments(ctx context.Context, meta types.StoredMeta) error {
+	for _, fragment := range meta.FragmentIndexes() {
+		if err := k.StoredMetaByFragment.Set(ctx, collections.Join(fragment, meta.Index)); err != nil {
+			return err
+		}
+	}
+	for _, fragment := range meta.DatachainFragments() {
+		if err := k.StoredMetaByDatachain.Set(ctx, collections.Join3(fragment.ConnectionId, fragment.Index, meta.Index)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeFragments removes the fragment index entries of the entry stored under index, if any,
+// and returns the connections of the datachains its chunks were on.
+func (k Keeper) removeFragments(ctx context.Context, index string) ([]string, error) {
+	meta, err := k.StoredMeta.Get(ctx, index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	for _, fragment := range meta.FragmentIndexes() {
+		if err := k.StoredMetaByFragment.Remove(ctx, collections.Join(fragment, index)); err != nil {
+			return nil, err
+		}
+	}
+	var connections []string
+	for _, fragment := range meta.DatachainFragments() {
+		if err := k.StoredMetaByDatachain.Remove(ctx, collections.Join3(fragment.ConnectionId, fragment.Index, index)); err != nil {
+			return nil, err
+		}
+		connections = append(connections, fragment.ConnectionId)
+	}
+	return connections, nil
+}
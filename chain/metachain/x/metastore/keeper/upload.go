@@ -0,0 +1,317 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"metachain/x/metastore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// TransmitChunkWrites sends the given chunks as MsgCreateStoredChunk messages through the
+// interchain account owned by owner on connectionID. All chunks travel in a single ICA tx,
+// so the datachain either stores all of them or none.
+func (k Keeper) TransmitChunkWrites(
+	ctx context.Context,
+	owner string,
+	connectionID string,
+	chunks []types.ChunkUpload,
+	timeoutTimestamp uint64,
+) (channelID string, sequence uint64, err error) {
+	return k.transmitDatastoreTx(ctx, owner, connectionID, timeoutTimestamp, func(icaAddress string) ([]*codectypes.Any, error) {
+		msgs := make([]*codectypes.Any, 0, len(chunks))
+		for _, chunk := range chunks {
+			write := types.ChunkWrite{
+				Creator: icaAddress,
+				Index:   chunk.Index,
+				Data:    chunk.Data,
+			}
+			bz, err := write.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, &codectypes.Any{TypeUrl: types.DatastoreCreateStoredChunkTypeURL, Value: bz})
+		}
+		return msgs, nil
+	})
+}
+
+// TransmitChunkDeletes sends MsgDeleteStoredChunk messages for the given chunks through the
+// interchain account owned by owner on connectionID, which must have written them.
+func (k Keeper) TransmitChunkDeletes(
+	ctx context.Context,
+	owner string,
+	connectionID string,
+	indexes []string,
+	timeoutTimestamp uint64,
+) (channelID string, sequence uint64, err error) {
+	return k.transmitDatastoreTx(ctx, owner, connectionID, timeoutTimestamp, func(icaAddress string) ([]*codectypes.Any, error) {
+		msgs := make([]*codectypes.Any, 0, len(indexes))
+		for _, index := range indexes {
+			bz, err := (&types.ChunkDelete{Creator: icaAddress, Index: index}).Marshal()
+			if err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, &codectypes.Any{TypeUrl: types.DatastoreDeleteStoredChunkTypeURL, Value: bz})
+		}
+		return msgs, nil
+	})
+}
+
+// transmitDatastoreTx sends the datastore messages msgs builds for the interchain account of
+// owner on connectionID in a single ICA tx.
+func (k Keeper) transmitDatastoreTx(
+	ctx context.Context,
+	owner string,
+	connectionID string,
+	timeoutTimestamp uint64,
+	msgs func(icaAddress string) ([]*codectypes.Any, error),
+) (channelID string, sequence uint64, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	icaKeeper := k.icaControllerKeeperFn()
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", 0, err
+	}
+
+	icaAddress, found := icaKeeper.GetInterchainAccountAddress(sdkCtx, connectionID, portID)
+	if !found {
+		return "", 0, errorsmod.Wrapf(types.ErrAccountNotFound, "no interchain account for %s on %s", owner, connectionID)
+	}
+	channelID, found = icaKeeper.GetOpenActiveChannel(sdkCtx, connectionID, portID)
+	if !found {
+		return "", 0, errorsmod.Wrapf(types.ErrAccountNotFound, "no open channel for %s on %s", owner, connectionID)
+	}
+
+	messages, err := msgs(icaAddress)
+	if err != nil {
+		return "", 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	cosmosTx := icatypes.CosmosTx{Messages: messages}
+	txBytes, err := cosmosTx.Marshal()
+	if err != nil {
+		return "", 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: txBytes,
+	}
+
+	sequence, err = icaKeeper.SendTx(sdkCtx, connectionID, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return channelID, sequence, nil
+}
+
+// OnAcknowledgementUploadPacket settles one datachain's part of an upload. The metadata is
+// stored once the last outstanding datachain acknowledges successfully; an error ack fails the
+// whole upload.
+func (k Keeper) OnAcknowledgementUploadPacket(ctx context.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	url, found, err := k.takeUploadPacket(ctx, packet)
+	if err != nil || !found {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPacketAcknowledged{
+		PacketType:    types.EventTypeChunkUpload,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+		Success:       ack.Success(),
+		Error:         ack.GetError(),
+	}); err != nil {
+		return err
+	}
+
+	upload, err := k.PendingUpload.Get(ctx, url)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// the chunks of a datachain are written in one tx, so an error ack leaves none of them
+	if !ack.Success() {
+		return k.failUpload(ctx, upload, ack.GetError())
+	}
+	connectionID := k.packetConnection(ctx, packet, upload.Chunks)
+	if upload.Failed {
+		// the upload already failed through another datachain
+		k.deleteUploadedChunks(ctx, upload, connectionID)
+		return k.settleUpload(ctx, upload)
+	}
+	upload.AckedConnections = append(upload.AckedConnections, connectionID)
+	if upload.Outstanding > 1 {
+		return k.settleUpload(ctx, upload)
+	}
+
+	storedMeta := types.StoredMeta{
+		Index:          upload.Url,
+		Url:            upload.Url,
+		Creator:        upload.Creator,
+		Encryption:     upload.Encryption,
+		Indexes:        upload.Indexes,
+		Placement:      upload.Placement,
+		UploadedChunks: upload.Chunks,
+	}
+	// the url was free or the creator's when the upload started, but another account may have
+	// taken it since
+	previous, err := k.StoredMeta.Get(ctx, storedMeta.Index)
+	replaced := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if replaced && previous.Creator != upload.Creator {
+		return k.failUpload(ctx, upload, "url taken by another account")
+	}
+	if err := k.SetStoredMeta(ctx, storedMeta); err != nil {
+		return err
+	}
+	if err := k.PendingUpload.Remove(ctx, url); err != nil {
+		return err
+	}
+	if replaced {
+		k.releaseReplaced(ctx, previous, storedMeta)
+	}
+	if err := emitStoredMetaSet(ctx, storedMeta, replaced); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChunkUpload,
+			sdk.NewAttribute(types.AttributeKeyUrl, url),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, "true"),
+		),
+	)
+
+	return nil
+}
+
+// OnTimeoutUploadPacket fails the upload the timed out packet belongs to.
+func (k Keeper) OnTimeoutUploadPacket(ctx context.Context, packet channeltypes.Packet) error {
+	url, found, err := k.takeUploadPacket(ctx, packet)
+	if err != nil || !found {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPacketTimedOut{
+		PacketType:    types.EventTypeChunkUpload,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+	}); err != nil {
+		return err
+	}
+
+	upload, err := k.PendingUpload.Get(ctx, url)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return k.failUpload(ctx, upload, "packet timed out")
+}
+
+// takeUploadPacket resolves and forgets the upload url of an interchain account packet.
+// Packets sent by other owners of the controller stack are not tracked and report found=false.
+func (k Keeper) takeUploadPacket(ctx context.Context, packet channeltypes.Packet) (string, bool, error) {
+	key := collections.Join3(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	url, err := k.UploadPacket.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	if err := k.UploadPacket.Remove(ctx, key); err != nil {
+		return "", false, err
+	}
+
+	return url, true, nil
+}
+
+// failUpload fails upload for reason, so no metadata is stored for it, and deletes the chunks
+// the datachains that already acknowledged stored. The upload is kept, failed, until the
+// datachains still outstanding settle.
+func (k Keeper) failUpload(ctx context.Context, upload types.PendingUpload, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChunkUpload,
+			sdk.NewAttribute(types.AttributeKeyUrl, upload.Url),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, "false"),
+			sdk.NewAttribute(types.AttributeKeyAckError, reason),
+		),
+	)
+
+	// an upload another datachain already failed is not failed again
+	if upload.Failed {
+		return k.settleUpload(ctx, upload)
+	}
+	upload.Failed = true
+	for _, connectionID := range upload.AckedConnections {
+		k.deleteUploadedChunks(ctx, upload, connectionID)
+	}
+	if err := k.settleUpload(ctx, upload); err != nil {
+		return err
+	}
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventUploadFailed{
+		Url:     upload.Url,
+		Creator: upload.Creator,
+		Reason:  reason,
+	})
+}
+
+// settleUpload counts one more datachain of upload as settled, and drops the upload once none
+// is outstanding.
+func (k Keeper) settleUpload(ctx context.Context, upload types.PendingUpload) error {
+	if upload.Outstanding > 1 {
+		upload.Outstanding--
+		return k.PendingUpload.Set(ctx, upload.Url, upload)
+	}
+	return k.PendingUpload.Remove(ctx, upload.Url)
+}
+
+// packetConnection returns the datachain among those of chunks that packet was sent to, or ""
+// if the channel of packet is no longer the open channel of any of them.
+func (k Keeper) packetConnection(ctx context.Context, packet channeltypes.Packet, chunks []types.UploadedChunk) string {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, chunk := range chunks {
+		channelID, found := k.icaControllerKeeperFn().GetOpenActiveChannel(sdkCtx, chunk.ConnectionId, packet.SourcePort)
+		if found && channelID == packet.SourceChannel {
+			return chunk.ConnectionId
+		}
+	}
+	return ""
+}
+
+// deleteUploadedChunks deletes the chunks of upload the datachain behind connectionID stored,
+// through the interchain account that wrote them. A datachain the delete cannot be sent to keeps
+// the chunks, so the acknowledgement that got here still succeeds.
+func (k Keeper) deleteUploadedChunks(ctx context.Context, upload types.PendingUpload, connectionID string) {
+	var indexes []string
+	for _, chunk := range upload.Chunks {
+		if chunk.ConnectionId == connectionID {
+			indexes = append(indexes, chunk.Index)
+		}
+	}
+	if len(indexes) == 0 {
+		return
+	}
+
+	k.transmitFragmentDeletes(ctx, upload.Creator, upload.Url, connectionID, indexes)
+}
@@ -29,6 +29,41 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-stored-meta"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod:      "ListStoredMetaByPrefix",
+					Use:            "list-stored-meta-by-prefix [prefix]",
+					Short:          "List the stored-meta whose index starts with a prefix",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "prefix"}},
+				},
+				{
+					RpcMethod:      "ListStoredMetaByFragment",
+					Use:            "list-stored-meta-by-fragment [chunk-index]",
+					Short:          "List the stored-meta that list a chunk",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fragment"}},
+				},
+				{
+					RpcMethod:      "GetCachedChunk",
+					Use:            "get-cached-chunk [channel-id] [index]",
+					Short:          "Gets a chunk kept in the retrieval cache",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "GetDatachain",
+					Use:            "get-datachain [connection-id]",
+					Short:          "Shows the status of a datachain and the fragments referenced on it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "connection_id"}},
+				},
+				{
+					RpcMethod: "ListDatachains",
+					Use:       "list-datachains",
+					Short:     "List the datachains that are draining or retired",
+				},
+				{
+					RpcMethod:      "ListDatachainFragments",
+					Use:            "list-datachain-fragments [connection-id]",
+					Short:          "List the fragments manifests reference on a datachain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "connection_id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -58,6 +93,32 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete stored-meta",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod:      "RegisterDatachainAccount",
+					Use:            "register-datachain-account [connection-id]",
+					Short:          "Open an interchain account on the datachain behind a connection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "connection_id"}},
+				},
+				{
+					RpcMethod: "UploadChunks",
+					Skip:      true, // skipped because it uses a custom command
+				},
+				{
+					RpcMethod: "RetrieveChunks",
+					Skip:      true, // skipped because it uses a custom command
+				},
+				{
+					RpcMethod: "DrainDatachain",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "MigrateFragment",
+					Skip:      true, // skipped because raidchaind migrate-fragments reads the data off the datachain
+				},
+				{
+					RpcMethod: "MoveFragments",
+					Skip:      true, // skipped because raidchaind rebalance reads the data off the datachains
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
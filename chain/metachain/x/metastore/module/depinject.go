@@ -37,7 +37,8 @@ type ModuleInputs struct {
 	AuthKeeper types.AuthKeeper
 	BankKeeper types.BankKeeper
 
-	IBCKeeperFn func() *ibckeeper.Keeper `optional:"true"`
+	IBCKeeperFn           func() *ibckeeper.Keeper         `optional:"true"`
+	ICAControllerKeeperFn func() types.ICAControllerKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -59,6 +60,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 		authority,
 		in.IBCKeeperFn,
+		in.ICAControllerKeeperFn,
 		in.BankKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
@@ -112,7 +113,11 @@ func (im IBCModule) OnRecvPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	var ack channeltypes.Acknowledgement
+	var (
+		packetAck gogoproto.Message
+		eventType string
+		recvErr   error
+	)
 
 	var modulePacketData types.MetastorePacketData
 	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
@@ -122,35 +127,56 @@ func (im IBCModule) OnRecvPacket(
 	// Dispatch packet
 	switch packet := modulePacketData.Packet.(type) {
 	case *types.MetastorePacketData_MetadataPacket:
-		packetAck, err := im.keeper.OnRecvMetadataPacket(ctx, modulePacket, *packet.MetadataPacket)
-		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
-		} else {
-			// Encode packet acknowledgment
-			packetAckBytes, err := im.cdc.MarshalJSON(&packetAck)
-			if err != nil {
-				return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
-			}
-			ack = channeltypes.NewResultAcknowledgement(packetAckBytes)
-		}
-
-		sdkCtx := sdk.UnwrapSDKContext(ctx)
-		sdkCtx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeMetadataPacket,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
-			),
-		)
-
+		eventType = types.EventTypeMetadataPacket
+		metadataAck, err := im.keeper.OnRecvMetadataPacket(ctx, modulePacket, *packet.MetadataPacket)
+		packetAck, recvErr = &metadataAck, err
+	case *types.MetastorePacketData_RetrievalPacket:
+		eventType = types.EventTypeChunkRetrieval
+		retrievalAck, err := im.keeper.OnRecvChunkRetrievalPacket(ctx, modulePacket, *packet.RetrievalPacket)
+		packetAck, recvErr = &retrievalAck, err
+	case *types.MetastorePacketData_ReleasePacket:
+		eventType = types.EventTypeChunkRelease
+		releaseAck, err := im.keeper.OnRecvChunkReleasePacket(ctx, modulePacket, *packet.ReleasePacket)
+		packetAck, recvErr = &releaseAck, err
 		// this line is used by starport scaffolding # ibc/packet/module/recv
 	default:
 		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packet)
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", recvErr == nil)),
+		),
+	)
+
+	event := &types.EventPacketReceived{
+		PacketType:    eventType,
+		SourceChannel: modulePacket.SourceChannel,
+		Sequence:      modulePacket.Sequence,
+		Success:       recvErr == nil,
+	}
+	if recvErr != nil {
+		event.Error = recvErr.Error()
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if recvErr != nil {
+		return channeltypes.NewErrorAcknowledgement(recvErr)
+	}
+
+	// Encode packet acknowledgment using the binary codec
+	packetAckBytes, err := im.cdc.Marshal(packetAck)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+	}
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
-	return ack
+	return channeltypes.NewResultAcknowledgement(packetAckBytes)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -181,6 +207,18 @@ func (im IBCModule) OnAcknowledgementPacket(
 			return err
 		}
 		eventType = types.EventTypeMetadataPacket
+	case *types.MetastorePacketData_RetrievalPacket:
+		err := im.keeper.OnAcknowledgementChunkRetrievalPacket(ctx, modulePacket, *packet.RetrievalPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeChunkRetrieval
+	case *types.MetastorePacketData_ReleasePacket:
+		err := im.keeper.OnAcknowledgementChunkReleasePacket(ctx, modulePacket, *packet.ReleasePacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeChunkRelease
 		// this line is used by starport scaffolding # ibc/packet/module/ack
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -211,7 +249,13 @@ func (im IBCModule) OnAcknowledgementPacket(
 		)
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventPacketAcknowledged{
+		PacketType:    eventType,
+		SourceChannel: modulePacket.SourceChannel,
+		Sequence:      modulePacket.Sequence,
+		Success:       ack.Success(),
+		Error:         ack.GetError(),
+	})
 }
 
 // OnTimeoutPacket implements the IBCModule interface
@@ -226,6 +270,8 @@ func (im IBCModule) OnTimeoutPacket(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
 	}
 
+	var eventType string
+
 	// Dispatch packet
 	switch packet := modulePacketData.Packet.(type) {
 	case *types.MetastorePacketData_MetadataPacket:
@@ -233,11 +279,28 @@ func (im IBCModule) OnTimeoutPacket(
 		if err != nil {
 			return err
 		}
+		eventType = types.EventTypeMetadataPacket
+	case *types.MetastorePacketData_RetrievalPacket:
+		err := im.keeper.OnTimeoutChunkRetrievalPacket(ctx, modulePacket, *packet.RetrievalPacket)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeChunkRetrieval
+	case *types.MetastorePacketData_ReleasePacket:
+		err := im.keeper.OnTimeoutChunkReleasePacket(ctx, modulePacket, *packet.ReleasePacket)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeChunkRelease
 		// this line is used by starport scaffolding # ibc/packet/module/timeout
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
 		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventPacketTimedOut{
+		PacketType:    eventType,
+		SourceChannel: modulePacket.SourceChannel,
+		Sequence:      modulePacket.Sequence,
+	})
 }
@@ -0,0 +1,158 @@
+package metastore_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
+	"github.com/stretchr/testify/require"
+
+	"metachain/testutil/sample"
+	"metachain/x/metastore/keeper"
+	metastore "metachain/x/metastore/module"
+	"metachain/x/metastore/types"
+)
+
+// initKeeper returns a metastore keeper on a fresh store.
+func initKeeper(t *testing.T) (sdk.Context, keeper.Keeper, codec.Codec) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(metastore.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		func() *ibckeeper.Keeper { return nil },
+		func() types.ICAControllerKeeper { return nil },
+		nil,
+	)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+
+	return ctx, k, encCfg.Codec
+}
+
+// modulePackets are one packet of every type the metastore sends. It receives none of them.
+var modulePackets = []struct {
+	name      string
+	data      types.MetastorePacketData
+	eventType string
+}{
+	{
+		name:      "metadata",
+		data:      types.MetastorePacketData{Packet: &types.MetastorePacketData_MetadataPacket{MetadataPacket: &types.MetadataPacketData{Url: "site/a", Addresses: []string{"idx1"}, Creator: sample.AccAddress()}}},
+		eventType: types.EventTypeMetadataPacket,
+	},
+	{
+		name:      "retrieval",
+		data:      types.MetastorePacketData{Packet: &types.MetastorePacketData_RetrievalPacket{RetrievalPacket: &types.ChunkRetrievalPacketData{Indexes: []string{"idx1"}, Requester: sample.AccAddress()}}},
+		eventType: types.EventTypeChunkRetrieval,
+	},
+	{
+		name:      "release",
+		data:      types.MetastorePacketData{Packet: &types.MetastorePacketData_ReleasePacket{ReleasePacket: &types.ChunkReleasePacketData{Url: "site/a", Addresses: []string{"idx1"}}}},
+		eventType: types.EventTypeChunkRelease,
+	},
+}
+
+// typedEvents returns the typed events emitted on ctx, in order.
+func typedEvents(ctx sdk.Context) []proto.Message {
+	var events []proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			// not a typed event
+			continue
+		}
+		events = append(events, msg)
+	}
+	return events
+}
+
+// packetReceived returns the only EventPacketReceived emitted on ctx.
+func packetReceived(t *testing.T, ctx sdk.Context) *types.EventPacketReceived {
+	t.Helper()
+
+	var received []*types.EventPacketReceived
+	for _, msg := range typedEvents(ctx) {
+		if event, ok := msg.(*types.EventPacketReceived); ok {
+			received = append(received, event)
+		}
+	}
+	require.Len(t, received, 1)
+	return received[0]
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	for _, tc := range modulePackets {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, cdc := initKeeper(t)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			data, err := tc.data.Marshal()
+			require.NoError(t, err)
+			packet := channeltypes.Packet{Sequence: 7, SourcePort: types.PortID, SourceChannel: "channel-0", DestinationPort: types.PortID, DestinationChannel: "channel-1", Data: data}
+
+			ack := metastore.NewIBCModule(cdc, k).OnRecvPacket(ctx, types.Version, packet, nil)
+			require.False(t, ack.Success())
+
+			event := packetReceived(t, ctx)
+			require.Equal(t, tc.eventType, event.PacketType)
+			require.Equal(t, "channel-0", event.SourceChannel)
+			require.Equal(t, uint64(7), event.Sequence)
+			require.False(t, event.Success)
+			require.NotEmpty(t, event.Error)
+		})
+	}
+}
+
+func TestOnRecvPacketUndecodable(t *testing.T) {
+	ctx, k, cdc := initKeeper(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	packet := channeltypes.Packet{Sequence: 1, SourceChannel: "channel-0", Data: []byte("not a packet")}
+
+	ack := metastore.NewIBCModule(cdc, k).OnRecvPacket(ctx, types.Version, packet, nil)
+	require.False(t, ack.Success())
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestOnAcknowledgementAndTimeoutPacket(t *testing.T) {
+	for _, tc := range modulePackets {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, cdc := initKeeper(t)
+			im := metastore.NewIBCModule(cdc, k)
+			data, err := tc.data.Marshal()
+			require.NoError(t, err)
+			packet := channeltypes.Packet{Sequence: 3, SourceChannel: "channel-0", Data: data}
+
+			// error acks are cleared without touching state
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			ack := channeltypes.NewErrorAcknowledgement(types.ErrPacketFailed)
+			require.NoError(t, im.OnAcknowledgementPacket(ctx, types.Version, packet, ack.Acknowledgement(), nil))
+			require.Contains(t, typedEvents(ctx), &types.EventPacketAcknowledged{
+				PacketType:    tc.eventType,
+				SourceChannel: "channel-0",
+				Sequence:      3,
+				Error:         ack.GetError(),
+			})
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			require.NoError(t, im.OnTimeoutPacket(ctx, types.Version, packet, nil))
+			require.Contains(t, typedEvents(ctx), &types.EventPacketTimedOut{PacketType: tc.eventType, SourceChannel: "channel-0", Sequence: 3})
+
+			require.Error(t, im.OnAcknowledgementPacket(ctx, types.Version, packet, []byte("not an ack"), nil))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package metastore_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/stretchr/testify/require"
+
+	"metachain/testutil/sample"
+	metastore "metachain/x/metastore/module"
+	"metachain/x/metastore/types"
+)
+
+func payload(t *testing.T, data types.MetastorePacketData) channeltypesv2.Payload {
+	t.Helper()
+
+	value, err := data.Marshal()
+	require.NoError(t, err)
+	return channeltypesv2.NewPayload(types.PortID, types.DatastorePortID, types.Version, types.EncodingProtobuf, value)
+}
+
+func TestOnRecvPacketV2(t *testing.T) {
+	for _, tc := range modulePackets {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, cdc := initKeeper(t)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			result := metastore.NewIBCModuleV2(cdc, k).OnRecvPacket(ctx, "07-tendermint-0", "07-tendermint-1", 7, payload(t, tc.data), nil)
+			require.Equal(t, channeltypesv2.PacketStatus_Failure, result.Status)
+
+			event := packetReceived(t, ctx)
+			require.Equal(t, tc.eventType, event.PacketType)
+			require.Equal(t, "07-tendermint-0", event.SourceChannel)
+			require.Equal(t, uint64(7), event.Sequence)
+			require.False(t, event.Success)
+			require.NotEmpty(t, event.Error)
+		})
+	}
+}
+
+func TestOnRecvPacketV2Payload(t *testing.T) {
+	valid := payload(t, modulePackets[0].data)
+	for _, tc := range []struct {
+		name   string
+		modify func(*channeltypesv2.Payload)
+	}{
+		{name: "version", modify: func(p *channeltypesv2.Payload) { p.Version = "ibc-proto-0" }},
+		{name: "encoding", modify: func(p *channeltypesv2.Payload) { p.Encoding = "application/json" }},
+		{name: "value", modify: func(p *channeltypesv2.Payload) { p.Value = []byte("not a packet") }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, cdc := initKeeper(t)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			p := valid
+			tc.modify(&p)
+
+			result := metastore.NewIBCModuleV2(cdc, k).OnRecvPacket(ctx, "07-tendermint-0", "07-tendermint-1", 1, p, nil)
+			require.Equal(t, channeltypesv2.PacketStatus_Failure, result.Status)
+			require.Empty(t, ctx.EventManager().Events())
+		})
+	}
+}
+
+func TestOnSendPacketV2(t *testing.T) {
+	creator := sample.AccAddress()
+	creatorAddr := sdk.MustAccAddressFromBech32(creator)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	for _, tc := range []struct {
+		name   string
+		data   types.MetastorePacketData
+		signer sdk.AccAddress
+		err    error
+	}{
+		{
+			name:   "metadata by its creator",
+			data:   types.MetastorePacketData{Packet: &types.MetastorePacketData_MetadataPacket{MetadataPacket: &types.MetadataPacketData{Url: "site/a", Addresses: []string{"idx1"}, Creator: creator}}},
+			signer: creatorAddr,
+		},
+		{
+			name:   "metadata by another account",
+			data:   types.MetastorePacketData{Packet: &types.MetastorePacketData_MetadataPacket{MetadataPacket: &types.MetadataPacketData{Url: "site/a", Addresses: []string{"idx1"}, Creator: sample.AccAddress()}}},
+			signer: creatorAddr,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "retrieval by its requester",
+			data:   types.MetastorePacketData{Packet: &types.MetastorePacketData_RetrievalPacket{RetrievalPacket: &types.ChunkRetrievalPacketData{Indexes: []string{"idx1"}, Requester: creator}}},
+			signer: creatorAddr,
+		},
+		{
+			name:   "release by the module",
+			data:   types.MetastorePacketData{Packet: &types.MetastorePacketData_ReleasePacket{ReleasePacket: &types.ChunkReleasePacketData{Url: "site/a", Addresses: []string{"idx1"}}}},
+			signer: moduleAddr,
+		},
+		{
+			name:   "release by an account",
+			data:   types.MetastorePacketData{Packet: &types.MetastorePacketData_ReleasePacket{ReleasePacket: &types.ChunkReleasePacketData{Url: "site/a", Addresses: []string{"idx1"}}}},
+			signer: creatorAddr,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, cdc := initKeeper(t)
+
+			err := metastore.NewIBCModuleV2(cdc, k).OnSendPacket(ctx, "07-tendermint-0", "07-tendermint-1", 1, payload(t, tc.data), tc.signer)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestOnAcknowledgementAndTimeoutPacketV2(t *testing.T) {
+	for _, tc := range modulePackets {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, cdc := initKeeper(t)
+			im := metastore.NewIBCModuleV2(cdc, k)
+			p := payload(t, tc.data)
+			failed := channeltypes.NewErrorAcknowledgement(types.ErrPacketFailed)
+
+			// IBC v2 error acks are a sentinel without the reason
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			require.NoError(t, im.OnAcknowledgementPacket(ctx, "07-tendermint-0", "07-tendermint-1", 3, channeltypesv2.ErrorAcknowledgement[:], p, nil))
+			require.Contains(t, typedEvents(ctx), &types.EventPacketAcknowledged{
+				PacketType:    tc.eventType,
+				SourceChannel: "07-tendermint-0",
+				Sequence:      3,
+				Error:         failed.GetError(),
+			})
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			require.NoError(t, im.OnTimeoutPacket(ctx, "07-tendermint-0", "07-tendermint-1", 3, p, nil))
+			require.Contains(t, typedEvents(ctx), &types.EventPacketTimedOut{PacketType: tc.eventType, SourceChannel: "07-tendermint-0", Sequence: 3})
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package metastore
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"metachain/x/metastore/keeper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = ICAAuthModule{}
+
+// ICAAuthModule is the authentication application under the interchain accounts controller
+// middleware. It receives the acknowledgements and timeouts of chunk writes sent through
+// accounts registered with MsgRegisterDatachainAccount, by uploads and fragment migrations and moves.
+type ICAAuthModule struct {
+	cdc    codec.Codec
+	keeper keeper.Keeper
+}
+
+// NewICAAuthModule creates a new ICAAuthModule given the associated keeper
+func NewICAAuthModule(cdc codec.Codec, k keeper.Keeper) ICAAuthModule {
+	return ICAAuthModule{
+		cdc:    cdc,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im ICAAuthModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	// the controller middleware owns the version, it is returned unchanged
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im ICAAuthModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im ICAAuthModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyChannelID,
+	counterpartyVersion string,
+) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im ICAAuthModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im ICAAuthModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im ICAAuthModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface
+func (im ICAAuthModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im ICAAuthModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	modulePacket channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := im.cdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	// each handler ignores the packets it did not send
+	if err := im.keeper.OnAcknowledgementMigrationPacket(ctx, modulePacket, ack); err != nil {
+		return err
+	}
+	if err := im.keeper.OnAcknowledgementMovePacket(ctx, modulePacket, ack); err != nil {
+		return err
+	}
+	return im.keeper.OnAcknowledgementUploadPacket(ctx, modulePacket, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im ICAAuthModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.keeper.OnTimeoutMigrationPacket(ctx, modulePacket); err != nil {
+		return err
+	}
+	if err := im.keeper.OnTimeoutMovePacket(ctx, modulePacket); err != nil {
+		return err
+	}
+	return im.keeper.OnTimeoutUploadPacket(ctx, modulePacket)
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgChunkBytes returns the chunk data uploaded by msg to the datachains, 0 for the messages that
+// upload none.
+func MsgChunkBytes(msg sdk.Msg) uint64 {
+	var n uint64
+	switch msg := msg.(type) {
+	case *MsgUploadChunks:
+		for _, chunk := range msg.Chunks {
+			n += uint64(len(chunk.Data))
+		}
+	case *MsgMigrateFragment:
+		n = uint64(len(msg.Data))
+	case *MsgMoveFragments:
+		for _, move := range msg.Moves {
+			n += uint64(len(move.Data))
+		}
+	}
+	return n
+}
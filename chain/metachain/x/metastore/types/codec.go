@@ -17,8 +17,17 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgSendMetadata{},
 	)
 
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterDatachainAccount{},
+		&MsgUploadChunks{},
+		&MsgRetrieveChunks{},
+		&MsgMigrateFragment{},
+		&MsgMoveFragments{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgDrainDatachain{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
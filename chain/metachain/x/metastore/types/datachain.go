@@ -0,0 +1,6 @@
+package types
+
+// Active reports whether the datachain takes new chunks.
+func (d Datachain) Active() bool {
+	return d.Status == DatachainStatus_DATACHAIN_STATUS_ACTIVE
+}
@@ -0,0 +1,1646 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: metachain/metastore/v1/datachain.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DatachainStatus is what the metastore may do with a datachain.
+type DatachainStatus int32
+
+const (
+	// DATACHAIN_STATUS_ACTIVE datachains take new chunks.
+	DatachainStatus_DATACHAIN_STATUS_ACTIVE DatachainStatus = 0
+	// DATACHAIN_STATUS_DRAINING datachains are read-only while their fragments
+	// are migrated to active datachains.
+	DatachainStatus_DATACHAIN_STATUS_DRAINING DatachainStatus = 1
+	// DATACHAIN_STATUS_RETIRED datachains hold no referenced fragment anymore.
+	DatachainStatus_DATACHAIN_STATUS_RETIRED DatachainStatus = 2
+)
+
+var DatachainStatus_name = map[int32]string{
+	0: "DATACHAIN_STATUS_ACTIVE",
+	1: "DATACHAIN_STATUS_DRAINING",
+	2: "DATACHAIN_STATUS_RETIRED",
+}
+
+var DatachainStatus_value = map[string]int32{
+	"DATACHAIN_STATUS_ACTIVE":   0,
+	"DATACHAIN_STATUS_DRAINING": 1,
+	"DATACHAIN_STATUS_RETIRED":  2,
+}
+
+func (x DatachainStatus) String() string {
+	return proto.EnumName(DatachainStatus_name, int32(x))
+}
+
+func (DatachainStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5c34651591bf78fd, []int{0}
+}
+
+// Datachain is the status of the datachain behind a connection. Datachains
+// without one are active.
+type Datachain struct {
+	ConnectionId string          `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Status       DatachainStatus `protobuf:"varint,2,opt,name=status,proto3,enum=metachain.metastore.v1.DatachainStatus" json:"status,omitempty"`
+	// drain_fragments is the number of fragments the datachain held when its
+	// drain started.
+	DrainFragments uint64 `protobuf:"varint,3,opt,name=drain_fragments,json=drainFragments,proto3" json:"drain_fragments,omitempty"`
+	// migrated_fragments is the number of fragments copied to other datachains
+	// since.
+	MigratedFragments uint64 `protobuf:"varint,4,opt,name=migrated_fragments,json=migratedFragments,proto3" json:"migrated_fragments,omitempty"`
+}
+
+func (m *Datachain) Reset()         { *m = Datachain{} }
+func (m *Datachain) String() string { return proto.CompactTextString(m) }
+func (*Datachain) ProtoMessage()    {}
+func (*Datachain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c34651591bf78fd, []int{0}
+}
+func (m *Datachain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Datachain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Datachain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Datachain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Datachain.Merge(m, src)
+}
+func (m *Datachain) XXX_Size() int {
+	return m.Size()
+}
+func (m *Datachain) XXX_DiscardUnknown() {
+	xxx_messageInfo_Datachain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Datachain proto.InternalMessageInfo
+
+func (m *Datachain) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *Datachain) GetStatus() DatachainStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DatachainStatus_DATACHAIN_STATUS_ACTIVE
+}
+
+func (m *Datachain) GetDrainFragments() uint64 {
+	if m != nil {
+		return m.DrainFragments
+	}
+	return 0
+}
+
+func (m *Datachain) GetMigratedFragments() uint64 {
+	if m != nil {
+		return m.MigratedFragments
+	}
+	return 0
+}
+
+// FragmentMigration is a fragment copy in flight to another datachain.
+type FragmentMigration struct {
+	Url              string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Index            string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	FromConnectionId string `protobuf:"bytes,3,opt,name=from_connection_id,json=fromConnectionId,proto3" json:"from_connection_id,omitempty"`
+	ToConnectionId   string `protobuf:"bytes,4,opt,name=to_connection_id,json=toConnectionId,proto3" json:"to_connection_id,omitempty"`
+}
+
+func (m *FragmentMigration) Reset()         { *m = FragmentMigration{} }
+func (m *FragmentMigration) String() string { return proto.CompactTextString(m) }
+func (*FragmentMigration) ProtoMessage()    {}
+func (*FragmentMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c34651591bf78fd, []int{1}
+}
+func (m *FragmentMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FragmentMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FragmentMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FragmentMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FragmentMigration.Merge(m, src)
+}
+func (m *FragmentMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *FragmentMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_FragmentMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FragmentMigration proto.InternalMessageInfo
+
+func (m *FragmentMigration) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *FragmentMigration) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *FragmentMigration) GetFromConnectionId() string {
+	if m != nil {
+		return m.FromConnectionId
+	}
+	return ""
+}
+
+func (m *FragmentMigration) GetToConnectionId() string {
+	if m != nil {
+		return m.ToConnectionId
+	}
+	return ""
+}
+
+// PendingMove tracks the fragments of a manifest copied by MsgMoveFragments
+// until every datachain they went to has acknowledged them.
+type PendingMove struct {
+	Url         string              `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Creator     string              `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Moves       []FragmentMigration `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves"`
+	Outstanding uint32              `protobuf:"varint,4,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	// id tells the packets of this move from those of an earlier move of the
+	// same manifest that failed.
+	Id uint64 `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *PendingMove) Reset()         { *m = PendingMove{} }
+func (m *PendingMove) String() string { return proto.CompactTextString(m) }
+func (*PendingMove) ProtoMessage()    {}
+func (*PendingMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c34651591bf78fd, []int{2}
+}
+func (m *PendingMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMove.Merge(m, src)
+}
+func (m *PendingMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMove proto.InternalMessageInfo
+
+func (m *PendingMove) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *PendingMove) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PendingMove) GetMoves() []FragmentMigration {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+func (m *PendingMove) GetOutstanding() uint32 {
+	if m != nil {
+		return m.Outstanding
+	}
+	return 0
+}
+
+func (m *PendingMove) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MovePacketRef is the pending move an in-flight interchain account packet
+// copies fragments for.
+type MovePacketRef struct {
+	Url    string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	MoveId uint64 `protobuf:"varint,2,opt,name=move_id,json=moveId,proto3" json:"move_id,omitempty"`
+}
+
+func (m *MovePacketRef) Reset()         { *m = MovePacketRef{} }
+func (m *MovePacketRef) String() string { return proto.CompactTextString(m) }
+func (*MovePacketRef) ProtoMessage()    {}
+func (*MovePacketRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c34651591bf78fd, []int{3}
+}
+func (m *MovePacketRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MovePacketRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MovePacketRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MovePacketRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MovePacketRef.Merge(m, src)
+}
+func (m *MovePacketRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *MovePacketRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_MovePacketRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MovePacketRef proto.InternalMessageInfo
+
+func (m *MovePacketRef) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *MovePacketRef) GetMoveId() uint64 {
+	if m != nil {
+		return m.MoveId
+	}
+	return 0
+}
+
+// DatachainFragment is a fragment a manifest references on a datachain.
+type DatachainFragment struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Index        string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// url is the index of the manifest referencing it.
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (m *DatachainFragment) Reset()         { *m = DatachainFragment{} }
+func (m *DatachainFragment) String() string { return proto.CompactTextString(m) }
+func (*DatachainFragment) ProtoMessage()    {}
+func (*DatachainFragment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c34651591bf78fd, []int{4}
+}
+func (m *DatachainFragment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatachainFragment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatachainFragment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatachainFragment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatachainFragment.Merge(m, src)
+}
+func (m *DatachainFragment) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatachainFragment) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatachainFragment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatachainFragment proto.InternalMessageInfo
+
+func (m *DatachainFragment) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *DatachainFragment) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *DatachainFragment) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("metachain.metastore.v1.DatachainStatus", DatachainStatus_name, DatachainStatus_value)
+	proto.RegisterType((*Datachain)(nil), "metachain.metastore.v1.Datachain")
+	proto.RegisterType((*FragmentMigration)(nil), "metachain.metastore.v1.FragmentMigration")
+	proto.RegisterType((*PendingMove)(nil), "metachain.metastore.v1.PendingMove")
+	proto.RegisterType((*MovePacketRef)(nil), "metachain.metastore.v1.MovePacketRef")
+	proto.RegisterType((*DatachainFragment)(nil), "metachain.metastore.v1.DatachainFragment")
+}
+
+func init() {
+	proto.RegisterFile("metachain/metastore/v1/datachain.proto", fileDescriptor_5c34651591bf78fd)
+}
+
+var fileDescriptor_5c34651591bf78fd = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xc7, 0xe3, 0x7c, 0x55, 0x99, 0x90, 0x74, 0x63, 0x55, 0x74, 0xa1, 0xb0, 0x44, 0x41, 0xa2,
+	0x01, 0x41, 0xa2, 0x16, 0x71, 0xe1, 0x82, 0xb6, 0x49, 0x80, 0x3d, 0x34, 0xaa, 0x9c, 0xc0, 0x81,
+	0x4b, 0x30, 0xb1, 0x13, 0xac, 0x12, 0xbb, 0xda, 0x75, 0xa2, 0xf2, 0x16, 0x48, 0xbc, 0x09, 0x4f,
+	0x51, 0x6e, 0x3d, 0x72, 0x42, 0x28, 0x79, 0x11, 0xb4, 0x4e, 0x76, 0xbb, 0x34, 0x41, 0xea, 0xcd,
+	0x33, 0xf3, 0xf3, 0xcc, 0x7f, 0xc6, 0x1e, 0x78, 0x34, 0xe1, 0x9a, 0x0e, 0x3f, 0x53, 0x21, 0x9b,
+	0xe1, 0x29, 0xd0, 0xca, 0xe7, 0xcd, 0xd9, 0x41, 0x93, 0xd1, 0x95, 0xbb, 0x71, 0xe6, 0x2b, 0xad,
+	0xf0, 0xed, 0x98, 0x6b, 0xc4, 0x5c, 0x63, 0x76, 0x70, 0x77, 0x67, 0xac, 0xc6, 0xca, 0x20, 0xcd,
+	0xf0, 0xb4, 0xa4, 0x6b, 0x3f, 0x11, 0x14, 0xda, 0x51, 0x06, 0xfc, 0x10, 0x4a, 0x43, 0x25, 0x25,
+	0x1f, 0x6a, 0xa1, 0xe4, 0x40, 0x30, 0x1b, 0x55, 0x51, 0xbd, 0x40, 0x6e, 0x5d, 0x39, 0x3d, 0x86,
+	0x5f, 0x41, 0x3e, 0xd0, 0x54, 0x4f, 0x03, 0x3b, 0x5d, 0x45, 0xf5, 0xf2, 0xe1, 0x7e, 0x63, 0x73,
+	0xc5, 0x46, 0x9c, 0xb7, 0x67, 0x70, 0xb2, 0xba, 0x86, 0xf7, 0x61, 0x9b, 0xf9, 0x54, 0xc8, 0xc1,
+	0xc8, 0xa7, 0xe3, 0x09, 0x97, 0x3a, 0xb0, 0x33, 0x55, 0x54, 0xcf, 0x92, 0xb2, 0x71, 0xbf, 0x8e,
+	0xbc, 0xf8, 0x19, 0xe0, 0x89, 0x18, 0xfb, 0x54, 0x73, 0x96, 0x60, 0xb3, 0x86, 0xad, 0x44, 0x91,
+	0x18, 0xaf, 0x7d, 0x47, 0x50, 0x89, 0xac, 0x63, 0x13, 0x15, 0x4a, 0x62, 0x0b, 0x32, 0x53, 0xff,
+	0xcb, 0xaa, 0x93, 0xf0, 0x88, 0x77, 0x20, 0x27, 0x24, 0xe3, 0xe7, 0x46, 0x7f, 0x81, 0x2c, 0x0d,
+	0xfc, 0x14, 0xf0, 0xc8, 0x57, 0x93, 0xc1, 0xbf, 0x03, 0xc8, 0x18, 0xc4, 0x0a, 0x23, 0xad, 0xe4,
+	0x10, 0xea, 0x60, 0x69, 0x75, 0x8d, 0xcd, 0x1a, 0xb6, 0xac, 0x55, 0x92, 0xac, 0xfd, 0x40, 0x50,
+	0x3c, 0xe1, 0x92, 0x09, 0x39, 0x3e, 0x56, 0x33, 0xbe, 0x41, 0x8f, 0x0d, 0x5b, 0x43, 0x9f, 0x53,
+	0xad, 0xfc, 0x95, 0xa2, 0xc8, 0xc4, 0x1d, 0xc8, 0x4d, 0xd4, 0x8c, 0x87, 0xf3, 0xc9, 0xd4, 0x8b,
+	0x87, 0x8f, 0xff, 0x37, 0xe9, 0xb5, 0xae, 0x8f, 0xb2, 0x17, 0xbf, 0x1f, 0xa4, 0xc8, 0xf2, 0x36,
+	0xae, 0x42, 0x51, 0x4d, 0x75, 0xa0, 0xa9, 0x51, 0x61, 0x74, 0x96, 0x48, 0xd2, 0x85, 0xcb, 0x90,
+	0x16, 0xcc, 0xce, 0x99, 0xc9, 0xa6, 0x05, 0xab, 0xbd, 0x84, 0x52, 0x28, 0xf6, 0x84, 0x0e, 0x4f,
+	0xb9, 0x26, 0x7c, 0xb4, 0x41, 0xf5, 0x2e, 0x6c, 0x85, 0xd9, 0xc3, 0xc6, 0xd3, 0xe6, 0x5e, 0x3e,
+	0x34, 0x3d, 0x56, 0xfb, 0x08, 0x95, 0xf8, 0xe5, 0x23, 0x61, 0x37, 0xfb, 0x59, 0x9b, 0x1f, 0x66,
+	0x55, 0x3a, 0x13, 0x97, 0x7e, 0x72, 0x0a, 0xdb, 0xd7, 0xfe, 0x16, 0xde, 0x83, 0xdd, 0xb6, 0xdb,
+	0x77, 0x5b, 0x6f, 0x5d, 0xaf, 0x3b, 0xe8, 0xf5, 0xdd, 0xfe, 0xbb, 0xde, 0xc0, 0x6d, 0xf5, 0xbd,
+	0xf7, 0x1d, 0x2b, 0x85, 0xef, 0xc3, 0x9d, 0xb5, 0x60, 0x9b, 0xb8, 0x5e, 0xd7, 0xeb, 0xbe, 0xb1,
+	0x10, 0xbe, 0x07, 0xf6, 0x5a, 0x98, 0x74, 0xfa, 0x1e, 0xe9, 0xb4, 0xad, 0xf4, 0xd1, 0x8b, 0x8b,
+	0xb9, 0x83, 0x2e, 0xe7, 0x0e, 0xfa, 0x33, 0x77, 0xd0, 0xb7, 0x85, 0x93, 0xba, 0x5c, 0x38, 0xa9,
+	0x5f, 0x0b, 0x27, 0xf5, 0x61, 0xef, 0x6a, 0x23, 0xcf, 0x13, 0x3b, 0xa9, 0xbf, 0x9e, 0xf1, 0xe0,
+	0x53, 0xde, 0xec, 0xd7, 0xf3, 0xbf, 0x03, 0x00, 0x2b, 0x79, 0x56, 0x57, 0xb7, 0x03, 0x00, 0x00,
+}
+
+func (m *Datachain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Datachain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Datachain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigratedFragments != 0 {
+		i = encodeVarintDatachain(dAtA, i, uint64(m.MigratedFragments))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DrainFragments != 0 {
+		i = encodeVarintDatachain(dAtA, i, uint64(m.DrainFragments))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintDatachain(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FragmentMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FragmentMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FragmentMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToConnectionId) > 0 {
+		i -= len(m.ToConnectionId)
+		copy(dAtA[i:], m.ToConnectionId)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.ToConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromConnectionId) > 0 {
+		i -= len(m.FromConnectionId)
+		copy(dAtA[i:], m.FromConnectionId)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.FromConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintDatachain(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Outstanding != 0 {
+		i = encodeVarintDatachain(dAtA, i, uint64(m.Outstanding))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Moves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDatachain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MovePacketRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MovePacketRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MovePacketRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MoveId != 0 {
+		i = encodeVarintDatachain(dAtA, i, uint64(m.MoveId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DatachainFragment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatachainFragment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatachainFragment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintDatachain(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDatachain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDatachain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Datachain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovDatachain(uint64(m.Status))
+	}
+	if m.DrainFragments != 0 {
+		n += 1 + sovDatachain(uint64(m.DrainFragments))
+	}
+	if m.MigratedFragments != 0 {
+		n += 1 + sovDatachain(uint64(m.MigratedFragments))
+	}
+	return n
+}
+
+func (m *FragmentMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	l = len(m.FromConnectionId)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	l = len(m.ToConnectionId)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovDatachain(uint64(l))
+		}
+	}
+	if m.Outstanding != 0 {
+		n += 1 + sovDatachain(uint64(m.Outstanding))
+	}
+	if m.Id != 0 {
+		n += 1 + sovDatachain(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MovePacketRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	if m.MoveId != 0 {
+		n += 1 + sovDatachain(uint64(m.MoveId))
+	}
+	return n
+}
+
+func (m *DatachainFragment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovDatachain(uint64(l))
+	}
+	return n
+}
+
+func sovDatachain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDatachain(x uint64) (n int) {
+	return sovDatachain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Datachain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDatachain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Datachain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Datachain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DatachainStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainFragments", wireType)
+			}
+			m.DrainFragments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrainFragments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedFragments", wireType)
+			}
+			m.MigratedFragments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedFragments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDatachain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FragmentMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDatachain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FragmentMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FragmentMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDatachain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDatachain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, FragmentMigration{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			m.Outstanding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outstanding |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDatachain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MovePacketRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDatachain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MovePacketRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MovePacketRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveId", wireType)
+			}
+			m.MoveId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDatachain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatachainFragment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDatachain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatachainFragment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatachainFragment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDatachain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDatachain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDatachain(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDatachain
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDatachain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDatachain
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDatachain
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDatachain
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDatachain        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDatachain          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDatachain = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,181 @@
+package types
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+)
+
+const (
+	// EncryptionScheme seals chunks with AES-256-GCM under the data key of a resource and wraps
+	// the data key with AES-256-GCM under a key derived by HKDF-SHA256 from an X25519 agreement.
+	EncryptionScheme = "x25519-hkdf-sha256/aes-256-gcm"
+
+	// DataKeySize is the size of the data key of a resource.
+	DataKeySize = 32
+
+	keyWrapInfo = "metachain/metastore wrapped data key"
+)
+
+// NewDataKey returns a random data key for the chunks of one resource.
+func NewDataKey() ([]byte, error) {
+	key := make([]byte, DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// EncryptChunk seals the data of the chunk stored at index. The output is the random nonce
+// followed by the ciphertext; the index is authenticated, so a chunk cannot be swapped for
+// another chunk of the resource.
+func EncryptChunk(dataKey []byte, index string, data []byte) ([]byte, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, data, []byte(index)), nil
+}
+
+// DecryptChunk opens a chunk sealed by EncryptChunk.
+func DecryptChunk(dataKey []byte, index string, sealed []byte) ([]byte, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize()+aead.Overhead() {
+		return nil, errors.Wrapf(ErrInvalidEncryption, "chunk %s is too short", index)
+	}
+	data, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(index))
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidEncryption, "chunk %s: %s", index, err)
+	}
+	return data, nil
+}
+
+// NewEncryption wraps dataKey for every recipient.
+func NewEncryption(dataKey []byte, recipients []*ecdh.PublicKey) (*Encryption, error) {
+	if len(recipients) == 0 {
+		return nil, errors.Wrap(ErrInvalidEncryption, "no recipients")
+	}
+
+	encryption := &Encryption{Scheme: EncryptionScheme}
+	for _, recipient := range recipients {
+		ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		kek, err := keyEncryptionKey(ephemeral, recipient)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := newAEAD(kek)
+		if err != nil {
+			return nil, err
+		}
+
+		// every key encryption key is used once, a zero nonce is safe
+		nonce := make([]byte, aead.NonceSize())
+		encryption.WrappedKeys = append(encryption.WrappedKeys, WrappedKey{
+			Recipient:    recipient.Bytes(),
+			EphemeralKey: ephemeral.PublicKey().Bytes(),
+			WrappedKey:   aead.Seal(nil, nonce, dataKey, nil),
+		})
+	}
+
+	return encryption, nil
+}
+
+// UnwrapDataKey returns the data key wrapped for the public key of key.
+func (e Encryption) UnwrapDataKey(key *ecdh.PrivateKey) ([]byte, error) {
+	if e.Scheme != EncryptionScheme {
+		return nil, errors.Wrapf(ErrInvalidEncryption, "unsupported scheme %q", e.Scheme)
+	}
+
+	recipient := key.PublicKey().Bytes()
+	for _, wrapped := range e.WrappedKeys {
+		if !bytes.Equal(wrapped.Recipient, recipient) {
+			continue
+		}
+		ephemeral, err := ecdh.X25519().NewPublicKey(wrapped.EphemeralKey)
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidEncryption, err.Error())
+		}
+		shared, err := key.ECDH(ephemeral)
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidEncryption, err.Error())
+		}
+		kek, err := deriveKeyEncryptionKey(shared, wrapped.EphemeralKey, recipient)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := newAEAD(kek)
+		if err != nil {
+			return nil, err
+		}
+		dataKey, err := aead.Open(nil, make([]byte, aead.NonceSize()), wrapped.WrappedKey, nil)
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidEncryption, "cannot unwrap the data key")
+		}
+		return dataKey, nil
+	}
+
+	return nil, errors.Wrap(ErrInvalidEncryption, "the data key is not wrapped for this key")
+}
+
+// Validate performs a basic validation of the encryption description.
+func (e Encryption) Validate() error {
+	if e.Scheme != EncryptionScheme {
+		return errors.Wrapf(ErrInvalidEncryption, "unsupported scheme %q", e.Scheme)
+	}
+	if len(e.WrappedKeys) == 0 {
+		return errors.Wrap(ErrInvalidEncryption, "no wrapped keys")
+	}
+	for _, wrapped := range e.WrappedKeys {
+		if _, err := ecdh.X25519().NewPublicKey(wrapped.Recipient); err != nil {
+			return errors.Wrapf(ErrInvalidEncryption, "recipient: %s", err)
+		}
+		if _, err := ecdh.X25519().NewPublicKey(wrapped.EphemeralKey); err != nil {
+			return errors.Wrapf(ErrInvalidEncryption, "ephemeral key: %s", err)
+		}
+		if len(wrapped.WrappedKey) != DataKeySize+16 {
+			return errors.Wrapf(ErrInvalidEncryption, "wrapped key of %d bytes", len(wrapped.WrappedKey))
+		}
+	}
+	return nil
+}
+
+func keyEncryptionKey(ephemeral *ecdh.PrivateKey, recipient *ecdh.PublicKey) ([]byte, error) {
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, err
+	}
+	return deriveKeyEncryptionKey(shared, ephemeral.PublicKey().Bytes(), recipient.Bytes())
+}
+
+// deriveKeyEncryptionKey binds the key encryption key to both public keys of the agreement.
+func deriveKeyEncryptionKey(shared, ephemeral, recipient []byte) ([]byte, error) {
+	salt := append(bytes.Clone(ephemeral), recipient...)
+	return hkdf.Key(sha256.New, shared, salt, keyWrapInfo, DataKeySize)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != DataKeySize {
+		return nil, errors.Wrapf(ErrInvalidEncryption, "key of %d bytes, expected %d", len(key), DataKeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
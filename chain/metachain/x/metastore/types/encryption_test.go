@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"metachain/x/metastore/types"
+)
+
+func TestEncryption(t *testing.T) {
+	alice, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	bob, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	mallory, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	dataKey, err := types.NewDataKey()
+	require.NoError(t, err)
+	encryption, err := types.NewEncryption(dataKey, []*ecdh.PublicKey{alice.PublicKey(), bob.PublicKey()})
+	require.NoError(t, err)
+	require.NoError(t, encryption.Validate())
+
+	sealed, err := types.EncryptChunk(dataKey, "idx0", []byte("<html>private</html>"))
+	require.NoError(t, err)
+
+	for _, recipient := range []*ecdh.PrivateKey{alice, bob} {
+		key, err := encryption.UnwrapDataKey(recipient)
+		require.NoError(t, err)
+		data, err := types.DecryptChunk(key, "idx0", sealed)
+		require.NoError(t, err)
+		require.Equal(t, []byte("<html>private</html>"), data)
+	}
+
+	_, err = encryption.UnwrapDataKey(mallory)
+	require.ErrorIs(t, err, types.ErrInvalidEncryption)
+
+	// the chunk index is authenticated, and so is the ciphertext
+	_, err = types.DecryptChunk(dataKey, "idx1", sealed)
+	require.ErrorIs(t, err, types.ErrInvalidEncryption)
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = types.DecryptChunk(dataKey, "idx0", sealed)
+	require.ErrorIs(t, err, types.ErrInvalidEncryption)
+
+	encryption.WrappedKeys[0].WrappedKey = encryption.WrappedKeys[0].WrappedKey[1:]
+	require.ErrorIs(t, encryption.Validate(), types.ErrInvalidEncryption)
+	_, err = types.NewEncryption(dataKey, nil)
+	require.ErrorIs(t, err, types.ErrInvalidEncryption)
+}
@@ -11,4 +11,13 @@ var (
 	ErrInvalidSigner        = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrInvalidPacketTimeout = errors.Register(ModuleName, 1500, "invalid packet timeout")
 	ErrInvalidVersion       = errors.Register(ModuleName, 1501, "invalid version")
+	ErrAccountNotFound      = errors.Register(ModuleName, 1502, "interchain account not found")
+	ErrUploadInProgress     = errors.Register(ModuleName, 1503, "upload already in progress")
+	ErrPacketFailed         = errors.Register(ModuleName, 1504, "packet failed on the counterparty")
+	ErrInvalidAck           = errors.Register(ModuleName, 1505, "invalid packet acknowledgement")
+	ErrInvalidEncryption    = errors.Register(ModuleName, 1506, "invalid encryption")
+	ErrInvalidPlacement     = errors.Register(ModuleName, 1508, "invalid placement")
+	ErrDatachainNotActive   = errors.Register(ModuleName, 1509, "datachain is not active")
+	ErrInvalidFragment      = errors.Register(ModuleName, 1510, "invalid fragment")
+	ErrMoveInProgress       = errors.Register(ModuleName, 1511, "fragment move already in progress")
 )
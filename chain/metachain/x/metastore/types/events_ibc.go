@@ -4,9 +4,21 @@ package types
 const (
 	EventTypeTimeout        = "timeout"
 	EventTypeMetadataPacket = "metadata_packet"
+	EventTypeChunkUpload    = "chunk_upload"
+	EventTypeChunkRetrieval = "chunk_retrieval"
+	EventTypeChunkRelease   = "chunk_release"
+	// EventTypeFragmentMigration is the packet type of chunk writes copying a fragment off a
+	// draining datachain
+	EventTypeFragmentMigration = "fragment_migration"
+	// EventTypeFragmentMove is the packet type of chunk writes of MsgMoveFragments
+	EventTypeFragmentMove = "fragment_move"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAck        = "acknowledgement"
 	AttributeKeyAckError   = "error"
+	AttributeKeyUrl        = "url"
+	AttributeKeyIndex      = "index"
+	AttributeKeySize       = "size"
+	AttributeKeyHeight     = "height"
 )
@@ -3,6 +3,8 @@ package types
 import (
 	"context"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 )
@@ -21,3 +23,11 @@ type ChannelKeeper interface {
 	) (uint64, error)
 	ChanCloseInit(ctx context.Context, portID, channelID string) error
 }
+
+// ICAControllerKeeper defines the expected interchain accounts controller keeper.
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string, ordering channeltypes.Order) error
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetOpenActiveChannel(ctx sdk.Context, connectionID, portID string) (string, bool)
+	SendTx(ctx sdk.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error)
+}
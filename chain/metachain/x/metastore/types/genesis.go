@@ -10,7 +10,7 @@ import (
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params: DefaultParams(),
-		PortId: PortID, StoredMetaMap: []StoredMeta{}}
+		PortId: PortID, StoredMetaMap: []StoredMeta{}, Datachains: []Datachain{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -26,8 +26,27 @@ func (gs GenesisState) Validate() error {
 		if _, ok := storedMetaIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for storedMeta")
 		}
+		if elem.Encryption != nil {
+			if err := elem.Encryption.Validate(); err != nil {
+				return fmt.Errorf("storedMeta %s: %w", index, err)
+			}
+		}
 		storedMetaIndexMap[index] = struct{}{}
 	}
 
+	datachainMap := make(map[string]struct{})
+	for _, elem := range gs.Datachains {
+		if elem.ConnectionId == "" {
+			return fmt.Errorf("datachain without connection id")
+		}
+		if _, ok := datachainMap[elem.ConnectionId]; ok {
+			return fmt.Errorf("duplicated connection id %s for datachain", elem.ConnectionId)
+		}
+		if _, ok := DatachainStatus_name[int32(elem.Status)]; !ok {
+			return fmt.Errorf("datachain %s: unknown status %d", elem.ConnectionId, elem.Status)
+		}
+		datachainMap[elem.ConnectionId] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -30,6 +30,7 @@ type GenesisState struct {
 	Params        Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	PortId        string       `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	StoredMetaMap []StoredMeta `protobuf:"bytes,3,rep,name=stored_meta_map,json=storedMetaMap,proto3" json:"stored_meta_map"`
+	Datachains    []Datachain  `protobuf:"bytes,4,rep,name=datachains,proto3" json:"datachains"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +87,13 @@ func (m *GenesisState) GetStoredMetaMap() []StoredMeta {
 	return nil
 }
 
+func (m *GenesisState) GetDatachains() []Datachain {
+	if m != nil {
+		return m.Datachains
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "metachain.metastore.v1.GenesisState")
 }
@@ -95,25 +103,26 @@ func init() {
 }
 
 var fileDescriptor_93e15459018a74c1 = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
+	// 302 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4d, 0x2d, 0x49,
 	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0x8a, 0x4b, 0xf2, 0x8b, 0x52, 0xf5, 0xcb, 0x0c,
 	0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4,
 	0xe0, 0xaa, 0xf4, 0xe0, 0xaa, 0xf4, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5,
 	0xc1, 0x24, 0x44, 0xa9, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45,
-	0x95, 0x71, 0x58, 0x53, 0x90, 0x58, 0x94, 0x98, 0x0b, 0xb5, 0x45, 0x4a, 0x03, 0x87, 0x22, 0x30,
-	0x23, 0x25, 0x1e, 0x24, 0x06, 0x51, 0xa9, 0xb4, 0x87, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0xc2, 0xe0,
-	0x92, 0xc4, 0x92, 0x54, 0x21, 0x47, 0x2e, 0x36, 0x88, 0x51, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc,
-	0x46, 0x72, 0x7a, 0xd8, 0x5d, 0xac, 0x17, 0x00, 0x56, 0xe5, 0xc4, 0x79, 0xe2, 0x9e, 0x3c, 0xc3,
-	0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0, 0x1a, 0x85, 0xc4, 0xb9, 0xd8, 0x0b, 0xf2, 0x8b, 0x4a,
-	0xe2, 0x33, 0x53, 0x24, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0xd8, 0x40, 0x5c, 0xcf, 0x14, 0xa1,
-	0x00, 0x2e, 0x7e, 0x24, 0x17, 0xc4, 0xe7, 0x26, 0x16, 0x48, 0x30, 0x2b, 0x30, 0x6b, 0x70, 0x1b,
-	0x29, 0xe1, 0xb2, 0x24, 0x18, 0xac, 0xdc, 0x37, 0xb5, 0x24, 0xd1, 0x89, 0x05, 0x64, 0x51, 0x10,
-	0x6f, 0x31, 0x5c, 0xc4, 0x37, 0xb1, 0xc0, 0xc9, 0xf4, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0xa4, 0x11, 0x41, 0x50, 0x81, 0x14, 0x08, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0x60, 0xcf, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x48, 0x22, 0x67, 0x9e, 0xb4, 0x01,
-	0x00, 0x00,
+	0xd5, 0x70, 0x58, 0x93, 0x92, 0x08, 0x33, 0x17, 0xa2, 0x4e, 0x19, 0x87, 0xba, 0x82, 0xc4, 0xa2,
+	0xc4, 0x5c, 0xa8, 0x6b, 0xa4, 0x34, 0x70, 0x28, 0x02, 0x33, 0x52, 0xe2, 0x41, 0x62, 0x10, 0x95,
+	0x4a, 0xad, 0x4c, 0x5c, 0x3c, 0xee, 0x10, 0x9f, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x39, 0x72,
+	0xb1, 0x41, 0x8c, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd3, 0xc3, 0xee, 0x33, 0xbd,
+	0x00, 0xb0, 0x2a, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04,
+	0xd5, 0x28, 0x24, 0xce, 0xc5, 0x5e, 0x90, 0x5f, 0x54, 0x12, 0x9f, 0x99, 0x22, 0xc1, 0xa4, 0xc0,
+	0xa8, 0xc1, 0x19, 0xc4, 0x06, 0xe2, 0x7a, 0xa6, 0x08, 0x05, 0x70, 0xf1, 0x23, 0xb9, 0x20, 0x3e,
+	0x37, 0xb1, 0x40, 0x82, 0x59, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x09, 0x97, 0x25, 0xc1, 0x60, 0xe5,
+	0xbe, 0xa9, 0x25, 0x89, 0x4e, 0x2c, 0x20, 0x8b, 0x82, 0x78, 0x8b, 0xe1, 0x22, 0xbe, 0x89, 0x05,
+	0x42, 0xee, 0x5c, 0x5c, 0xf0, 0x00, 0x2a, 0x96, 0x60, 0x01, 0x1b, 0xa6, 0x88, 0xcb, 0x30, 0x17,
+	0x98, 0x4a, 0xa8, 0x59, 0x48, 0x5a, 0x9d, 0x4c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
+	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
+	0x8e, 0x21, 0x4a, 0x1a, 0x11, 0x96, 0x15, 0x48, 0xa1, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
+	0x06, 0x0e, 0x45, 0x63, 0xc0, 0x00, 0x2b, 0xc9, 0x14, 0xac, 0x25, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +145,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Datachains) > 0 {
+		for iNdEx := len(m.Datachains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datachains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.StoredMetaMap) > 0 {
 		for iNdEx := len(m.StoredMetaMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -199,6 +222,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Datachains) > 0 {
+		for _, e := range m.Datachains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +365,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datachains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datachains = append(m.Datachains, Datachain{})
+			if err := m.Datachains[len(m.Datachains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,8 +23,19 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
 				PortId:        types.PortID,
-				StoredMetaMap: []types.StoredMeta{{Index: "0"}, {Index: "1"}}},
+				StoredMetaMap: []types.StoredMeta{{Index: "0"}, {Index: "1"}},
+				Datachains: []types.Datachain{
+					{ConnectionId: "connection-0", Status: types.DatachainStatus_DATACHAIN_STATUS_DRAINING},
+					{ConnectionId: "connection-1", Status: types.DatachainStatus_DATACHAIN_STATUS_RETIRED},
+				}},
 			valid: true,
+		}, {
+			desc: "duplicated datachain",
+			genState: &types.GenesisState{
+				PortId:     types.PortID,
+				Datachains: []types.Datachain{{ConnectionId: "connection-0"}, {ConnectionId: "connection-0"}},
+			},
+			valid: false,
 		}, {
 			desc: "duplicated storedMeta",
 			genState: &types.GenesisState{
@@ -0,0 +1,20 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// DatachainKey is the prefix to retrieve all Datachain
+var DatachainKey = collections.NewPrefix("datachain/value/")
+
+// MigrationPacketKey is the prefix mapping in-flight fragment migration packets to the fragment
+// they copy
+var MigrationPacketKey = collections.NewPrefix("migrationPacket/value/")
+
+// PendingMoveKey is the prefix to retrieve all PendingMove
+var PendingMoveKey = collections.NewPrefix("pendingMove/value/")
+
+// MovePacketKey is the prefix mapping in-flight fragment move packets to the pending move whose
+// fragments they copy
+var MovePacketKey = collections.NewPrefix("movePacket/value/")
+
+// MoveSeqKey is the key of the sequence numbering the moves of MsgMoveFragments
+var MoveSeqKey = collections.NewPrefix("moveSeq")
@@ -4,3 +4,10 @@ import "cosmossdk.io/collections"
 
 // StoredMetaKey is the prefix to retrieve all StoredMeta
 var StoredMetaKey = collections.NewPrefix("storedMeta/value/")
+
+// StoredMetaByFragmentKey is the prefix indexing StoredMeta by the chunks it lists
+var StoredMetaByFragmentKey = collections.NewPrefix("storedMetaByFragment/value/")
+
+// StoredMetaByDatachainKey is the prefix indexing StoredMeta by the datachain holding each of its
+// chunks
+var StoredMetaByDatachainKey = collections.NewPrefix("storedMetaByDatachain/value/")
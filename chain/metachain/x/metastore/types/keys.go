@@ -1,6 +1,10 @@
 package types
 
-import "cosmossdk.io/collections"
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+)
 
 const (
 	// ModuleName defines the module name
@@ -19,6 +23,12 @@ const (
 
 	// PortID is the default port id that module binds to
 	PortID = "metastore"
+
+	// EncodingProtobuf is the payload encoding the module accepts on IBC v2
+	EncodingProtobuf = "application/x-protobuf"
+
+	// AckVersion is the version of the proto-binary packet acknowledgement results
+	AckVersion uint32 = 1
 )
 
 var (
@@ -28,3 +38,30 @@ var (
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_metastore")
+
+var (
+	// PendingUploadKey is the prefix to retrieve all PendingUpload
+	PendingUploadKey = collections.NewPrefix("pendingUpload/value/")
+
+	// UploadPacketKey is the prefix mapping in-flight interchain account packets to their upload url
+	UploadPacketKey = collections.NewPrefix("uploadPacket/value/")
+
+	// CachedChunkKey is the prefix to retrieve all CachedChunk
+	CachedChunkKey = collections.NewPrefix("cachedChunk/value/")
+
+	// CachedChunkExpiryKey is the prefix of the cached chunks by the height they expire at
+	CachedChunkExpiryKey = collections.NewPrefix("cachedChunk/expiry/")
+)
+
+// DatastorePortID is the port the datachain datastore module binds to. Release packets sent
+// over IBC v2 are addressed to it.
+const DatastorePortID = "datastore"
+
+// ReleasePacketTimeout is how long a datachain has to receive a release packet.
+const ReleasePacketTimeout = 10 * time.Minute
+
+// DatastoreCreateStoredChunkTypeURL is the type url datachains register MsgCreateStoredChunk under.
+const DatastoreCreateStoredChunkTypeURL = "/datachain.datastore.v1.MsgCreateStoredChunk"
+
+// DatastoreDeleteStoredChunkTypeURL is the type url datachains register MsgDeleteStoredChunk under.
+const DatastoreDeleteStoredChunkTypeURL = "/datachain.datastore.v1.MsgDeleteStoredChunk"
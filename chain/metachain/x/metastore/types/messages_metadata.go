@@ -17,3 +17,42 @@ func NewMsgSendMetadata(
 		Addresses:        addresses,
 	}
 }
+
+func NewMsgRegisterDatachainAccount(creator string, connectionID string) *MsgRegisterDatachainAccount {
+	return &MsgRegisterDatachainAccount{
+		Creator:      creator,
+		ConnectionId: connectionID,
+	}
+}
+
+func NewMsgUploadChunks(
+	creator string,
+	url string,
+	chunks []ChunkUpload,
+	timeoutTimestamp uint64,
+) *MsgUploadChunks {
+	return &MsgUploadChunks{
+		Creator:          creator,
+		Url:              url,
+		Chunks:           chunks,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func NewMsgRetrieveChunks(
+	creator string,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+	indexes []string,
+	cacheBytes uint64,
+) *MsgRetrieveChunks {
+	return &MsgRetrieveChunks{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		Indexes:          indexes,
+		CacheBytes:       cacheBytes,
+	}
+}
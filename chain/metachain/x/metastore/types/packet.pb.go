@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -27,6 +28,8 @@ type MetastorePacketData struct {
 	// Types that are valid to be assigned to Packet:
 	//	*MetastorePacketData_NoData
 	//	*MetastorePacketData_MetadataPacket
+	//	*MetastorePacketData_RetrievalPacket
+	//	*MetastorePacketData_ReleasePacket
 	Packet isMetastorePacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -75,9 +78,17 @@ type MetastorePacketData_NoData struct {
 type MetastorePacketData_MetadataPacket struct {
 	MetadataPacket *MetadataPacketData `protobuf:"bytes,2,opt,name=metadata_packet,json=metadataPacket,proto3,oneof" json:"metadata_packet,omitempty"`
 }
+type MetastorePacketData_RetrievalPacket struct {
+	RetrievalPacket *ChunkRetrievalPacketData `protobuf:"bytes,4,opt,name=retrieval_packet,json=retrievalPacket,proto3,oneof" json:"retrieval_packet,omitempty"`
+}
+type MetastorePacketData_ReleasePacket struct {
+	ReleasePacket *ChunkReleasePacketData `protobuf:"bytes,5,opt,name=release_packet,json=releasePacket,proto3,oneof" json:"release_packet,omitempty"`
+}
 
-func (*MetastorePacketData_NoData) isMetastorePacketData_Packet()         {}
-func (*MetastorePacketData_MetadataPacket) isMetastorePacketData_Packet() {}
+func (*MetastorePacketData_NoData) isMetastorePacketData_Packet()          {}
+func (*MetastorePacketData_MetadataPacket) isMetastorePacketData_Packet()  {}
+func (*MetastorePacketData_RetrievalPacket) isMetastorePacketData_Packet() {}
+func (*MetastorePacketData_ReleasePacket) isMetastorePacketData_Packet()   {}
 
 func (m *MetastorePacketData) GetPacket() isMetastorePacketData_Packet {
 	if m != nil {
@@ -100,11 +111,27 @@ func (m *MetastorePacketData) GetMetadataPacket() *MetadataPacketData {
 	return nil
 }
 
+func (m *MetastorePacketData) GetRetrievalPacket() *ChunkRetrievalPacketData {
+	if x, ok := m.GetPacket().(*MetastorePacketData_RetrievalPacket); ok {
+		return x.RetrievalPacket
+	}
+	return nil
+}
+
+func (m *MetastorePacketData) GetReleasePacket() *ChunkReleasePacketData {
+	if x, ok := m.GetPacket().(*MetastorePacketData_ReleasePacket); ok {
+		return x.ReleasePacket
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MetastorePacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MetastorePacketData_NoData)(nil),
 		(*MetastorePacketData_MetadataPacket)(nil),
+		(*MetastorePacketData_RetrievalPacket)(nil),
+		(*MetastorePacketData_ReleasePacket)(nil),
 	}
 }
 
@@ -206,15 +233,92 @@ func (m *MetadataPacketData) GetCreator() string {
 	return ""
 }
 
-// MetadataPacketAck defines a struct for the packet acknowledgment
+// VerifiedChunk is a datachain's attestation of one stored chunk. It mirrors
+// datachain.datastore.v1.VerifiedChunk.
+type VerifiedChunk struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// hash is the SHA-256 digest of the chunk data.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// height is the datachain block height the chunk was checked at.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *VerifiedChunk) Reset()         { *m = VerifiedChunk{} }
+func (m *VerifiedChunk) String() string { return proto.CompactTextString(m) }
+func (*VerifiedChunk) ProtoMessage()    {}
+func (*VerifiedChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db1310aeede5c4f, []int{3}
+}
+func (m *VerifiedChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifiedChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifiedChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifiedChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifiedChunk.Merge(m, src)
+}
+func (m *VerifiedChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifiedChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifiedChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifiedChunk proto.InternalMessageInfo
+
+func (m *VerifiedChunk) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *VerifiedChunk) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *VerifiedChunk) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *VerifiedChunk) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MetadataPacketAck defines a struct for the packet acknowledgment. It is
+// proto-binary encoded into the result of the channel acknowledgement.
 type MetadataPacketAck struct {
+	// version is the ack encoding version, see types.AckVersion.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// chunks lists every requested address in request order.
+	Chunks []VerifiedChunk `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks"`
 }
 
 func (m *MetadataPacketAck) Reset()         { *m = MetadataPacketAck{} }
 func (m *MetadataPacketAck) String() string { return proto.CompactTextString(m) }
 func (*MetadataPacketAck) ProtoMessage()    {}
 func (*MetadataPacketAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1db1310aeede5c4f, []int{3}
+	return fileDescriptor_1db1310aeede5c4f, []int{4}
 }
 func (m *MetadataPacketAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,11 +347,320 @@ func (m *MetadataPacketAck) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MetadataPacketAck proto.InternalMessageInfo
 
+func (m *MetadataPacketAck) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MetadataPacketAck) GetChunks() []VerifiedChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+// ChunkRetrievalPacketData asks a datachain for the bytes of the chunks listed
+// in indexes. It mirrors datachain.datastore.v1.ChunkRetrievalPacketData.
+type ChunkRetrievalPacketData struct {
+	Indexes   []string `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Requester string   `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	// cache_bytes is how much of the returned chunk data the requester paid to
+	// keep in the chunk cache.
+	CacheBytes uint64 `protobuf:"varint,4,opt,name=cache_bytes,json=cacheBytes,proto3" json:"cache_bytes,omitempty"`
+}
+
+func (m *ChunkRetrievalPacketData) Reset()         { *m = ChunkRetrievalPacketData{} }
+func (m *ChunkRetrievalPacketData) String() string { return proto.CompactTextString(m) }
+func (*ChunkRetrievalPacketData) ProtoMessage()    {}
+func (*ChunkRetrievalPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db1310aeede5c4f, []int{5}
+}
+func (m *ChunkRetrievalPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkRetrievalPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkRetrievalPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkRetrievalPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkRetrievalPacketData.Merge(m, src)
+}
+func (m *ChunkRetrievalPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkRetrievalPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkRetrievalPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkRetrievalPacketData proto.InternalMessageInfo
+
+func (m *ChunkRetrievalPacketData) GetIndexes() []string {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *ChunkRetrievalPacketData) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *ChunkRetrievalPacketData) GetCacheBytes() uint64 {
+	if m != nil {
+		return m.CacheBytes
+	}
+	return 0
+}
+
+// RetrievedChunk carries the data of one chunk back to the requester.
+type RetrievedChunk struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Data  []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// height is the datachain block height the chunk was read at.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RetrievedChunk) Reset()         { *m = RetrievedChunk{} }
+func (m *RetrievedChunk) String() string { return proto.CompactTextString(m) }
+func (*RetrievedChunk) ProtoMessage()    {}
+func (*RetrievedChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db1310aeede5c4f, []int{6}
+}
+func (m *RetrievedChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetrievedChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetrievedChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetrievedChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrievedChunk.Merge(m, src)
+}
+func (m *RetrievedChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetrievedChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrievedChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrievedChunk proto.InternalMessageInfo
+
+func (m *RetrievedChunk) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *RetrievedChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RetrievedChunk) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ChunkRetrievalPacketAck defines a struct for the packet acknowledgment. It
+// is encoded like MetadataPacketAck and lists the chunks in request order.
+type ChunkRetrievalPacketAck struct {
+	Version uint32           `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Chunks  []RetrievedChunk `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks"`
+}
+
+func (m *ChunkRetrievalPacketAck) Reset()         { *m = ChunkRetrievalPacketAck{} }
+func (m *ChunkRetrievalPacketAck) String() string { return proto.CompactTextString(m) }
+func (*ChunkRetrievalPacketAck) ProtoMessage()    {}
+func (*ChunkRetrievalPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db1310aeede5c4f, []int{7}
+}
+func (m *ChunkRetrievalPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkRetrievalPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkRetrievalPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkRetrievalPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkRetrievalPacketAck.Merge(m, src)
+}
+func (m *ChunkRetrievalPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkRetrievalPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkRetrievalPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkRetrievalPacketAck proto.InternalMessageInfo
+
+func (m *ChunkRetrievalPacketAck) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ChunkRetrievalPacketAck) GetChunks() []RetrievedChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+// ChunkReleasePacketData tells a datachain that the metadata entry for url no
+// longer references the chunks listed in addresses. It mirrors
+// datachain.datastore.v1.ChunkReleasePacketData.
+type ChunkReleasePacketData struct {
+	Url       string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *ChunkReleasePacketData) Reset()         { *m = ChunkReleasePacketData{} }
+func (m *ChunkReleasePacketData) String() string { return proto.CompactTextString(m) }
+func (*ChunkReleasePacketData) ProtoMessage()    {}
+func (*ChunkReleasePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db1310aeede5c4f, []int{8}
+}
+func (m *ChunkReleasePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkReleasePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkReleasePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkReleasePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkReleasePacketData.Merge(m, src)
+}
+func (m *ChunkReleasePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkReleasePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkReleasePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkReleasePacketData proto.InternalMessageInfo
+
+func (m *ChunkReleasePacketData) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ChunkReleasePacketData) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// ChunkReleasePacketAck defines a struct for the packet acknowledgment.
+type ChunkReleasePacketAck struct {
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// released lists the addresses whose reference the datachain dropped.
+	Released []string `protobuf:"bytes,2,rep,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *ChunkReleasePacketAck) Reset()         { *m = ChunkReleasePacketAck{} }
+func (m *ChunkReleasePacketAck) String() string { return proto.CompactTextString(m) }
+func (*ChunkReleasePacketAck) ProtoMessage()    {}
+func (*ChunkReleasePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db1310aeede5c4f, []int{9}
+}
+func (m *ChunkReleasePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkReleasePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkReleasePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkReleasePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkReleasePacketAck.Merge(m, src)
+}
+func (m *ChunkReleasePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkReleasePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkReleasePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkReleasePacketAck proto.InternalMessageInfo
+
+func (m *ChunkReleasePacketAck) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ChunkReleasePacketAck) GetReleased() []string {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MetastorePacketData)(nil), "metachain.metastore.v1.MetastorePacketData")
 	proto.RegisterType((*NoData)(nil), "metachain.metastore.v1.NoData")
 	proto.RegisterType((*MetadataPacketData)(nil), "metachain.metastore.v1.MetadataPacketData")
+	proto.RegisterType((*VerifiedChunk)(nil), "metachain.metastore.v1.VerifiedChunk")
 	proto.RegisterType((*MetadataPacketAck)(nil), "metachain.metastore.v1.MetadataPacketAck")
+	proto.RegisterType((*ChunkRetrievalPacketData)(nil), "metachain.metastore.v1.ChunkRetrievalPacketData")
+	proto.RegisterType((*RetrievedChunk)(nil), "metachain.metastore.v1.RetrievedChunk")
+	proto.RegisterType((*ChunkRetrievalPacketAck)(nil), "metachain.metastore.v1.ChunkRetrievalPacketAck")
+	proto.RegisterType((*ChunkReleasePacketData)(nil), "metachain.metastore.v1.ChunkReleasePacketData")
+	proto.RegisterType((*ChunkReleasePacketAck)(nil), "metachain.metastore.v1.ChunkReleasePacketAck")
 }
 
 func init() {
@@ -255,24 +668,42 @@ func init() {
 }
 
 var fileDescriptor_1db1310aeede5c4f = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x4d, 0x2d, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0x8a, 0x4b, 0xf2, 0x8b, 0x52, 0xf5, 0xcb, 0x0c,
-	0xf5, 0x0b, 0x12, 0x93, 0xb3, 0x53, 0x4b, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe0,
-	0x8a, 0xf4, 0xe0, 0x8a, 0xf4, 0xca, 0x0c, 0x95, 0x36, 0x30, 0x72, 0x09, 0xfb, 0xc2, 0x04, 0x02,
-	0xc0, 0x3a, 0x5c, 0x12, 0x4b, 0x12, 0x85, 0x2c, 0xb8, 0xd8, 0xf2, 0xf2, 0x41, 0x2c, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x39, 0x3d, 0xec, 0x06, 0xe8, 0xf9, 0x81, 0x55, 0x79, 0x30, 0x04,
-	0x41, 0xd5, 0x0b, 0x85, 0x72, 0xf1, 0x83, 0x14, 0xa4, 0x24, 0x96, 0x24, 0xc6, 0x43, 0x9c, 0x20,
-	0xc1, 0x04, 0x36, 0x42, 0x0b, 0x97, 0x11, 0xbe, 0x50, 0xe5, 0x08, 0xeb, 0x3d, 0x18, 0x82, 0xf8,
-	0x72, 0x51, 0x44, 0x9d, 0x38, 0xb8, 0xd8, 0x20, 0xa6, 0x29, 0x71, 0x70, 0xb1, 0x41, 0x2c, 0x55,
-	0x8a, 0xe3, 0x12, 0xc2, 0xd4, 0x2b, 0x24, 0xc0, 0xc5, 0x5c, 0x5a, 0x94, 0x03, 0x76, 0x37, 0x67,
-	0x10, 0x88, 0x29, 0x24, 0xc3, 0xc5, 0x99, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x9c, 0x5a, 0x2c,
-	0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x19, 0x84, 0x10, 0x10, 0x92, 0xe0, 0x62, 0x4f, 0x2e, 0x4a, 0x4d,
-	0x2c, 0xc9, 0x2f, 0x92, 0x60, 0x06, 0xeb, 0x81, 0x71, 0x95, 0x84, 0xb9, 0x04, 0x51, 0xcd, 0x77,
-	0x4c, 0xce, 0x76, 0x32, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x69,
-	0x44, 0x44, 0x54, 0x20, 0x45, 0x45, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x1e, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x5a, 0x5f, 0xb9, 0xae, 0x01, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xed, 0xda, 0xf5, 0x97, 0x4c, 0x9a, 0x34, 0xdf, 0x52, 0x82, 0x55, 0x90, 0x5b, 0x19,
+	0x81, 0x2a, 0x0e, 0x0e, 0x2d, 0x42, 0xe2, 0x4a, 0xda, 0x43, 0x84, 0x14, 0x84, 0x56, 0x02, 0x24,
+	0x24, 0xa8, 0xb6, 0xf6, 0x10, 0x5b, 0x49, 0xe3, 0xb0, 0xeb, 0x44, 0x0d, 0x4f, 0xc1, 0x63, 0xf5,
+	0xd8, 0x23, 0x27, 0x84, 0x92, 0x0b, 0x8f, 0x81, 0x76, 0x6d, 0x27, 0x71, 0x9b, 0xb4, 0xe2, 0x36,
+	0x33, 0xfa, 0xef, 0x6f, 0x66, 0xfe, 0x5e, 0x2f, 0x3c, 0x3e, 0xc7, 0x84, 0xf9, 0x21, 0x8b, 0x06,
+	0x4d, 0x19, 0x89, 0x24, 0xe6, 0xd8, 0x1c, 0x1f, 0x36, 0x87, 0xcc, 0xef, 0x61, 0xe2, 0x0d, 0x79,
+	0x9c, 0xc4, 0xa4, 0x31, 0x17, 0x79, 0x73, 0x91, 0x37, 0x3e, 0xdc, 0xdd, 0xe9, 0xc6, 0xdd, 0x58,
+	0x49, 0x9a, 0x32, 0x4a, 0xd5, 0xee, 0x9f, 0x0d, 0xb8, 0xd7, 0xc9, 0x65, 0xef, 0x14, 0xe7, 0x84,
+	0x25, 0x8c, 0xbc, 0x02, 0x6b, 0x10, 0xcb, 0xc8, 0xd6, 0xf7, 0xf5, 0x83, 0xca, 0x91, 0xe3, 0xad,
+	0xc6, 0x7a, 0x6f, 0x95, 0xaa, 0xad, 0xd1, 0x4c, 0x4f, 0xde, 0xc3, 0xb6, 0x14, 0x04, 0x2c, 0x61,
+	0xa7, 0xe9, 0x60, 0xf6, 0x86, 0x42, 0x3c, 0x5b, 0x87, 0xe8, 0x64, 0xf2, 0x45, 0xfb, 0xb6, 0x46,
+	0x6b, 0xe7, 0x85, 0x2a, 0xf9, 0x0c, 0x75, 0x8e, 0x09, 0x8f, 0x70, 0xcc, 0xfa, 0x39, 0xd7, 0x54,
+	0xdc, 0xe7, 0xeb, 0xb8, 0xc7, 0xe1, 0x68, 0xd0, 0xa3, 0xf9, 0xa1, 0x02, 0x7d, 0x9b, 0x17, 0xcb,
+	0xe4, 0x23, 0xd4, 0x38, 0xf6, 0x91, 0x09, 0xcc, 0xe1, 0x9b, 0x0a, 0xee, 0xdd, 0x01, 0x57, 0x47,
+	0x0a, 0xe8, 0x2a, 0x5f, 0x2e, 0xb6, 0x4a, 0x60, 0xa5, 0x40, 0xb7, 0x04, 0x56, 0x6a, 0x96, 0xfb,
+	0x05, 0xc8, 0xcd, 0x9d, 0x49, 0x1d, 0x8c, 0x11, 0xef, 0x2b, 0xbf, 0xcb, 0x54, 0x86, 0xe4, 0x11,
+	0x94, 0x59, 0x10, 0x70, 0x14, 0x02, 0x85, 0xbd, 0xb1, 0x6f, 0x1c, 0x94, 0xe9, 0xa2, 0x40, 0x6c,
+	0xf8, 0xcf, 0xe7, 0xc8, 0x92, 0x98, 0xdb, 0x86, 0x3a, 0x93, 0xa7, 0x2e, 0x42, 0xf5, 0x03, 0xf2,
+	0xe8, 0x6b, 0x84, 0x81, 0x1a, 0x93, 0xec, 0xc0, 0x66, 0x34, 0x08, 0xf0, 0x22, 0x83, 0xa7, 0x09,
+	0x21, 0x60, 0x8a, 0xe8, 0x3b, 0xaa, 0xcf, 0x63, 0x52, 0x15, 0xcb, 0x5a, 0xc8, 0x44, 0xa8, 0x88,
+	0x5b, 0x54, 0xc5, 0xa4, 0x01, 0x56, 0x88, 0x51, 0x37, 0x4c, 0x0d, 0x37, 0x68, 0x96, 0xb9, 0x1c,
+	0xfe, 0x2f, 0xae, 0xf1, 0xda, 0xef, 0xc9, 0xa9, 0xc6, 0xc8, 0x45, 0x14, 0x0f, 0x54, 0xb3, 0x2a,
+	0xcd, 0x53, 0x72, 0x0c, 0x96, 0x2f, 0xa7, 0x49, 0x57, 0xa9, 0x1c, 0x3d, 0x59, 0x67, 0x6d, 0x61,
+	0xf6, 0x96, 0x79, 0xf9, 0x6b, 0x4f, 0xa3, 0xd9, 0x51, 0x77, 0x02, 0xf6, 0xba, 0xcf, 0x2a, 0x5b,
+	0xab, 0xc5, 0x50, 0xd8, 0xba, 0x32, 0x2b, 0x4f, 0xa5, 0x91, 0x1c, 0xbf, 0x8d, 0x50, 0x24, 0xc8,
+	0xd5, 0xba, 0x65, 0xba, 0x28, 0x90, 0x3d, 0xa8, 0xf8, 0xcc, 0x0f, 0xf1, 0xf4, 0x6c, 0x92, 0xa0,
+	0x50, 0x4b, 0x9a, 0x14, 0x54, 0xa9, 0x25, 0x2b, 0x6f, 0xcc, 0x92, 0x51, 0x37, 0x5d, 0x0a, 0xb5,
+	0xac, 0xeb, 0x9d, 0xb6, 0x4a, 0x4b, 0x54, 0x9f, 0x2d, 0xaa, 0xe2, 0x25, 0x0b, 0x8d, 0x82, 0x85,
+	0x13, 0x78, 0xb0, 0x6a, 0x9d, 0xdb, 0x8d, 0x3c, 0xb9, 0x66, 0xe4, 0xd3, 0x75, 0x46, 0x16, 0xc7,
+	0xbd, 0xe6, 0x64, 0x1b, 0x1a, 0xab, 0xef, 0xf0, 0xbf, 0x5e, 0x44, 0xb7, 0x03, 0xf7, 0x6f, 0x92,
+	0x6e, 0x5f, 0x61, 0x17, 0x4a, 0xd9, 0x6f, 0x12, 0x64, 0xbc, 0x79, 0xde, 0x7a, 0x79, 0x39, 0x75,
+	0xf4, 0xab, 0xa9, 0xa3, 0xff, 0x9e, 0x3a, 0xfa, 0x8f, 0x99, 0xa3, 0x5d, 0xcd, 0x1c, 0xed, 0xe7,
+	0xcc, 0xd1, 0x3e, 0x3d, 0x5c, 0xbc, 0x7f, 0x17, 0x4b, 0x2f, 0x60, 0x32, 0x19, 0xa2, 0x38, 0xb3,
+	0xd4, 0x83, 0xf6, 0xe2, 0xef, 0x00, 0x8a, 0xd6, 0x9f, 0xfe, 0x25, 0x05, 0x00, 0x00,
 }
 
 func (m *MetastorePacketData) Marshal() (dAtA []byte, err error) {
@@ -349,36 +780,78 @@ func (m *MetastorePacketData_MetadataPacket) MarshalToSizedBuffer(dAtA []byte) (
 	}
 	return len(dAtA) - i, nil
 }
-func (m *NoData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+func (m *MetastorePacketData_RetrievalPacket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MetastorePacketData_RetrievalPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	if m.RetrievalPacket != nil {
+		{
+			size, err := m.RetrievalPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MetadataPacketData) Marshal() (dAtA []byte, err error) {
+func (m *MetastorePacketData_ReleasePacket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetastorePacketData_ReleasePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReleasePacket != nil {
+		{
+			size, err := m.ReleasePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
 	return dAtA[:n], nil
 }
 
@@ -418,6 +891,53 @@ func (m *MetadataPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VerifiedChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifiedChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiedChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MetadataPacketAck) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -438,6 +958,229 @@ func (m *MetadataPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkRetrievalPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkRetrievalPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkRetrievalPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CacheBytes != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.CacheBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Indexes[iNdEx])
+			copy(dAtA[i:], m.Indexes[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Indexes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetrievedChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrievedChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrievedChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkRetrievalPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkRetrievalPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkRetrievalPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkReleasePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkReleasePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkReleasePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkReleasePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkReleasePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkReleasePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Released[iNdEx])
+			copy(dAtA[i:], m.Released[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Released[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -488,6 +1231,30 @@ func (m *MetastorePacketData_MetadataPacket) Size() (n int) {
 	}
 	return n
 }
+func (m *MetastorePacketData_RetrievalPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetrievalPacket != nil {
+		l = m.RetrievalPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *MetastorePacketData_ReleasePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleasePacket != nil {
+		l = m.ReleasePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
 func (m *NoData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -513,29 +1280,934 @@ func (m *MetadataPacketData) Size() (n int) {
 			n += 1 + l + sovPacket(uint64(l))
 		}
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
-	}
-	return n
-}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifiedChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPacket(uint64(m.Size_))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MetadataPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChunkRetrievalPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for _, s := range m.Indexes {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.CacheBytes != 0 {
+		n += 1 + sovPacket(uint64(m.CacheBytes))
+	}
+	return n
+}
+
+func (m *RetrievedChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ChunkRetrievalPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChunkReleasePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChunkReleasePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	if len(m.Released) > 0 {
+		for _, s := range m.Released {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MetastorePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetastorePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetastorePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &MetastorePacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MetadataPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &MetastorePacketData_MetadataPacket{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChunkRetrievalPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &MetastorePacketData_RetrievalPacket{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChunkReleasePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &MetastorePacketData_ReleasePacket{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifiedChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifiedChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifiedChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, VerifiedChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkRetrievalPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkRetrievalPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkRetrievalPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheBytes", wireType)
+			}
+			m.CacheBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MetadataPacketAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovPacket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPacket(x uint64) (n int) {
-	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MetastorePacketData) Unmarshal(dAtA []byte) error {
+func (m *RetrievedChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -558,17 +2230,17 @@ func (m *MetastorePacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetastorePacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetrievedChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetastorePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetrievedChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -578,32 +2250,29 @@ func (m *MetastorePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &NoData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Packet = &MetastorePacketData_NoData{v}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataPacket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -613,27 +2282,45 @@ func (m *MetastorePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MetadataPacketData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.Packet = &MetastorePacketData_MetadataPacket{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -655,7 +2342,7 @@ func (m *MetastorePacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoData) Unmarshal(dAtA []byte) error {
+func (m *ChunkRetrievalPacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -678,12 +2365,65 @@ func (m *NoData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkRetrievalPacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkRetrievalPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, RetrievedChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -705,7 +2445,7 @@ func (m *NoData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetadataPacketData) Unmarshal(dAtA []byte) error {
+func (m *ChunkReleasePacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -728,10 +2468,10 @@ func (m *MetadataPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkReleasePacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkReleasePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -798,38 +2538,6 @@ func (m *MetadataPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPacket
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -851,7 +2559,7 @@ func (m *MetadataPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetadataPacketAck) Unmarshal(dAtA []byte) error {
+func (m *ChunkReleasePacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -874,12 +2582,63 @@ func (m *MetadataPacketAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataPacketAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkReleasePacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkReleasePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -8,3 +8,21 @@ func (p MetadataPacketData) GetBytes() ([]byte, error) {
 
 	return modulePacket.Marshal()
 }
+
+// GetBytes is a helper for serialising
+func (p ChunkRetrievalPacketData) GetBytes() ([]byte, error) {
+	var modulePacket MetastorePacketData
+
+	modulePacket.Packet = &MetastorePacketData_RetrievalPacket{&p}
+
+	return modulePacket.Marshal()
+}
+
+// GetBytes is a helper for serialising
+func (p ChunkReleasePacketData) GetBytes() ([]byte, error) {
+	var modulePacket MetastorePacketData
+
+	modulePacket.Packet = &MetastorePacketData_ReleasePacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -1,13 +1,33 @@
 package types
 
+const (
+	// DefaultChunkGasPerByte charges chunk data as much gas again as the default tx size cost.
+	DefaultChunkGasPerByte uint64 = 10
+
+	// DefaultMaxBlockChunkBytes keeps the chunk data of a block below the 21 MiB CometBFT
+	// default block size, leaving room for the rest of the block.
+	DefaultMaxBlockChunkBytes uint64 = 16 * 1024 * 1024
+
+	// DefaultEnabled serves the metadata store by default.
+	DefaultEnabled = true
+
+	// DefaultChunkCacheTTL keeps retrieved chunks for about a day of 6 second blocks.
+	DefaultChunkCacheTTL uint64 = 14400
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(chunkGasPerByte, maxBlockChunkBytes uint64, enabled bool, chunkCacheTTL uint64) Params {
+	return Params{
+		ChunkGasPerByte:    chunkGasPerByte,
+		MaxBlockChunkBytes: maxBlockChunkBytes,
+		Enabled:            enabled,
+		ChunkCacheTtl:      chunkCacheTTL,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultChunkGasPerByte, DefaultMaxBlockChunkBytes, DefaultEnabled, DefaultChunkCacheTTL)
 }
 
 // Validate validates the set of params.
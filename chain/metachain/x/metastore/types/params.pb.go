@@ -26,6 +26,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// chunk_gas_per_byte is the gas charged for every byte of chunk data a
+	// transaction uploads, on top of the cost of the transaction size.
+	ChunkGasPerByte uint64 `protobuf:"varint,1,opt,name=chunk_gas_per_byte,json=chunkGasPerByte,proto3" json:"chunk_gas_per_byte,omitempty"`
+	// max_block_chunk_bytes bounds the chunk data uploaded by the transactions of
+	// a block. Zero disables the bound.
+	MaxBlockChunkBytes uint64 `protobuf:"varint,2,opt,name=max_block_chunk_bytes,json=maxBlockChunkBytes,proto3" json:"max_block_chunk_bytes,omitempty"`
+	// enabled reports whether the chain serves the metadata store. While it is
+	// false, raidchaind rejects the messages and packets of the module.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// chunk_cache_ttl is the number of blocks a chunk retrieved with
+	// MsgRetrieveChunks stays in the chunk cache. Zero disables the cache.
+	ChunkCacheTtl uint64 `protobuf:"varint,4,opt,name=chunk_cache_ttl,json=chunkCacheTtl,proto3" json:"chunk_cache_ttl,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +73,34 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetChunkGasPerByte() uint64 {
+	if m != nil {
+		return m.ChunkGasPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockChunkBytes() uint64 {
+	if m != nil {
+		return m.MaxBlockChunkBytes
+	}
+	return 0
+}
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetChunkCacheTtl() uint64 {
+	if m != nil {
+		return m.ChunkCacheTtl
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "metachain.metastore.v1.Params")
 }
@@ -70,18 +110,25 @@ func init() {
 }
 
 var fileDescriptor_3073177ea4a0f50c = []byte{
-	// 161 bytes of a gzipped FileDescriptorProto
+	// 285 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x4d, 0x2d, 0x49,
 	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0x8a, 0x4b, 0xf2, 0x8b, 0x52, 0xf5, 0xcb, 0x0c,
 	0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe0,
 	0x8a, 0xf4, 0xe0, 0x8a, 0xf4, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1,
 	0x24, 0x44, 0xa9, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95,
-	0xf4, 0xb9, 0xd8, 0x02, 0xc0, 0x06, 0x5a, 0xa9, 0xbe, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83,
-	0x96, 0x0c, 0xc2, 0xe2, 0x0a, 0x24, 0xab, 0x21, 0xca, 0x9c, 0x4c, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x1a, 0xbb, 0xbe, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0x75, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37, 0x08, 0xbe, 0x39, 0xd6, 0x00, 0x00,
-	0x00,
+	0x2e, 0x32, 0x72, 0xb1, 0x05, 0x80, 0x4d, 0x14, 0xd2, 0xe6, 0x12, 0x4a, 0xce, 0x28, 0xcd, 0xcb,
+	0x8e, 0x4f, 0x4f, 0x2c, 0x8e, 0x2f, 0x48, 0x2d, 0x8a, 0x4f, 0xaa, 0x2c, 0x49, 0x95, 0x60, 0x54,
+	0x60, 0xd4, 0x60, 0x09, 0xe2, 0x07, 0xcb, 0xb8, 0x27, 0x16, 0x07, 0xa4, 0x16, 0x39, 0x55, 0x96,
+	0xa4, 0x0a, 0x19, 0x72, 0x89, 0xe6, 0x26, 0x56, 0xc4, 0x27, 0xe5, 0xe4, 0x27, 0x67, 0xc7, 0x43,
+	0xb4, 0x81, 0x94, 0x17, 0x4b, 0x30, 0x81, 0xd5, 0x0b, 0xe5, 0x26, 0x56, 0x38, 0x81, 0xe4, 0x9c,
+	0x41, 0x52, 0x20, 0x1d, 0xc5, 0x42, 0x12, 0x5c, 0xec, 0xa9, 0x79, 0x89, 0x49, 0x39, 0xa9, 0x29,
+	0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x1c, 0x41, 0x30, 0xae, 0x90, 0x1a, 0x17, 0xc4, 0xfc, 0xf8, 0xe4,
+	0xc4, 0xe4, 0x8c, 0xd4, 0xf8, 0x92, 0x92, 0x1c, 0x09, 0x16, 0xb0, 0x31, 0xbc, 0x60, 0x61, 0x67,
+	0x90, 0x68, 0x48, 0x49, 0x8e, 0x95, 0xea, 0x8b, 0x05, 0xf2, 0x8c, 0x5d, 0xcf, 0x37, 0x68, 0xc9,
+	0x20, 0xc2, 0xa6, 0x02, 0x29, 0x74, 0x20, 0x1e, 0x71, 0x32, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
+	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
+	0xc6, 0x63, 0x39, 0x86, 0x28, 0x69, 0xec, 0xfa, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0,
+	0x21, 0x62, 0x0c, 0x18, 0x00, 0x12, 0x3d, 0x32, 0x7e, 0x79, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +150,18 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.ChunkGasPerByte != that1.ChunkGasPerByte {
+		return false
+	}
+	if this.MaxBlockChunkBytes != that1.MaxBlockChunkBytes {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ChunkCacheTtl != that1.ChunkCacheTtl {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +184,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChunkCacheTtl != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChunkCacheTtl))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBlockChunkBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockChunkBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChunkGasPerByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChunkGasPerByte))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +229,18 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ChunkGasPerByte != 0 {
+		n += 1 + sovParams(uint64(m.ChunkGasPerByte))
+	}
+	if m.MaxBlockChunkBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlockChunkBytes))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ChunkCacheTtl != 0 {
+		n += 1 + sovParams(uint64(m.ChunkCacheTtl))
+	}
 	return n
 }
 
@@ -183,6 +279,83 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkGasPerByte", wireType)
+			}
+			m.ChunkGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChunkBytes", wireType)
+			}
+			m.MaxBlockChunkBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockChunkBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCacheTtl", wireType)
+			}
+			m.ChunkCacheTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCacheTtl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// Validate checks that p can have placed chunks: every chunk went to one of its datachains, and
+// its chunk sizes, when recorded, are those of chunks.
+func (p Placement) Validate(chunks []ChunkUpload) error {
+	if p.Policy == "" {
+		return errors.Wrap(ErrInvalidPlacement, "no policy")
+	}
+
+	datachains := make(map[string]bool, len(p.Datachains))
+	for _, datachain := range p.Datachains {
+		if datachain.ConnectionId == "" {
+			return errors.Wrap(ErrInvalidPlacement, "datachain without a connection id")
+		}
+		if datachains[datachain.ConnectionId] {
+			return errors.Wrapf(ErrInvalidPlacement, "duplicated datachain %s", datachain.ConnectionId)
+		}
+		datachains[datachain.ConnectionId] = true
+	}
+
+	if len(p.ChunkSizes) > 0 && len(p.ChunkSizes) != len(chunks) {
+		return errors.Wrapf(ErrInvalidPlacement, "%d chunk sizes for %d chunks", len(p.ChunkSizes), len(chunks))
+	}
+	for i, chunk := range chunks {
+		if !datachains[chunk.ConnectionId] {
+			return errors.Wrapf(ErrInvalidPlacement, "chunk %s is placed on %s, which is not a datachain of the placement", chunk.Index, chunk.ConnectionId)
+		}
+		if len(p.ChunkSizes) > 0 && p.ChunkSizes[i] != uint64(len(chunk.Data)) {
+			return errors.Wrapf(ErrInvalidPlacement, "chunk %s is %d bytes, recorded as %d", chunk.Index, len(chunk.Data), p.ChunkSizes[i])
+		}
+	}
+
+	return nil
+}
+
+// Stripe returns the stripe of the chunk at position i of the placed chunks.
+func (p Placement) Stripe(i int) int {
+	if p.StripeWidth == 0 {
+		return 0
+	}
+	return i / int(p.StripeWidth)
+}
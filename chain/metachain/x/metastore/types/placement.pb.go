@@ -0,0 +1,932 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: metachain/metastore/v1/placement.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Placement records how the upload tooling assigned the chunks of a resource
+// to datachains: the policy and every input it decided on, so that a repair
+// job can run the policy again and get the same connections.
+type Placement struct {
+	// policy names the placement policy, such as round-robin or
+	// least-used-bytes.
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// stripe_width is the number of consecutive chunks forming a stripe.
+	StripeWidth uint32 `protobuf:"varint,2,opt,name=stripe_width,json=stripeWidth,proto3" json:"stripe_width,omitempty"`
+	// datachains are the datachains the chunks could be placed on, with their
+	// load as queried at upload time.
+	Datachains []DatachainLoad `protobuf:"bytes,3,rep,name=datachains,proto3" json:"datachains"`
+	// chunk_sizes are the sizes of the chunks, in upload order.
+	ChunkSizes []uint64 `protobuf:"varint,4,rep,packed,name=chunk_sizes,json=chunkSizes,proto3" json:"chunk_sizes,omitempty"`
+}
+
+func (m *Placement) Reset()         { *m = Placement{} }
+func (m *Placement) String() string { return proto.CompactTextString(m) }
+func (*Placement) ProtoMessage()    {}
+func (*Placement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70498637e7105e3b, []int{0}
+}
+func (m *Placement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Placement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Placement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Placement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Placement.Merge(m, src)
+}
+func (m *Placement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Placement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Placement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Placement proto.InternalMessageInfo
+
+func (m *Placement) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *Placement) GetStripeWidth() uint32 {
+	if m != nil {
+		return m.StripeWidth
+	}
+	return 0
+}
+
+func (m *Placement) GetDatachains() []DatachainLoad {
+	if m != nil {
+		return m.Datachains
+	}
+	return nil
+}
+
+func (m *Placement) GetChunkSizes() []uint64 {
+	if m != nil {
+		return m.ChunkSizes
+	}
+	return nil
+}
+
+// DatachainLoad is the load of a datachain a placement was decided on.
+type DatachainLoad struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ChainId      string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Chunks       uint64 `protobuf:"varint,3,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// bytes is the data the datachain stores, as compressed.
+	Bytes uint64 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// capacity is the data the datachain is meant to hold, 0 if unbounded.
+	Capacity        uint64    `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	LatestBlockTime time.Time `protobuf:"bytes,6,opt,name=latest_block_time,json=latestBlockTime,proto3,stdtime" json:"latest_block_time"`
+}
+
+func (m *DatachainLoad) Reset()         { *m = DatachainLoad{} }
+func (m *DatachainLoad) String() string { return proto.CompactTextString(m) }
+func (*DatachainLoad) ProtoMessage()    {}
+func (*DatachainLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70498637e7105e3b, []int{1}
+}
+func (m *DatachainLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatachainLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatachainLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatachainLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatachainLoad.Merge(m, src)
+}
+func (m *DatachainLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatachainLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatachainLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatachainLoad proto.InternalMessageInfo
+
+func (m *DatachainLoad) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *DatachainLoad) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *DatachainLoad) GetChunks() uint64 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *DatachainLoad) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *DatachainLoad) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *DatachainLoad) GetLatestBlockTime() time.Time {
+	if m != nil {
+		return m.LatestBlockTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Placement)(nil), "metachain.metastore.v1.Placement")
+	proto.RegisterType((*DatachainLoad)(nil), "metachain.metastore.v1.DatachainLoad")
+}
+
+func init() {
+	proto.RegisterFile("metachain/metastore/v1/placement.proto", fileDescriptor_70498637e7105e3b)
+}
+
+var fileDescriptor_70498637e7105e3b = []byte{
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0x8e, 0xd7, 0xac, 0x74, 0xce, 0x2a, 0x84, 0x35, 0x4d, 0x21, 0x48, 0x49, 0x18, 0x02, 0xe5,
+	0xe4, 0x68, 0x43, 0xbc, 0x40, 0xc4, 0x65, 0x82, 0xc3, 0x14, 0x90, 0x90, 0xb8, 0x44, 0x8e, 0x63,
+	0x52, 0x6b, 0x49, 0x1c, 0xd5, 0xde, 0x20, 0x3c, 0xc5, 0x1e, 0x86, 0x87, 0xd8, 0xb1, 0x47, 0x4e,
+	0x80, 0xda, 0x23, 0x2f, 0x81, 0xec, 0x24, 0x6d, 0x91, 0x76, 0xf3, 0xf7, 0xcf, 0xfe, 0xec, 0x9f,
+	0xe1, 0xab, 0x9a, 0x29, 0x42, 0x17, 0x84, 0x37, 0xb1, 0x5e, 0x49, 0x25, 0x96, 0x2c, 0xbe, 0x3d,
+	0x8f, 0xdb, 0x8a, 0x50, 0x56, 0xb3, 0x46, 0xe1, 0x76, 0x29, 0x94, 0x40, 0xa7, 0x5b, 0x1f, 0xde,
+	0xfa, 0xf0, 0xed, 0xb9, 0x77, 0x52, 0x8a, 0x52, 0x18, 0x4b, 0xac, 0x57, 0xbd, 0xdb, 0x0b, 0x4a,
+	0x21, 0xca, 0x8a, 0xc5, 0x06, 0xe5, 0x37, 0x5f, 0x62, 0xc5, 0x6b, 0x26, 0x15, 0xa9, 0xdb, 0xde,
+	0x70, 0xf6, 0x03, 0xc0, 0xa3, 0xab, 0xf1, 0x08, 0x74, 0x0a, 0xa7, 0xad, 0xa8, 0x38, 0xed, 0x5c,
+	0x10, 0x82, 0xe8, 0x28, 0x1d, 0x10, 0x7a, 0x0e, 0x8f, 0xa5, 0x5a, 0xf2, 0x96, 0x65, 0x5f, 0x79,
+	0xa1, 0x16, 0xee, 0x41, 0x08, 0xa2, 0x79, 0xea, 0xf4, 0xdc, 0x27, 0x4d, 0xa1, 0x77, 0x10, 0x16,
+	0x64, 0x68, 0x26, 0xdd, 0x49, 0x38, 0x89, 0x9c, 0x8b, 0x97, 0xf8, 0xe1, 0xb2, 0xf8, 0xed, 0xe8,
+	0x7c, 0x2f, 0x48, 0x91, 0xd8, 0xf7, 0xbf, 0x02, 0x2b, 0xdd, 0x8b, 0xa3, 0x00, 0x3a, 0x74, 0x71,
+	0xd3, 0x5c, 0x67, 0x92, 0x7f, 0x67, 0xd2, 0xb5, 0xc3, 0x49, 0x64, 0xa7, 0xd0, 0x50, 0x1f, 0x34,
+	0x73, 0xf6, 0x17, 0xc0, 0xf9, 0x7f, 0x9b, 0xa0, 0x17, 0x70, 0x4e, 0x45, 0xd3, 0x30, 0xaa, 0xb8,
+	0x68, 0x32, 0x5e, 0x0c, 0x37, 0x38, 0xde, 0x91, 0x97, 0x05, 0x7a, 0x0a, 0x67, 0x26, 0xa1, 0xf5,
+	0x03, 0xa3, 0x3f, 0x32, 0xf8, 0xb2, 0xd0, 0x57, 0x37, 0xfb, 0xeb, 0xee, 0x20, 0xb2, 0xd3, 0x01,
+	0xa1, 0x13, 0x78, 0x98, 0x77, 0xca, 0x94, 0xd0, 0x74, 0x0f, 0x90, 0x07, 0x67, 0x94, 0xb4, 0x84,
+	0x72, 0xd5, 0xb9, 0x87, 0x46, 0xd8, 0x62, 0x74, 0x05, 0x9f, 0x54, 0x44, 0x31, 0xa9, 0xb2, 0xbc,
+	0x12, 0xf4, 0x3a, 0xd3, 0x4f, 0xee, 0x4e, 0x43, 0x10, 0x39, 0x17, 0x1e, 0xee, 0xe7, 0x81, 0xc7,
+	0x79, 0xe0, 0x8f, 0xe3, 0x3c, 0x92, 0x99, 0x7e, 0x85, 0xbb, 0xdf, 0x01, 0x48, 0x1f, 0xf7, 0xf1,
+	0x44, 0xa7, 0xb5, 0x9e, 0xbc, 0xb9, 0x5f, 0xfb, 0x60, 0xb5, 0xf6, 0xc1, 0x9f, 0xb5, 0x0f, 0xee,
+	0x36, 0xbe, 0xb5, 0xda, 0xf8, 0xd6, 0xcf, 0x8d, 0x6f, 0x7d, 0x7e, 0xb6, 0xfb, 0x35, 0xdf, 0xf6,
+	0xfe, 0x8d, 0xea, 0x5a, 0x26, 0xf3, 0xa9, 0x39, 0xe5, 0xf5, 0xbf, 0x01, 0x00, 0x85, 0x11, 0xc9,
+	0x6b, 0x5b, 0x02, 0x00, 0x00,
+}
+
+func (m *Placement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Placement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Placement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChunkSizes) > 0 {
+		dAtA2 := make([]byte, len(m.ChunkSizes)*10)
+		var j1 int
+		for _, num := range m.ChunkSizes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPlacement(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Datachains) > 0 {
+		for iNdEx := len(m.Datachains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datachains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlacement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StripeWidth != 0 {
+		i = encodeVarintPlacement(dAtA, i, uint64(m.StripeWidth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintPlacement(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DatachainLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatachainLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatachainLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LatestBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LatestBlockTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPlacement(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if m.Capacity != 0 {
+		i = encodeVarintPlacement(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintPlacement(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintPlacement(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintPlacement(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintPlacement(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPlacement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPlacement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Placement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovPlacement(uint64(l))
+	}
+	if m.StripeWidth != 0 {
+		n += 1 + sovPlacement(uint64(m.StripeWidth))
+	}
+	if len(m.Datachains) > 0 {
+		for _, e := range m.Datachains {
+			l = e.Size()
+			n += 1 + l + sovPlacement(uint64(l))
+		}
+	}
+	if len(m.ChunkSizes) > 0 {
+		l = 0
+		for _, e := range m.ChunkSizes {
+			l += sovPlacement(uint64(e))
+		}
+		n += 1 + sovPlacement(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DatachainLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovPlacement(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovPlacement(uint64(l))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovPlacement(uint64(m.Chunks))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovPlacement(uint64(m.Bytes))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovPlacement(uint64(m.Capacity))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LatestBlockTime)
+	n += 1 + l + sovPlacement(uint64(l))
+	return n
+}
+
+func sovPlacement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPlacement(x uint64) (n int) {
+	return sovPlacement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Placement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlacement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Placement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Placement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StripeWidth", wireType)
+			}
+			m.StripeWidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StripeWidth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datachains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datachains = append(m.Datachains, DatachainLoad{})
+			if err := m.Datachains[len(m.Datachains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlacement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChunkSizes = append(m.ChunkSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlacement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlacement
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlacement
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChunkSizes) == 0 {
+					m.ChunkSizes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlacement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChunkSizes = append(m.ChunkSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSizes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlacement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatachainLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlacement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatachainLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatachainLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LatestBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlacement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPlacement(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPlacement
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPlacement
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPlacement
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPlacement
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPlacement        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPlacement          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPlacement = fmt.Errorf("proto: unexpected end of group")
+)
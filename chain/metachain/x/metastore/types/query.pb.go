@@ -302,512 +302,3272 @@ func (m *QueryAllStoredMetaResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "metachain.metastore.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "metachain.metastore.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetStoredMetaRequest)(nil), "metachain.metastore.v1.QueryGetStoredMetaRequest")
-	proto.RegisterType((*QueryGetStoredMetaResponse)(nil), "metachain.metastore.v1.QueryGetStoredMetaResponse")
-	proto.RegisterType((*QueryAllStoredMetaRequest)(nil), "metachain.metastore.v1.QueryAllStoredMetaRequest")
-	proto.RegisterType((*QueryAllStoredMetaResponse)(nil), "metachain.metastore.v1.QueryAllStoredMetaResponse")
+// QueryStoredMetaByPrefixRequest defines the QueryStoredMetaByPrefixRequest message.
+type QueryStoredMetaByPrefixRequest struct {
+	Prefix     string             `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("metachain/metastore/v1/query.proto", fileDescriptor_86de99bf0c5e218f)
+func (m *QueryStoredMetaByPrefixRequest) Reset()         { *m = QueryStoredMetaByPrefixRequest{} }
+func (m *QueryStoredMetaByPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredMetaByPrefixRequest) ProtoMessage()    {}
+func (*QueryStoredMetaByPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{6}
 }
-
-var fileDescriptor_86de99bf0c5e218f = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6a, 0x13, 0x41,
-	0x18, 0xc7, 0x33, 0xad, 0x0d, 0x74, 0x8a, 0x82, 0x63, 0x10, 0x5d, 0x65, 0x2c, 0x53, 0xac, 0x25,
-	0xc1, 0x19, 0x36, 0xc5, 0x07, 0x68, 0x0e, 0x16, 0x41, 0xa1, 0xae, 0x17, 0xf1, 0x22, 0x93, 0x74,
-	0x58, 0x17, 0x92, 0x99, 0xed, 0xce, 0x34, 0xb4, 0x88, 0x17, 0x6f, 0xde, 0x04, 0x5f, 0xc1, 0x83,
-	0xe0, 0xa5, 0x8f, 0xd1, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0x44, 0xd0, 0xc7, 0x90, 0x9d, 0x19, 0x93,
-	0x8d, 0xdd, 0x6d, 0xa2, 0x97, 0x30, 0x99, 0xfc, 0xff, 0xdf, 0xf7, 0xfb, 0xf2, 0xfd, 0x77, 0x21,
-	0x19, 0x08, 0xc3, 0x7b, 0xaf, 0x78, 0x22, 0x59, 0x7e, 0xd2, 0x46, 0x65, 0x82, 0x0d, 0x43, 0x76,
-	0x70, 0x28, 0xb2, 0x63, 0x9a, 0x66, 0xca, 0x28, 0x74, 0x7d, 0xa2, 0xa1, 0x13, 0x0d, 0x1d, 0x86,
-	0xc1, 0x55, 0x3e, 0x48, 0xa4, 0x62, 0xf6, 0xd3, 0x49, 0x83, 0x66, 0x4f, 0xe9, 0x81, 0xd2, 0xac,
-	0xcb, 0xb5, 0x70, 0x35, 0xd8, 0x30, 0xec, 0x0a, 0xc3, 0x43, 0x96, 0xf2, 0x38, 0x91, 0xdc, 0x24,
-	0x4a, 0x7a, 0x6d, 0x23, 0x56, 0xb1, 0xb2, 0x47, 0x96, 0x9f, 0xfc, 0xed, 0xed, 0x58, 0xa9, 0xb8,
-	0x2f, 0x18, 0x4f, 0x13, 0xc6, 0xa5, 0x54, 0xc6, 0x5a, 0xb4, 0xff, 0x75, 0xa3, 0x02, 0x37, 0xe5,
-	0x19, 0x1f, 0xfc, 0x11, 0x6d, 0x55, 0x88, 0xec, 0x61, 0xff, 0x65, 0x7e, 0xe7, 0x94, 0xa4, 0x01,
-	0xd1, 0xd3, 0x1c, 0x72, 0xcf, 0xda, 0x23, 0x71, 0x70, 0x28, 0xb4, 0x21, 0xcf, 0xe1, 0xb5, 0x99,
-	0x5b, 0x9d, 0x2a, 0xa9, 0x05, 0xda, 0x81, 0x75, 0xd7, 0xe6, 0x06, 0x58, 0x07, 0x5b, 0x6b, 0x6d,
-	0x4c, 0xcb, 0xff, 0x17, 0xea, 0x7c, 0x9d, 0xd5, 0xd3, 0x6f, 0x77, 0x6a, 0x9f, 0x7e, 0x9e, 0x34,
-	0x41, 0xe4, 0x8d, 0x24, 0x84, 0x37, 0x6d, 0xe5, 0x5d, 0x61, 0x9e, 0x59, 0x98, 0x27, 0xc2, 0x70,
-	0xdf, 0x16, 0x35, 0xe0, 0x4a, 0x22, 0xf7, 0xc5, 0x91, 0x2d, 0xbf, 0x1a, 0xb9, 0x2f, 0x24, 0x86,
-	0x41, 0x99, 0xc5, 0x33, 0x3d, 0x82, 0x6b, 0x85, 0xa9, 0x3c, 0x18, 0xa9, 0x02, 0x9b, 0x16, 0xe8,
-	0x5c, 0xca, 0xe1, 0x22, 0xa8, 0x27, 0x37, 0xa4, 0xe7, 0xd9, 0x76, 0xfa, 0xfd, 0xf3, 0x6c, 0x0f,
-	0x21, 0x9c, 0xee, 0xcf, 0xb7, 0xd9, 0xa4, 0x6e, 0xd9, 0x34, 0x5f, 0x36, 0x75, 0x81, 0xf1, 0xcb,
-	0xa6, 0x7b, 0x3c, 0x16, 0xde, 0x1b, 0x15, 0x9c, 0xe4, 0x04, 0xf8, 0x71, 0xfe, 0xea, 0x52, 0x35,
-	0xce, 0xf2, 0xff, 0x8e, 0x83, 0x76, 0x67, 0x88, 0x97, 0x2c, 0xf1, 0xbd, 0xb9, 0xc4, 0x8e, 0xa3,
-	0x88, 0xdc, 0xfe, 0xb5, 0x0c, 0x57, 0x2c, 0x32, 0x7a, 0x07, 0x60, 0xdd, 0xed, 0x16, 0x35, 0xab,
-	0x98, 0xce, 0xc7, 0x29, 0x68, 0x2d, 0xa4, 0x75, 0x9d, 0xc9, 0xe6, 0xdb, 0x2f, 0x3f, 0x3e, 0x2c,
-	0xad, 0x23, 0xcc, 0x2e, 0x4c, 0x3a, 0xfa, 0x0c, 0xe0, 0xe5, 0x99, 0x48, 0xa0, 0xf0, 0xc2, 0x36,
-	0x65, 0x89, 0x0b, 0xda, 0xff, 0x62, 0xf1, 0x80, 0xdb, 0x16, 0xf0, 0x3e, 0x6a, 0xb1, 0xf9, 0x4f,
-	0x19, 0x7b, 0x6d, 0x33, 0xfc, 0x06, 0x7d, 0x04, 0xf0, 0xca, 0xe3, 0x44, 0x2f, 0x8e, 0x5b, 0x16,
-	0xc2, 0x39, 0xb8, 0xa5, 0x89, 0x22, 0x2d, 0x8b, 0x7b, 0x17, 0x6d, 0x2c, 0x80, 0xdb, 0x79, 0x70,
-	0x3a, 0xc2, 0xe0, 0x6c, 0x84, 0xc1, 0xf7, 0x11, 0x06, 0xef, 0xc7, 0xb8, 0x76, 0x36, 0xc6, 0xb5,
-	0xaf, 0x63, 0x5c, 0x7b, 0x71, 0x6b, 0xea, 0x3e, 0x2a, 0xf8, 0xcd, 0x71, 0x2a, 0x74, 0xb7, 0x6e,
-	0x5f, 0x26, 0xdb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x91, 0xef, 0x2f, 0xf8, 0x4c, 0x05, 0x00,
-	0x00,
+func (m *QueryStoredMetaByPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredMetaByPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredMetaByPrefixRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStoredMetaByPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredMetaByPrefixRequest.Merge(m, src)
+}
+func (m *QueryStoredMetaByPrefixRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredMetaByPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredMetaByPrefixRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryStoredMetaByPrefixRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListStoredMeta Queries a list of StoredMeta items.
-	GetStoredMeta(ctx context.Context, in *QueryGetStoredMetaRequest, opts ...grpc.CallOption) (*QueryGetStoredMetaResponse, error)
-	// ListStoredMeta defines the ListStoredMeta RPC.
-	ListStoredMeta(ctx context.Context, in *QueryAllStoredMetaRequest, opts ...grpc.CallOption) (*QueryAllStoredMetaResponse, error)
+func (m *QueryStoredMetaByPrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryStoredMetaByPrefixRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryStoredMetaByPrefixResponse defines the QueryStoredMetaByPrefixResponse message.
+type QueryStoredMetaByPrefixResponse struct {
+	StoredMeta []StoredMeta        `protobuf:"bytes,1,rep,name=stored_meta,json=storedMeta,proto3" json:"stored_meta"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStoredMetaByPrefixResponse) Reset()         { *m = QueryStoredMetaByPrefixResponse{} }
+func (m *QueryStoredMetaByPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredMetaByPrefixResponse) ProtoMessage()    {}
+func (*QueryStoredMetaByPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{7}
+}
+func (m *QueryStoredMetaByPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredMetaByPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredMetaByPrefixResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStoredMetaByPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredMetaByPrefixResponse.Merge(m, src)
+}
+func (m *QueryStoredMetaByPrefixResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredMetaByPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredMetaByPrefixResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetStoredMeta(ctx context.Context, in *QueryGetStoredMetaRequest, opts ...grpc.CallOption) (*QueryGetStoredMetaResponse, error) {
-	out := new(QueryGetStoredMetaResponse)
-	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/GetStoredMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStoredMetaByPrefixResponse proto.InternalMessageInfo
+
+func (m *QueryStoredMetaByPrefixResponse) GetStoredMeta() []StoredMeta {
+	if m != nil {
+		return m.StoredMeta
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListStoredMeta(ctx context.Context, in *QueryAllStoredMetaRequest, opts ...grpc.CallOption) (*QueryAllStoredMetaResponse, error) {
-	out := new(QueryAllStoredMetaResponse)
-	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/ListStoredMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStoredMetaByPrefixResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListStoredMeta Queries a list of StoredMeta items.
-	GetStoredMeta(context.Context, *QueryGetStoredMetaRequest) (*QueryGetStoredMetaResponse, error)
-	// ListStoredMeta defines the ListStoredMeta RPC.
-	ListStoredMeta(context.Context, *QueryAllStoredMetaRequest) (*QueryAllStoredMetaResponse, error)
+// QueryStoredMetaByFragmentRequest defines the QueryStoredMetaByFragmentRequest message.
+type QueryStoredMetaByFragmentRequest struct {
+	// fragment is the index of a chunk.
+	Fragment   string             `protobuf:"bytes,1,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryStoredMetaByFragmentRequest) Reset()         { *m = QueryStoredMetaByFragmentRequest{} }
+func (m *QueryStoredMetaByFragmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredMetaByFragmentRequest) ProtoMessage()    {}
+func (*QueryStoredMetaByFragmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryStoredMetaByFragmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetStoredMeta(ctx context.Context, req *QueryGetStoredMetaRequest) (*QueryGetStoredMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStoredMeta not implemented")
+func (m *QueryStoredMetaByFragmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredMetaByFragmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListStoredMeta(ctx context.Context, req *QueryAllStoredMetaRequest) (*QueryAllStoredMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListStoredMeta not implemented")
+func (m *QueryStoredMetaByFragmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredMetaByFragmentRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryStoredMetaByFragmentRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/metachain.metastore.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryStoredMetaByFragmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredMetaByFragmentRequest.DiscardUnknown(m)
 }
 
-func _Query_GetStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStoredMetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStoredMeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/metachain.metastore.v1.Query/GetStoredMeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStoredMeta(ctx, req.(*QueryGetStoredMetaRequest))
+var xxx_messageInfo_QueryStoredMetaByFragmentRequest proto.InternalMessageInfo
+
+func (m *QueryStoredMetaByFragmentRequest) GetFragment() string {
+	if m != nil {
+		return m.Fragment
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllStoredMetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListStoredMeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/metachain.metastore.v1.Query/ListStoredMeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListStoredMeta(ctx, req.(*QueryAllStoredMetaRequest))
+func (m *QueryStoredMetaByFragmentRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "metachain.metastore.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetStoredMeta",
-			Handler:    _Query_GetStoredMeta_Handler,
-		},
-		{
-			MethodName: "ListStoredMeta",
-			Handler:    _Query_ListStoredMeta_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "metachain/metastore/v1/query.proto",
+// QueryStoredMetaByFragmentResponse defines the QueryStoredMetaByFragmentResponse message.
+type QueryStoredMetaByFragmentResponse struct {
+	StoredMeta []StoredMeta        `protobuf:"bytes,1,rep,name=stored_meta,json=storedMeta,proto3" json:"stored_meta"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStoredMetaByFragmentResponse) Reset()         { *m = QueryStoredMetaByFragmentResponse{} }
+func (m *QueryStoredMetaByFragmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredMetaByFragmentResponse) ProtoMessage()    {}
+func (*QueryStoredMetaByFragmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{9}
+}
+func (m *QueryStoredMetaByFragmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredMetaByFragmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredMetaByFragmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStoredMetaByFragmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredMetaByFragmentResponse.Merge(m, src)
+}
+func (m *QueryStoredMetaByFragmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredMetaByFragmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredMetaByFragmentResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryStoredMetaByFragmentResponse proto.InternalMessageInfo
+
+func (m *QueryStoredMetaByFragmentResponse) GetStoredMeta() []StoredMeta {
+	if m != nil {
+		return m.StoredMeta
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStoredMetaByFragmentResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetCachedChunkRequest defines the QueryGetCachedChunkRequest message.
+type QueryGetCachedChunkRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// channel_id is the channel, or IBC v2 client, the chunk was retrieved over.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetCachedChunkRequest) Reset()         { *m = QueryGetCachedChunkRequest{} }
+func (m *QueryGetCachedChunkRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCachedChunkRequest) ProtoMessage()    {}
+func (*QueryGetCachedChunkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{10}
+}
+func (m *QueryGetCachedChunkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCachedChunkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCachedChunkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetStoredMetaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetCachedChunkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCachedChunkRequest.Merge(m, src)
 }
-
-func (m *QueryGetStoredMetaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetCachedChunkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCachedChunkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCachedChunkRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetStoredMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryGetCachedChunkRequest proto.InternalMessageInfo
+
+func (m *QueryGetCachedChunkRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetStoredMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetCachedChunkRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetCachedChunkResponse defines the QueryGetCachedChunkResponse message.
+type QueryGetCachedChunkResponse struct {
+	CachedChunk CachedChunk `protobuf:"bytes,1,opt,name=cached_chunk,json=cachedChunk,proto3" json:"cached_chunk"`
 }
 
-func (m *QueryGetStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StoredMeta.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetCachedChunkResponse) Reset()         { *m = QueryGetCachedChunkResponse{} }
+func (m *QueryGetCachedChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCachedChunkResponse) ProtoMessage()    {}
+func (*QueryGetCachedChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{11}
+}
+func (m *QueryGetCachedChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCachedChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCachedChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetCachedChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCachedChunkResponse.Merge(m, src)
+}
+func (m *QueryGetCachedChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCachedChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCachedChunkResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllStoredMetaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCachedChunkResponse proto.InternalMessageInfo
+
+func (m *QueryGetCachedChunkResponse) GetCachedChunk() CachedChunk {
+	if m != nil {
+		return m.CachedChunk
 	}
-	return dAtA[:n], nil
+	return CachedChunk{}
 }
 
-func (m *QueryAllStoredMetaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetDatachainRequest defines the QueryGetDatachainRequest message.
+type QueryGetDatachainRequest struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 }
 
-func (m *QueryAllStoredMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetDatachainRequest) Reset()         { *m = QueryGetDatachainRequest{} }
+func (m *QueryGetDatachainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDatachainRequest) ProtoMessage()    {}
+func (*QueryGetDatachainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{12}
+}
+func (m *QueryGetDatachainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDatachainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDatachainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetDatachainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDatachainRequest.Merge(m, src)
+}
+func (m *QueryGetDatachainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDatachainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDatachainRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllStoredMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDatachainRequest proto.InternalMessageInfo
+
+func (m *QueryGetDatachainRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetDatachainResponse defines the QueryGetDatachainResponse message.
+type QueryGetDatachainResponse struct {
+	Datachain Datachain `protobuf:"bytes,1,opt,name=datachain,proto3" json:"datachain"`
+	// fragments is the number of fragments manifests reference on it.
+	Fragments uint64 `protobuf:"varint,2,opt,name=fragments,proto3" json:"fragments,omitempty"`
 }
 
-func (m *QueryAllStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StoredMeta) > 0 {
-		for iNdEx := len(m.StoredMeta) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StoredMeta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetDatachainResponse) Reset()         { *m = QueryGetDatachainResponse{} }
+func (m *QueryGetDatachainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDatachainResponse) ProtoMessage()    {}
+func (*QueryGetDatachainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{13}
+}
+func (m *QueryGetDatachainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDatachainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDatachainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetDatachainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDatachainResponse.Merge(m, src)
+}
+func (m *QueryGetDatachainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDatachainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDatachainResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryGetDatachainResponse proto.InternalMessageInfo
+
+func (m *QueryGetDatachainResponse) GetDatachain() Datachain {
+	if m != nil {
+		return m.Datachain
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return Datachain{}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryGetDatachainResponse) GetFragments() uint64 {
+	if m != nil {
+		return m.Fragments
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryAllDatachainRequest defines the QueryAllDatachainRequest message.
+type QueryAllDatachainRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetStoredMetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllDatachainRequest) Reset()         { *m = QueryAllDatachainRequest{} }
+func (m *QueryAllDatachainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDatachainRequest) ProtoMessage()    {}
+func (*QueryAllDatachainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{14}
+}
+func (m *QueryAllDatachainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDatachainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDatachainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAllDatachainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDatachainRequest.Merge(m, src)
+}
+func (m *QueryAllDatachainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDatachainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDatachainRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetStoredMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllDatachainRequest proto.InternalMessageInfo
+
+func (m *QueryAllDatachainRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.StoredMeta.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllStoredMetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+// QueryAllDatachainResponse defines the QueryAllDatachainResponse message.
+type QueryAllDatachainResponse struct {
+	Datachains []Datachain         `protobuf:"bytes,1,rep,name=datachains,proto3" json:"datachains"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDatachainResponse) Reset()         { *m = QueryAllDatachainResponse{} }
+func (m *QueryAllDatachainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDatachainResponse) ProtoMessage()    {}
+func (*QueryAllDatachainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{15}
+}
+func (m *QueryAllDatachainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDatachainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDatachainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDatachainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDatachainResponse.Merge(m, src)
+}
+func (m *QueryAllDatachainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDatachainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDatachainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDatachainResponse proto.InternalMessageInfo
+
+func (m *QueryAllDatachainResponse) GetDatachains() []Datachain {
+	if m != nil {
+		return m.Datachains
+	}
+	return nil
+}
+
+func (m *QueryAllDatachainResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDatachainFragmentsRequest defines the QueryDatachainFragmentsRequest message.
+type QueryDatachainFragmentsRequest struct {
+	ConnectionId string             `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDatachainFragmentsRequest) Reset()         { *m = QueryDatachainFragmentsRequest{} }
+func (m *QueryDatachainFragmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDatachainFragmentsRequest) ProtoMessage()    {}
+func (*QueryDatachainFragmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{16}
+}
+func (m *QueryDatachainFragmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatachainFragmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatachainFragmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDatachainFragmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatachainFragmentsRequest.Merge(m, src)
+}
+func (m *QueryDatachainFragmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatachainFragmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatachainFragmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDatachainFragmentsRequest proto.InternalMessageInfo
+
+func (m *QueryDatachainFragmentsRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryDatachainFragmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDatachainFragmentsResponse defines the QueryDatachainFragmentsResponse message.
+type QueryDatachainFragmentsResponse struct {
+	Fragments  []DatachainFragment `protobuf:"bytes,1,rep,name=fragments,proto3" json:"fragments"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDatachainFragmentsResponse) Reset()         { *m = QueryDatachainFragmentsResponse{} }
+func (m *QueryDatachainFragmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDatachainFragmentsResponse) ProtoMessage()    {}
+func (*QueryDatachainFragmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86de99bf0c5e218f, []int{17}
+}
+func (m *QueryDatachainFragmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatachainFragmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatachainFragmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDatachainFragmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatachainFragmentsResponse.Merge(m, src)
+}
+func (m *QueryDatachainFragmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatachainFragmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatachainFragmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDatachainFragmentsResponse proto.InternalMessageInfo
+
+func (m *QueryDatachainFragmentsResponse) GetFragments() []DatachainFragment {
+	if m != nil {
+		return m.Fragments
+	}
+	return nil
+}
+
+func (m *QueryDatachainFragmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "metachain.metastore.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "metachain.metastore.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetStoredMetaRequest)(nil), "metachain.metastore.v1.QueryGetStoredMetaRequest")
+	proto.RegisterType((*QueryGetStoredMetaResponse)(nil), "metachain.metastore.v1.QueryGetStoredMetaResponse")
+	proto.RegisterType((*QueryAllStoredMetaRequest)(nil), "metachain.metastore.v1.QueryAllStoredMetaRequest")
+	proto.RegisterType((*QueryAllStoredMetaResponse)(nil), "metachain.metastore.v1.QueryAllStoredMetaResponse")
+	proto.RegisterType((*QueryStoredMetaByPrefixRequest)(nil), "metachain.metastore.v1.QueryStoredMetaByPrefixRequest")
+	proto.RegisterType((*QueryStoredMetaByPrefixResponse)(nil), "metachain.metastore.v1.QueryStoredMetaByPrefixResponse")
+	proto.RegisterType((*QueryStoredMetaByFragmentRequest)(nil), "metachain.metastore.v1.QueryStoredMetaByFragmentRequest")
+	proto.RegisterType((*QueryStoredMetaByFragmentResponse)(nil), "metachain.metastore.v1.QueryStoredMetaByFragmentResponse")
+	proto.RegisterType((*QueryGetCachedChunkRequest)(nil), "metachain.metastore.v1.QueryGetCachedChunkRequest")
+	proto.RegisterType((*QueryGetCachedChunkResponse)(nil), "metachain.metastore.v1.QueryGetCachedChunkResponse")
+	proto.RegisterType((*QueryGetDatachainRequest)(nil), "metachain.metastore.v1.QueryGetDatachainRequest")
+	proto.RegisterType((*QueryGetDatachainResponse)(nil), "metachain.metastore.v1.QueryGetDatachainResponse")
+	proto.RegisterType((*QueryAllDatachainRequest)(nil), "metachain.metastore.v1.QueryAllDatachainRequest")
+	proto.RegisterType((*QueryAllDatachainResponse)(nil), "metachain.metastore.v1.QueryAllDatachainResponse")
+	proto.RegisterType((*QueryDatachainFragmentsRequest)(nil), "metachain.metastore.v1.QueryDatachainFragmentsRequest")
+	proto.RegisterType((*QueryDatachainFragmentsResponse)(nil), "metachain.metastore.v1.QueryDatachainFragmentsResponse")
+}
+
+func init() {
+	proto.RegisterFile("metachain/metastore/v1/query.proto", fileDescriptor_86de99bf0c5e218f)
+}
+
+var fileDescriptor_86de99bf0c5e218f = []byte{
+	// 1031 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0x4f, 0x6b, 0xdc, 0x46,
+	0x14, 0xc0, 0x3d, 0x69, 0x62, 0xba, 0xcf, 0x4e, 0xa0, 0x53, 0x63, 0x6c, 0x25, 0x55, 0x6c, 0x99,
+	0xba, 0xb1, 0x4d, 0x35, 0x59, 0x9b, 0x26, 0x71, 0xd3, 0x3f, 0xd8, 0x4e, 0x6d, 0x0c, 0x09, 0x38,
+	0xdb, 0x4b, 0xe9, 0x65, 0x99, 0xd5, 0x4e, 0xd6, 0x22, 0xbb, 0xd2, 0x66, 0x25, 0x1b, 0x9b, 0x65,
+	0xa1, 0xf4, 0x50, 0xe8, 0xa1, 0x50, 0xe8, 0x17, 0xe8, 0xa1, 0x87, 0x42, 0x69, 0xc9, 0xa5, 0x94,
+	0x42, 0x3f, 0x40, 0x7a, 0x29, 0x81, 0x5c, 0x7a, 0x2a, 0xc5, 0x2e, 0xf4, 0xd6, 0xcf, 0x50, 0x34,
+	0x7a, 0x23, 0x69, 0xbd, 0xd2, 0x6a, 0xd7, 0xec, 0x21, 0x17, 0x7b, 0x34, 0xfb, 0xfe, 0xfc, 0xde,
+	0xd3, 0x7b, 0xf3, 0x46, 0x60, 0x34, 0x84, 0xcf, 0xad, 0x7d, 0x6e, 0x3b, 0x2c, 0x58, 0x79, 0xbe,
+	0xdb, 0x12, 0xec, 0xb0, 0xc8, 0x9e, 0x1c, 0x88, 0xd6, 0xb1, 0xd9, 0x6c, 0xb9, 0xbe, 0x4b, 0xa7,
+	0x23, 0x19, 0x33, 0x92, 0x31, 0x0f, 0x8b, 0xda, 0x6b, 0xbc, 0x61, 0x3b, 0x2e, 0x93, 0x7f, 0x43,
+	0x51, 0x6d, 0xd9, 0x72, 0xbd, 0x86, 0xeb, 0xb1, 0x0a, 0xf7, 0x44, 0x68, 0x83, 0x1d, 0x16, 0x2b,
+	0xc2, 0xe7, 0x45, 0xd6, 0xe4, 0x35, 0xdb, 0xe1, 0xbe, 0xed, 0x3a, 0x28, 0x3b, 0x55, 0x73, 0x6b,
+	0xae, 0x5c, 0xb2, 0x60, 0x85, 0xbb, 0xd7, 0x6a, 0xae, 0x5b, 0xab, 0x0b, 0xc6, 0x9b, 0x36, 0xe3,
+	0x8e, 0xe3, 0xfa, 0x52, 0xc5, 0xc3, 0x5f, 0x97, 0x32, 0x70, 0x2d, 0x6e, 0xed, 0x8b, 0x6a, 0xd9,
+	0xda, 0x3f, 0x70, 0x1e, 0xa3, 0xe8, 0x62, 0x86, 0x68, 0x95, 0xab, 0x60, 0x42, 0xb9, 0x85, 0x0c,
+	0xb9, 0x26, 0x6f, 0xf1, 0x86, 0xf2, 0x7b, 0x23, 0x43, 0x48, 0x2e, 0xaa, 0xe5, 0x60, 0x2f, 0x94,
+	0x34, 0xa6, 0x80, 0x3e, 0x0c, 0xe2, 0xde, 0x93, 0xea, 0x25, 0xf1, 0xe4, 0x40, 0x78, 0xbe, 0xf1,
+	0x09, 0xbc, 0xde, 0xb5, 0xeb, 0x35, 0x5d, 0xc7, 0x13, 0x74, 0x03, 0xc6, 0x43, 0x37, 0x33, 0x64,
+	0x8e, 0xdc, 0x98, 0x58, 0xd5, 0xcd, 0xf4, 0x54, 0x9b, 0xa1, 0xde, 0x66, 0xe1, 0xd9, 0x5f, 0xd7,
+	0xc7, 0xbe, 0xff, 0xf7, 0xe9, 0x32, 0x29, 0xa1, 0xa2, 0x51, 0x84, 0x59, 0x69, 0x79, 0x47, 0xf8,
+	0x1f, 0x4b, 0x98, 0x07, 0xc2, 0xe7, 0xe8, 0x96, 0x4e, 0xc1, 0x25, 0xdb, 0xa9, 0x8a, 0x23, 0x69,
+	0xbe, 0x50, 0x0a, 0x1f, 0x8c, 0x1a, 0x68, 0x69, 0x2a, 0xc8, 0xb4, 0x0b, 0x13, 0x89, 0xa8, 0x10,
+	0xcc, 0xc8, 0x02, 0x8b, 0x0d, 0x6c, 0x5e, 0x0c, 0xe0, 0x4a, 0xe0, 0x45, 0x3b, 0x86, 0x85, 0x6c,
+	0x1b, 0xf5, 0x7a, 0x2f, 0xdb, 0x36, 0x40, 0x5c, 0x12, 0xe8, 0x66, 0xd1, 0x0c, 0xeb, 0xc7, 0x0c,
+	0xea, 0xc7, 0x0c, 0x6b, 0x10, 0xeb, 0xc7, 0xdc, 0xe3, 0x35, 0x81, 0xba, 0xa5, 0x84, 0xa6, 0xf1,
+	0x94, 0x80, 0x96, 0xe6, 0x25, 0x2b, 0x9c, 0x57, 0xce, 0x1b, 0x0e, 0xdd, 0xe9, 0x22, 0xbe, 0x20,
+	0x89, 0xdf, 0xca, 0x25, 0x0e, 0x39, 0xba, 0x90, 0x3f, 0x23, 0xa0, 0x4b, 0xe4, 0x84, 0xbb, 0xe3,
+	0xbd, 0x96, 0x78, 0x64, 0x1f, 0xa9, 0xec, 0x4c, 0xc3, 0x78, 0x53, 0x6e, 0xe0, 0xab, 0xc3, 0x27,
+	0xba, 0x9d, 0xc2, 0x70, 0x9e, 0xac, 0xfd, 0x4c, 0xe0, 0x7a, 0x26, 0xc2, 0x4b, 0x9c, 0xba, 0x2f,
+	0x08, 0xcc, 0xf5, 0x70, 0x6f, 0xb7, 0x78, 0xad, 0x21, 0x1c, 0x5f, 0x25, 0x4f, 0x83, 0x57, 0x1f,
+	0xe1, 0x16, 0xa6, 0x2f, 0x7a, 0x1e, 0x59, 0x02, 0x7f, 0x21, 0x30, 0xdf, 0x07, 0xe4, 0x25, 0x4e,
+	0xe1, 0xc3, 0xb8, 0xfd, 0xb7, 0xe4, 0xb1, 0xb9, 0x15, 0x9c, 0x9a, 0x7d, 0x8f, 0x0c, 0xfa, 0x06,
+	0x80, 0xb5, 0xcf, 0x1d, 0x47, 0xd4, 0xcb, 0x76, 0x55, 0x3a, 0x2f, 0x94, 0x0a, 0xb8, 0xb3, 0x5b,
+	0x35, 0x1e, 0xc3, 0xd5, 0x54, 0x93, 0x98, 0x85, 0xfb, 0x30, 0x99, 0x3c, 0xa0, 0xb1, 0xd9, 0x17,
+	0xb2, 0xd2, 0x90, 0x30, 0x81, 0x79, 0x98, 0xb0, 0xe2, 0x2d, 0xe3, 0x43, 0x98, 0x51, 0xce, 0xee,
+	0xa9, 0xb3, 0x5c, 0xd1, 0x2f, 0xc0, 0x65, 0xcb, 0x75, 0x1c, 0x61, 0x05, 0x91, 0x06, 0xa8, 0x61,
+	0x14, 0x93, 0xf1, 0xe6, 0x6e, 0x35, 0x68, 0xbf, 0xd9, 0x14, 0x0b, 0x08, 0xfb, 0x11, 0x14, 0xa2,
+	0x11, 0x81, 0xa4, 0xf3, 0x59, 0xa4, 0x91, 0x36, 0x72, 0xc6, 0x9a, 0xf4, 0x1a, 0x14, 0x54, 0xcd,
+	0x79, 0x32, 0x61, 0x17, 0x4b, 0xf1, 0x86, 0x51, 0xc1, 0x18, 0x36, 0xea, 0xf5, 0x9e, 0x18, 0x46,
+	0x75, 0x30, 0xfe, 0x48, 0x60, 0x36, 0xc5, 0x09, 0x86, 0xb9, 0x03, 0x10, 0xc1, 0x7a, 0x58, 0x98,
+	0x03, 0xc7, 0x99, 0x50, 0x1d, 0x5d, 0x5d, 0x7e, 0xa5, 0x4e, 0xc5, 0xc8, 0x9b, 0x6a, 0x27, 0x6f,
+	0x98, 0xd7, 0x3b, 0xb2, 0x0e, 0xff, 0x55, 0x1d, 0x91, 0x69, 0x3c, 0x98, 0xc5, 0x07, 0xc9, 0xb7,
+	0x1c, 0x26, 0x71, 0x29, 0x37, 0x89, 0xca, 0x8c, 0x2a, 0x9a, 0xc8, 0xc2, 0xc8, 0x72, 0xb9, 0xfa,
+	0xdf, 0x24, 0x5c, 0x92, 0xec, 0xf4, 0x4b, 0x02, 0xe3, 0xe1, 0xed, 0x81, 0x2e, 0x67, 0x91, 0xf5,
+	0x5e, 0x58, 0xb4, 0x95, 0x81, 0x64, 0x43, 0xcf, 0xc6, 0xe2, 0xe7, 0x2f, 0xfe, 0xf9, 0xe6, 0xc2,
+	0x1c, 0xd5, 0x59, 0xdf, 0xbb, 0x14, 0xfd, 0x81, 0xc0, 0xe5, 0xae, 0x4b, 0x07, 0x2d, 0xf6, 0x75,
+	0x93, 0x76, 0xa7, 0xd1, 0x56, 0x87, 0x51, 0x41, 0xc0, 0x35, 0x09, 0xf8, 0x36, 0x5d, 0x61, 0xf9,
+	0xf7, 0x38, 0xd6, 0x96, 0x47, 0x5e, 0x87, 0x7e, 0x47, 0xe0, 0xca, 0x7d, 0xdb, 0x1b, 0x1c, 0x37,
+	0xed, 0x9a, 0xa3, 0xad, 0x0e, 0xa3, 0x82, 0xb8, 0x2b, 0x12, 0xf7, 0x4d, 0xba, 0x30, 0x00, 0x2e,
+	0xfd, 0x9d, 0xc0, 0x74, 0x37, 0xa6, 0x1a, 0xe4, 0xf4, 0x56, 0x5f, 0xdf, 0x99, 0x97, 0x0f, 0xed,
+	0xf6, 0xd0, 0x7a, 0x08, 0xfe, 0x81, 0x04, 0xbf, 0x43, 0x6f, 0x0d, 0x00, 0x5e, 0xae, 0x1c, 0x97,
+	0xc3, 0x4b, 0x0d, 0x6b, 0x87, 0xff, 0x3b, 0xf4, 0x05, 0x81, 0x99, 0xb3, 0xb1, 0xa8, 0x6e, 0xa1,
+	0x77, 0x06, 0xa6, 0x3a, 0x73, 0x1f, 0xd0, 0xd6, 0xcf, 0xa1, 0x89, 0x11, 0x6d, 0xca, 0x88, 0xde,
+	0xa3, 0xef, 0x0e, 0x18, 0x91, 0xea, 0x65, 0xd6, 0x56, 0xab, 0x0e, 0xfd, 0x8d, 0xc0, 0x95, 0xee,
+	0xc9, 0x48, 0x73, 0x8b, 0xb8, 0x77, 0x32, 0x6b, 0x6b, 0x43, 0xe9, 0x20, 0xff, 0x86, 0xe4, 0xbf,
+	0x4b, 0xd7, 0xd9, 0x00, 0x5f, 0x4e, 0xac, 0x1d, 0x0f, 0xf9, 0x4e, 0xd4, 0x07, 0x3f, 0x11, 0x98,
+	0x4c, 0x4e, 0x4a, 0x7a, 0x33, 0x0f, 0xe4, 0xec, 0x48, 0xd3, 0x8a, 0x43, 0x68, 0x20, 0xf8, 0xba,
+	0x04, 0x5f, 0xa3, 0x45, 0x96, 0xf7, 0x1d, 0xc7, 0xda, 0x5d, 0x33, 0xa1, 0x43, 0xbf, 0xc5, 0xc6,
+	0xbd, 0x17, 0x0f, 0xa9, 0x9b, 0x79, 0x5d, 0x38, 0x24, 0x72, 0xda, 0x48, 0x35, 0x96, 0x24, 0xf2,
+	0x02, 0x9d, 0xcf, 0x45, 0xa6, 0x7f, 0x60, 0xd3, 0xf6, 0x8e, 0x96, 0x9c, 0xa6, 0xcd, 0x9c, 0x8d,
+	0xda, 0xed, 0xa1, 0xf5, 0x10, 0x7b, 0x4b, 0x62, 0xbf, 0x4f, 0xef, 0xe6, 0x62, 0x47, 0xd5, 0xed,
+	0x9d, 0xcd, 0xf9, 0xe6, 0x3b, 0xcf, 0x4e, 0x74, 0xf2, 0xfc, 0x44, 0x27, 0x7f, 0x9f, 0xe8, 0xe4,
+	0xeb, 0x53, 0x7d, 0xec, 0xf9, 0xa9, 0x3e, 0xf6, 0xe7, 0xa9, 0x3e, 0xf6, 0xe9, 0xd5, 0xd8, 0xea,
+	0x51, 0xc2, 0xae, 0x7f, 0xdc, 0x14, 0x5e, 0x65, 0x5c, 0x7e, 0x34, 0xaf, 0xfd, 0x3f, 0x00, 0xa0,
+	0x7d, 0x6b, 0x94, 0x87, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListStoredMeta Queries a list of StoredMeta items.
+	GetStoredMeta(ctx context.Context, in *QueryGetStoredMetaRequest, opts ...grpc.CallOption) (*QueryGetStoredMetaResponse, error)
+	// ListStoredMeta defines the ListStoredMeta RPC.
+	ListStoredMeta(ctx context.Context, in *QueryAllStoredMetaRequest, opts ...grpc.CallOption) (*QueryAllStoredMetaResponse, error)
+	// ListStoredMetaByPrefix lists the StoredMeta items whose index starts with
+	// a prefix, like every resource under example.com/blog/.
+	ListStoredMetaByPrefix(ctx context.Context, in *QueryStoredMetaByPrefixRequest, opts ...grpc.CallOption) (*QueryStoredMetaByPrefixResponse, error)
+	// ListStoredMetaByFragment lists the StoredMeta items that list a chunk.
+	ListStoredMetaByFragment(ctx context.Context, in *QueryStoredMetaByFragmentRequest, opts ...grpc.CallOption) (*QueryStoredMetaByFragmentResponse, error)
+	// GetCachedChunk queries a chunk kept in the retrieval cache.
+	GetCachedChunk(ctx context.Context, in *QueryGetCachedChunkRequest, opts ...grpc.CallOption) (*QueryGetCachedChunkResponse, error)
+	// GetDatachain queries the status of a datachain and the fragments
+	// referenced on it.
+	GetDatachain(ctx context.Context, in *QueryGetDatachainRequest, opts ...grpc.CallOption) (*QueryGetDatachainResponse, error)
+	// ListDatachains lists the datachains that are draining or retired.
+	ListDatachains(ctx context.Context, in *QueryAllDatachainRequest, opts ...grpc.CallOption) (*QueryAllDatachainResponse, error)
+	// ListDatachainFragments lists the fragments manifests reference on a
+	// datachain.
+	ListDatachainFragments(ctx context.Context, in *QueryDatachainFragmentsRequest, opts ...grpc.CallOption) (*QueryDatachainFragmentsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStoredMeta(ctx context.Context, in *QueryGetStoredMetaRequest, opts ...grpc.CallOption) (*QueryGetStoredMetaResponse, error) {
+	out := new(QueryGetStoredMetaResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/GetStoredMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListStoredMeta(ctx context.Context, in *QueryAllStoredMetaRequest, opts ...grpc.CallOption) (*QueryAllStoredMetaResponse, error) {
+	out := new(QueryAllStoredMetaResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/ListStoredMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListStoredMetaByPrefix(ctx context.Context, in *QueryStoredMetaByPrefixRequest, opts ...grpc.CallOption) (*QueryStoredMetaByPrefixResponse, error) {
+	out := new(QueryStoredMetaByPrefixResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/ListStoredMetaByPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListStoredMetaByFragment(ctx context.Context, in *QueryStoredMetaByFragmentRequest, opts ...grpc.CallOption) (*QueryStoredMetaByFragmentResponse, error) {
+	out := new(QueryStoredMetaByFragmentResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/ListStoredMetaByFragment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCachedChunk(ctx context.Context, in *QueryGetCachedChunkRequest, opts ...grpc.CallOption) (*QueryGetCachedChunkResponse, error) {
+	out := new(QueryGetCachedChunkResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/GetCachedChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDatachain(ctx context.Context, in *QueryGetDatachainRequest, opts ...grpc.CallOption) (*QueryGetDatachainResponse, error) {
+	out := new(QueryGetDatachainResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/GetDatachain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDatachains(ctx context.Context, in *QueryAllDatachainRequest, opts ...grpc.CallOption) (*QueryAllDatachainResponse, error) {
+	out := new(QueryAllDatachainResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/ListDatachains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDatachainFragments(ctx context.Context, in *QueryDatachainFragmentsRequest, opts ...grpc.CallOption) (*QueryDatachainFragmentsResponse, error) {
+	out := new(QueryDatachainFragmentsResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Query/ListDatachainFragments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListStoredMeta Queries a list of StoredMeta items.
+	GetStoredMeta(context.Context, *QueryGetStoredMetaRequest) (*QueryGetStoredMetaResponse, error)
+	// ListStoredMeta defines the ListStoredMeta RPC.
+	ListStoredMeta(context.Context, *QueryAllStoredMetaRequest) (*QueryAllStoredMetaResponse, error)
+	// ListStoredMetaByPrefix lists the StoredMeta items whose index starts with
+	// a prefix, like every resource under example.com/blog/.
+	ListStoredMetaByPrefix(context.Context, *QueryStoredMetaByPrefixRequest) (*QueryStoredMetaByPrefixResponse, error)
+	// ListStoredMetaByFragment lists the StoredMeta items that list a chunk.
+	ListStoredMetaByFragment(context.Context, *QueryStoredMetaByFragmentRequest) (*QueryStoredMetaByFragmentResponse, error)
+	// GetCachedChunk queries a chunk kept in the retrieval cache.
+	GetCachedChunk(context.Context, *QueryGetCachedChunkRequest) (*QueryGetCachedChunkResponse, error)
+	// GetDatachain queries the status of a datachain and the fragments
+	// referenced on it.
+	GetDatachain(context.Context, *QueryGetDatachainRequest) (*QueryGetDatachainResponse, error)
+	// ListDatachains lists the datachains that are draining or retired.
+	ListDatachains(context.Context, *QueryAllDatachainRequest) (*QueryAllDatachainResponse, error)
+	// ListDatachainFragments lists the fragments manifests reference on a
+	// datachain.
+	ListDatachainFragments(context.Context, *QueryDatachainFragmentsRequest) (*QueryDatachainFragmentsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetStoredMeta(ctx context.Context, req *QueryGetStoredMetaRequest) (*QueryGetStoredMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoredMeta not implemented")
+}
+func (*UnimplementedQueryServer) ListStoredMeta(ctx context.Context, req *QueryAllStoredMetaRequest) (*QueryAllStoredMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoredMeta not implemented")
+}
+func (*UnimplementedQueryServer) ListStoredMetaByPrefix(ctx context.Context, req *QueryStoredMetaByPrefixRequest) (*QueryStoredMetaByPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoredMetaByPrefix not implemented")
+}
+func (*UnimplementedQueryServer) ListStoredMetaByFragment(ctx context.Context, req *QueryStoredMetaByFragmentRequest) (*QueryStoredMetaByFragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoredMetaByFragment not implemented")
+}
+func (*UnimplementedQueryServer) GetCachedChunk(ctx context.Context, req *QueryGetCachedChunkRequest) (*QueryGetCachedChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCachedChunk not implemented")
+}
+func (*UnimplementedQueryServer) GetDatachain(ctx context.Context, req *QueryGetDatachainRequest) (*QueryGetDatachainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatachain not implemented")
+}
+func (*UnimplementedQueryServer) ListDatachains(ctx context.Context, req *QueryAllDatachainRequest) (*QueryAllDatachainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatachains not implemented")
+}
+func (*UnimplementedQueryServer) ListDatachainFragments(ctx context.Context, req *QueryDatachainFragmentsRequest) (*QueryDatachainFragmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatachainFragments not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStoredMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStoredMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Query/GetStoredMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStoredMeta(ctx, req.(*QueryGetStoredMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStoredMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListStoredMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Query/ListStoredMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListStoredMeta(ctx, req.(*QueryAllStoredMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListStoredMetaByPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredMetaByPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListStoredMetaByPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Query/ListStoredMetaByPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListStoredMetaByPrefix(ctx, req.(*QueryStoredMetaByPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListStoredMetaByFragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredMetaByFragmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListStoredMetaByFragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Query/ListStoredMetaByFragment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListStoredMetaByFragment(ctx, req.(*QueryStoredMetaByFragmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCachedChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCachedChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCachedChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Query/GetCachedChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCachedChunk(ctx, req.(*QueryGetCachedChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDatachain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDatachainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDatachain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Query/GetDatachain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDatachain(ctx, req.(*QueryGetDatachainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDatachains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDatachainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDatachains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Query/ListDatachains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDatachains(ctx, req.(*QueryAllDatachainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDatachainFragments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDatachainFragmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDatachainFragments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Query/ListDatachainFragments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDatachainFragments(ctx, req.(*QueryDatachainFragmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "metachain.metastore.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetStoredMeta",
+			Handler:    _Query_GetStoredMeta_Handler,
+		},
+		{
+			MethodName: "ListStoredMeta",
+			Handler:    _Query_ListStoredMeta_Handler,
+		},
+		{
+			MethodName: "ListStoredMetaByPrefix",
+			Handler:    _Query_ListStoredMetaByPrefix_Handler,
+		},
+		{
+			MethodName: "ListStoredMetaByFragment",
+			Handler:    _Query_ListStoredMetaByFragment_Handler,
+		},
+		{
+			MethodName: "GetCachedChunk",
+			Handler:    _Query_GetCachedChunk_Handler,
+		},
+		{
+			MethodName: "GetDatachain",
+			Handler:    _Query_GetDatachain_Handler,
+		},
+		{
+			MethodName: "ListDatachains",
+			Handler:    _Query_ListDatachains_Handler,
+		},
+		{
+			MethodName: "ListDatachainFragments",
+			Handler:    _Query_ListDatachainFragments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "metachain/metastore/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStoredMetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStoredMetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStoredMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStoredMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StoredMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStoredMetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStoredMetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStoredMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStoredMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredMeta) > 0 {
+		for iNdEx := len(m.StoredMeta) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredMeta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredMetaByPrefixRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredMetaByPrefixRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredMetaByPrefixRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredMetaByPrefixResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredMetaByPrefixResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredMetaByPrefixResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredMeta) > 0 {
+		for iNdEx := len(m.StoredMeta) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredMeta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredMetaByFragmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredMetaByFragmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredMetaByFragmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fragment) > 0 {
+		i -= len(m.Fragment)
+		copy(dAtA[i:], m.Fragment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fragment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredMetaByFragmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredMetaByFragmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredMetaByFragmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredMeta) > 0 {
+		for iNdEx := len(m.StoredMeta) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredMeta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCachedChunkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCachedChunkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCachedChunkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCachedChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCachedChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCachedChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CachedChunk.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDatachainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDatachainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDatachainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDatachainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDatachainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDatachainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fragments != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Fragments))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Datachain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDatachainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDatachainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDatachainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDatachainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDatachainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDatachainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Datachains) > 0 {
+		for iNdEx := len(m.Datachains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datachains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatachainFragmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatachainFragmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatachainFragmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatachainFragmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatachainFragmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatachainFragmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fragments) > 0 {
+		for iNdEx := len(m.Fragments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fragments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetStoredMetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStoredMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StoredMeta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStoredMetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStoredMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredMeta) > 0 {
+		for _, e := range m.StoredMeta {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredMetaByPrefixRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredMetaByPrefixResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredMeta) > 0 {
+		for _, e := range m.StoredMeta {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredMetaByFragmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fragment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredMetaByFragmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredMeta) > 0 {
+		for _, e := range m.StoredMeta {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCachedChunkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCachedChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CachedChunk.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDatachainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDatachainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Datachain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Fragments != 0 {
+		n += 1 + sovQuery(uint64(m.Fragments))
+	}
+	return n
+}
+
+func (m *QueryAllDatachainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDatachainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datachains) > 0 {
+		for _, e := range m.Datachains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDatachainFragmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDatachainFragmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
+	if len(m.Fragments) > 0 {
+		for _, e := range m.Fragments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredMetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredMetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StoredMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStoredMetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStoredMetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStoredMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStoredMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStoredMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredMeta = append(m.StoredMeta, StoredMeta{})
+			if err := m.StoredMeta[len(m.StoredMeta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredMetaByPrefixRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredMetaByPrefixRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredMetaByPrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredMetaByPrefixResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredMetaByPrefixResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredMetaByPrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredMeta = append(m.StoredMeta, StoredMeta{})
+			if err := m.StoredMeta[len(m.StoredMeta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredMetaByFragmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredMetaByFragmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredMetaByFragmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fragment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredMetaByFragmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredMetaByFragmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredMetaByFragmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredMeta = append(m.StoredMeta, StoredMeta{})
+			if err := m.StoredMeta[len(m.StoredMeta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCachedChunkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCachedChunkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCachedChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllStoredMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.StoredMeta) > 0 {
-		for _, e := range m.StoredMeta {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryGetCachedChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCachedChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCachedChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CachedChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CachedChunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDatachainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,12 +3590,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDatachainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDatachainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -857,7 +3649,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDatachainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -880,15 +3672,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDatachainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDatachainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datachain", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -915,10 +3707,29 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datachain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragments", wireType)
+			}
+			m.Fragments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fragments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -940,7 +3751,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredMetaRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDatachainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -963,17 +3774,17 @@ func (m *QueryGetStoredMetaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredMetaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDatachainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDatachainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -983,23 +3794,27 @@ func (m *QueryGetStoredMetaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1022,7 +3837,7 @@ func (m *QueryGetStoredMetaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredMetaResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDatachainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1045,15 +3860,15 @@ func (m *QueryGetStoredMetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredMetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDatachainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDatachainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datachains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1080,7 +3895,44 @@ func (m *QueryGetStoredMetaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StoredMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Datachains = append(m.Datachains, Datachain{})
+			if err := m.Datachains[len(m.Datachains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1105,7 +3957,7 @@ func (m *QueryGetStoredMetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStoredMetaRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDatachainFragmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1128,13 +3980,45 @@ func (m *QueryAllStoredMetaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredMetaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDatachainFragmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDatachainFragmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1191,7 +4075,7 @@ func (m *QueryAllStoredMetaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStoredMetaResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDatachainFragmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1214,15 +4098,15 @@ func (m *QueryAllStoredMetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredMetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDatachainFragmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDatachainFragmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1249,8 +4133,8 @@ func (m *QueryAllStoredMetaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoredMeta = append(m.StoredMeta, StoredMeta{})
-			if err := m.StoredMeta[len(m.StoredMeta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fragments = append(m.Fragments, DatachainFragment{})
+			if err := m.Fragments[len(m.Fragments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
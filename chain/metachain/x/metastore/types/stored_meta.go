@@ -0,0 +1,89 @@
+package types
+
+import "slices"
+
+// FragmentIndexes returns the indexes of the chunks the entry lists: its verified chunks, then
+// the chunks of its upload.
+func (m StoredMeta) FragmentIndexes() []string {
+	indexes := make([]string, 0, len(m.Chunks)+len(m.Indexes))
+	for _, chunk := range m.Chunks {
+		indexes = append(indexes, chunk.Index)
+	}
+	return append(indexes, m.Indexes...)
+}
+
+// DatachainFragments returns the chunks of the entry whose datachain is known, with the
+// connection of that datachain. A chunk written through an interchain account, by an upload or
+// by a migration, lives where it was written; the verified chunks of an entry live on the
+// datachain of its channel otherwise.
+func (m StoredMeta) DatachainFragments() []UploadedChunk {
+	written := make(map[string]bool, len(m.UploadedChunks))
+	for _, chunk := range m.UploadedChunks {
+		written[chunk.Index] = true
+	}
+
+	fragments := make([]UploadedChunk, 0, len(m.Chunks)+len(m.UploadedChunks))
+	if m.ConnectionId != "" {
+		for _, chunk := range m.Chunks {
+			if written[chunk.Index] {
+				continue
+			}
+			fragments = append(fragments, UploadedChunk{
+				ConnectionId: m.ConnectionId,
+				Index:        chunk.Index,
+				Size_:        chunk.Size_,
+				Hash:         chunk.Hash,
+			})
+		}
+	}
+	return append(fragments, m.UploadedChunks...)
+}
+
+// MoveFragment records that the chunk index of the entry lives on the datachain behind
+// connectionID, and reports whether it was one of its verified chunks until then. It returns
+// found=false if the entry lists no chunk index on a known datachain.
+func (m *StoredMeta) MoveFragment(index, connectionID string) (verified, found bool) {
+	fragment, found := m.DatachainFragment(index)
+	if !found {
+		return false, false
+	}
+
+	fragment.ConnectionId = connectionID
+	for i, chunk := range m.UploadedChunks {
+		if chunk.Index == index {
+			m.UploadedChunks[i] = fragment
+			return false, true
+		}
+	}
+	m.UploadedChunks = append(m.UploadedChunks, fragment)
+	return true, true
+}
+
+// DatachainFragment returns the chunk index of the entry along with its datachain, if known.
+func (m StoredMeta) DatachainFragment(index string) (UploadedChunk, bool) {
+	for _, fragment := range m.DatachainFragments() {
+		if fragment.Index == index {
+			return fragment, true
+		}
+	}
+	return UploadedChunk{}, false
+}
+
+// StripeConnections returns the connections of the datachains holding the other chunks of the
+// stripe of the chunk index. Without a recorded stripe width, the whole entry is one stripe.
+func (m StoredMeta) StripeConnections(index string) map[string]bool {
+	stripe := func(string) int { return 0 }
+	if m.Placement != nil {
+		stripe = func(index string) int {
+			return m.Placement.Stripe(slices.Index(m.Indexes, index))
+		}
+	}
+
+	connections := make(map[string]bool)
+	for _, fragment := range m.DatachainFragments() {
+		if fragment.Index != index && stripe(fragment.Index) == stripe(index) {
+			connections[fragment.ConnectionId] = true
+		}
+	}
+	return connections
+}
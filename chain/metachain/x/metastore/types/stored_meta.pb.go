@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -27,6 +28,29 @@ type StoredMeta struct {
 	Index   string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 	Url     string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// chunks are the chunk attestations returned by the datachain. Entries
+	// stored through MsgUploadChunks leave it empty.
+	Chunks []VerifiedChunk `protobuf:"bytes,4,rep,name=chunks,proto3" json:"chunks"`
+	// channel_id is the channel the chunks were verified over. Deleting the
+	// entry sends a release packet back over it.
+	ChannelId string `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// client_id replaces channel_id for entries verified over IBC v2.
+	ClientId string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// encryption is set when the chunks hold ciphertext. Chunk attestations and
+	// datachain hashes cover the ciphertext.
+	Encryption *Encryption `protobuf:"bytes,7,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	// indexes are the chunk indexes of entries stored through MsgUploadChunks,
+	// in upload order. Entries verified by datachains list theirs in chunks.
+	Indexes []string `protobuf:"bytes,8,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	// placement is how the chunks of entries stored through MsgUploadChunks
+	// were assigned to datachains, when the uploader recorded it.
+	Placement *Placement `protobuf:"bytes,9,opt,name=placement,proto3" json:"placement,omitempty"`
+	// uploaded_chunks are the datachain, size and hash of each chunk of
+	// indexes. Entries uploaded before they were recorded leave it empty.
+	UploadedChunks []UploadedChunk `protobuf:"bytes,10,rep,name=uploaded_chunks,json=uploadedChunks,proto3" json:"uploaded_chunks"`
+	// connection_id is the connection of channel_id, the datachain holding the
+	// verified chunks.
+	ConnectionId string `protobuf:"bytes,11,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 }
 
 func (m *StoredMeta) Reset()         { *m = StoredMeta{} }
@@ -83,6 +107,62 @@ func (m *StoredMeta) GetCreator() string {
 	return ""
 }
 
+func (m *StoredMeta) GetChunks() []VerifiedChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+func (m *StoredMeta) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *StoredMeta) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StoredMeta) GetEncryption() *Encryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
+func (m *StoredMeta) GetIndexes() []string {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *StoredMeta) GetPlacement() *Placement {
+	if m != nil {
+		return m.Placement
+	}
+	return nil
+}
+
+func (m *StoredMeta) GetUploadedChunks() []UploadedChunk {
+	if m != nil {
+		return m.UploadedChunks
+	}
+	return nil
+}
+
+func (m *StoredMeta) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StoredMeta)(nil), "metachain.metastore.v1.StoredMeta")
 }
@@ -92,18 +172,33 @@ func init() {
 }
 
 var fileDescriptor_1f5610701de3b0d7 = []byte{
-	// 172 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc8, 0x4d, 0x2d, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0x8a, 0x4b, 0xf2, 0x8b, 0x52, 0xf5, 0xcb, 0x0c,
-	0xf5, 0xc1, 0x8c, 0x94, 0x78, 0x90, 0x98, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x90, 0x18, 0x5c,
-	0xa5, 0x1e, 0x5c, 0xa5, 0x5e, 0x99, 0xa1, 0x92, 0x1f, 0x17, 0x57, 0x30, 0x58, 0xb1, 0x6f, 0x6a,
-	0x49, 0xa2, 0x90, 0x08, 0x17, 0x6b, 0x66, 0x5e, 0x4a, 0x6a, 0x85, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0x67, 0x10, 0x84, 0x23, 0x24, 0xc0, 0xc5, 0x5c, 0x5a, 0x94, 0x23, 0xc1, 0x04, 0x16, 0x03, 0x31,
-	0x85, 0x24, 0xb8, 0xd8, 0x93, 0x8b, 0x52, 0x13, 0x4b, 0xf2, 0x8b, 0x24, 0x98, 0xc1, 0xa2, 0x30,
-	0xae, 0x93, 0xe9, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x49, 0x23, 0xdc,
-	0x5a, 0x81, 0xe4, 0xda, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x2b, 0x8d, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x12, 0xc5, 0xaa, 0xf4, 0xd1, 0x00, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbd, 0x6e, 0xd4, 0x40,
+	0x10, 0xc7, 0x6d, 0x9c, 0x5c, 0xe2, 0x39, 0xbe, 0xb4, 0x8a, 0xd0, 0x2a, 0x11, 0xc6, 0x24, 0x02,
+	0x5c, 0xd9, 0x4a, 0x10, 0x35, 0xd2, 0x45, 0x14, 0x57, 0x20, 0x21, 0xf3, 0x51, 0xd0, 0x9c, 0x96,
+	0xdd, 0x21, 0x67, 0xc5, 0xd9, 0xb5, 0xec, 0x75, 0x94, 0xbc, 0x05, 0x8f, 0x95, 0x32, 0x25, 0x15,
+	0x42, 0x77, 0x15, 0x6f, 0x81, 0x76, 0xed, 0xb3, 0x5d, 0x60, 0xd2, 0xcd, 0xfc, 0xf7, 0x37, 0xe3,
+	0x99, 0xbf, 0x07, 0xa2, 0x0b, 0xd4, 0x8c, 0x2f, 0x59, 0x26, 0x13, 0x13, 0x55, 0x5a, 0x95, 0x98,
+	0x5c, 0x1e, 0x27, 0x36, 0x10, 0x0b, 0xa3, 0xc5, 0x45, 0xa9, 0xb4, 0x22, 0x4f, 0x3a, 0x32, 0xee,
+	0xc8, 0xf8, 0xf2, 0x78, 0x7f, 0xef, 0x4c, 0x9d, 0x29, 0x8b, 0x24, 0x26, 0x6a, 0xe8, 0xfd, 0x57,
+	0x23, 0x7d, 0x51, 0xf2, 0xf2, 0xba, 0xd0, 0x99, 0x92, 0x2d, 0x78, 0x34, 0x02, 0x16, 0x8c, 0x9f,
+	0xa3, 0x6e, 0xa1, 0x97, 0x63, 0x50, 0xce, 0x38, 0x5e, 0xa0, 0xd4, 0x77, 0x34, 0xab, 0x8b, 0x5c,
+	0x31, 0xd1, 0x40, 0x87, 0x7f, 0x3c, 0x80, 0x8f, 0x76, 0xbd, 0xf7, 0xa8, 0x19, 0xd9, 0x83, 0xed,
+	0x4c, 0x0a, 0xbc, 0xa2, 0x6e, 0xe8, 0x46, 0x7e, 0xda, 0x24, 0xe4, 0x31, 0x78, 0x75, 0x99, 0xd3,
+	0x7b, 0x56, 0x33, 0x21, 0xa1, 0xb0, 0xc3, 0x4b, 0x64, 0x5a, 0x95, 0xd4, 0xb3, 0xea, 0x26, 0x25,
+	0xa7, 0x30, 0xe1, 0xcb, 0x5a, 0x9e, 0x57, 0x74, 0x2b, 0xf4, 0xa2, 0xe9, 0xc9, 0x8b, 0xf8, 0xdf,
+	0x56, 0xc5, 0x5f, 0xb0, 0xcc, 0xbe, 0x67, 0x28, 0x4e, 0x0d, 0x3d, 0xdb, 0xba, 0xf9, 0xf5, 0xcc,
+	0x49, 0xdb, 0x52, 0xf2, 0x14, 0x80, 0x2f, 0x99, 0x94, 0x98, 0x2f, 0x32, 0x41, 0xb7, 0xed, 0x17,
+	0xfc, 0x56, 0x99, 0x0b, 0x72, 0x00, 0x3e, 0xcf, 0x33, 0x94, 0xda, 0xbc, 0x4e, 0xec, 0xeb, 0x6e,
+	0x23, 0xcc, 0x05, 0x99, 0x01, 0xf4, 0xbe, 0xd2, 0x9d, 0xd0, 0x8d, 0xa6, 0x27, 0x87, 0x63, 0x43,
+	0xbc, 0xeb, 0xc8, 0x74, 0x50, 0x65, 0xd6, 0xb3, 0x9b, 0x63, 0x45, 0x77, 0x43, 0xcf, 0xac, 0xd7,
+	0xa6, 0xe4, 0x2d, 0xf8, 0x9d, 0xcf, 0xd4, 0xb7, 0xcd, 0x9f, 0x8f, 0x35, 0xff, 0xb0, 0x01, 0xd3,
+	0xbe, 0x86, 0x7c, 0x82, 0x47, 0xcd, 0x0f, 0x40, 0xb1, 0x68, 0x8d, 0x82, 0xff, 0x1b, 0xf5, 0xb9,
+	0xc5, 0x87, 0x46, 0x3d, 0xac, 0x87, 0x62, 0x45, 0x8e, 0xe0, 0x01, 0x57, 0x52, 0x22, 0x37, 0xe3,
+	0x1b, 0x57, 0xa6, 0xd6, 0x95, 0xfb, 0xbd, 0x38, 0x17, 0xb3, 0x37, 0x37, 0xab, 0xc0, 0xbd, 0x5d,
+	0x05, 0xee, 0xef, 0x55, 0xe0, 0xfe, 0x58, 0x07, 0xce, 0xed, 0x3a, 0x70, 0x7e, 0xae, 0x03, 0xe7,
+	0xeb, 0x41, 0x7f, 0x2a, 0x57, 0x83, 0x63, 0xd1, 0xd7, 0x05, 0x56, 0xdf, 0x26, 0xf6, 0x52, 0x5e,
+	0xff, 0x1d, 0x00, 0x01, 0xe8, 0x93, 0x95, 0x1e, 0x03, 0x00, 0x00,
 }
 
 func (m *StoredMeta) Marshal() (dAtA []byte, err error) {
@@ -126,6 +221,88 @@ func (m *StoredMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintStoredMeta(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.UploadedChunks) > 0 {
+		for iNdEx := len(m.UploadedChunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadedChunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredMeta(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Placement != nil {
+		{
+			size, err := m.Placement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStoredMeta(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Indexes[iNdEx])
+			copy(dAtA[i:], m.Indexes[iNdEx])
+			i = encodeVarintStoredMeta(dAtA, i, uint64(len(m.Indexes[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStoredMeta(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintStoredMeta(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintStoredMeta(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredMeta(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -179,6 +356,44 @@ func (m *StoredMeta) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStoredMeta(uint64(l))
 	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovStoredMeta(uint64(l))
+		}
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovStoredMeta(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovStoredMeta(uint64(l))
+	}
+	if m.Encryption != nil {
+		l = m.Encryption.Size()
+		n += 1 + l + sovStoredMeta(uint64(l))
+	}
+	if len(m.Indexes) > 0 {
+		for _, s := range m.Indexes {
+			l = len(s)
+			n += 1 + l + sovStoredMeta(uint64(l))
+		}
+	}
+	if m.Placement != nil {
+		l = m.Placement.Size()
+		n += 1 + l + sovStoredMeta(uint64(l))
+	}
+	if len(m.UploadedChunks) > 0 {
+		for _, e := range m.UploadedChunks {
+			l = e.Size()
+			n += 1 + l + sovStoredMeta(uint64(l))
+		}
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovStoredMeta(uint64(l))
+	}
 	return n
 }
 
@@ -313,6 +528,274 @@ func (m *StoredMeta) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, VerifiedChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Encryption == nil {
+				m.Encryption = &Encryption{}
+			}
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Placement == nil {
+				m.Placement = &Placement{}
+			}
+			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadedChunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadedChunks = append(m.UploadedChunks, UploadedChunk{})
+			if err := m.UploadedChunks[len(m.UploadedChunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStoredMeta(dAtA[iNdEx:])
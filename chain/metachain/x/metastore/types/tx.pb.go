@@ -532,815 +532,4034 @@ func (m *MsgDeleteStoredMetaResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteStoredMetaResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "metachain.metastore.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "metachain.metastore.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgSendMetadata)(nil), "metachain.metastore.v1.MsgSendMetadata")
-	proto.RegisterType((*MsgSendMetadataResponse)(nil), "metachain.metastore.v1.MsgSendMetadataResponse")
-	proto.RegisterType((*MsgCreateStoredMeta)(nil), "metachain.metastore.v1.MsgCreateStoredMeta")
-	proto.RegisterType((*MsgCreateStoredMetaResponse)(nil), "metachain.metastore.v1.MsgCreateStoredMetaResponse")
-	proto.RegisterType((*MsgUpdateStoredMeta)(nil), "metachain.metastore.v1.MsgUpdateStoredMeta")
-	proto.RegisterType((*MsgUpdateStoredMetaResponse)(nil), "metachain.metastore.v1.MsgUpdateStoredMetaResponse")
-	proto.RegisterType((*MsgDeleteStoredMeta)(nil), "metachain.metastore.v1.MsgDeleteStoredMeta")
-	proto.RegisterType((*MsgDeleteStoredMetaResponse)(nil), "metachain.metastore.v1.MsgDeleteStoredMetaResponse")
+// MsgRegisterDatachainAccount opens an interchain account owned by creator
+// on the datachain at the other end of connection_id.
+type MsgRegisterDatachainAccount struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("metachain/metastore/v1/tx.proto", fileDescriptor_72e1da5e9106f50f) }
-
-var fileDescriptor_72e1da5e9106f50f = []byte{
-	// 591 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0xc9, 0x0f, 0xe4, 0xa3, 0x12, 0xe1, 0x88, 0xa8, 0x9b, 0x82, 0x1b, 0x05, 0x21, 0xa2,
-	0x54, 0xc4, 0x6a, 0x2a, 0x10, 0xea, 0xd6, 0xd0, 0x85, 0x21, 0x12, 0x72, 0x60, 0x61, 0x41, 0x47,
-	0x7c, 0x72, 0x2c, 0xe5, 0x7c, 0xd6, 0xdd, 0xa5, 0x4a, 0x27, 0x10, 0x23, 0x13, 0x7f, 0x06, 0x63,
-	0x06, 0xfe, 0x02, 0x06, 0xd4, 0xb1, 0x62, 0x62, 0x42, 0x90, 0x0c, 0xf9, 0x37, 0xd0, 0xf9, 0x9c,
-	0x1f, 0x75, 0x1c, 0xb7, 0x20, 0xa1, 0x2e, 0xd1, 0xdd, 0x7b, 0x9f, 0xdf, 0xf7, 0x7d, 0x2f, 0xef,
-	0xd9, 0x60, 0x87, 0x60, 0x81, 0xba, 0x3d, 0xe4, 0xf9, 0x96, 0x3c, 0x71, 0x41, 0x19, 0xb6, 0x8e,
-	0xf7, 0x2c, 0x31, 0x6c, 0x04, 0x8c, 0x0a, 0x0a, 0xef, 0xcc, 0x01, 0x8d, 0x39, 0xa0, 0x71, 0xbc,
-	0x57, 0xbe, 0x85, 0x88, 0xe7, 0x53, 0x2b, 0xfc, 0x55, 0xd0, 0xf2, 0x66, 0x97, 0x72, 0x42, 0xb9,
-	0x45, 0xb8, 0x2b, 0x4b, 0x10, 0xee, 0x46, 0x89, 0x2d, 0x95, 0x78, 0x13, 0xde, 0x2c, 0x75, 0x89,
-	0x52, 0x25, 0x97, 0xba, 0x54, 0xc5, 0xe5, 0x29, 0x8a, 0xde, 0x5f, 0xa3, 0x2a, 0x40, 0x0c, 0x91,
-	0xe8, 0xd1, 0xea, 0x37, 0x0d, 0xdc, 0x6c, 0x73, 0xf7, 0x55, 0xe0, 0x20, 0x81, 0x5f, 0x84, 0x19,
-	0xf8, 0x04, 0xe8, 0x68, 0x20, 0x7a, 0x94, 0x79, 0xe2, 0xc4, 0xd0, 0x2a, 0x5a, 0x4d, 0x6f, 0x19,
-	0xdf, 0xbf, 0x3c, 0x2a, 0x45, 0x9c, 0x87, 0x8e, 0xc3, 0x30, 0xe7, 0x1d, 0xc1, 0x3c, 0xdf, 0xb5,
-	0x17, 0x50, 0x78, 0x08, 0x0a, 0xaa, 0xb6, 0x71, 0xad, 0xa2, 0xd5, 0x6e, 0x34, 0xcd, 0x46, 0xb2,
-	0xed, 0x86, 0xe2, 0x69, 0xe9, 0xa7, 0x3f, 0x77, 0x32, 0x9f, 0xa7, 0xa3, 0xba, 0x66, 0x47, 0x0f,
-	0x1e, 0x3c, 0xfd, 0x30, 0x1d, 0xd5, 0x17, 0x25, 0x3f, 0x4e, 0x47, 0xf5, 0x07, 0x0b, 0x1b, 0xc3,
-	0x25, 0x23, 0x31, 0xd1, 0xd5, 0x2d, 0xb0, 0x19, 0x0b, 0xd9, 0x98, 0x07, 0xd4, 0xe7, 0xb8, 0xfa,
-	0x5b, 0x79, 0xec, 0x60, 0xdf, 0x69, 0x63, 0x81, 0x1c, 0x24, 0x10, 0x2c, 0x82, 0xec, 0x80, 0xf5,
-	0x8d, 0xbc, 0x74, 0x67, 0xcb, 0x23, 0xbc, 0x0b, 0x74, 0xa4, 0x9c, 0x61, 0x6e, 0x14, 0x2a, 0xd9,
-	0x9a, 0x6e, 0x2f, 0x02, 0xb0, 0x09, 0xae, 0x77, 0x19, 0x46, 0x82, 0xb2, 0x0b, 0x3b, 0x32, 0x03,
-	0x42, 0x08, 0x72, 0x01, 0x65, 0x22, 0xec, 0x86, 0x6e, 0x87, 0x67, 0xc9, 0xd2, 0xed, 0x21, 0xdf,
-	0xc7, 0xfd, 0xe7, 0x47, 0x46, 0x36, 0x4c, 0x2c, 0x02, 0xb0, 0x0e, 0x8a, 0xc2, 0x23, 0x98, 0x0e,
-	0xc4, 0x4b, 0x8f, 0x60, 0x2e, 0x10, 0x09, 0x8c, 0x5c, 0x45, 0xab, 0xe5, 0xec, 0x95, 0xf8, 0xc1,
-	0x86, 0x6c, 0xd5, 0x8c, 0x2b, 0xb2, 0xbf, 0x6c, 0x71, 0x6e, 0xff, 0x1d, 0xb8, 0xdd, 0xe6, 0xee,
-	0x33, 0x09, 0xc4, 0x1d, 0xd9, 0xbb, 0x10, 0xf2, 0x4f, 0x8e, 0x4a, 0x20, 0xef, 0xf9, 0x0e, 0x1e,
-	0x46, 0x96, 0xd4, 0x65, 0xd6, 0xcb, 0xec, 0xbc, 0x97, 0x31, 0x6d, 0xf7, 0xc0, 0x76, 0x82, 0x80,
-	0x98, 0x3e, 0xf5, 0xcf, 0x5d, 0xa1, 0xbe, 0xb8, 0x80, 0xb9, 0x3e, 0x12, 0xea, 0x3b, 0xc2, 0x7d,
-	0xfc, 0x7f, 0xf4, 0x25, 0xaa, 0x89, 0xd3, 0xcd, 0xd4, 0x34, 0xbf, 0xe6, 0x40, 0xb6, 0xcd, 0x5d,
-	0xd8, 0x03, 0x1b, 0xe7, 0x96, 0xf6, 0xe1, 0xba, 0x65, 0x8b, 0x6d, 0x45, 0xd9, 0xba, 0x24, 0x70,
-	0xc6, 0x28, 0x99, 0xce, 0xad, 0x4e, 0x1a, 0xd3, 0x32, 0x30, 0x95, 0x29, 0x69, 0x52, 0xa1, 0x00,
-	0xc5, 0x95, 0x31, 0xdd, 0x4d, 0x29, 0x12, 0x07, 0x97, 0xf7, 0xff, 0x02, 0xbc, 0xcc, 0xba, 0x32,
-	0x7c, 0xbb, 0x17, 0x36, 0xe9, 0x92, 0xac, 0xeb, 0xa6, 0x4a, 0xb2, 0xae, 0x8c, 0x54, 0x1a, 0x6b,
-	0x1c, 0x9c, 0xca, 0xba, 0x6e, 0x7a, 0xca, 0xf9, 0xf7, 0xf2, 0x75, 0xdb, 0x7a, 0x7c, 0x3a, 0x36,
-	0xb5, 0xb3, 0xb1, 0xa9, 0xfd, 0x1a, 0x9b, 0xda, 0xa7, 0x89, 0x99, 0x39, 0x9b, 0x98, 0x99, 0x1f,
-	0x13, 0x33, 0xf3, 0x7a, 0x3b, 0xf9, 0x6d, 0x2b, 0x4e, 0x02, 0xcc, 0xdf, 0x16, 0xc2, 0x6f, 0xc6,
-	0xfe, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x41, 0xfa, 0x3f, 0xf0, 0x06, 0x00, 0x00,
+func (m *MsgRegisterDatachainAccount) Reset()         { *m = MsgRegisterDatachainAccount{} }
+func (m *MsgRegisterDatachainAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDatachainAccount) ProtoMessage()    {}
+func (*MsgRegisterDatachainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{10}
+}
+func (m *MsgRegisterDatachainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDatachainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDatachainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDatachainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDatachainAccount.Merge(m, src)
+}
+func (m *MsgRegisterDatachainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDatachainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDatachainAccount.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterDatachainAccount proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// SendMetadata defines the SendMetadata RPC.
-	SendMetadata(ctx context.Context, in *MsgSendMetadata, opts ...grpc.CallOption) (*MsgSendMetadataResponse, error)
-	// CreateStoredMeta defines the CreateStoredMeta RPC.
-	CreateStoredMeta(ctx context.Context, in *MsgCreateStoredMeta, opts ...grpc.CallOption) (*MsgCreateStoredMetaResponse, error)
-	// UpdateStoredMeta defines the UpdateStoredMeta RPC.
-	UpdateStoredMeta(ctx context.Context, in *MsgUpdateStoredMeta, opts ...grpc.CallOption) (*MsgUpdateStoredMetaResponse, error)
-	// DeleteStoredMeta defines the DeleteStoredMeta RPC.
-	DeleteStoredMeta(ctx context.Context, in *MsgDeleteStoredMeta, opts ...grpc.CallOption) (*MsgDeleteStoredMetaResponse, error)
+func (m *MsgRegisterDatachainAccount) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterDatachainAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgRegisterDatachainAccountResponse defines the MsgRegisterDatachainAccountResponse message.
+type MsgRegisterDatachainAccountResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterDatachainAccountResponse) Reset()         { *m = MsgRegisterDatachainAccountResponse{} }
+func (m *MsgRegisterDatachainAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDatachainAccountResponse) ProtoMessage()    {}
+func (*MsgRegisterDatachainAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{11}
+}
+func (m *MsgRegisterDatachainAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDatachainAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDatachainAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterDatachainAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDatachainAccountResponse.Merge(m, src)
+}
+func (m *MsgRegisterDatachainAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDatachainAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDatachainAccountResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SendMetadata(ctx context.Context, in *MsgSendMetadata, opts ...grpc.CallOption) (*MsgSendMetadataResponse, error) {
-	out := new(MsgSendMetadataResponse)
-	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/SendMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRegisterDatachainAccountResponse proto.InternalMessageInfo
+
+// ChunkUpload is a single chunk destined for the datachain behind connection_id.
+type ChunkUpload struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Index        string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Data         []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (c *msgClient) CreateStoredMeta(ctx context.Context, in *MsgCreateStoredMeta, opts ...grpc.CallOption) (*MsgCreateStoredMetaResponse, error) {
-	out := new(MsgCreateStoredMetaResponse)
-	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/CreateStoredMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChunkUpload) Reset()         { *m = ChunkUpload{} }
+func (m *ChunkUpload) String() string { return proto.CompactTextString(m) }
+func (*ChunkUpload) ProtoMessage()    {}
+func (*ChunkUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{12}
+}
+func (m *ChunkUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ChunkUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkUpload.Merge(m, src)
+}
+func (m *ChunkUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkUpload.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateStoredMeta(ctx context.Context, in *MsgUpdateStoredMeta, opts ...grpc.CallOption) (*MsgUpdateStoredMetaResponse, error) {
-	out := new(MsgUpdateStoredMetaResponse)
-	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/UpdateStoredMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChunkUpload proto.InternalMessageInfo
+
+func (m *ChunkUpload) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteStoredMeta(ctx context.Context, in *MsgDeleteStoredMeta, opts ...grpc.CallOption) (*MsgDeleteStoredMetaResponse, error) {
-	out := new(MsgDeleteStoredMetaResponse)
-	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/DeleteStoredMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChunkUpload) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// SendMetadata defines the SendMetadata RPC.
-	SendMetadata(context.Context, *MsgSendMetadata) (*MsgSendMetadataResponse, error)
-	// CreateStoredMeta defines the CreateStoredMeta RPC.
-	CreateStoredMeta(context.Context, *MsgCreateStoredMeta) (*MsgCreateStoredMetaResponse, error)
-	// UpdateStoredMeta defines the UpdateStoredMeta RPC.
-	UpdateStoredMeta(context.Context, *MsgUpdateStoredMeta) (*MsgUpdateStoredMetaResponse, error)
-	// DeleteStoredMeta defines the DeleteStoredMeta RPC.
-	DeleteStoredMeta(context.Context, *MsgDeleteStoredMeta) (*MsgDeleteStoredMetaResponse, error)
+func (m *ChunkUpload) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgUploadChunks writes chunks to one or more datachains through the
+// creator's interchain accounts and stores the metadata for url once every
+// datachain has acknowledged.
+type MsgUploadChunks struct {
+	Creator          string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Url              string        `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Chunks           []ChunkUpload `protobuf:"bytes,3,rep,name=chunks,proto3" json:"chunks"`
+	TimeoutTimestamp uint64        `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+	// encryption is recorded in the metadata of url when the chunks are
+	// encrypted.
+	Encryption *Encryption `protobuf:"bytes,5,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	// placement is recorded in the metadata of url when the uploader chose the
+	// connections of the chunks with a placement policy.
+	Placement *Placement `protobuf:"bytes,6,opt,name=placement,proto3" json:"placement,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUploadChunks) Reset()         { *m = MsgUploadChunks{} }
+func (m *MsgUploadChunks) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadChunks) ProtoMessage()    {}
+func (*MsgUploadChunks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{13}
 }
-func (*UnimplementedMsgServer) SendMetadata(ctx context.Context, req *MsgSendMetadata) (*MsgSendMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendMetadata not implemented")
+func (m *MsgUploadChunks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateStoredMeta(ctx context.Context, req *MsgCreateStoredMeta) (*MsgCreateStoredMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStoredMeta not implemented")
+func (m *MsgUploadChunks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadChunks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateStoredMeta(ctx context.Context, req *MsgUpdateStoredMeta) (*MsgUpdateStoredMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateStoredMeta not implemented")
+func (m *MsgUploadChunks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadChunks.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteStoredMeta(ctx context.Context, req *MsgDeleteStoredMeta) (*MsgDeleteStoredMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteStoredMeta not implemented")
+func (m *MsgUploadChunks) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgUploadChunks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadChunks.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/metachain.metastore.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgUploadChunks proto.InternalMessageInfo
+
+func (m *MsgUploadChunks) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SendMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/metachain.metastore.v1.Msg/SendMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendMetadata(ctx, req.(*MsgSendMetadata))
+func (m *MsgUploadChunks) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateStoredMeta)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateStoredMeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/metachain.metastore.v1.Msg/CreateStoredMeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateStoredMeta(ctx, req.(*MsgCreateStoredMeta))
+func (m *MsgUploadChunks) GetChunks() []ChunkUpload {
+	if m != nil {
+		return m.Chunks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateStoredMeta)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateStoredMeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/metachain.metastore.v1.Msg/UpdateStoredMeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateStoredMeta(ctx, req.(*MsgUpdateStoredMeta))
+func (m *MsgUploadChunks) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_DeleteStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteStoredMeta)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUploadChunks) GetEncryption() *Encryption {
+	if m != nil {
+		return m.Encryption
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteStoredMeta(ctx, in)
+	return nil
+}
+
+func (m *MsgUploadChunks) GetPlacement() *Placement {
+	if m != nil {
+		return m.Placement
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/metachain.metastore.v1.Msg/DeleteStoredMeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteStoredMeta(ctx, req.(*MsgDeleteStoredMeta))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "metachain.metastore.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "SendMetadata",
-			Handler:    _Msg_SendMetadata_Handler,
-		},
-		{
-			MethodName: "CreateStoredMeta",
-			Handler:    _Msg_CreateStoredMeta_Handler,
-		},
-		{
-			MethodName: "UpdateStoredMeta",
-			Handler:    _Msg_UpdateStoredMeta_Handler,
-		},
-		{
-			MethodName: "DeleteStoredMeta",
-			Handler:    _Msg_DeleteStoredMeta_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "metachain/metastore/v1/tx.proto",
+// MsgUploadChunksResponse defines the MsgUploadChunksResponse message.
+type MsgUploadChunksResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUploadChunksResponse) Reset()         { *m = MsgUploadChunksResponse{} }
+func (m *MsgUploadChunksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadChunksResponse) ProtoMessage()    {}
+func (*MsgUploadChunksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{14}
+}
+func (m *MsgUploadChunksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadChunksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadChunksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUploadChunksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadChunksResponse.Merge(m, src)
+}
+func (m *MsgUploadChunksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadChunksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadChunksResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUploadChunksResponse proto.InternalMessageInfo
+
+// MsgRetrieveChunks requests chunk data from the datachain behind a channel.
+// The size and hash of the chunks are emitted in chunk_retrieval events when
+// the ack arrives, and their data is kept in the chunk cache up to cache_bytes.
+type MsgRetrieveChunks struct {
+	Creator          string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port             string   `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID        string   `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	TimeoutTimestamp uint64   `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+	Indexes          []string `protobuf:"bytes,5,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	// cache_bytes is how much of the returned chunk data to keep in the chunk
+	// cache, for chunk_cache_ttl blocks. The creator pays the gas of caching it
+	// with this message; the chunks past it are not cached.
+	CacheBytes uint64 `protobuf:"varint,7,opt,name=cache_bytes,json=cacheBytes,proto3" json:"cache_bytes,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRetrieveChunks) Reset()         { *m = MsgRetrieveChunks{} }
+func (m *MsgRetrieveChunks) String() string { return proto.CompactTextString(m) }
+func (*MsgRetrieveChunks) ProtoMessage()    {}
+func (*MsgRetrieveChunks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{15}
+}
+func (m *MsgRetrieveChunks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetrieveChunks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetrieveChunks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRetrieveChunks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetrieveChunks.Merge(m, src)
+}
+func (m *MsgRetrieveChunks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetrieveChunks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetrieveChunks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetrieveChunks proto.InternalMessageInfo
+
+func (m *MsgRetrieveChunks) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRetrieveChunks) GetPort() string {
+	if m != nil {
+		return m.Port
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRetrieveChunks) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRetrieveChunks) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
 }
 
-func (m *MsgSendMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRetrieveChunks) GetIndexes() []string {
+	if m != nil {
+		return m.Indexes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSendMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRetrieveChunks) GetCacheBytes() uint64 {
+	if m != nil {
+		return m.CacheBytes
+	}
+	return 0
 }
 
-func (m *MsgSendMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x32
+// MsgRetrieveChunksResponse defines the MsgRetrieveChunksResponse message.
+type MsgRetrieveChunksResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgRetrieveChunksResponse) Reset()         { *m = MsgRetrieveChunksResponse{} }
+func (m *MsgRetrieveChunksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetrieveChunksResponse) ProtoMessage()    {}
+func (*MsgRetrieveChunksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{16}
+}
+func (m *MsgRetrieveChunksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetrieveChunksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetrieveChunksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TimeoutTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Port) > 0 {
-		i -= len(m.Port)
-		copy(dAtA[i:], m.Port)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Port)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRetrieveChunksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetrieveChunksResponse.Merge(m, src)
+}
+func (m *MsgRetrieveChunksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetrieveChunksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetrieveChunksResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRetrieveChunksResponse proto.InternalMessageInfo
+
+func (m *MsgRetrieveChunksResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSendMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDrainDatachain marks the datachain behind connection_id read-only. It is
+// retired once no manifest references its fragments.
+type MsgDrainDatachain struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 }
 
-func (m *MsgSendMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgDrainDatachain) Reset()         { *m = MsgDrainDatachain{} }
+func (m *MsgDrainDatachain) String() string { return proto.CompactTextString(m) }
+func (*MsgDrainDatachain) ProtoMessage()    {}
+func (*MsgDrainDatachain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{17}
 }
-
-func (m *MsgCreateStoredMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDrainDatachain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDrainDatachain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDrainDatachain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateStoredMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDrainDatachain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDrainDatachain.Merge(m, src)
+}
+func (m *MsgDrainDatachain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDrainDatachain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDrainDatachain.DiscardUnknown(m)
 }
 
-func (m *MsgCreateStoredMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgDrainDatachain proto.InternalMessageInfo
+
+func (m *MsgDrainDatachain) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateStoredMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDrainDatachain) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDrainDatachainResponse defines the MsgDrainDatachainResponse message.
+type MsgDrainDatachainResponse struct {
 }
 
-func (m *MsgCreateStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgDrainDatachainResponse) Reset()         { *m = MsgDrainDatachainResponse{} }
+func (m *MsgDrainDatachainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDrainDatachainResponse) ProtoMessage()    {}
+func (*MsgDrainDatachainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{18}
 }
-
-func (m *MsgUpdateStoredMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDrainDatachainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDrainDatachainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDrainDatachainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDrainDatachainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDrainDatachainResponse.Merge(m, src)
+}
+func (m *MsgDrainDatachainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDrainDatachainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDrainDatachainResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateStoredMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDrainDatachainResponse proto.InternalMessageInfo
+
+// MsgMigrateFragment copies the fragment index of the manifest url, which
+// lives on a draining datachain, to the datachain behind connection_id
+// through the creator's interchain account. data must hash to the hash the
+// manifest recorded. The manifest points to the copy once it is
+// acknowledged.
+type MsgMigrateFragment struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Url              string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Index            string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	ConnectionId     string `protobuf:"bytes,4,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Data             []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	TimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
 }
 
-func (m *MsgUpdateStoredMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgMigrateFragment) Reset()         { *m = MsgMigrateFragment{} }
+func (m *MsgMigrateFragment) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateFragment) ProtoMessage()    {}
+func (*MsgMigrateFragment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{19}
+}
+func (m *MsgMigrateFragment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateFragment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateFragment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgMigrateFragment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateFragment.Merge(m, src)
+}
+func (m *MsgMigrateFragment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateFragment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateFragment.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateStoredMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgMigrateFragment proto.InternalMessageInfo
+
+func (m *MsgMigrateFragment) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateFragment) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
 }
 
-func (m *MsgUpdateStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgMigrateFragment) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
 }
 
-func (m *MsgDeleteStoredMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateFragment) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteStoredMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateFragment) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *MsgDeleteStoredMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgMigrateFragment) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgDeleteStoredMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgMigrateFragmentResponse defines the MsgMigrateFragmentResponse message.
+type MsgMigrateFragmentResponse struct {
 }
 
-func (m *MsgDeleteStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateFragmentResponse) Reset()         { *m = MsgMigrateFragmentResponse{} }
+func (m *MsgMigrateFragmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateFragmentResponse) ProtoMessage()    {}
+func (*MsgMigrateFragmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{20}
+}
+func (m *MsgMigrateFragmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateFragmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateFragmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateFragmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateFragmentResponse.Merge(m, src)
+}
+func (m *MsgMigrateFragmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateFragmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateFragmentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgMigrateFragmentResponse proto.InternalMessageInfo
+
+// FragmentMove is the copy of the fragment index to the datachain behind
+// connection_id.
+type FragmentMove struct {
+	Index        string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Data         []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FragmentMove) Reset()         { *m = FragmentMove{} }
+func (m *FragmentMove) String() string { return proto.CompactTextString(m) }
+func (*FragmentMove) ProtoMessage()    {}
+func (*FragmentMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{21}
+}
+func (m *FragmentMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FragmentMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FragmentMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FragmentMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FragmentMove.Merge(m, src)
+}
+func (m *FragmentMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *FragmentMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_FragmentMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FragmentMove proto.InternalMessageInfo
+
+func (m *FragmentMove) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *FragmentMove) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FragmentMove) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgSendMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+// MsgMoveFragments copies fragments of the manifest url to other active
+// datachains through the creator's interchain account, each with data that
+// must hash to the hash the manifest recorded. The manifest points to all the
+// copies at once when the last datachain acknowledges them, or to none if one
+// fails.
+type MsgMoveFragments struct {
+	Creator          string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Url              string         `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Moves            []FragmentMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves"`
+	TimeoutTimestamp uint64         `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+}
+
+func (m *MsgMoveFragments) Reset()         { *m = MsgMoveFragments{} }
+func (m *MsgMoveFragments) String() string { return proto.CompactTextString(m) }
+func (*MsgMoveFragments) ProtoMessage()    {}
+func (*MsgMoveFragments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{22}
+}
+func (m *MsgMoveFragments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMoveFragments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMoveFragments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Port)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgMoveFragments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMoveFragments.Merge(m, src)
+}
+func (m *MsgMoveFragments) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMoveFragments) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMoveFragments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMoveFragments proto.InternalMessageInfo
+
+func (m *MsgMoveFragments) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgMoveFragments) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	return ""
+}
+
+func (m *MsgMoveFragments) GetMoves() []FragmentMove {
+	if m != nil {
+		return m.Moves
 	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgMoveFragments) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgMoveFragmentsResponse defines the MsgMoveFragmentsResponse message.
+type MsgMoveFragmentsResponse struct {
+}
+
+func (m *MsgMoveFragmentsResponse) Reset()         { *m = MsgMoveFragmentsResponse{} }
+func (m *MsgMoveFragmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMoveFragmentsResponse) ProtoMessage()    {}
+func (*MsgMoveFragmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e1da5e9106f50f, []int{23}
+}
+func (m *MsgMoveFragmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMoveFragmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMoveFragmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgMoveFragmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMoveFragmentsResponse.Merge(m, src)
+}
+func (m *MsgMoveFragmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMoveFragmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMoveFragmentsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgMoveFragmentsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "metachain.metastore.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "metachain.metastore.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSendMetadata)(nil), "metachain.metastore.v1.MsgSendMetadata")
+	proto.RegisterType((*MsgSendMetadataResponse)(nil), "metachain.metastore.v1.MsgSendMetadataResponse")
+	proto.RegisterType((*MsgCreateStoredMeta)(nil), "metachain.metastore.v1.MsgCreateStoredMeta")
+	proto.RegisterType((*MsgCreateStoredMetaResponse)(nil), "metachain.metastore.v1.MsgCreateStoredMetaResponse")
+	proto.RegisterType((*MsgUpdateStoredMeta)(nil), "metachain.metastore.v1.MsgUpdateStoredMeta")
+	proto.RegisterType((*MsgUpdateStoredMetaResponse)(nil), "metachain.metastore.v1.MsgUpdateStoredMetaResponse")
+	proto.RegisterType((*MsgDeleteStoredMeta)(nil), "metachain.metastore.v1.MsgDeleteStoredMeta")
+	proto.RegisterType((*MsgDeleteStoredMetaResponse)(nil), "metachain.metastore.v1.MsgDeleteStoredMetaResponse")
+	proto.RegisterType((*MsgRegisterDatachainAccount)(nil), "metachain.metastore.v1.MsgRegisterDatachainAccount")
+	proto.RegisterType((*MsgRegisterDatachainAccountResponse)(nil), "metachain.metastore.v1.MsgRegisterDatachainAccountResponse")
+	proto.RegisterType((*ChunkUpload)(nil), "metachain.metastore.v1.ChunkUpload")
+	proto.RegisterType((*MsgUploadChunks)(nil), "metachain.metastore.v1.MsgUploadChunks")
+	proto.RegisterType((*MsgUploadChunksResponse)(nil), "metachain.metastore.v1.MsgUploadChunksResponse")
+	proto.RegisterType((*MsgRetrieveChunks)(nil), "metachain.metastore.v1.MsgRetrieveChunks")
+	proto.RegisterType((*MsgRetrieveChunksResponse)(nil), "metachain.metastore.v1.MsgRetrieveChunksResponse")
+	proto.RegisterType((*MsgDrainDatachain)(nil), "metachain.metastore.v1.MsgDrainDatachain")
+	proto.RegisterType((*MsgDrainDatachainResponse)(nil), "metachain.metastore.v1.MsgDrainDatachainResponse")
+	proto.RegisterType((*MsgMigrateFragment)(nil), "metachain.metastore.v1.MsgMigrateFragment")
+	proto.RegisterType((*MsgMigrateFragmentResponse)(nil), "metachain.metastore.v1.MsgMigrateFragmentResponse")
+	proto.RegisterType((*FragmentMove)(nil), "metachain.metastore.v1.FragmentMove")
+	proto.RegisterType((*MsgMoveFragments)(nil), "metachain.metastore.v1.MsgMoveFragments")
+	proto.RegisterType((*MsgMoveFragmentsResponse)(nil), "metachain.metastore.v1.MsgMoveFragmentsResponse")
+}
+
+func init() { proto.RegisterFile("metachain/metastore/v1/tx.proto", fileDescriptor_72e1da5e9106f50f) }
+
+var fileDescriptor_72e1da5e9106f50f = []byte{
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x9b, 0x1f, 0xdd, 0xbc, 0x66, 0xd9, 0xae, 0xa9, 0x58, 0xd7, 0x5d, 0xd2, 0x92, 0xb2,
+	0x34, 0x64, 0x45, 0x42, 0x53, 0xf1, 0x43, 0xe5, 0x00, 0xcd, 0x16, 0xa4, 0x45, 0x8a, 0x84, 0x5c,
+	0xf6, 0x82, 0x40, 0xd5, 0xac, 0x33, 0x72, 0xac, 0x8d, 0x3d, 0x59, 0xcf, 0x24, 0x6a, 0x4f, 0x20,
+	0xc4, 0x09, 0x71, 0xe0, 0xcf, 0xe0, 0xc0, 0xa1, 0x07, 0xfe, 0x06, 0xb4, 0xc7, 0x15, 0xe2, 0x00,
+	0x17, 0x04, 0xed, 0xa1, 0xff, 0x00, 0x37, 0x2e, 0xc8, 0x63, 0x7b, 0xec, 0xd8, 0xb1, 0xd7, 0xcd,
+	0xee, 0x6a, 0x2f, 0x91, 0xfd, 0xfc, 0xcd, 0xfb, 0xbe, 0xf7, 0x66, 0xe6, 0x9b, 0x09, 0x6c, 0x58,
+	0x98, 0x21, 0x7d, 0x80, 0x4c, 0xbb, 0xed, 0x3e, 0x51, 0x46, 0x1c, 0xdc, 0x9e, 0xec, 0xb4, 0xd9,
+	0x71, 0x6b, 0xe4, 0x10, 0x46, 0xe4, 0x57, 0x04, 0xa0, 0x25, 0x00, 0xad, 0xc9, 0x8e, 0x7a, 0x1d,
+	0x59, 0xa6, 0x4d, 0xda, 0xfc, 0xd7, 0x83, 0xaa, 0x37, 0x74, 0x42, 0x2d, 0x42, 0xdb, 0x16, 0x35,
+	0xdc, 0x14, 0x16, 0x35, 0xfc, 0x0f, 0x6b, 0xde, 0x87, 0x23, 0xfe, 0xd6, 0xf6, 0x5e, 0xfc, 0x4f,
+	0xab, 0x06, 0x31, 0x88, 0x17, 0x77, 0x9f, 0xfc, 0xe8, 0x76, 0x8a, 0x2a, 0x6c, 0xeb, 0xce, 0xc9,
+	0x88, 0x99, 0xc4, 0xf6, 0x81, 0x5b, 0x29, 0xc0, 0x11, 0x72, 0x90, 0x15, 0x70, 0xbc, 0x91, 0x06,
+	0x1a, 0x22, 0x1d, 0x5b, 0xd8, 0x66, 0x1e, 0xae, 0xfe, 0xab, 0x04, 0xd7, 0x7a, 0xd4, 0xb8, 0x37,
+	0xea, 0x23, 0x86, 0x3f, 0xe3, 0x19, 0xe4, 0x77, 0xa1, 0x82, 0xc6, 0x6c, 0x40, 0x1c, 0x93, 0x9d,
+	0x28, 0xd2, 0xa6, 0xd4, 0xa8, 0x74, 0x95, 0xdf, 0x7e, 0x79, 0x6b, 0xd5, 0x2f, 0x62, 0xbf, 0xdf,
+	0x77, 0x30, 0xa5, 0x87, 0xcc, 0x31, 0x6d, 0x43, 0x0b, 0xa1, 0xf2, 0x3e, 0x94, 0x3d, 0x0d, 0xca,
+	0xe2, 0xa6, 0xd4, 0x58, 0xee, 0xd4, 0x5a, 0xb3, 0xfb, 0xd8, 0xf2, 0x78, 0xba, 0x95, 0x47, 0x7f,
+	0x6d, 0x2c, 0xfc, 0x74, 0x71, 0xda, 0x94, 0x34, 0x7f, 0xe0, 0xde, 0xfb, 0xdf, 0x5e, 0x9c, 0x36,
+	0xc3, 0x94, 0xdf, 0x5f, 0x9c, 0x36, 0x6f, 0x85, 0x95, 0x1c, 0x47, 0x6a, 0x89, 0x89, 0xae, 0xaf,
+	0xc1, 0x8d, 0x58, 0x48, 0xc3, 0x74, 0x44, 0x6c, 0x8a, 0xeb, 0xff, 0x78, 0x35, 0x1e, 0x62, 0xbb,
+	0xdf, 0xc3, 0x0c, 0xf5, 0x11, 0x43, 0xf2, 0x0a, 0x14, 0xc6, 0xce, 0x50, 0x29, 0xb9, 0xd5, 0x69,
+	0xee, 0xa3, 0x7c, 0x13, 0x2a, 0xc8, 0xab, 0x0c, 0x53, 0xa5, 0xbc, 0x59, 0x68, 0x54, 0xb4, 0x30,
+	0x20, 0x77, 0x60, 0x49, 0x77, 0x30, 0x62, 0xc4, 0x79, 0x62, 0x47, 0x02, 0xa0, 0x2c, 0x43, 0x71,
+	0x44, 0x1c, 0xc6, 0xbb, 0x51, 0xd1, 0xf8, 0xb3, 0xcb, 0xa2, 0x0f, 0x90, 0x6d, 0xe3, 0xe1, 0xdd,
+	0x03, 0xa5, 0xc0, 0x3f, 0x84, 0x01, 0xb9, 0x09, 0x2b, 0xcc, 0xb4, 0x30, 0x19, 0xb3, 0xcf, 0x4d,
+	0x0b, 0x53, 0x86, 0xac, 0x91, 0x52, 0xdc, 0x94, 0x1a, 0x45, 0x2d, 0x11, 0xdf, 0xab, 0xba, 0xad,
+	0x0a, 0xb8, 0xfc, 0xf2, 0xa3, 0x25, 0x8a, 0xf2, 0xbf, 0x86, 0x97, 0x7b, 0xd4, 0xb8, 0xe3, 0x02,
+	0xf1, 0xa1, 0xdb, 0x3b, 0x0e, 0x99, 0xab, 0xa2, 0x55, 0x28, 0x99, 0x76, 0x1f, 0x1f, 0xfb, 0x25,
+	0x79, 0x2f, 0x41, 0x2f, 0x0b, 0xa2, 0x97, 0x31, 0x6d, 0xaf, 0xc2, 0xfa, 0x0c, 0x01, 0x31, 0x7d,
+	0xde, 0xcc, 0xbd, 0x40, 0x7d, 0x71, 0x01, 0x42, 0x9f, 0xc5, 0xf5, 0x1d, 0xe0, 0x21, 0x7e, 0x3e,
+	0xfa, 0x66, 0xaa, 0x89, 0xd3, 0x09, 0x35, 0xdf, 0x49, 0xfc, 0xbb, 0x86, 0x0d, 0x93, 0x32, 0xec,
+	0x1c, 0x20, 0x7f, 0x73, 0xec, 0xeb, 0x3a, 0x19, 0xdb, 0x6c, 0x2e, 0x59, 0x5b, 0x70, 0x55, 0x27,
+	0xb6, 0x8d, 0x75, 0xd7, 0x65, 0x8e, 0xcc, 0xbe, 0x2f, 0xaf, 0x1a, 0x06, 0xef, 0xf6, 0x63, 0x2a,
+	0x6f, 0xc1, 0x56, 0x86, 0x0a, 0xa1, 0xf6, 0x4b, 0x58, 0xbe, 0x33, 0x18, 0xdb, 0x0f, 0xee, 0x8d,
+	0x86, 0x04, 0xf5, 0x93, 0x44, 0x52, 0x92, 0x28, 0x65, 0x12, 0x65, 0x28, 0xba, 0xab, 0x9a, 0xcf,
+	0x62, 0x55, 0xe3, 0xcf, 0xf5, 0x3f, 0x17, 0x7d, 0xf3, 0x72, 0x93, 0x73, 0x9e, 0xf9, 0x36, 0xaa,
+	0xbf, 0x40, 0x16, 0x43, 0x33, 0xd8, 0x87, 0xb2, 0xce, 0xf3, 0x29, 0x85, 0xcd, 0x42, 0x63, 0xb9,
+	0xb3, 0x95, 0x66, 0x65, 0x91, 0xea, 0xba, 0x45, 0xd7, 0xcf, 0x34, 0x7f, 0xe0, 0x65, 0xf6, 0xb2,
+	0xdc, 0x05, 0x08, 0x6d, 0x9e, 0x9b, 0xd2, 0x72, 0xa7, 0x9e, 0x46, 0xf9, 0xb1, 0x40, 0x6a, 0x91,
+	0x51, 0xf2, 0x87, 0x50, 0x11, 0xe6, 0xae, 0x94, 0x79, 0x8a, 0xd7, 0x52, 0x0d, 0x38, 0x00, 0x6a,
+	0xe1, 0x98, 0x99, 0x86, 0x12, 0x6d, 0xad, 0x98, 0xd4, 0x7f, 0x25, 0xb8, 0xce, 0x27, 0x9f, 0x39,
+	0x26, 0x9e, 0xe0, 0xa7, 0x68, 0xfc, 0x73, 0x75, 0x48, 0x59, 0x81, 0x25, 0xbe, 0x76, 0x30, 0x55,
+	0x4a, 0xdc, 0xcf, 0x83, 0x57, 0x79, 0x03, 0x96, 0x75, 0xa4, 0x0f, 0xf0, 0xd1, 0xfd, 0x13, 0x86,
+	0xa9, 0xb2, 0xc4, 0x13, 0x00, 0x0f, 0x75, 0xdd, 0xc8, 0x74, 0x2f, 0x3e, 0x2d, 0x5e, 0x29, 0xaf,
+	0x2c, 0xd5, 0xdf, 0x83, 0xb5, 0x44, 0xd5, 0x41, 0x4f, 0x64, 0x15, 0xae, 0x50, 0xfc, 0x70, 0x8c,
+	0x6d, 0x1d, 0xf3, 0xf2, 0x8b, 0x9a, 0x78, 0xaf, 0xff, 0xec, 0xf5, 0xeb, 0xc0, 0x41, 0xa6, 0x2d,
+	0x76, 0xca, 0xdc, 0xa7, 0x6c, 0xae, 0xcd, 0xba, 0x97, 0x3c, 0x47, 0xb7, 0x53, 0xcf, 0xd1, 0x69,
+	0x61, 0xf5, 0x75, 0x58, 0x4b, 0x04, 0xc5, 0xdc, 0x9f, 0x4b, 0x20, 0xf7, 0xa8, 0xd1, 0x33, 0x0d,
+	0x07, 0x31, 0xfc, 0x89, 0x83, 0x0c, 0x77, 0xed, 0x3c, 0xa3, 0x5d, 0x27, 0x76, 0x7e, 0x21, 0xba,
+	0xf3, 0x13, 0x05, 0x17, 0x67, 0x98, 0x46, 0x60, 0x0f, 0xa5, 0xd0, 0x1e, 0x66, 0xae, 0x95, 0x72,
+	0xae, 0xd3, 0xf4, 0x26, 0xa8, 0xc9, 0x22, 0x45, 0x0f, 0xbe, 0x82, 0x6a, 0x10, 0xeb, 0x91, 0x09,
+	0x0e, 0x65, 0x4b, 0x99, 0xb2, 0x17, 0x33, 0x64, 0x47, 0x5d, 0xed, 0x77, 0x09, 0x56, 0x5c, 0x76,
+	0x32, 0x11, 0xd4, 0xcf, 0xca, 0xd6, 0x3e, 0x82, 0x92, 0x45, 0x26, 0x38, 0x70, 0xb5, 0xd7, 0xd3,
+	0xfc, 0x21, 0x5a, 0x9e, 0x6f, 0x6b, 0xde, 0xc0, 0xa7, 0xb8, 0xa1, 0xa8, 0xa0, 0xc4, 0xab, 0x0a,
+	0x3a, 0xda, 0xf9, 0xaf, 0x02, 0x85, 0x1e, 0x35, 0xe4, 0x01, 0x54, 0xa7, 0x6e, 0xa2, 0xdb, 0x69,
+	0x02, 0x63, 0x57, 0x3d, 0xb5, 0x9d, 0x13, 0x28, 0xf6, 0xeb, 0x00, 0xaa, 0x53, 0xf7, 0xc1, 0x2c,
+	0xa6, 0x28, 0x50, 0x6d, 0xe7, 0x04, 0x0a, 0x26, 0x06, 0x2b, 0x89, 0xbb, 0xd7, 0xed, 0x8c, 0x24,
+	0x71, 0xb0, 0xba, 0x7b, 0x09, 0x70, 0x94, 0x35, 0x71, 0xa3, 0xba, 0xfd, 0xc4, 0x26, 0xe5, 0x64,
+	0x4d, 0xbb, 0x2a, 0xb9, 0xac, 0x89, 0x7b, 0x52, 0x16, 0x6b, 0x1c, 0xac, 0xee, 0x5e, 0x02, 0x2c,
+	0x58, 0x7f, 0x90, 0x40, 0x49, 0xbd, 0x0f, 0x65, 0x65, 0x4c, 0x1b, 0xa4, 0x7e, 0x30, 0xc7, 0xa0,
+	0xe8, 0xd2, 0x9a, 0xba, 0x91, 0x64, 0x2f, 0xe2, 0x10, 0xa8, 0xb6, 0x73, 0x02, 0x05, 0x93, 0x0d,
+	0x2f, 0xc5, 0x0e, 0xe1, 0x37, 0x33, 0x85, 0x47, 0xa1, 0xea, 0x4e, 0x6e, 0x68, 0x94, 0x2f, 0x76,
+	0x88, 0x65, 0xf1, 0x4d, 0x43, 0xd5, 0x9d, 0xdc, 0x50, 0xc1, 0xf7, 0x10, 0xae, 0xc5, 0x0f, 0x9a,
+	0x66, 0x46, 0x96, 0x18, 0x56, 0xed, 0xe4, 0xc7, 0x0a, 0xca, 0x07, 0x70, 0x75, 0xda, 0x78, 0x1b,
+	0x59, 0x49, 0xa2, 0x48, 0xf5, 0xed, 0xbc, 0xc8, 0x80, 0x4c, 0x2d, 0x7d, 0xe3, 0xfe, 0xf9, 0xed,
+	0xbe, 0xf3, 0xe8, 0xac, 0x26, 0x3d, 0x3e, 0xab, 0x49, 0x7f, 0x9f, 0xd5, 0xa4, 0x1f, 0xcf, 0x6b,
+	0x0b, 0x8f, 0xcf, 0x6b, 0x0b, 0x7f, 0x9c, 0xd7, 0x16, 0xbe, 0x58, 0x9f, 0x7d, 0x66, 0xb3, 0x93,
+	0x11, 0xa6, 0xf7, 0xcb, 0xfc, 0x1f, 0xfc, 0xee, 0xff, 0x03, 0x00, 0x35, 0xdb, 0xac, 0xe8, 0xcf,
+	0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SendMetadata defines the SendMetadata RPC.
+	SendMetadata(ctx context.Context, in *MsgSendMetadata, opts ...grpc.CallOption) (*MsgSendMetadataResponse, error)
+	// CreateStoredMeta defines the CreateStoredMeta RPC.
+	CreateStoredMeta(ctx context.Context, in *MsgCreateStoredMeta, opts ...grpc.CallOption) (*MsgCreateStoredMetaResponse, error)
+	// UpdateStoredMeta defines the UpdateStoredMeta RPC.
+	UpdateStoredMeta(ctx context.Context, in *MsgUpdateStoredMeta, opts ...grpc.CallOption) (*MsgUpdateStoredMetaResponse, error)
+	// DeleteStoredMeta defines the DeleteStoredMeta RPC.
+	DeleteStoredMeta(ctx context.Context, in *MsgDeleteStoredMeta, opts ...grpc.CallOption) (*MsgDeleteStoredMetaResponse, error)
+	// RegisterDatachainAccount defines the RegisterDatachainAccount RPC.
+	RegisterDatachainAccount(ctx context.Context, in *MsgRegisterDatachainAccount, opts ...grpc.CallOption) (*MsgRegisterDatachainAccountResponse, error)
+	// UploadChunks defines the UploadChunks RPC.
+	UploadChunks(ctx context.Context, in *MsgUploadChunks, opts ...grpc.CallOption) (*MsgUploadChunksResponse, error)
+	// RetrieveChunks defines the RetrieveChunks RPC.
+	RetrieveChunks(ctx context.Context, in *MsgRetrieveChunks, opts ...grpc.CallOption) (*MsgRetrieveChunksResponse, error)
+	// DrainDatachain defines a (governance) operation making a datachain
+	// read-only until its fragments are migrated to other datachains.
+	DrainDatachain(ctx context.Context, in *MsgDrainDatachain, opts ...grpc.CallOption) (*MsgDrainDatachainResponse, error)
+	// MigrateFragment defines the MigrateFragment RPC.
+	MigrateFragment(ctx context.Context, in *MsgMigrateFragment, opts ...grpc.CallOption) (*MsgMigrateFragmentResponse, error)
+	// MoveFragments defines the MoveFragments RPC.
+	MoveFragments(ctx context.Context, in *MsgMoveFragments, opts ...grpc.CallOption) (*MsgMoveFragmentsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateStoredMeta) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SendMetadata(ctx context.Context, in *MsgSendMetadata, opts ...grpc.CallOption) (*MsgSendMetadataResponse, error) {
+	out := new(MsgSendMetadataResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/SendMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateStoredMeta(ctx context.Context, in *MsgCreateStoredMeta, opts ...grpc.CallOption) (*MsgCreateStoredMetaResponse, error) {
+	out := new(MsgCreateStoredMetaResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/CreateStoredMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateStoredMeta(ctx context.Context, in *MsgUpdateStoredMeta, opts ...grpc.CallOption) (*MsgUpdateStoredMetaResponse, error) {
+	out := new(MsgUpdateStoredMetaResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/UpdateStoredMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DeleteStoredMeta(ctx context.Context, in *MsgDeleteStoredMeta, opts ...grpc.CallOption) (*MsgDeleteStoredMetaResponse, error) {
+	out := new(MsgDeleteStoredMetaResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/DeleteStoredMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateStoredMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RegisterDatachainAccount(ctx context.Context, in *MsgRegisterDatachainAccount, opts ...grpc.CallOption) (*MsgRegisterDatachainAccountResponse, error) {
+	out := new(MsgRegisterDatachainAccountResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/RegisterDatachainAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateStoredMeta) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UploadChunks(ctx context.Context, in *MsgUploadChunks, opts ...grpc.CallOption) (*MsgUploadChunksResponse, error) {
+	out := new(MsgUploadChunksResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/UploadChunks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RetrieveChunks(ctx context.Context, in *MsgRetrieveChunks, opts ...grpc.CallOption) (*MsgRetrieveChunksResponse, error) {
+	out := new(MsgRetrieveChunksResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/RetrieveChunks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DrainDatachain(ctx context.Context, in *MsgDrainDatachain, opts ...grpc.CallOption) (*MsgDrainDatachainResponse, error) {
+	out := new(MsgDrainDatachainResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/DrainDatachain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) MigrateFragment(ctx context.Context, in *MsgMigrateFragment, opts ...grpc.CallOption) (*MsgMigrateFragmentResponse, error) {
+	out := new(MsgMigrateFragmentResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/MigrateFragment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MoveFragments(ctx context.Context, in *MsgMoveFragments, opts ...grpc.CallOption) (*MsgMoveFragmentsResponse, error) {
+	out := new(MsgMoveFragmentsResponse)
+	err := c.cc.Invoke(ctx, "/metachain.metastore.v1.Msg/MoveFragments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SendMetadata defines the SendMetadata RPC.
+	SendMetadata(context.Context, *MsgSendMetadata) (*MsgSendMetadataResponse, error)
+	// CreateStoredMeta defines the CreateStoredMeta RPC.
+	CreateStoredMeta(context.Context, *MsgCreateStoredMeta) (*MsgCreateStoredMetaResponse, error)
+	// UpdateStoredMeta defines the UpdateStoredMeta RPC.
+	UpdateStoredMeta(context.Context, *MsgUpdateStoredMeta) (*MsgUpdateStoredMetaResponse, error)
+	// DeleteStoredMeta defines the DeleteStoredMeta RPC.
+	DeleteStoredMeta(context.Context, *MsgDeleteStoredMeta) (*MsgDeleteStoredMetaResponse, error)
+	// RegisterDatachainAccount defines the RegisterDatachainAccount RPC.
+	RegisterDatachainAccount(context.Context, *MsgRegisterDatachainAccount) (*MsgRegisterDatachainAccountResponse, error)
+	// UploadChunks defines the UploadChunks RPC.
+	UploadChunks(context.Context, *MsgUploadChunks) (*MsgUploadChunksResponse, error)
+	// RetrieveChunks defines the RetrieveChunks RPC.
+	RetrieveChunks(context.Context, *MsgRetrieveChunks) (*MsgRetrieveChunksResponse, error)
+	// DrainDatachain defines a (governance) operation making a datachain
+	// read-only until its fragments are migrated to other datachains.
+	DrainDatachain(context.Context, *MsgDrainDatachain) (*MsgDrainDatachainResponse, error)
+	// MigrateFragment defines the MigrateFragment RPC.
+	MigrateFragment(context.Context, *MsgMigrateFragment) (*MsgMigrateFragmentResponse, error)
+	// MoveFragments defines the MoveFragments RPC.
+	MoveFragments(context.Context, *MsgMoveFragments) (*MsgMoveFragmentsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SendMetadata(ctx context.Context, req *MsgSendMetadata) (*MsgSendMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMetadata not implemented")
+}
+func (*UnimplementedMsgServer) CreateStoredMeta(ctx context.Context, req *MsgCreateStoredMeta) (*MsgCreateStoredMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStoredMeta not implemented")
+}
+func (*UnimplementedMsgServer) UpdateStoredMeta(ctx context.Context, req *MsgUpdateStoredMeta) (*MsgUpdateStoredMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStoredMeta not implemented")
+}
+func (*UnimplementedMsgServer) DeleteStoredMeta(ctx context.Context, req *MsgDeleteStoredMeta) (*MsgDeleteStoredMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStoredMeta not implemented")
+}
+func (*UnimplementedMsgServer) RegisterDatachainAccount(ctx context.Context, req *MsgRegisterDatachainAccount) (*MsgRegisterDatachainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDatachainAccount not implemented")
+}
+func (*UnimplementedMsgServer) UploadChunks(ctx context.Context, req *MsgUploadChunks) (*MsgUploadChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadChunks not implemented")
+}
+func (*UnimplementedMsgServer) RetrieveChunks(ctx context.Context, req *MsgRetrieveChunks) (*MsgRetrieveChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveChunks not implemented")
+}
+func (*UnimplementedMsgServer) DrainDatachain(ctx context.Context, req *MsgDrainDatachain) (*MsgDrainDatachainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainDatachain not implemented")
+}
+func (*UnimplementedMsgServer) MigrateFragment(ctx context.Context, req *MsgMigrateFragment) (*MsgMigrateFragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateFragment not implemented")
+}
+func (*UnimplementedMsgServer) MoveFragments(ctx context.Context, req *MsgMoveFragments) (*MsgMoveFragmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFragments not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/SendMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendMetadata(ctx, req.(*MsgSendMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStoredMeta)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStoredMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/CreateStoredMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStoredMeta(ctx, req.(*MsgCreateStoredMeta))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateStoredMeta)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateStoredMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/UpdateStoredMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateStoredMeta(ctx, req.(*MsgUpdateStoredMeta))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteStoredMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteStoredMeta)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteStoredMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/DeleteStoredMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteStoredMeta(ctx, req.(*MsgDeleteStoredMeta))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterDatachainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDatachainAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDatachainAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/RegisterDatachainAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDatachainAccount(ctx, req.(*MsgRegisterDatachainAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UploadChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUploadChunks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UploadChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/UploadChunks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UploadChunks(ctx, req.(*MsgUploadChunks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetrieveChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetrieveChunks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetrieveChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/RetrieveChunks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetrieveChunks(ctx, req.(*MsgRetrieveChunks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DrainDatachain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDrainDatachain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DrainDatachain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/DrainDatachain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DrainDatachain(ctx, req.(*MsgDrainDatachain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateFragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateFragment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateFragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/MigrateFragment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateFragment(ctx, req.(*MsgMigrateFragment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MoveFragments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMoveFragments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MoveFragments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metachain.metastore.v1.Msg/MoveFragments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MoveFragments(ctx, req.(*MsgMoveFragments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "metachain.metastore.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SendMetadata",
+			Handler:    _Msg_SendMetadata_Handler,
+		},
+		{
+			MethodName: "CreateStoredMeta",
+			Handler:    _Msg_CreateStoredMeta_Handler,
+		},
+		{
+			MethodName: "UpdateStoredMeta",
+			Handler:    _Msg_UpdateStoredMeta_Handler,
+		},
+		{
+			MethodName: "DeleteStoredMeta",
+			Handler:    _Msg_DeleteStoredMeta_Handler,
+		},
+		{
+			MethodName: "RegisterDatachainAccount",
+			Handler:    _Msg_RegisterDatachainAccount_Handler,
+		},
+		{
+			MethodName: "UploadChunks",
+			Handler:    _Msg_UploadChunks_Handler,
+		},
+		{
+			MethodName: "RetrieveChunks",
+			Handler:    _Msg_RetrieveChunks_Handler,
+		},
+		{
+			MethodName: "DrainDatachain",
+			Handler:    _Msg_DrainDatachain_Handler,
+		},
+		{
+			MethodName: "MigrateFragment",
+			Handler:    _Msg_MigrateFragment_Handler,
+		},
+		{
+			MethodName: "MoveFragments",
+			Handler:    _Msg_MoveFragments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "metachain/metastore/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStoredMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStoredMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStoredMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStoredMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateStoredMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStoredMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStoredMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateStoredMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteStoredMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteStoredMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteStoredMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteStoredMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteStoredMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteStoredMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDatachainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDatachainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDatachainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDatachainAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDatachainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDatachainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadChunks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadChunks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadChunks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Placement != nil {
+		{
+			size, err := m.Placement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadChunksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadChunksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadChunksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetrieveChunks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetrieveChunks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetrieveChunks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CacheBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CacheBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Indexes[iNdEx])
+			copy(dAtA[i:], m.Indexes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Indexes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetrieveChunksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetrieveChunksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetrieveChunksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrainDatachain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrainDatachain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrainDatachain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrainDatachainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrainDatachainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrainDatachainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateFragment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateFragment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateFragment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateFragmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateFragmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateFragmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FragmentMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FragmentMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FragmentMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMoveFragments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMoveFragments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMoveFragments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Moves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMoveFragmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMoveFragmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMoveFragmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateStoredMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateStoredMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateStoredMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateStoredMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteStoredMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteStoredMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterDatachainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterDatachainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ChunkUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUploadChunks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	if m.Encryption != nil {
+		l = m.Encryption.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Placement != nil {
+		l = m.Placement.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUploadChunksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetrieveChunks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	if len(m.Indexes) > 0 {
+		for _, s := range m.Indexes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CacheBytes != 0 {
+		n += 1 + sovTx(uint64(m.CacheBytes))
+	}
+	return n
+}
+
+func (m *MsgRetrieveChunksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgDrainDatachain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDrainDatachainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateFragment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MsgMigrateFragmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FragmentMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMoveFragments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MsgMoveFragmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStoredMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStoredMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStoredMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStoredMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStoredMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStoredMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStoredMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStoredMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStoredMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStoredMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteStoredMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteStoredMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteStoredMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteStoredMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteStoredMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDatachainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDatachainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDatachainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateStoredMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDeleteStoredMeta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterDatachainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDatachainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDatachainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDeleteStoredMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *ChunkUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUploadChunks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1363,15 +4582,47 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUploadChunks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUploadChunks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1399,11 +4650,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1430,7 +4681,99 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Chunks = append(m.Chunks, ChunkUpload{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Encryption == nil {
+				m.Encryption = &Encryption{}
+			}
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Placement == nil {
+				m.Placement = &Placement{}
+			}
+			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1455,7 +4798,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUploadChunksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1478,10 +4821,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUploadChunksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUploadChunksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1505,7 +4848,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgRetrieveChunks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1528,10 +4871,10 @@ func (m *MsgSendMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetrieveChunks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetrieveChunks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1634,24 +4977,194 @@ func (m *MsgSendMetadata) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
-			m.TimeoutTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheBytes", wireType)
+			}
+			m.CacheBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetrieveChunksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetrieveChunksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetrieveChunksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrainDatachain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrainDatachain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrainDatachain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1679,11 +5192,11 @@ func (m *MsgSendMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1711,7 +5224,7 @@ func (m *MsgSendMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1734,7 +5247,7 @@ func (m *MsgSendMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDrainDatachainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1757,10 +5270,10 @@ func (m *MsgSendMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDrainDatachainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDrainDatachainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1784,7 +5297,7 @@ func (m *MsgSendMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateStoredMeta) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateFragment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1807,10 +5320,10 @@ func (m *MsgCreateStoredMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStoredMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateFragment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStoredMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateFragment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1846,6 +5359,38 @@ func (m *MsgCreateStoredMeta) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
@@ -1877,9 +5422,9 @@ func (m *MsgCreateStoredMeta) Unmarshal(dAtA []byte) error {
 			}
 			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1907,8 +5452,61 @@ func (m *MsgCreateStoredMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1930,7 +5528,7 @@ func (m *MsgCreateStoredMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateStoredMetaResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateFragmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1953,10 +5551,10 @@ func (m *MsgCreateStoredMetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStoredMetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateFragmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateFragmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1980,7 +5578,7 @@ func (m *MsgCreateStoredMetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateStoredMeta) Unmarshal(dAtA []byte) error {
+func (m *FragmentMove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,15 +5601,15 @@ func (m *MsgUpdateStoredMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStoredMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: FragmentMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStoredMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FragmentMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2039,11 +5637,11 @@ func (m *MsgUpdateStoredMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2071,13 +5669,13 @@ func (m *MsgUpdateStoredMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2087,74 +5685,26 @@ func (m *MsgUpdateStoredMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateStoredMetaResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStoredMetaResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2176,7 +5726,7 @@ func (m *MsgUpdateStoredMetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteStoredMeta) Unmarshal(dAtA []byte) error {
+func (m *MsgMoveFragments) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2199,10 +5749,10 @@ func (m *MsgDeleteStoredMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteStoredMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMoveFragments: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteStoredMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMoveFragments: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2239,7 +5789,7 @@ func (m *MsgDeleteStoredMeta) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2267,8 +5817,61 @@ func (m *MsgDeleteStoredMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, FragmentMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2290,7 +5893,7 @@ func (m *MsgDeleteStoredMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteStoredMetaResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMoveFragmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2313,10 +5916,10 @@ func (m *MsgDeleteStoredMetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteStoredMetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMoveFragmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteStoredMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMoveFragmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
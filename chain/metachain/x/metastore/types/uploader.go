@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UploaderMsgTypeURLs returns the messages an uploader may execute on behalf of the account that
+// granted it upload rights with chunks.NewUploaderGrantMsgs.
+func UploaderMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgCreateStoredMeta{}),
+		sdk.MsgTypeURL(&MsgRegisterDatachainAccount{}),
+		sdk.MsgTypeURL(&MsgUploadChunks{}),
+	}
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"chunks"
+
+	datastoremoduletypes "datachain/x/datastore/types"
+	metastoremoduletypes "metachain/x/metastore/types"
+)
+
+// chunkBudgetStoreKey is the name of the transient store counting the chunk data of a block.
+const chunkBudgetStoreKey = "transient_chunks"
+
+// registerChunkBudget sets up the block budget shared by the chunk data of both stores.
+func (app *App) registerChunkBudget() error {
+	key := storetypes.NewTransientStoreKey(chunkBudgetStoreKey)
+	if err := app.RegisterStores(key); err != nil {
+		return err
+	}
+
+	app.chunkBudget = chunks.NewBudget(runtime.NewTransientStoreService(key), chunks.Stores{
+		{MsgBytes: datastoremoduletypes.MsgChunkBytes, Params: app.DatastoreKeeper.ChunkParams},
+		{MsgBytes: metastoremoduletypes.MsgChunkBytes, Params: app.MetastoreKeeper.ChunkParams},
+	})
+	return nil
+}
+
+// newAnteHandler returns the default SDK ante handler with the chunk gas of both stores charged
+// right after the tx size.
+func (app *App) newAnteHandler() sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(nil),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(app.AuthKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(app.AuthKeeper),
+		chunks.NewAnteDecorator(app.chunkBudget.Stores),
+		ante.NewDeductFeeDecorator(app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, nil),
+		ante.NewSetPubKeyDecorator(app.AuthKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(app.AuthKeeper),
+		ante.NewSigGasConsumeDecorator(app.AuthKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(app.AuthKeeper, app.txConfig.SignModeHandler()),
+		ante.NewIncrementSequenceDecorator(app.AuthKeeper),
+	)
+}
+
+// newPostHandler returns the post handler counting the chunk data of a block against its budget.
+// It runs once the ante handler has deducted the fees, so a tx the proposer should have left out
+// pays for the block space it takes.
+func (app *App) newPostHandler() sdk.PostHandler {
+	return sdk.ChainPostDecorators(
+		chunks.NewPostDecorator(app.chunkBudget),
+	)
+}
@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -45,14 +46,18 @@ import (
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
-	"datachain/docs"
-	datachainmodulekeeper "datachain/x/datachain/keeper"
+	"chunks"
+
 	datastoremodulekeeper "datachain/x/datastore/keeper"
+	metastoremodulekeeper "metachain/x/metastore/keeper"
+	metastoremoduletypes "metachain/x/metastore/types"
+
+	"raidchain/docs"
 )
 
 const (
 	// Name is the name of the application.
-	Name = "datachain"
+	Name = "raidchain"
 	// AccountAddressPrefix is the prefix for accounts addresses.
 	AccountAddressPrefix = "cosmos"
 	// ChainCoinType is the coin type of the chain.
@@ -89,6 +94,7 @@ type App struct {
 	GovKeeper             *govkeeper.Keeper
 	UpgradeKeeper         *upgradekeeper.Keeper
 	AuthzKeeper           authzkeeper.Keeper
+	FeeGrantKeeper        feegrantkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
 	CircuitBreakerKeeper  circuitkeeper.Keeper
 	ParamsKeeper          paramskeeper.Keeper
@@ -99,8 +105,14 @@ type App struct {
 	ICAHostKeeper       icahostkeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
 
-	DatachainKeeper datachainmodulekeeper.Keeper
 	DatastoreKeeper datastoremodulekeeper.Keeper
+	MetastoreKeeper metastoremodulekeeper.Keeper
+
+	// chunkBudget bounds the chunk data of a block, whichever store it is for.
+	chunkBudget chunks.Budget
+
+	// restoreChunks is the number of chunks of the snapshot state sync is restoring.
+	restoreChunks uint32
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -151,6 +163,10 @@ func New(
 			depinject.Supply(
 				appOpts, // supply app options
 				logger,  // supply logger
+				// IBC keepers are created after dependency injection in registerIBCModules,
+				// modules that need them receive closures resolved at call time.
+				func() *ibckeeper.Keeper { return app.IBCKeeper },
+				func() metastoremoduletypes.ICAControllerKeeper { return app.ICAControllerKeeper },
 				// here alternative options can be supplied to the DI container.
 				// those options can be used f.e to override the default behavior of some modules.
 				// for instance supplying a custom address codec for not using bech32 addresses.
@@ -177,11 +193,12 @@ func New(
 		&app.GovKeeper,
 		&app.UpgradeKeeper,
 		&app.AuthzKeeper,
+		&app.FeeGrantKeeper,
 		&app.ConsensusParamsKeeper,
 		&app.CircuitBreakerKeeper,
 		&app.ParamsKeeper,
-		&app.DatachainKeeper,
 		&app.DatastoreKeeper,
+		&app.MetastoreKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -193,11 +210,22 @@ func New(
 	// build app
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// the role wraps the breaker the circuit module installed while building
+	app.SetCircuitBreaker(roleCircuitBreaker{role: app.Role, next: &app.CircuitBreakerKeeper})
+
 	// register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
 		panic(err)
 	}
 
+	// state sync snapshots carry the stored chunks in segments checked on restore, none on a
+	// chain without the chunk store
+	if manager := app.SnapshotManager(); manager != nil {
+		if err := manager.RegisterExtensions(datastoremodulekeeper.NewSnapshotter(app.CommitMultiStore(), app.DatastoreKeeper)); err != nil {
+			panic(err)
+		}
+	}
+
 	/****  Module Options ****/
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -219,6 +247,20 @@ func New(
 		return app.App.InitChainer(ctx, req)
 	})
 
+	if err := app.registerChunkBudget(); err != nil {
+		panic(err)
+	}
+	app.SetAnteHandler(app.newAnteHandler())
+	app.SetPostHandler(app.newPostHandler())
+
+	if err := app.setUpgradeHandlers(); err != nil {
+		panic(err)
+	}
+
+	if err := app.setLanes(appOpts); err != nil {
+		panic(err)
+	}
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
@@ -252,6 +294,21 @@ func (app *App) TxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// GetBaseApp returns the App's BaseApp.
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.App.BaseApp
+}
+
+// GetIBCKeeper returns the App's IBC keeper.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetTxConfig returns App's TxConfig. It is an alias of TxConfig used by the IBC testing package.
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 func (app *App) GetKey(storeKey string) *storetypes.KVStoreKey {
 	kvStoreKey, ok := app.UnsafeFindStoreKey(storeKey).(*storetypes.KVStoreKey)
@@ -1,8 +1,8 @@
 package app
 
 import (
-	_ "metachain/x/metachain/module"
-	metachainmoduletypes "metachain/x/metachain/types"
+	_ "datachain/x/datastore/module"
+	datastoremoduletypes "datachain/x/datastore/types"
 	_ "metachain/x/metastore/module"
 	metastoremoduletypes "metachain/x/metastore/types"
 	"time"
@@ -85,6 +85,7 @@ var (
 		{Account: nft.ModuleName},
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: icatypes.ModuleName},
+		{Account: datastoremoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
 		{Account: metastoremoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
@@ -129,7 +130,7 @@ var (
 						// ibc modules
 						ibcexported.ModuleName,
 						// chain modules
-						metachainmoduletypes.ModuleName,
+						datastoremoduletypes.ModuleName,
 						metastoremoduletypes.ModuleName,
 						// this line is used by starport scaffolding # stargate/app/beginBlockers
 					},
@@ -139,7 +140,7 @@ var (
 						feegrant.ModuleName,
 						group.ModuleName,
 						// chain modules
-						metachainmoduletypes.ModuleName,
+						datastoremoduletypes.ModuleName,
 						metastoremoduletypes.ModuleName,
 						// this line is used by starport scaffolding # stargate/app/endBlockers
 					},
@@ -177,7 +178,7 @@ var (
 						ibctransfertypes.ModuleName,
 						icatypes.ModuleName,
 						// chain modules
-						metachainmoduletypes.ModuleName,
+						datastoremoduletypes.ModuleName,
 						metastoremoduletypes.ModuleName,
 						// this line is used by starport scaffolding # stargate/app/initGenesis
 					},
@@ -213,8 +214,9 @@ var (
 				Config: appconfig.WrapAny(&slashingmodulev1.Module{}),
 			},
 			{
-				Name:   "tx",
-				Config: appconfig.WrapAny(&txconfigv1.Config{}),
+				Name: "tx",
+				// the ante handler is built in app.go to charge chunk gas
+				Config: appconfig.WrapAny(&txconfigv1.Config{SkipAnteHandler: true}),
 			},
 			{
 				Name:   genutiltypes.ModuleName,
@@ -276,8 +278,8 @@ var (
 				Config: appconfig.WrapAny(&epochsmodulev1.Module{}),
 			},
 			{
-				Name:   metachainmoduletypes.ModuleName,
-				Config: appconfig.WrapAny(&metachainmoduletypes.Module{}),
+				Name:   datastoremoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&datastoremoduletypes.Module{}),
 			},
 			{
 				Name:   metastoremoduletypes.ModuleName,
@@ -0,0 +1,283 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"chunks"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+)
+
+const (
+	proposalTestChainID = "raidchain-proposals"
+	proposalTestGas     = 100_000
+)
+
+// proposalTestChain is a chain whose txs go through CheckTx, the mempool and the proposal
+// handler of the app, as on a node.
+type proposalTestChain struct {
+	t      *testing.T
+	app    *App
+	valSet *cmttypes.ValidatorSet
+	height int64
+
+	keys []cryptotypes.PrivKey
+	// seqs are the next sequences of keys, counting the txs sent but not committed yet
+	seqs []uint64
+}
+
+// funds returns the balance of every account of a proposalTestChain at genesis.
+func (c *proposalTestChain) funds() sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000))
+}
+
+// newProposalTestChain starts a chain with role and accounts funded accounts, with genesis
+// edited by setup if set, and commits its first block.
+func newProposalTestChain(t *testing.T, role Role, accounts int, setup func(cdc codec.JSONCodec, genesis map[string]json.RawMessage)) *proposalTestChain {
+	t.Helper()
+
+	c := &proposalTestChain{
+		t:   t,
+		app: New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID(proposalTestChainID)),
+	}
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	c.valSet = cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	var (
+		genAccs  []authtypes.GenesisAccount
+		balances []banktypes.Balance
+	)
+	for i := range accounts {
+		key := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(key.PubKey().Address())
+		c.keys = append(c.keys, key)
+		c.seqs = append(c.seqs, 0)
+		genAccs = append(genAccs, authtypes.NewBaseAccount(addr, key.PubKey(), uint64(i), 0))
+		balances = append(balances, banktypes.Balance{Address: addr.String(), Coins: c.funds()})
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(c.app.AppCodec(), c.app.DefaultGenesis(), c.valSet, genAccs, balances...)
+	require.NoError(t, err)
+	require.NoError(t, SetGenesisRole(c.app.AppCodec(), genesisState, role))
+	if setup != nil {
+		setup(c.app.AppCodec(), genesisState)
+	}
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	_, err = c.app.InitChain(&abci.RequestInitChain{
+		ChainId:         proposalTestChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	c.finalize()
+	return c
+}
+
+// addr returns the address of the account of key i.
+func (c *proposalTestChain) addr(i int) sdk.AccAddress {
+	return sdk.AccAddress(c.keys[i].PubKey().Address())
+}
+
+// proposalTestTx is the content of a tx of a proposalTestChain.
+type proposalTestTx struct {
+	fee           int64
+	gas           uint64
+	timeoutHeight uint64
+	memo          string
+	msgs          []sdk.Msg
+}
+
+// signTx signs msgs with the key of account i, paying fee for proposalTestGas with memo.
+func (c *proposalTestChain) signTx(i int, fee int64, memo string, msgs ...sdk.Msg) []byte {
+	c.t.Helper()
+	return c.sign(i, proposalTestTx{fee: fee, gas: proposalTestGas, memo: memo, msgs: msgs})
+}
+
+// signPaddedTx signs content with the key of account i, with a memo padding it to size bytes.
+func (c *proposalTestChain) signPaddedTx(i int, size int, content proposalTestTx) []byte {
+	c.t.Helper()
+	for content.memo = ""; uint64(len(content.memo)) <= authtypes.DefaultMaxMemoCharacters; content.memo += "m" {
+		if txBytes := c.encodeTx(i, c.seqs[i], content); len(txBytes) == size {
+			c.seqs[i]++
+			return txBytes
+		}
+	}
+	c.t.Fatalf("no memo pads the tx of account %d to %d bytes", i, size)
+	return nil
+}
+
+// sign signs content with the key of account i at its next sequence.
+func (c *proposalTestChain) sign(i int, content proposalTestTx) []byte {
+	c.t.Helper()
+	txBytes := c.encodeTx(i, c.seqs[i], content)
+	c.seqs[i]++
+	return txBytes
+}
+
+// encodeTx returns content signed with the key of account i at seq.
+func (c *proposalTestChain) encodeTx(i int, seq uint64, content proposalTestTx) []byte {
+	c.t.Helper()
+
+	ctx := c.app.NewUncachedContext(false, cmtproto.Header{})
+	acc := c.app.AuthKeeper.GetAccount(ctx, c.addr(i))
+	require.NotNil(c.t, acc)
+	key := c.keys[i]
+
+	txConfig := c.app.TxConfig()
+	builder := txConfig.NewTxBuilder()
+	require.NoError(c.t, builder.SetMsgs(content.msgs...))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, content.fee)))
+	builder.SetGasLimit(content.gas)
+	builder.SetTimeoutHeight(content.timeoutHeight)
+	builder.SetMemo(content.memo)
+	require.NoError(c.t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   key.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: seq,
+	}))
+
+	sig, err := tx.SignWithPrivKey(context.Background(), signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       proposalTestChainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      seq,
+		PubKey:        key.PubKey(),
+	}, builder, key, txConfig, seq)
+	require.NoError(c.t, err)
+	require.NoError(c.t, builder.SetSignatures(sig))
+
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(c.t, err)
+	return txBytes
+}
+
+// checkTx submits txs to the mempool, as a node receiving them does.
+func (c *proposalTestChain) checkTx(txs ...[]byte) {
+	c.t.Helper()
+	for _, txBytes := range txs {
+		res, err := c.app.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		require.NoError(c.t, err)
+		require.Zero(c.t, res.Code, res.Log)
+	}
+}
+
+// propose returns the txs the node proposes for the next block out of its mempool.
+func (c *proposalTestChain) propose(maxTxBytes int64) [][]byte {
+	c.t.Helper()
+	res, err := c.app.PrepareProposal(&abci.RequestPrepareProposal{
+		Height:     c.height + 1,
+		MaxTxBytes: maxTxBytes,
+	})
+	require.NoError(c.t, err)
+	return res.Txs
+}
+
+// finalize executes and commits a block of txs, which leave the mempool.
+func (c *proposalTestChain) finalize(txs ...[]byte) []*abci.ExecTxResult {
+	c.t.Helper()
+	c.height++
+	res, err := c.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: c.height, Txs: txs, NextValidatorsHash: c.valSet.Hash()})
+	require.NoError(c.t, err)
+	_, err = c.app.Commit()
+	require.NoError(c.t, err)
+	return res.TxResults
+}
+
+// maxBlockChunkBytes returns a genesis setup bounding the chunk data of a block to max bytes in
+// both stores.
+func maxBlockChunkBytes(t *testing.T, max uint64) func(cdc codec.JSONCodec, genesis map[string]json.RawMessage) {
+	return func(cdc codec.JSONCodec, genesis map[string]json.RawMessage) {
+		var datastoreGenesis datastoretypes.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(genesis[datastoretypes.ModuleName], &datastoreGenesis))
+		datastoreGenesis.Params.MaxBlockChunkBytes = max
+		var metastoreGenesis metastoretypes.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(genesis[metastoretypes.ModuleName], &metastoreGenesis))
+		metastoreGenesis.Params.MaxBlockChunkBytes = max
+
+		var err error
+		genesis[datastoretypes.ModuleName], err = cdc.MarshalJSON(&datastoreGenesis)
+		require.NoError(t, err)
+		genesis[metastoretypes.ModuleName], err = cdc.MarshalJSON(&metastoreGenesis)
+		require.NoError(t, err)
+	}
+}
+
+func TestChunkBudget(t *testing.T) {
+	c := newProposalTestChain(t, RoleDatachain, 4, maxBlockChunkBytes(t, 10))
+	chunk := func(i int, index, data string) sdk.Msg {
+		return &datastoretypes.MsgCreateStoredChunk{Creator: c.addr(i).String(), Index: index, Data: []byte(data)}
+	}
+	mempoolTxs := func() int { return c.app.Mempool().CountTx() }
+
+	// The highest paying upload fills the chunk budget on its own but is too large for the block
+	// bytes of the first proposal. Skipping it leaves the budget to the next upload.
+	large := c.signTx(0, 3*proposalTestGas, strings.Repeat("m", 256), chunk(0, "large", "0123456789"))
+	small := c.signTx(1, proposalTestGas, "", chunk(1, "small", "012345"))
+	require.Greater(t, len(large)-len(small), 256)
+	c.checkTx(large, small)
+	require.Equal(t, [][]byte{small}, c.propose(int64(len(large)-1)))
+	results := c.finalize(small)
+	require.Zero(t, results[0].Code, results[0].Log)
+	require.Equal(t, 1, mempoolTxs())
+
+	// Uploads past the budget of a block wait in the mempool for the next ones instead of being
+	// dropped, even though CheckTx admitted them all.
+	next := c.signTx(2, 2*proposalTestGas, "", chunk(2, "next", "012345"))
+	last := c.signTx(3, proposalTestGas, "", chunk(3, "last", "012345"))
+	c.checkTx(next, last)
+	for _, want := range [][]byte{large, next, last} {
+		proposal := c.propose(simtestutil.DefaultConsensusParams.Block.MaxBytes)
+		require.Equal(t, [][]byte{want}, proposal)
+		results := c.finalize(proposal...)
+		require.Zero(t, results[0].Code, results[0].Log)
+	}
+	require.Zero(t, mempoolTxs())
+
+	// A proposer ignoring the budget gets the upload past it failed in the block: its chunk is
+	// not stored, but it pays the fees of the block space it takes.
+	results = c.finalize(
+		c.signTx(0, proposalTestGas, "", chunk(0, "kept", "012345")),
+		c.signTx(1, proposalTestGas, "", chunk(1, "over", "012345")),
+	)
+	require.Zero(t, results[0].Code, results[0].Log)
+	require.Equal(t, chunks.Codespace, results[1].Codespace)
+	require.Equal(t, chunks.ErrBlockChunkBytes.ABCICode(), results[1].Code)
+
+	ctx := c.app.NewUncachedContext(false, cmtproto.Header{Height: c.height})
+	has, err := c.app.DatastoreKeeper.StoredChunk.Has(ctx, "kept")
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = c.app.DatastoreKeeper.StoredChunk.Has(ctx, "over")
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, c.funds().Sub(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2*proposalTestGas)), c.app.BankKeeper.GetAllBalances(ctx, c.addr(1)))
+}
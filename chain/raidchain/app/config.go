@@ -0,0 +1,29 @@
+package app
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+func init() {
+	// Set prefixes
+	accountPubKeyPrefix := AccountAddressPrefix + "pub"
+	validatorAddressPrefix := AccountAddressPrefix + "valoper"
+	validatorPubKeyPrefix := AccountAddressPrefix + "valoperpub"
+	consNodeAddressPrefix := AccountAddressPrefix + "valcons"
+	consNodePubKeyPrefix := AccountAddressPrefix + "valconspub"
+
+	// Set and seal config. The config is process-wide, so when another chain app linked into
+	// the same binary (e.g. the e2e tests) already sealed it with these values, leave it be.
+	config := sdk.GetConfig()
+	if config.GetCoinType() != ChainCoinType ||
+		config.GetBech32AccountAddrPrefix() != AccountAddressPrefix ||
+		config.GetBech32AccountPubPrefix() != accountPubKeyPrefix ||
+		config.GetBech32ValidatorAddrPrefix() != validatorAddressPrefix ||
+		config.GetBech32ValidatorPubPrefix() != validatorPubKeyPrefix ||
+		config.GetBech32ConsensusAddrPrefix() != consNodeAddressPrefix ||
+		config.GetBech32ConsensusPubPrefix() != consNodePubKeyPrefix {
+		config.SetCoinType(ChainCoinType)
+		config.SetBech32PrefixForAccount(AccountAddressPrefix, accountPubKeyPrefix)
+		config.SetBech32PrefixForValidator(validatorAddressPrefix, validatorPubKeyPrefix)
+		config.SetBech32PrefixForConsensusNode(consNodeAddressPrefix, consNodePubKeyPrefix)
+	}
+	config.Seal()
+}
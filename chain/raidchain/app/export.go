@@ -3,6 +3,8 @@ package app
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"slices"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
@@ -13,6 +15,8 @@ import (
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	datastoremoduletypes "datachain/x/datastore/types"
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
@@ -49,6 +53,44 @@ func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs
 	}, err
 }
 
+// ExportAppStateWithChunkArchive exports the state of the application like
+// ExportAppStateAndValidators, except that the stored chunks of the datastore module are streamed
+// to w as a chunk archive named file instead of being held in the app state.
+func (app *App) ExportAppStateWithChunkArchive(w io.Writer, file string) (servertypes.ExportedApp, error) {
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	modulesToExport := slices.DeleteFunc(slices.Clone(app.ModuleManager.OrderExportGenesis), func(name string) bool {
+		return name == datastoremoduletypes.ModuleName
+	})
+	genState, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	datastoreGenesis, err := app.DatastoreKeeper.ExportGenesisWithChunkArchive(ctx, w, file)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	genState[datastoremoduletypes.ModuleName], err = app.appCodec.MarshalJSON(datastoreGenesis)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Validators:      validators,
+		Height:          app.LastBlockHeight() + 1,
+		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
+	}, err
+}
+
 // prepForZeroHeightGenesis prepares for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
@@ -1,6 +1,8 @@
 package app
 
 import (
+	datastoremodule "datachain/x/datastore/module"
+	datastoremoduletypes "datachain/x/datastore/types"
 	metastoremodule "metachain/x/metastore/module"
 	metastoremoduletypes "metachain/x/metastore/types"
 
@@ -103,10 +105,11 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	)
 
 	// create IBC module from bottom to top of stack
+	// metastore authenticates the controller stack so it receives the acks of its chunk uploads
 	var (
 		transferStack      porttypes.IBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
 		transferStackV2    ibcapi.IBCModule    = ibctransferv2.NewIBCModule(app.TransferKeeper)
-		icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+		icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddlewareWithAuth(metastoremodule.NewICAAuthModule(app.appCodec, app.MetastoreKeeper), app.ICAControllerKeeper)
 		icaHostStack       porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
 	)
 
@@ -120,8 +123,17 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	ibcv2Router := ibcapi.NewRouter().
 		AddRoute(ibctransfertypes.PortID, transferStackV2)
 
-	metastoreIBCModule := metastoremodule.NewIBCModule(app.appCodec, app.MetastoreKeeper)
-	ibcRouter.AddRoute(metastoremoduletypes.ModuleName, metastoreIBCModule)
+	// both store ports are routed; channels and packets of the store the role disables are refused
+	var (
+		datastoreGate = storeGate{role: app.Role, store: datastoremoduletypes.ModuleName, enabled: Role.ChunkStore}
+		metastoreGate = storeGate{role: app.Role, store: metastoremoduletypes.ModuleName, enabled: Role.MetadataStore}
+	)
+	ibcRouter.
+		AddRoute(datastoremoduletypes.ModuleName, roleIBCModule{IBCModule: datastoremodule.NewIBCModule(app.appCodec, app.DatastoreKeeper), gate: datastoreGate}).
+		AddRoute(metastoremoduletypes.ModuleName, roleIBCModule{IBCModule: metastoremodule.NewIBCModule(app.appCodec, app.MetastoreKeeper), gate: metastoreGate})
+	ibcv2Router.
+		AddRoute(datastoremoduletypes.PortID, roleIBCModuleV2{IBCModule: datastoremodule.NewIBCModuleV2(app.appCodec, app.DatastoreKeeper), gate: datastoreGate}).
+		AddRoute(metastoremoduletypes.PortID, roleIBCModuleV2{IBCModule: metastoremodule.NewIBCModuleV2(app.appCodec, app.MetastoreKeeper), gate: metastoreGate})
 	// this line is used by starport scaffolding # ibc/app/module
 
 	app.IBCKeeper.SetRouter(ibcRouter)
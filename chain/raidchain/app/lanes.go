@@ -0,0 +1,296 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"chunks"
+
+	metastoremoduletypes "metachain/x/metastore/types"
+)
+
+// Lane selects the share of block space a tx competes for. Lanes are filled in order: a tx of an
+// earlier lane is proposed before the txs of the later ones, and a lane may use its share of the
+// block plus the space the lanes before it left unused.
+type Lane int
+
+const (
+	// LaneIBC holds IBC client updates, packets, acknowledgements and timeouts.
+	LaneIBC Lane = iota
+	// LaneMetastore holds metastore messages that carry no chunk data.
+	LaneMetastore
+	// LaneDefault holds everything the other lanes do not.
+	LaneDefault
+	// LaneDatastore holds datastore messages and the chunk uploads of the metastore, which turn
+	// into datastore writes on the datachains.
+	LaneDatastore
+
+	numLanes
+)
+
+var laneNames = [numLanes]string{"ibc", "metastore", "default", "datastore"}
+
+func (l Lane) String() string {
+	if l < 0 || l >= numLanes {
+		return fmt.Sprintf("Lane(%d)", int(l))
+	}
+	return laneNames[l]
+}
+
+// ibcMsgPrefix is the name prefix of the IBC core client, connection and channel messages.
+const ibcMsgPrefix = "ibc.core."
+
+// TxLane returns the lane of tx: the first lane all its messages, including those executed
+// through authz, belong to.
+func TxLane(tx sdk.Tx) Lane {
+	msgs, err := unwrapMsgs(tx.GetMsgs())
+	if err != nil || len(msgs) == 0 {
+		return LaneDefault
+	}
+
+	ibc, metastore, datastore := true, true, true
+	for _, msg := range msgs {
+		name := strings.TrimPrefix(sdk.MsgTypeURL(msg), "/")
+		isMeta := strings.HasPrefix(name, metastoreMsgPrefix)
+		isData := strings.HasPrefix(name, datastoreMsgPrefix)
+
+		ibc = ibc && strings.HasPrefix(name, ibcMsgPrefix)
+		metastore = metastore && isMeta
+		datastore = datastore && (isMeta || isData)
+	}
+
+	switch {
+	case ibc:
+		return LaneIBC
+	case metastore:
+		if n, err := chunks.ChunkBytes(msgs, metastoremoduletypes.MsgChunkBytes); err == nil && n == 0 {
+			return LaneMetastore
+		}
+		return LaneDatastore
+	case datastore:
+		return LaneDatastore
+	default:
+		return LaneDefault
+	}
+}
+
+// unwrapMsgs replaces the authz MsgExec in msgs with the messages they execute.
+func unwrapMsgs(msgs []sdk.Msg) ([]sdk.Msg, error) {
+	unwrapped := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			unwrapped = append(unwrapped, msg)
+			continue
+		}
+		inner, err := exec.GetMessages()
+		if err != nil {
+			return nil, err
+		}
+		inner, err = unwrapMsgs(inner)
+		if err != nil {
+			return nil, err
+		}
+		unwrapped = append(unwrapped, inner...)
+	}
+	return unwrapped, nil
+}
+
+// app.toml keys of the lane shares.
+const (
+	FlagIBCLaneShare       = "raidchain.lanes.ibc"
+	FlagMetastoreLaneShare = "raidchain.lanes.metastore"
+	FlagDefaultLaneShare   = "raidchain.lanes.default"
+	FlagDatastoreLaneShare = "raidchain.lanes.datastore"
+)
+
+// LaneShares are the percentages of the block bytes and gas each lane may use, indexed by lane.
+type LaneShares [numLanes]uint64
+
+// DefaultLaneShares keeps half of the block for chunk data, and leaves the rest to the control
+// traffic that uploads wait on.
+func DefaultLaneShares() LaneShares {
+	return LaneShares{LaneIBC: 20, LaneMetastore: 20, LaneDefault: 10, LaneDatastore: 50}
+}
+
+// Validate checks that the shares do not add up to more than the whole block.
+func (s LaneShares) Validate() error {
+	var total uint64
+	for _, share := range s {
+		total += share
+	}
+	if total > 100 {
+		return fmt.Errorf("lane shares add up to %d%% of the block", total)
+	}
+	return nil
+}
+
+// LaneSharesFromAppOptions reads the lane shares of the node from its app options. Shares missing
+// from app.toml keep their default.
+func LaneSharesFromAppOptions(appOpts servertypes.AppOptions) (LaneShares, error) {
+	shares := DefaultLaneShares()
+	for lane, key := range [numLanes]string{FlagIBCLaneShare, FlagMetastoreLaneShare, FlagDefaultLaneShare, FlagDatastoreLaneShare} {
+		if v := appOpts.Get(key); v != nil {
+			share, err := cast.ToUint64E(v)
+			if err != nil {
+				return LaneShares{}, fmt.Errorf("invalid %s: %w", key, err)
+			}
+			shares[lane] = share
+		}
+	}
+	return shares, shares.Validate()
+}
+
+// lanePriority orders the mempool by lane, then by the priority the fee gave the tx.
+type lanePriority struct {
+	lane     Lane
+	priority int64
+}
+
+func laneTxPriority() mempool.TxPriority[lanePriority] {
+	return mempool.TxPriority[lanePriority]{
+		GetTxPriority: func(ctx context.Context, tx sdk.Tx) lanePriority {
+			return lanePriority{lane: TxLane(tx), priority: sdk.UnwrapSDKContext(ctx).Priority()}
+		},
+		Compare: func(a, b lanePriority) int {
+			switch {
+			case a.lane < b.lane:
+				return 1
+			case a.lane > b.lane:
+				return -1
+			case a.priority > b.priority:
+				return 1
+			case a.priority < b.priority:
+				return -1
+			default:
+				return 0
+			}
+		},
+		MinValue: lanePriority{lane: numLanes, priority: math.MinInt64},
+	}
+}
+
+// NewLaneMempool returns a mempool handing out txs lane by lane, in the priority order of their
+// fees within a lane, and in nonce order per sender. maxTx bounds its size as the SDK mempool
+// does: 0 for no bound, and a negative value to keep no txs at all.
+func NewLaneMempool(maxTx int) *mempool.PriorityNonceMempool[lanePriority] {
+	return mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[lanePriority]{
+		TxPriority:      laneTxPriority(),
+		MaxTx:           maxTx,
+		SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+	})
+}
+
+// laneTxSelector selects the txs of a proposal within the shares of their lanes and the chunk
+// budget of the block. It relies on the txs being offered lane by lane, so the space an earlier
+// lane leaves unused is known by the time a later lane fills up. A tx that does not fit is
+// skipped and stays in the mempool for a later block.
+type laneTxSelector struct {
+	shares LaneShares
+	chunks chunks.Stores
+
+	laneBytes  [numLanes]uint64
+	laneGas    [numLanes]uint64
+	totalBytes uint64
+	totalGas   uint64
+	chunkBytes uint64
+	selected   [][]byte
+}
+
+// NewLaneTxSelector returns a baseapp.TxSelector enforcing shares and the block budget of the
+// chunk data of stores.
+func NewLaneTxSelector(shares LaneShares, stores chunks.Stores) baseapp.TxSelector {
+	return &laneTxSelector{shares: shares, chunks: stores}
+}
+
+func (ts *laneTxSelector) SelectedTxs(_ context.Context) [][]byte {
+	txs := make([][]byte, len(ts.selected))
+	copy(txs, ts.selected)
+	return txs
+}
+
+func (ts *laneTxSelector) Clear() {
+	*ts = laneTxSelector{shares: ts.shares, chunks: ts.chunks}
+}
+
+func (ts *laneTxSelector) SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	lane := TxLane(memTx)
+	txSize := uint64(len(txBz))
+	var txGas uint64
+	if feeTx, ok := memTx.(sdk.FeeTx); ok {
+		txGas = feeTx.GetGas()
+	}
+
+	fits := ts.totalBytes+txSize <= maxTxBytes &&
+		ts.laneBytes[lane]+txSize <= ts.limit(lane, maxTxBytes, &ts.laneBytes)
+	if maxBlockGas > 0 {
+		fits = fits && ts.totalGas+txGas <= maxBlockGas &&
+			ts.laneGas[lane]+txGas <= ts.limit(lane, maxBlockGas, &ts.laneGas)
+	}
+	var usage chunks.Usage
+	if fits {
+		var err error
+		usage, err = ts.chunks.TxUsage(ctx, memTx.GetMsgs())
+		fits = err == nil && usage.Fits(ts.chunkBytes)
+	}
+	if fits {
+		ts.laneBytes[lane] += txSize
+		ts.laneGas[lane] += txGas
+		ts.totalBytes += txSize
+		ts.totalGas += txGas
+		ts.chunkBytes += usage.Bytes
+		ts.selected = append(ts.selected, txBz)
+	}
+
+	// stop once the block is full
+	return ts.totalBytes >= maxTxBytes || (maxBlockGas > 0 && ts.totalGas >= maxBlockGas)
+}
+
+// limit returns how much of max lane may use: its share plus what the lanes before it left of
+// theirs.
+func (ts *laneTxSelector) limit(lane Lane, max uint64, used *[numLanes]uint64) uint64 {
+	var shared, taken uint64
+	for l := Lane(0); l <= lane; l++ {
+		shared += max/100*ts.shares[l] + max%100*ts.shares[l]/100
+		if l < lane {
+			taken += used[l]
+		}
+	}
+	if taken >= shared {
+		return 0
+	}
+	return shared - taken
+}
+
+// setLanes replaces the mempool and the proposal tx selection of the app with lane aware ones,
+// sized by the mempool.max-txs of appOpts.
+func (app *App) setLanes(appOpts servertypes.AppOptions) error {
+	shares, err := LaneSharesFromAppOptions(appOpts)
+	if err != nil {
+		return err
+	}
+
+	var mp mempool.Mempool = mempool.NoOpMempool{}
+	if maxTx := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs)); maxTx >= 0 {
+		mp = NewLaneMempool(maxTx)
+	}
+	app.SetMempool(mp)
+
+	handler := baseapp.NewDefaultProposalHandler(mp, app)
+	handler.SetTxSelector(NewLaneTxSelector(shares, app.chunkBudget.Stores))
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(handler.ProcessProposalHandler())
+
+	return nil
+}
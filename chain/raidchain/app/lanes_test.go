@@ -0,0 +1,147 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	datastoremoduletypes "datachain/x/datastore/types"
+	metastoremoduletypes "metachain/x/metastore/types"
+)
+
+// laneTx is a tx carrying msgs.
+type laneTx struct {
+	sdk.Tx
+	msgs []sdk.Msg
+}
+
+func (tx laneTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func TestTxLane(t *testing.T) {
+	exec := authz.NewMsgExec(sdk.AccAddress("controller"), []sdk.Msg{&datastoremoduletypes.MsgCreateStoredChunk{Data: []byte("a")}})
+
+	for name, tt := range map[string]struct {
+		msgs []sdk.Msg
+		want Lane
+	}{
+		"relay":             {[]sdk.Msg{&clienttypes.MsgUpdateClient{}, &channeltypes.MsgAcknowledgement{}}, LaneIBC},
+		"metadata":          {[]sdk.Msg{&metastoremoduletypes.MsgSendMetadata{}}, LaneMetastore},
+		"upload":            {[]sdk.Msg{&metastoremoduletypes.MsgUploadChunks{Chunks: []metastoremoduletypes.ChunkUpload{{Data: []byte("a")}}}}, LaneDatastore},
+		"chunk":             {[]sdk.Msg{&datastoremoduletypes.MsgCreateStoredChunk{}}, LaneDatastore},
+		"delegated chunk":   {[]sdk.Msg{&exec}, LaneDatastore},
+		"chunk and meta":    {[]sdk.Msg{&datastoremoduletypes.MsgDeleteStoredChunk{}, &metastoremoduletypes.MsgSendMetadata{}}, LaneDatastore},
+		"relay and payment": {[]sdk.Msg{&clienttypes.MsgUpdateClient{}, &banktypes.MsgSend{}}, LaneDefault},
+		"payment":           {[]sdk.Msg{&banktypes.MsgSend{}}, LaneDefault},
+	} {
+		require.Equal(t, tt.want, TxLane(laneTx{msgs: tt.msgs}), name)
+	}
+}
+
+func TestLaneSharesFromAppOptions(t *testing.T) {
+	shares, err := LaneSharesFromAppOptions(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultLaneShares(), shares)
+
+	shares, err = LaneSharesFromAppOptions(simtestutil.AppOptionsMap{FlagIBCLaneShare: 30, FlagDatastoreLaneShare: "40"})
+	require.NoError(t, err)
+	require.Equal(t, LaneShares{LaneIBC: 30, LaneMetastore: 20, LaneDefault: 10, LaneDatastore: 40}, shares)
+
+	_, err = LaneSharesFromAppOptions(simtestutil.AppOptionsMap{FlagDatastoreLaneShare: 60})
+	require.ErrorContains(t, err, "110%")
+}
+
+func TestLaneProposals(t *testing.T) {
+	const (
+		uploader = iota
+		user
+		payer
+		user2
+		relayerA
+		relayerB
+		relayerC
+		bulkUploader
+		lateUploader
+		accounts
+	)
+	c := newProposalTestChain(t, RoleAll, accounts, maxBlockChunkBytes(t, 120))
+	data := func(n int) []byte { return bytes.Repeat([]byte("d"), n) }
+	chunk := func(i int, index string, n int) sdk.Msg {
+		return &datastoremoduletypes.MsgCreateStoredChunk{Creator: c.addr(i).String(), Index: index, Data: data(n)}
+	}
+	relay := func(i int) sdk.Msg {
+		return &channeltypes.MsgRecvPacket{
+			Packet:          channeltypes.NewPacket([]byte("packet"), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.ZeroHeight(), 1),
+			ProofCommitment: []byte("proof"),
+			Signer:          c.addr(i).String(),
+		}
+	}
+	maxGas := uint64(simtestutil.DefaultConsensusParams.Block.MaxGas)
+
+	// Every tx but the bulk upload takes size bytes, so the lane shares of a 10*size block come
+	// out in whole txs: 2 for the IBC and metastore lanes, 1 for the default lane, 5 for the
+	// datastore lane, which also gets what the lanes before it leave.
+	const size = 400
+	padded := func(i int, fee int64, msgs ...sdk.Msg) []byte {
+		return c.signPaddedTx(i, size, proposalTestTx{fee: fee * proposalTestGas, gas: proposalTestGas, msgs: msgs})
+	}
+	var (
+		// an upload in progress, queued ahead of the control traffic it waits on
+		chunk0 = padded(uploader, 3, chunk(uploader, "chunk-0", 50))
+		chunk1 = padded(uploader, 3, chunk(uploader, "chunk-1", 50))
+		chunk2 = padded(uploader, 3, chunk(uploader, "chunk-2", 50))
+		upload = padded(user, 1, &metastoremoduletypes.MsgUploadChunks{
+			Creator: c.addr(user).String(),
+			Url:     "example.com/upload",
+			Chunks:  []metastoremoduletypes.ChunkUpload{{ConnectionId: "connection-0", Index: "upload", Data: data(50)}},
+		})
+		payment  = padded(payer, 1, &banktypes.MsgSend{FromAddress: c.addr(payer).String(), ToAddress: c.addr(user).String(), Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))})
+		metadata = padded(user2, 1, &metastoremoduletypes.MsgSendMetadata{Creator: c.addr(user2).String(), Url: "example.com/metadata", Port: "metastore", ChannelID: "channel-0", TimeoutTimestamp: 1})
+		relayA   = padded(relayerA, 3, relay(relayerA))
+		relayB   = padded(relayerB, 2, relay(relayerB))
+		relayC   = padded(relayerC, 1, relay(relayerC))
+		// the highest paying upload takes all of the block gas, so it only fits a block of its own
+		bulk = c.sign(bulkUploader, proposalTestTx{fee: 4 * int64(maxGas), gas: maxGas, msgs: []sdk.Msg{chunk(bulkUploader, "bulk", 110)}})
+		// an upload paying more still, which expires before a proposal picks it
+		late = c.sign(lateUploader, proposalTestTx{fee: 5 * proposalTestGas, gas: proposalTestGas, timeoutHeight: uint64(c.height + 1), msgs: []sdk.Msg{chunk(lateUploader, "late", 110)}})
+	)
+	c.checkTx(chunk0, chunk1, chunk2, upload, payment, metadata, relayA, relayB, relayC, bulk, late)
+	c.finalize()
+
+	names := map[string]string{
+		string(chunk0): "chunk-0", string(chunk1): "chunk-1", string(chunk2): "chunk-2", string(upload): "upload",
+		string(payment): "payment", string(metadata): "metadata",
+		string(relayA): "relay-a", string(relayB): "relay-b", string(relayC): "relay-c",
+		string(bulk): "bulk", string(late): "late",
+	}
+	mempoolTxs := func() int { return c.app.Mempool().CountTx() }
+	propose := func(want ...string) {
+		t.Helper()
+		proposal := c.propose(10 * size)
+		got := make([]string, 0, len(proposal))
+		for _, txBytes := range proposal {
+			got = append(got, names[string(txBytes)])
+		}
+		require.Equal(t, want, got)
+		c.finalize(proposal...)
+	}
+
+	// The IBC lane fits two relays, then the metastore and default lanes take their txs. The
+	// expired upload fails the ante handler and leaves the mempool without taking any of the chunk
+	// budget, and the bulk upload is skipped for the gas of the lanes before it. The chunks that
+	// follow fill the chunk budget of the block, the rest of the datastore lane wait behind them.
+	propose("relay-a", "relay-b", "metadata", "payment", "chunk-0", "chunk-1")
+	require.Equal(t, 4, mempoolTxs())
+	// once the control traffic is served, uploads fill the space the other lanes leave
+	propose("relay-c", "chunk-2", "upload")
+	propose("bulk")
+	require.Zero(t, mempoolTxs())
+	require.Empty(t, c.propose(10*size))
+}
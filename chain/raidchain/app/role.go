@@ -0,0 +1,209 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	datastoremoduletypes "datachain/x/datastore/types"
+	metastoremoduletypes "metachain/x/metastore/types"
+)
+
+// Role selects which raidchain stores a chain serves. It is held in state, as the enabled param
+// of the datastore and metastore modules, so every node of a chain agrees on it; it is set in
+// the genesis of the chain and can only change through governance.
+type Role string
+
+const (
+	// RoleDatachain enables the datastore module, which holds chunk data.
+	RoleDatachain Role = "datachain"
+	// RoleMetachain enables the metastore module, which holds the metadata of the chunks.
+	RoleMetachain Role = "metachain"
+	// RoleAll enables both stores on one chain.
+	RoleAll Role = "all"
+)
+
+// ParseRole parses a role name. There is no default role, so that a chain set up without one
+// fails instead of serving other stores than intended.
+func ParseRole(s string) (Role, error) {
+	switch role := Role(strings.ToLower(strings.TrimSpace(s))); role {
+	case "":
+		return "", fmt.Errorf("no raidchain role set, expected %s, %s or %s", RoleDatachain, RoleMetachain, RoleAll)
+	case RoleDatachain, RoleMetachain, RoleAll:
+		return role, nil
+	default:
+		return "", fmt.Errorf("unknown raidchain role %q, expected %s, %s or %s", s, RoleDatachain, RoleMetachain, RoleAll)
+	}
+}
+
+// roleOf returns the role enabling the given stores.
+func roleOf(chunkStore, metadataStore bool) (Role, error) {
+	switch {
+	case chunkStore && metadataStore:
+		return RoleAll, nil
+	case chunkStore:
+		return RoleDatachain, nil
+	case metadataStore:
+		return RoleMetachain, nil
+	default:
+		return "", fmt.Errorf("the chain serves neither the %s nor the %s store", datastoremoduletypes.ModuleName, metastoremoduletypes.ModuleName)
+	}
+}
+
+// ChunkStore reports whether the role enables the datastore module.
+func (r Role) ChunkStore() bool {
+	return r == RoleDatachain || r == RoleAll
+}
+
+// MetadataStore reports whether the role enables the metastore module.
+func (r Role) MetadataStore() bool {
+	return r == RoleMetachain || r == RoleAll
+}
+
+// Role returns the role of the chain, read from the params of its store modules.
+func (app *App) Role(ctx context.Context) (Role, error) {
+	datastoreParams, err := app.DatastoreKeeper.Params.Get(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the %s params: %w", datastoremoduletypes.ModuleName, err)
+	}
+	metastoreParams, err := app.MetastoreKeeper.Params.Get(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the %s params: %w", metastoremoduletypes.ModuleName, err)
+	}
+	return roleOf(datastoreParams.Enabled, metastoreParams.Enabled)
+}
+
+// SetGenesisRole sets the role of the chain in the datastore and metastore sections of genesis.
+func SetGenesisRole(cdc codec.JSONCodec, genesis map[string]json.RawMessage, role Role) error {
+	var datastoreGenesis datastoremoduletypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesis[datastoremoduletypes.ModuleName], &datastoreGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", datastoremoduletypes.ModuleName, err)
+	}
+	var metastoreGenesis metastoremoduletypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesis[metastoremoduletypes.ModuleName], &metastoreGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", metastoremoduletypes.ModuleName, err)
+	}
+
+	datastoreGenesis.Params.Enabled = role.ChunkStore()
+	metastoreGenesis.Params.Enabled = role.MetadataStore()
+
+	var err error
+	if genesis[datastoremoduletypes.ModuleName], err = cdc.MarshalJSON(&datastoreGenesis); err != nil {
+		return err
+	}
+	genesis[metastoremoduletypes.ModuleName], err = cdc.MarshalJSON(&metastoreGenesis)
+	return err
+}
+
+var (
+	datastoreMsgPrefix = msgPackagePrefix(&datastoremoduletypes.MsgCreateStoredChunk{})
+	metastoreMsgPrefix = msgPackagePrefix(&metastoremoduletypes.MsgCreateStoredMeta{})
+)
+
+// msgPackagePrefix returns the name prefix shared by the messages of msg's proto package.
+func msgPackagePrefix(msg sdk.Msg) string {
+	name := strings.TrimPrefix(sdk.MsgTypeURL(msg), "/")
+	return name[:strings.LastIndex(name, ".")+1]
+}
+
+// roleCircuitBreaker rejects the messages of the stores the role disables, then defers to the
+// circuit module for everything else. It applies to txs and to messages executed by interchain
+// accounts alike.
+type roleCircuitBreaker struct {
+	role func(context.Context) (Role, error)
+	next baseapp.CircuitBreaker
+}
+
+func (cb roleCircuitBreaker) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	// msg handlers pass a type URL, hybrid handlers the bare message name
+	name := strings.TrimPrefix(typeURL, "/")
+	chunkMsg, metadataMsg := strings.HasPrefix(name, datastoreMsgPrefix), strings.HasPrefix(name, metastoreMsgPrefix)
+	if chunkMsg || metadataMsg {
+		role, err := cb.role(ctx)
+		if err != nil {
+			return false, err
+		}
+		if chunkMsg && !role.ChunkStore() || metadataMsg && !role.MetadataStore() {
+			return false, nil
+		}
+	}
+
+	return cb.next.IsAllowed(ctx, typeURL)
+}
+
+// storeGate fails unless the role of the chain enables the store of an IBC module.
+type storeGate struct {
+	role    func(context.Context) (Role, error)
+	store   string
+	enabled func(Role) bool
+}
+
+func (g storeGate) check(ctx context.Context) error {
+	role, err := g.role(ctx)
+	if err != nil {
+		return err
+	}
+	if !g.enabled(role) {
+		return fmt.Errorf("the %s store is disabled on this %s chain", g.store, role)
+	}
+	return nil
+}
+
+// roleIBCModule refuses the channel handshakes and packets of a store the role disables.
+type roleIBCModule struct {
+	porttypes.IBCModule
+	gate storeGate
+}
+
+func (m roleIBCModule) OnChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID, channelID string, counterparty channeltypes.Counterparty, version string) (string, error) {
+	if err := m.gate.check(ctx); err != nil {
+		return "", err
+	}
+	return m.IBCModule.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, counterparty, version)
+}
+
+func (m roleIBCModule) OnChanOpenTry(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID, channelID string, counterparty channeltypes.Counterparty, counterpartyVersion string) (string, error) {
+	if err := m.gate.check(ctx); err != nil {
+		return "", err
+	}
+	return m.IBCModule.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+}
+
+func (m roleIBCModule) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	if err := m.gate.check(ctx); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return m.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// roleIBCModuleV2 refuses the IBC v2 packets of a store the role disables, which have no
+// handshake to refuse.
+type roleIBCModuleV2 struct {
+	ibcapi.IBCModule
+	gate storeGate
+}
+
+func (m roleIBCModuleV2) OnSendPacket(ctx sdk.Context, sourceClient, destinationClient string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	if err := m.gate.check(ctx); err != nil {
+		return err
+	}
+	return m.IBCModule.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+func (m roleIBCModuleV2) OnRecvPacket(ctx sdk.Context, sourceClient, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	if err := m.gate.check(ctx); err != nil {
+		ctx.Logger().Error("refused IBC v2 packet", "port", payload.DestinationPort, "sequence", sequence, "err", err)
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+	return m.IBCModule.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	datastoremoduletypes "datachain/x/datastore/types"
+	metastoremoduletypes "metachain/x/metastore/types"
+)
+
+type allowAll struct{}
+
+func (allowAll) IsAllowed(context.Context, string) (bool, error) { return true, nil }
+
+func TestParseRole(t *testing.T) {
+	for in, want := range map[string]Role{
+		"all":        RoleAll,
+		"datachain":  RoleDatachain,
+		" Metachain": RoleMetachain,
+	} {
+		got, err := ParseRole(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got, in)
+	}
+
+	_, err := ParseRole("chunkchain")
+	require.Error(t, err)
+	// nodes never fall back to a role of their own
+	_, err = ParseRole(" ")
+	require.ErrorContains(t, err, "no raidchain role set")
+}
+
+func TestRoleCircuitBreaker(t *testing.T) {
+	chunkMsg := sdk.MsgTypeURL(&datastoremoduletypes.MsgCreateStoredChunk{})
+	metaMsg := sdk.MsgTypeURL(&metastoremoduletypes.MsgCreateStoredMeta{})
+	bankMsg := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	tests := []struct {
+		role    Role
+		allowed map[string]bool
+	}{
+		{RoleAll, map[string]bool{chunkMsg: true, metaMsg: true, bankMsg: true}},
+		{RoleDatachain, map[string]bool{chunkMsg: true, metaMsg: false, bankMsg: true}},
+		{RoleMetachain, map[string]bool{chunkMsg: false, metaMsg: true, bankMsg: true}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			role := func(context.Context) (Role, error) { return tt.role, nil }
+			cb := roleCircuitBreaker{role: role, next: allowAll{}}
+			for typeURL, want := range tt.allowed {
+				for _, name := range []string{typeURL, typeURL[1:]} {
+					got, err := cb.IsAllowed(context.Background(), name)
+					require.NoError(t, err)
+					require.Equal(t, want, got, name)
+				}
+			}
+		})
+	}
+}
+
+func TestGenesisRole(t *testing.T) {
+	for _, role := range []Role{RoleDatachain, RoleMetachain, RoleAll} {
+		t.Run(string(role), func(t *testing.T) {
+			app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID("raidchain-role"))
+			initTestChain(t, app, role)
+			ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 1})
+
+			got, err := app.Role(ctx)
+			require.NoError(t, err)
+			require.Equal(t, role, got)
+
+			datastoreGate := storeGate{role: app.Role, store: datastoremoduletypes.ModuleName, enabled: Role.ChunkStore}
+			metastoreGate := storeGate{role: app.Role, store: metastoremoduletypes.ModuleName, enabled: Role.MetadataStore}
+			require.Equal(t, role.ChunkStore(), datastoreGate.check(ctx) == nil)
+			require.Equal(t, role.MetadataStore(), metastoreGate.check(ctx) == nil)
+		})
+	}
+}
+
+func TestRoleWithoutStores(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID("raidchain-role"))
+	initTestChain(t, app, RoleAll)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 1})
+
+	params, err := app.MetastoreKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.Enabled = false
+	require.NoError(t, app.MetastoreKeeper.Params.Set(ctx, params))
+	role, err := app.Role(ctx)
+	require.NoError(t, err)
+	require.Equal(t, RoleDatachain, role)
+
+	datastoreParams, err := app.DatastoreKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	datastoreParams.Enabled = false
+	require.NoError(t, app.DatastoreKeeper.Params.Set(ctx, datastoreParams))
+	_, err = app.Role(ctx)
+	require.ErrorContains(t, err, "neither")
+
+	// store messages fail instead of running on a chain without a role, others are unaffected
+	cb := roleCircuitBreaker{role: app.Role, next: allowAll{}}
+	_, err = cb.IsAllowed(ctx, sdk.MsgTypeURL(&datastoremoduletypes.MsgCreateStoredChunk{}))
+	require.Error(t, err)
+	allowed, err := cb.IsAllowed(ctx, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
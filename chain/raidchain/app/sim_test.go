@@ -33,7 +33,7 @@ import (
 )
 
 const (
-	SimAppChainID = "metachain-simapp"
+	SimAppChainID = "raidchain-simapp"
 )
 
 var FlagEnableStreamingValue bool
@@ -132,7 +132,7 @@ func TestFullAppSimulation(t *testing.T) {
 	if !simcli.FlagSigverifyTxValue {
 		app.SetNotSigverifyTx()
 	}
-	require.Equal(t, "metachain", app.Name())
+	require.Equal(t, "raidchain", app.Name())
 
 	// run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
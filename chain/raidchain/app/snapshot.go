@@ -0,0 +1,34 @@
+package app
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// OfferSnapshot records the number of chunks of an accepted snapshot, so ApplySnapshotChunk
+// knows which chunk completes its restore.
+func (app *App) OfferSnapshot(req *abci.RequestOfferSnapshot) (*abci.ResponseOfferSnapshot, error) {
+	res, err := app.BaseApp.OfferSnapshot(req)
+	if err == nil && res.Result == abci.ResponseOfferSnapshot_ACCEPT {
+		app.restoreChunks = req.Snapshot.Chunks
+	}
+	return res, err
+}
+
+// ApplySnapshotChunk restores a snapshot chunk. State sync applies the chunks in order, so the
+// last one completes the restore. The datastore extension has compared its segments with the
+// restored chunks by then; the chunk store as a whole, storage usage included, is checked on top
+// of that before the node starts on it, and the restore aborted if it does not hold together.
+func (app *App) ApplySnapshotChunk(req *abci.RequestApplySnapshotChunk) (*abci.ResponseApplySnapshotChunk, error) {
+	res, err := app.BaseApp.ApplySnapshotChunk(req)
+	if err != nil || res.Result != abci.ResponseApplySnapshotChunk_ACCEPT || req.Index+1 != app.restoreChunks {
+		return res, err
+	}
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight()})
+	if err := app.DatastoreKeeper.VerifyStoredChunks(ctx); err != nil {
+		app.Logger().Error("restored snapshot holds an inconsistent chunk store", "err", err)
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}, nil
+	}
+	return res, nil
+}
@@ -0,0 +1,252 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	datastoretypes "datachain/x/datastore/types"
+)
+
+const snapshotTestChainID = "raidchain-snapshot"
+
+// newSnapshotApp returns a datachain app taking snapshots into a store of its own.
+func newSnapshotApp(t *testing.T) *App {
+	t.Helper()
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	return New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{},
+		baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(0, 1)),
+		baseapp.SetChainID(snapshotTestChainID),
+	)
+}
+
+func TestDatastoreSnapshotRestore(t *testing.T) {
+	source := newSnapshotApp(t)
+
+	// chunks larger than a snapshot chunk, so they span several of them
+	var chunks []datastoretypes.StoredChunk
+	for i, b := range []byte("abcd") {
+		chunks = append(chunks, datastoretypes.StoredChunk{
+			Index:   string(b),
+			Data:    bytes.Repeat([]byte{b}, 3<<20),
+			Creator: sdk.AccAddress([]byte{byte(i)}).String(),
+		})
+	}
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	senderKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderKey.PubKey().Address().Bytes(), senderKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000_000_000))),
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(source.AppCodec(), source.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	datastoreGenesis := datastoretypes.DefaultGenesis()
+	datastoreGenesis.StoredChunkMap = chunks
+	genesisState[datastoretypes.ModuleName] = source.AppCodec().MustMarshalJSON(datastoreGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	_, err = source.InitChain(&abci.RequestInitChain{
+		ChainId:         snapshotTestChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = source.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	_, err = source.Commit()
+	require.NoError(t, err)
+
+	snapshot, chunkData := createSnapshot(t, source, 1)
+
+	// the extension writes the chunks in several segments, each resuming after the one before
+	var segments []datastoretypes.ChunkSnapshotSegment
+	items := snapshotItems(t, chunkData)
+	for _, item := range datastoreExtension(t, items) {
+		var segment datastoretypes.ChunkSnapshotSegment
+		require.NoError(t, segment.Unmarshal(item.GetExtensionPayload().Payload))
+		segments = append(segments, segment)
+	}
+	require.Len(t, segments, 2)
+	require.Equal(t, "b", segments[1].After)
+
+	target := newSnapshotApp(t)
+	require.Equal(t, abci.ResponseApplySnapshotChunk_ACCEPT, restoreSnapshot(t, target, snapshot, chunkData, source.LastCommitID().Hash))
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+
+	ctx := target.NewUncachedContext(false, cmtproto.Header{Height: 1})
+	for _, chunk := range chunks {
+		got, err := target.DatastoreKeeper.StoredChunk.Get(ctx, chunk.Index)
+		require.NoError(t, err)
+		require.Equal(t, chunk, got)
+	}
+
+	// a segment that does not match the restored store aborts the restore
+	segments[1].Entries[0].Checksum = make([]byte, 32)
+	tampered, err := segments[1].Marshal()
+	require.NoError(t, err)
+	datastoreExtension(t, items)[1].GetExtensionPayload().Payload = tampered
+	snapshot, chunkData = writeSnapshot(t, snapshot, items)
+	require.Equal(t, abci.ResponseApplySnapshotChunk_ABORT, restoreSnapshot(t, newSnapshotApp(t), snapshot, chunkData, source.LastCommitID().Hash))
+
+	// so does a chunk store that does not hold together, once the last chunk is applied
+	ctx = source.NewUncachedContext(false, cmtproto.Header{Height: 1})
+	require.NoError(t, source.DatastoreKeeper.StorageUsage.Set(ctx, datastoretypes.StorageUsage{Chunks: 1}))
+	_, err = source.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	_, err = source.Commit()
+	require.NoError(t, err)
+
+	snapshot, chunkData = createSnapshot(t, source, 2)
+	require.Equal(t, abci.ResponseApplySnapshotChunk_ABORT, restoreSnapshot(t, newSnapshotApp(t), snapshot, chunkData, source.LastCommitID().Hash))
+}
+
+// createSnapshot takes the snapshot of source at height and returns it with its chunks.
+func createSnapshot(t *testing.T, source *App, height uint64) (abci.Snapshot, [][]byte) {
+	t.Helper()
+
+	snapshot, err := source.SnapshotManager().Create(height)
+	require.NoError(t, err)
+	abciSnapshot, err := snapshot.ToABCI()
+	require.NoError(t, err)
+
+	var chunkData [][]byte
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := source.LoadSnapshotChunk(&abci.RequestLoadSnapshotChunk{Height: snapshot.Height, Format: snapshot.Format, Chunk: i})
+		require.NoError(t, err)
+		chunkData = append(chunkData, chunk.Chunk)
+	}
+	return abciSnapshot, chunkData
+}
+
+// snapshotItems decodes the items of the snapshot stream split into chunkData.
+func snapshotItems(t *testing.T, chunkData [][]byte) []*snapshottypes.SnapshotItem {
+	t.Helper()
+
+	ch := make(chan io.ReadCloser, len(chunkData))
+	for _, chunk := range chunkData {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	reader, err := snapshots.NewStreamReader(ch)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var items []*snapshottypes.SnapshotItem
+	for {
+		item := &snapshottypes.SnapshotItem{}
+		err := reader.ReadMsg(item)
+		if errors.Is(err, io.EOF) {
+			return items
+		}
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+}
+
+// datastoreExtension returns the payload items of the datastore extension among items.
+func datastoreExtension(t *testing.T, items []*snapshottypes.SnapshotItem) []*snapshottypes.SnapshotItem {
+	t.Helper()
+
+	var (
+		payloads []*snapshottypes.SnapshotItem
+		inside   bool
+	)
+	for _, item := range items {
+		if extension := item.GetExtension(); extension != nil {
+			inside = extension.Name == datastoretypes.ModuleName
+			continue
+		}
+		if inside && item.GetExtensionPayload() != nil {
+			payloads = append(payloads, item)
+		}
+	}
+	require.NotEmpty(t, payloads)
+	return payloads
+}
+
+// writeSnapshot encodes items into a snapshot stream and returns the snapshot of height of
+// snapshot holding it, with its chunks.
+func writeSnapshot(t *testing.T, snapshot abci.Snapshot, items []*snapshottypes.SnapshotItem) (abci.Snapshot, [][]byte) {
+	t.Helper()
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		writer := snapshots.NewStreamWriter(ch)
+		for _, item := range items {
+			if err := writer.WriteMsg(item); err != nil {
+				writer.CloseWithError(err)
+				return
+			}
+		}
+		_ = writer.Close()
+	}()
+
+	var (
+		chunkData [][]byte
+		metadata  snapshottypes.Metadata
+	)
+	for chunk := range ch {
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		hash := sha256.Sum256(bz)
+		chunkData = append(chunkData, bz)
+		metadata.ChunkHashes = append(metadata.ChunkHashes, hash[:])
+	}
+	metadataBz, err := metadata.Marshal()
+	require.NoError(t, err)
+
+	snapshot.Chunks = uint32(len(chunkData))
+	snapshot.Metadata = metadataBz
+	return snapshot, chunkData
+}
+
+// restoreSnapshot offers snapshot to target and applies its chunks in order. It returns the
+// result of the last chunk applied: the restore stops at the first chunk not accepted.
+func restoreSnapshot(t *testing.T, target *App, snapshot abci.Snapshot, chunkData [][]byte, appHash []byte) abci.ResponseApplySnapshotChunk_Result {
+	t.Helper()
+
+	offer, err := target.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: &snapshot, AppHash: appHash})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, offer.Result)
+
+	var result abci.ResponseApplySnapshotChunk_Result
+	for i, chunk := range chunkData {
+		applied, err := target.ApplySnapshotChunk(&abci.RequestApplySnapshotChunk{Index: uint32(i), Chunk: chunk})
+		require.NoError(t, err)
+		if result = applied.Result; result != abci.ResponseApplySnapshotChunk_ACCEPT {
+			break
+		}
+	}
+	return result
+}
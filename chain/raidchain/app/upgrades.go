@@ -0,0 +1,71 @@
+package app
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+
+	"raidchain/app/upgrades"
+)
+
+// setUpgradeHandlers registers the handlers of upgrades.Upgrades and, when the node restarts at
+// the height of one of them, the store loader adding its stores.
+func (app *App) setUpgradeHandlers() error {
+	for _, upgrade := range upgrades.Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(upgrade.Name, upgrade.CreateUpgradeHandler(app.ModuleManager, app.Configurator(), upgrades.Keepers{
+			DatastoreKeeper: app.DatastoreKeeper,
+			MetastoreKeeper: app.MetastoreKeeper,
+		}))
+	}
+
+	info, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return fmt.Errorf("failed to read upgrade info from disk: %w", err)
+	}
+	if info.Name == "" || app.UpgradeKeeper.IsSkipHeight(info.Height) {
+		return nil
+	}
+	for _, upgrade := range upgrades.Upgrades {
+		if upgrade.Name == info.Name {
+			app.SetStoreLoader(upgradeStoreLoader(info.Height, upgrade.StoreUpgrades))
+		}
+	}
+	return nil
+}
+
+// upgradeStoreLoader returns the store loader of an upgrade at height. Stores it adds that the
+// last commit already holds are dropped from storeUpgrades, as adding them again would reset them.
+func upgradeStoreLoader(height int64, storeUpgrades storetypes.StoreUpgrades) baseapp.StoreLoader {
+	return func(ms storetypes.CommitMultiStore) error {
+		version := ms.LastCommitID().Version
+		if height != version+1 || len(storeUpgrades.Added) == 0 {
+			return upgradetypes.UpgradeStoreLoader(height, &storeUpgrades)(ms)
+		}
+
+		committer, ok := ms.(interface {
+			GetCommitInfo(int64) (*storetypes.CommitInfo, error)
+		})
+		if !ok {
+			return fmt.Errorf("cannot read the stores of version %d from %T", version, ms)
+		}
+		info, err := committer.GetCommitInfo(version)
+		if err != nil {
+			return fmt.Errorf("failed to read the stores of version %d: %w", version, err)
+		}
+		committed := make(map[string]bool, len(info.StoreInfos))
+		for _, store := range info.StoreInfos {
+			committed[store.Name] = true
+		}
+
+		upgrade := storeUpgrades
+		upgrade.Added = nil
+		for _, name := range storeUpgrades.Added {
+			if !committed[name] {
+				upgrade.Added = append(upgrade.Added, name)
+			}
+		}
+		return upgradetypes.UpgradeStoreLoader(height, &upgrade)(ms)
+	}
+}
@@ -0,0 +1,89 @@
+// Package upgrades holds the software upgrades of the chain. Each is registered with the upgrade
+// module under its name, which governance software upgrade proposals refer to.
+package upgrades
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	datastoremodulekeeper "datachain/x/datastore/keeper"
+	datastoremoduletypes "datachain/x/datastore/types"
+	metastoremodulekeeper "metachain/x/metastore/keeper"
+	metastoremoduletypes "metachain/x/metastore/types"
+)
+
+// Upgrade is a named software upgrade: the handler run at the upgrade height, and the stores the
+// new binary adds, renames or deletes. Added stores a chain already holds are left as they are,
+// so one upgrade can bring chains that held different stores to the same layout.
+type Upgrade struct {
+	Name                 string
+	CreateUpgradeHandler func(*module.Manager, module.Configurator, Keepers) upgradetypes.UpgradeHandler
+	StoreUpgrades        storetypes.StoreUpgrades
+}
+
+// Keepers are the keepers upgrade handlers change state through besides module migrations.
+type Keepers struct {
+	DatastoreKeeper datastoremodulekeeper.Keeper
+	MetastoreKeeper metastoremodulekeeper.Keeper
+}
+
+// Upgrades are the upgrades the binary can run, oldest first.
+var Upgrades = []Upgrade{V2}
+
+// V2 migrates the datastore and metastore modules to consensus version 2. It is also the first
+// release of raidchaind, which mounts both stores: a v1 datachain gains the metastore store and
+// a v1 metachain the datastore store, initialized from their default genesis but disabled, so
+// the chain keeps serving only the store it held.
+var V2 = Upgrade{
+	Name:                 "v2",
+	CreateUpgradeHandler: createV2Handler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{datastoremoduletypes.StoreKey, metastoremoduletypes.StoreKey},
+	},
+}
+
+func createV2Handler(mm *module.Manager, cfg module.Configurator, keepers Keepers) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		_, chunkStore := fromVM[datastoremoduletypes.ModuleName]
+		_, metadataStore := fromVM[metastoremoduletypes.ModuleName]
+
+		toVM, err := mm.RunMigrations(ctx, cfg, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		if !chunkStore {
+			params, err := keepers.DatastoreKeeper.Params.Get(ctx)
+			if err != nil {
+				return nil, err
+			}
+			params.Enabled = false
+			if err := keepers.DatastoreKeeper.Params.Set(ctx, params); err != nil {
+				return nil, err
+			}
+		}
+		if !metadataStore {
+			params, err := keepers.MetastoreKeeper.Params.Get(ctx)
+			if err != nil {
+				return nil, err
+			}
+			params.Enabled = false
+			if err := keepers.MetastoreKeeper.Params.Set(ctx, params); err != nil {
+				return nil, err
+			}
+		}
+		return toVM, nil
+	}
+}
+
+// RunMigrations returns an upgrade handler running the migrations of every module whose
+// consensus version changed.
+func RunMigrations(mm *module.Manager, cfg module.Configurator, _ Keepers) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, cfg, fromVM)
+	}
+}
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	datastoremoduletypes "datachain/x/datastore/types"
+	metastoremoduletypes "metachain/x/metastore/types"
+	"raidchain/app/upgrades"
+)
+
+const upgradeTestChainID = "raidchain-upgrade"
+
+// TestUpgradeV2 upgrades chains laid out like the v1 datachain and metachain, which held only one
+// of the two stores raidchaind mounts.
+func TestUpgradeV2(t *testing.T) {
+	for _, tt := range []struct {
+		role Role
+		// v1Store is the store the v1 chain held, missing the one raidchaind adds
+		v1Store, added string
+	}{
+		{role: RoleDatachain, v1Store: datastoremoduletypes.StoreKey, added: metastoremoduletypes.StoreKey},
+		{role: RoleMetachain, v1Store: metastoremoduletypes.StoreKey, added: datastoremoduletypes.StoreKey},
+	} {
+		t.Run(string(tt.role), func(t *testing.T) {
+			db := dbm.NewMemDB()
+			appOpts := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}
+			newApp := func() *App {
+				return New(log.NewNopLogger(), db, nil, true, appOpts, baseapp.SetChainID(upgradeTestChainID))
+			}
+			plan := upgradetypes.Plan{Name: upgrades.V2.Name, Height: 3}
+
+			// the v1 binary runs two blocks and halts before the upgrade height
+			v1 := newApp()
+			valSet := initTestChain(t, v1, RoleAll)
+			ctx := v1.NewUncachedContext(false, cmtproto.Header{Height: 1})
+			// the v1 chain never ran the module of the added store, so it has no version for it
+			ctx.KVStore(v1.GetKey(upgradetypes.StoreKey)).Delete(append([]byte{upgradetypes.VersionMapByte}, tt.added...))
+			require.NoError(t, v1.UpgradeKeeper.SetModuleVersionMap(ctx, module.VersionMap{tt.v1Store: 1}))
+			require.NoError(t, v1.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+			// the params of the first release of the stores were empty
+			if tt.v1Store == datastoremoduletypes.StoreKey {
+				require.NoError(t, v1.DatastoreKeeper.Params.Set(ctx, datastoremoduletypes.Params{}))
+			} else {
+				require.NoError(t, v1.MetastoreKeeper.Params.Set(ctx, metastoremoduletypes.Params{}))
+			}
+			// the block before the upgrade height is committed as is: the v1 binary lacks the handler,
+			// so its pre-blocker would not refuse the scheduled plan as this one does
+			v1.CommitMultiStore().Commit()
+			require.NoError(t, v1.UpgradeKeeper.DumpUpgradeInfoToDisk(plan.Height, plan))
+			removeStore(t, db, tt.added)
+
+			// raidchaind restarts on it, adds the store and runs the upgrade
+			v2 := newApp()
+			require.Equal(t, int64(2), v2.LastBlockHeight())
+			_, err := v2.FinalizeBlock(&abci.RequestFinalizeBlock{Height: plan.Height, NextValidatorsHash: valSet.Hash()})
+			require.NoError(t, err)
+			_, err = v2.Commit()
+			require.NoError(t, err)
+
+			ctx = v2.NewUncachedContext(false, cmtproto.Header{Height: plan.Height})
+			vm, err := v2.UpgradeKeeper.GetModuleVersionMap(ctx)
+			require.NoError(t, err)
+			require.Equal(t, uint64(2), vm[datastoremoduletypes.ModuleName])
+			require.Equal(t, uint64(2), vm[metastoremoduletypes.ModuleName])
+
+			// the added store gets its default params but stays disabled
+			wantDatastoreParams, wantMetastoreParams := datastoremoduletypes.DefaultParams(), metastoremoduletypes.DefaultParams()
+			wantDatastoreParams.Enabled, wantMetastoreParams.Enabled = tt.role.ChunkStore(), tt.role.MetadataStore()
+			datastoreParams, err := v2.DatastoreKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, wantDatastoreParams, datastoreParams)
+			metastoreParams, err := v2.MetastoreKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, wantMetastoreParams, metastoreParams)
+			role, err := v2.Role(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tt.role, role)
+		})
+	}
+}
+
+// TestUpgradeV2KeepsStores checks that a chain already holding both stores keeps their data.
+func TestUpgradeV2KeepsStores(t *testing.T) {
+	db := dbm.NewMemDB()
+	appOpts := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}
+	plan := upgradetypes.Plan{Name: upgrades.V2.Name, Height: 2}
+
+	v1 := New(log.NewNopLogger(), db, nil, true, appOpts, baseapp.SetChainID(upgradeTestChainID))
+	valSet := initTestChain(t, v1, RoleAll)
+	require.NoError(t, v1.UpgradeKeeper.DumpUpgradeInfoToDisk(plan.Height, plan))
+
+	v2 := New(log.NewNopLogger(), db, nil, true, appOpts, baseapp.SetChainID(upgradeTestChainID))
+	require.Equal(t, v1.LastCommitID(), v2.LastCommitID())
+	ctx := v2.NewUncachedContext(false, cmtproto.Header{Height: 1})
+	for _, store := range []string{datastoremoduletypes.StoreKey, metastoremoduletypes.StoreKey} {
+		require.True(t, hasData(ctx.KVStore(v2.GetKey(store))), store)
+	}
+	_, err := v2.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+}
+
+// hasData reports whether store holds any entry.
+func hasData(store storetypes.KVStore) bool {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// initTestChain starts the chain of app from its default genesis with role and commits its
+// first block. It returns the validator set of the chain.
+func initTestChain(t *testing.T, app *App, role Role) *cmttypes.ValidatorSet {
+	t.Helper()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	senderKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderKey.PubKey().Address().Bytes(), senderKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000_000_000))),
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	require.NoError(t, SetGenesisRole(app.AppCodec(), genesisState, role))
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ChainId:         app.ChainID(),
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	return valSet
+}
+
+// removeStore rewrites the last commit of db as if the chain never held store: its data is
+// deleted and it is dropped from the commit info, as on a chain built without it.
+func removeStore(t *testing.T, db dbm.DB, store string) {
+	t.Helper()
+
+	prefix := []byte(fmt.Sprintf("s/k:%s/", store))
+	iter, err := db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	require.NoError(t, err)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	require.NoError(t, iter.Close())
+	require.NotEmpty(t, keys)
+	for _, key := range keys {
+		require.NoError(t, db.Delete(key))
+	}
+
+	commitKey := []byte(fmt.Sprintf("s/%d", rootmulti.GetLatestVersion(db)))
+	commitBz, err := db.Get(commitKey)
+	require.NoError(t, err)
+
+	var info storetypes.CommitInfo
+	require.NoError(t, info.Unmarshal(commitBz))
+	stores := info.StoreInfos[:0]
+	for _, storeInfo := range info.StoreInfos {
+		if storeInfo.Name != store {
+			stores = append(stores, storeInfo)
+		}
+	}
+	require.Len(t, stores, len(info.StoreInfos)-1)
+	info.StoreInfos = stores
+	commitBz, err = info.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, db.Set(commitKey, commitBz))
+}
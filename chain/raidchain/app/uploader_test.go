@@ -0,0 +1,199 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"chunks"
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+)
+
+const uploaderTestChainID = "raidchain-uploader"
+
+// uploaderTestFee returns the fee of every transaction of TestUploaderGrants.
+func uploaderTestFee() sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+}
+
+// signUploaderTestTx signs msgs with priv, paying the fee from feeGranter if set.
+func signUploaderTestTx(t *testing.T, app *App, priv cryptotypes.PrivKey, feeGranter sdk.AccAddress, msgs ...sdk.Msg) []byte {
+	t.Helper()
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	acc := app.AuthKeeper.GetAccount(ctx, sdk.AccAddress(priv.PubKey().Address()))
+	require.NotNil(t, acc)
+
+	txConfig := app.TxConfig()
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetFeeAmount(uploaderTestFee())
+	builder.SetGasLimit(1_000_000)
+	builder.SetFeeGranter(feeGranter)
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: acc.GetSequence(),
+	}))
+
+	sig, err := tx.SignWithPrivKey(context.Background(), signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       uploaderTestChainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+		PubKey:        priv.PubKey(),
+	}, builder, priv, txConfig, acc.GetSequence())
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sig))
+
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return txBytes
+}
+
+// uploaderTestStore is the store whose uploader grants TestUploaderGrants checks.
+type uploaderTestStore struct {
+	role                     Role
+	createA, createB, delete sdk.Msg
+	typeURLs                 []string
+	// creator returns the creator of what createA stored
+	creator func(app *App, ctx sdk.Context) (string, error)
+}
+
+func TestUploaderGrants(t *testing.T) {
+	granterKey, uploaderKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	granter := sdk.AccAddress(granterKey.PubKey().Address())
+
+	stores := []uploaderTestStore{
+		{
+			role:    RoleDatachain,
+			createA: &datastoretypes.MsgCreateStoredChunk{Creator: granter.String(), Index: "a", Data: []byte("data")},
+			createB: &datastoretypes.MsgCreateStoredChunk{Creator: granter.String(), Index: "b", Data: []byte("data")},
+			// the grants cover creating chunks only
+			delete:   &datastoretypes.MsgDeleteStoredChunk{Creator: granter.String(), Index: "a"},
+			typeURLs: datastoretypes.UploaderMsgTypeURLs(),
+			creator: func(app *App, ctx sdk.Context) (string, error) {
+				chunk, err := app.DatastoreKeeper.StoredChunk.Get(ctx, "a")
+				return chunk.Creator, err
+			},
+		}, {
+			role:    RoleMetachain,
+			createA: &metastoretypes.MsgCreateStoredMeta{Creator: granter.String(), Index: "a", Url: "a.example"},
+			createB: &metastoretypes.MsgCreateStoredMeta{Creator: granter.String(), Index: "b", Url: "b.example"},
+			// the grants do not cover deleting metadata
+			delete:   &metastoretypes.MsgDeleteStoredMeta{Creator: granter.String(), Index: "a"},
+			typeURLs: metastoretypes.UploaderMsgTypeURLs(),
+			creator: func(app *App, ctx sdk.Context) (string, error) {
+				meta, err := app.MetastoreKeeper.StoredMeta.Get(ctx, "a")
+				return meta.Creator, err
+			},
+		},
+	}
+	for _, store := range stores {
+		t.Run(string(store.role), func(t *testing.T) {
+			testUploaderGrants(t, store, granterKey, uploaderKey)
+		})
+	}
+}
+
+func testUploaderGrants(t *testing.T, store uploaderTestStore, granterKey, uploaderKey cryptotypes.PrivKey) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{},
+		baseapp.SetChainID(uploaderTestChainID),
+	)
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	// the uploader holds no funds: its fees are paid by the granter
+	granter, uploader := sdk.AccAddress(granterKey.PubKey().Address()), sdk.AccAddress(uploaderKey.PubKey().Address())
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000)))
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet,
+		[]authtypes.GenesisAccount{
+			authtypes.NewBaseAccount(granter, granterKey.PubKey(), 0, 0),
+			authtypes.NewBaseAccount(uploader, uploaderKey.PubKey(), 1, 0),
+		},
+		banktypes.Balance{Address: granter.String(), Coins: funds},
+	)
+	require.NoError(t, err)
+	require.NoError(t, SetGenesisRole(app.AppCodec(), genesisState, store.role))
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ChainId:         uploaderTestChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	height := int64(0)
+	deliver := func(txs ...[]byte) []*abci.ExecTxResult {
+		height++
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Txs: txs, NextValidatorsHash: valSet.Hash()})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+		return res.TxResults
+	}
+	exec := func(msgs ...sdk.Msg) []byte {
+		msg := authz.NewMsgExec(uploader, msgs)
+		return signUploaderTestTx(t, app, uploaderKey, granter, &msg)
+	}
+	deliver()
+
+	// without grants, the uploader can neither act for the granter nor spend its funds
+	results := deliver(exec(store.createA))
+	require.NotZero(t, results[0].Code)
+
+	grants, err := chunks.NewUploaderGrantMsgs(store.typeURLs, granter, uploader, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500)), nil)
+	require.NoError(t, err)
+	results = deliver(signUploaderTestTx(t, app, granterKey, nil, grants...))
+	require.Zero(t, results[0].Code, results[0].Log)
+
+	results = deliver(exec(store.createA))
+	require.Zero(t, results[0].Code, results[0].Log)
+
+	results = deliver(exec(store.delete))
+	require.NotZero(t, results[0].Code)
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: height})
+	creator, err := store.creator(app, ctx)
+	require.NoError(t, err)
+	require.Equal(t, granter.String(), creator)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, uploader).IsZero())
+	require.Equal(t, funds.Sub(uploaderTestFee().MulInt(sdkmath.NewInt(3))...), app.BankKeeper.GetAllBalances(ctx, granter))
+
+	// the fee allowance is spent
+	results = deliver(exec(store.createB))
+	require.NotZero(t, results[0].Code)
+
+	results = deliver(signUploaderTestTx(t, app, granterKey, nil, chunks.NewUploaderRevokeMsgs(store.typeURLs, granter, uploader)...))
+	require.Zero(t, results[0].Code, results[0].Log)
+	ctx = app.NewUncachedContext(false, cmtproto.Header{Height: height})
+	authorization, _ := app.AuthzKeeper.GetAuthorization(ctx, uploader, granter, sdk.MsgTypeURL(store.createA))
+	require.Nil(t, authorization)
+}
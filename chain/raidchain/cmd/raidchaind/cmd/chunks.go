@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	datastoretypes "datachain/x/datastore/types"
+	"raidchain/app"
+)
+
+// NewExportChunksCmd exports the app state like `export`, with the stored chunks streamed to a
+// chunk archive instead of being inlined in the genesis.
+func NewExportChunksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-chunks [archive-file]",
+		Short: "Export state to JSON with the stored chunks in a chunk archive",
+		Long: `export-chunks exports the app state like export, but streams the stored chunks of the
+datastore module to archive-file instead of holding them in the genesis. The datastore genesis
+refers to the archive by file name, SHA-256 and chunk count; nodes starting from the genesis
+install the archive with import-chunks first.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			if _, err := os.Stat(config.GenesisFile()); err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+
+			var bApp *app.App
+			if height != -1 {
+				bApp = app.New(serverCtx.Logger, db, nil, false, serverCtx.Viper)
+				if err := bApp.LoadHeight(height); err != nil {
+					return err
+				}
+			} else {
+				bApp = app.New(serverCtx.Logger, db, nil, true, serverCtx.Viper)
+			}
+
+			f, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			w := bufio.NewWriter(f)
+			exported, err := bApp.ExportAppStateWithChunkArchive(w, filepath.Base(args[0]))
+			if err != nil {
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			appGenesis.AppName = version.AppName
+			appGenesis.AppVersion = version.Version
+			appGenesis.AppState = exported.AppState
+			appGenesis.InitialHeight = exported.Height
+			appGenesis.Consensus = genutiltypes.NewConsensusGenesis(exported.ConsensusParams, exported.Validators)
+
+			if outputDocument == "" {
+				out, err := json.Marshal(appGenesis)
+				if err != nil {
+					return err
+				}
+				_, err = cmd.OutOrStdout().Write(out)
+				return err
+			}
+
+			return appGenesis.SaveAs(outputDocument)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// NewImportChunksCmd verifies a chunk archive against the genesis of the node and installs it
+// where InitGenesis reads it.
+func NewImportChunksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-chunks [archive-file]",
+		Short: "Install the chunk archive of the genesis in the node's config directory",
+		Long: `import-chunks reads archive-file, checks every entry and the whole archive against the
+chunk_archive of the datastore genesis, and copies it into the config directory of the node,
+next to genesis.json. Run it before the first start of a node whose genesis was written by
+export-chunks.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := server.GetServerContextFromCmd(cmd).Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			archive, err := genesisChunkArchive(clientCtx, config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			src, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer src.Close()
+
+			dir := filepath.Dir(config.GenesisFile())
+			tmp, err := os.CreateTemp(dir, archive.File+".*")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(tmp.Name())
+			defer tmp.Close()
+
+			w := bufio.NewWriter(tmp)
+			r := datastoretypes.NewChunkArchiveReader(io.TeeReader(src, w))
+			for {
+				if _, err := r.Next(); errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					return err
+				}
+			}
+			if err := r.Verify(archive); err != nil {
+				return err
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if err := tmp.Close(); err != nil {
+				return err
+			}
+
+			dst := filepath.Join(dir, archive.File)
+			if err := os.Rename(tmp.Name(), dst); err != nil {
+				return err
+			}
+
+			cmd.Printf("imported %d chunks to %s\n", archive.Chunks, dst)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// genesisChunkArchive returns the chunk archive the datastore genesis in genesisFile refers to.
+func genesisChunkArchive(clientCtx client.Context, genesisFile string) (datastoretypes.ChunkArchive, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	if err != nil {
+		return datastoretypes.ChunkArchive{}, err
+	}
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return datastoretypes.ChunkArchive{}, err
+	}
+
+	var genesis datastoretypes.GenesisState
+	if err := clientCtx.Codec.UnmarshalJSON(appState[datastoretypes.ModuleName], &genesis); err != nil {
+		return datastoretypes.ChunkArchive{}, err
+	}
+	if genesis.ChunkArchive == nil {
+		return datastoretypes.ChunkArchive{}, fmt.Errorf("the %s genesis does not refer to a chunk archive", datastoretypes.ModuleName)
+	}
+	if err := genesis.ChunkArchive.Validate(); err != nil {
+		return datastoretypes.ChunkArchive{}, err
+	}
+
+	return *genesis.ChunkArchive, nil
+}
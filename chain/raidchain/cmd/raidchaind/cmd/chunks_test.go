@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	datastoretypes "datachain/x/datastore/types"
+	"raidchain/app"
+)
+
+const chunksTestChainID = "data-0"
+
+// initChunksHome initializes a datachain home and commits a first block storing chunks to its
+// application database.
+func initChunksHome(t *testing.T, chunks []datastoretypes.StoredChunk) string {
+	t.Helper()
+
+	home := t.TempDir()
+	out, err := execute(t, "init", chunksTestChainID, "--chain-id", chunksTestChainID, "--home", home)
+	require.NoError(t, err, out)
+
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	defer db.Close()
+	chainApp := app.New(log.NewNopLogger(), db, nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID(chunksTestChainID))
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	senderKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderKey.PubKey().Address().Bytes(), senderKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000_000_000))),
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(chainApp.AppCodec(), chainApp.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	require.NoError(t, app.SetGenesisRole(chainApp.AppCodec(), genesisState, app.RoleDatachain))
+	var datastoreGenesis datastoretypes.GenesisState
+	chainApp.AppCodec().MustUnmarshalJSON(genesisState[datastoretypes.ModuleName], &datastoreGenesis)
+	datastoreGenesis.StoredChunkMap = chunks
+	genesisState[datastoretypes.ModuleName] = chainApp.AppCodec().MustMarshalJSON(&datastoreGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	_, err = chainApp.InitChain(&abci.RequestInitChain{
+		ChainId:         chunksTestChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = chainApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	_, err = chainApp.Commit()
+	require.NoError(t, err)
+
+	return home
+}
+
+func TestExportImportChunks(t *testing.T) {
+	var chunks []datastoretypes.StoredChunk
+	for i := range 3 {
+		chunks = append(chunks, datastoretypes.StoredChunk{
+			Index:   fmt.Sprintf("idx%d", i),
+			Data:    []byte(fmt.Sprintf("chunk %d", i)),
+			Creator: sdk.AccAddress([]byte{byte(i)}).String(),
+		})
+	}
+	home := initChunksHome(t, chunks)
+
+	exportDir := t.TempDir()
+	archiveFile := filepath.Join(exportDir, "chunks.archive")
+	genesisFile := filepath.Join(exportDir, "genesis.json")
+	out, err := execute(t, "export-chunks", archiveFile, "--home", home, "--output-document", genesisFile)
+	require.NoError(t, err, out)
+
+	// the genesis refers to the archive instead of holding the chunks
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	require.NoError(t, err)
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(appGenesis.AppState, &appState))
+	cdc := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}).AppCodec()
+	var datastoreGenesis datastoretypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[datastoretypes.ModuleName], &datastoreGenesis)
+	require.Empty(t, datastoreGenesis.StoredChunkMap)
+	require.NotNil(t, datastoreGenesis.ChunkArchive)
+	require.Equal(t, "chunks.archive", datastoreGenesis.ChunkArchive.File)
+	require.Equal(t, uint64(len(chunks)), datastoreGenesis.ChunkArchive.Chunks)
+
+	// a node starting from the genesis imports the archive next to it
+	target := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(target, "config"), 0o755))
+	require.NoError(t, appGenesis.SaveAs(filepath.Join(target, "config", "genesis.json")))
+
+	tampered, err := os.ReadFile(archiveFile)
+	require.NoError(t, err)
+	tampered[len(tampered)-1] ^= 1
+	tamperedFile := filepath.Join(exportDir, "tampered.archive")
+	require.NoError(t, os.WriteFile(tamperedFile, tampered, 0o600))
+	_, err = execute(t, "import-chunks", tamperedFile, "--home", target)
+	require.Error(t, err)
+	_, err = os.Stat(filepath.Join(target, "config", "chunks.archive"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	out, err = execute(t, "import-chunks", archiveFile, "--home", target)
+	require.NoError(t, err, out)
+	require.Contains(t, out, fmt.Sprintf("imported %d chunks", len(chunks)))
+
+	// and restores the chunks from it
+	targetApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.AppOptionsMap{flags.FlagHome: target}, baseapp.SetChainID(chunksTestChainID))
+	consensusParams := appGenesis.Consensus.Params.ToProto()
+	_, err = targetApp.InitChain(&abci.RequestInitChain{
+		ChainId:         chunksTestChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   appGenesis.AppState,
+		InitialHeight:   appGenesis.InitialHeight,
+	})
+	require.NoError(t, err)
+	_, err = targetApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: appGenesis.InitialHeight})
+	require.NoError(t, err)
+	_, err = targetApp.Commit()
+	require.NoError(t, err)
+
+	ctx := targetApp.NewUncachedContext(false, cmtproto.Header{Height: appGenesis.InitialHeight})
+	for _, chunk := range chunks {
+		got, err := targetApp.DatastoreKeeper.StoredChunk.Get(ctx, chunk.Index)
+		require.NoError(t, err)
+		require.Equal(t, chunk, got)
+	}
+}
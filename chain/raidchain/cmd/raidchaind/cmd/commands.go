@@ -24,7 +24,8 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
-	"metachain/app"
+	metastorecli "metachain/x/metastore/client/cli"
+	"raidchain/app"
 )
 
 func initRootCmd(
@@ -36,30 +37,34 @@ func initRootCmd(
 		genutilcli.InitCmd(basicManager, app.DefaultNodeHome),
 		NewInPlaceTestnetCmd(),
 		NewTestnetMultiNodeCmd(basicManager, banktypes.GenesisBalancesIterator{}),
+		NewTestnetCmd(banktypes.GenesisBalancesIterator{}),
+		NewRelayerCmd(),
 		debug.Cmd(),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, app.DefaultNodeHome),
 		snapshot.Cmd(newApp),
+		NewExportChunksCmd(),
+		NewImportChunksCmd(),
+		metastorecli.NewEncryptionCmd(),
+		NewIndexerCmd(),
+		NewS3GatewayCmd(),
 	)
 
-	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{
-		AddFlags: addModuleInitFlags,
-	})
+	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{})
+
+	genesisCmd := genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome)
+	genesisCmd.AddCommand(NewSetRoleCmd())
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome),
+		genesisCmd,
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
 	)
 }
 
-// addModuleInitFlags adds more flags to the start command.
-func addModuleInitFlags(startCmd *cobra.Command) {
-}
-
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -103,6 +108,10 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		flags.LineBreak,
+		NewUploadFileCmd(),
+		NewMigrateFragmentsCmd(),
+		NewRebalanceCmd(),
 	)
 
 	return cmd
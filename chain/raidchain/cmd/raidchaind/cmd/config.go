@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	cmtcfg "github.com/cometbft/cometbft/config"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+
+	"raidchain/app"
+)
+
+// initCometBFTConfig helps to override default CometBFT Config values.
+// return cmtcfg.DefaultConfig if no custom configuration is required for the application.
+func initCometBFTConfig() *cmtcfg.Config {
+	cfg := cmtcfg.DefaultConfig()
+
+	// these values put a higher strain on node memory
+	// cfg.P2P.MaxNumInboundPeers = 100
+	// cfg.P2P.MaxNumOutboundPeers = 40
+
+	return cfg
+}
+
+// lanesConfig is the [raidchain.lanes] section of app.toml.
+type lanesConfig struct {
+	IBC       uint64 `mapstructure:"ibc"`
+	Metastore uint64 `mapstructure:"metastore"`
+	Default   uint64 `mapstructure:"default"`
+	Datastore uint64 `mapstructure:"datastore"`
+}
+
+// raidchainConfig is the [raidchain] section of app.toml.
+type raidchainConfig struct {
+	Lanes lanesConfig `mapstructure:"lanes"`
+}
+
+type customAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	Raidchain raidchainConfig `mapstructure:"raidchain"`
+}
+
+const customAppTemplate = serverconfig.DefaultConfigTemplate + `
+###############################################################################
+###                           Raidchain Configuration                       ###
+###############################################################################
+
+# Percentages of the block bytes and gas each lane of the mempool may use. Proposals take txs
+# lane by lane, in the order below, and a lane may also use the space the lanes above it left
+# unused. The shares may not add up to more than 100.
+[raidchain.lanes]
+
+# IBC client updates, packets, acknowledgements and timeouts.
+ibc = {{ .Raidchain.Lanes.IBC }}
+# Metastore messages without chunk data, such as MsgSendMetadata.
+metastore = {{ .Raidchain.Lanes.Metastore }}
+# Every other tx.
+default = {{ .Raidchain.Lanes.Default }}
+# Datastore messages and metastore chunk uploads.
+datastore = {{ .Raidchain.Lanes.Datastore }}
+`
+
+// initAppConfig helps to override default appConfig template and configs.
+// return "", nil if no custom configuration is required for the application.
+func initAppConfig() (string, interface{}) {
+	return customAppTemplate, newAppConfig()
+}
+
+// newAppConfig returns the default app.toml of a node.
+func newAppConfig() customAppConfig {
+	// Optionally allow the chain developer to overwrite the SDK's default
+	// server config.
+	srvCfg := serverconfig.DefaultConfig()
+	// The SDK's default minimum gas price is set to "" (empty value) inside
+	// app.toml. If left empty by validators, the node will halt on startup.
+	// However, the chain developer can set a default app.toml value for their
+	// validators here.
+	//
+	// In summary:
+	// - if you leave srvCfg.MinGasPrices = "", all validators MUST tweak their
+	//   own app.toml config,
+	// - if you set srvCfg.MinGasPrices non-empty, validators CAN tweak their
+	//   own app.toml to override, or use this default value.
+	//
+	// In tests, we set the min gas prices to 0.
+	// srvCfg.MinGasPrices = "0stake"
+
+	// the app-side mempool orders txs by lane, which the SDK leaves disabled by default; it holds
+	// as many txs as the CometBFT mempool
+	srvCfg.Mempool.MaxTxs = cmtcfg.DefaultMempoolConfig().Size
+
+	shares := app.DefaultLaneShares()
+	return customAppConfig{
+		Config: *srvCfg,
+		Raidchain: raidchainConfig{
+			Lanes: lanesConfig{
+				IBC:       shares[app.LaneIBC],
+				Metastore: shares[app.LaneMetastore],
+				Default:   shares[app.LaneDefault],
+				Datastore: shares[app.LaneDatastore],
+			},
+		},
+	}
+}
+
+// writeAppConfig writes config to the app.toml at file, with the raidchain section.
+func writeAppConfig(file string, config customAppConfig) {
+	serverconfig.SetConfigTemplate(customAppTemplate)
+	serverconfig.WriteConfigFile(file, config)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"raidchain/app"
+)
+
+// flagRole selects the role of the chain a testnet command sets up.
+const flagRole = "role"
+
+// NewSetRoleCmd returns the genesis subcommand setting the role of the chain in genesis.json.
+func NewSetRoleCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-role [datachain|metachain|all]",
+		Short: "Set the stores the chain serves in genesis.json",
+		Long: `Set the stores the chain serves in genesis.json: "datachain" for chunk data, "metachain"
+for chunk metadata or "all" for both. The role is the enabled param of the datastore and
+metastore modules, so every node of the chain runs with the role of its genesis.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role, err := app.ParseRole(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := server.GetServerContextFromCmd(cmd).Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			genFile := config.GenesisFile()
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to read genesis from %s: %w", genFile, err)
+			}
+			if appGenesis.AppState, err = setGenesisRole(clientCtx.Codec, appGenesis.AppState, role); err != nil {
+				return err
+			}
+			return genutil.ExportGenesisFile(appGenesis, genFile)
+		},
+	}
+}
+
+// setGenesisRole sets role in the app state of a genesis file.
+func setGenesisRole(cdc codec.JSONCodec, appState json.RawMessage, role app.Role) (json.RawMessage, error) {
+	var genesis map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &genesis); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal genesis app state: %w", err)
+	}
+	if err := app.SetGenesisRole(cdc, genesis, role); err != nil {
+		return nil, err
+	}
+	return json.Marshal(genesis)
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"raidchain/indexer"
+)
+
+var (
+	flagIndexerDB    = "db"
+	flagIndexerRPC   = "rpc"
+	flagIndexerAddr  = "listen"
+	flagPollInterval = "poll-interval"
+)
+
+// NewIndexerCmd returns the off-chain indexer of the metastore and datastore events.
+func NewIndexerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indexer",
+		Short: "Index the resources of a metachain and its datachains into SQLite and serve searches over them",
+		Long: `indexer replays the blocks of every chain given with --rpc and records the typed metastore
+and datastore events they hold in a SQLite database: the resources with their url, creator,
+status and chunks, and the chunks each datachain stores. It resumes after the last indexed
+height of each chain when restarted.
+
+The database is searched over HTTP/JSON:
+
+  GET /resources?prefix=example.com/blog/&creator=&mime_type=image/*&status=registered&from=2025-01-01T00:00:00Z&to=
+  GET /resources/{index}
+  GET /status`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbPath, _ := cmd.Flags().GetString(flagIndexerDB)
+			rpcAddrs, _ := cmd.Flags().GetStringSlice(flagIndexerRPC)
+			listenAddr, _ := cmd.Flags().GetString(flagIndexerAddr)
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+			if len(rpcAddrs) == 0 {
+				return errors.New("at least one --rpc endpoint is required")
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			store, err := indexer.OpenStore(dbPath)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			chains := make([]*indexer.Chain, 0, len(rpcAddrs))
+			for _, rpcAddr := range rpcAddrs {
+				chain, err := indexer.NewChain(ctx, rpcAddr)
+				if err != nil {
+					return err
+				}
+				chains = append(chains, chain)
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			server := &http.Server{Addr: listenAddr, Handler: indexer.NewHandler(store), ReadHeaderTimeout: 10 * time.Second}
+			serveErr := make(chan error, 1)
+			go func() {
+				serveErr <- server.ListenAndServe()
+				stop()
+			}()
+
+			logger.Info("indexing", "chains", len(chains), "listen", listenAddr)
+			runErr := indexer.NewIndexer(store, chains, pollInterval, logger).Run(ctx)
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				return err
+			}
+			if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return runErr
+		},
+	}
+
+	cmd.Flags().String(flagIndexerDB, "indexer.db", "Path of the SQLite database")
+	cmd.Flags().StringSlice(flagIndexerRPC, nil, "CometBFT RPC endpoints of the metachain and datachains to index")
+	cmd.Flags().String(flagIndexerAddr, "localhost:8080", "Address to serve the HTTP API on")
+	cmd.Flags().Duration(flagPollInterval, time.Second, "Interval between polls for new blocks")
+
+	return cmd
+}
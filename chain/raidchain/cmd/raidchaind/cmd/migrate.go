@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	metastorecli "metachain/x/metastore/client/cli"
+	metastoretypes "metachain/x/metastore/types"
+	"raidchain/placement"
+)
+
+var (
+	flagDrainingRPC  = "draining-rpc"
+	flagMaxFragments = "max-fragments"
+)
+
+// NewMigrateFragmentsCmd returns the command copying the fragments of a draining datachain to
+// active datachains.
+func NewMigrateFragmentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-fragments [connection-id]",
+		Short: "Copy the fragments of a draining datachain to active datachains",
+		Long: `migrate-fragments moves the fragments manifests reference on the datachain behind
+connection-id, which governance is draining with MsgDrainDatachain, to the datachains given with
+--datachain. Each fragment is read from --draining-rpc, checked against the hash its manifest
+recorded and sent with MsgMigrateFragment, up to --max-fragments per transaction. A fragment goes
+to the least used datachain holding no other chunk of its stripe. Only the fragments of the
+manifests of the --from account are moved; each owner migrates its own.
+
+The metachain points each manifest to the copy once the datachain it went to acknowledges it, and
+retires the draining datachain when nothing references it anymore. Run the command again until
+then, once the copies sent before are acknowledged: a fragment stays listed on the draining
+datachain, and would be copied again, until then.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+			drainingRPC, _ := cmd.Flags().GetString(flagDrainingRPC)
+			specs, _ := cmd.Flags().GetStringArray(flagDatachain)
+			maxFragments, _ := cmd.Flags().GetUint64(flagMaxFragments)
+			maxBlockAge, _ := cmd.Flags().GetDuration(flagMaxBlockAge)
+			timeoutTimestamp, _ := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if drainingRPC == "" {
+				return fmt.Errorf("--%s is required", flagDrainingRPC)
+			}
+			if len(specs) == 0 {
+				return errors.New("at least one --datachain is required")
+			}
+			if maxFragments == 0 {
+				return fmt.Errorf("invalid --%s 0", flagMaxFragments)
+			}
+
+			queryClient := metastoretypes.NewQueryClient(clientCtx)
+			res, err := queryClient.GetDatachain(cmd.Context(), &metastoretypes.QueryGetDatachainRequest{ConnectionId: connectionID})
+			if err != nil {
+				return err
+			}
+			if res.Datachain.Status != metastoretypes.DatachainStatus_DATACHAIN_STATUS_DRAINING {
+				return fmt.Errorf("datachain %s is %s, not draining", connectionID, res.Datachain.Status)
+			}
+
+			datachains := make([]placement.Datachain, 0, len(specs))
+			for _, spec := range specs {
+				datachain, err := placement.ParseDatachain(spec)
+				if err != nil {
+					return err
+				}
+				datachains = append(datachains, datachain)
+			}
+			loads, err := placement.QueryLoads(cmd.Context(), datachains)
+			if err != nil {
+				return err
+			}
+			loads = placement.Healthy(loads, time.Now(), maxBlockAge)
+			if len(loads) == 0 {
+				return fmt.Errorf("none of the %d datachains produced a block in the last %s", len(datachains), maxBlockAge)
+			}
+
+			// interchain account packets need an absolute timeout
+			timeoutTimestamp += uint64(time.Now().UnixNano())
+
+			owner := clientCtx.GetFromAddress().String()
+			draining := placement.Datachain{ConnectionID: connectionID, RPC: drainingRPC}
+			var (
+				msgs   []sdk.Msg
+				listed int
+				key    []byte
+			)
+			for uint64(len(msgs)) < maxFragments {
+				fragments, err := queryClient.ListDatachainFragments(cmd.Context(), &metastoretypes.QueryDatachainFragmentsRequest{
+					ConnectionId: connectionID,
+					Pagination:   &query.PageRequest{Key: key, Limit: maxFragments},
+				})
+				if err != nil {
+					return err
+				}
+				listed += len(fragments.Fragments)
+
+				for _, fragment := range fragments.Fragments {
+					if uint64(len(msgs)) == maxFragments {
+						break
+					}
+					meta, err := queryClient.GetStoredMeta(cmd.Context(), &metastoretypes.QueryGetStoredMetaRequest{Index: fragment.Url})
+					if err != nil {
+						return err
+					}
+					// the metachain only lets the owner of a manifest move its fragments
+					if meta.StoredMeta.Creator != owner {
+						continue
+					}
+					recorded, found := meta.StoredMeta.DatachainFragment(fragment.Index)
+					if !found {
+						return fmt.Errorf("%s no longer lists chunk %s", fragment.Url, fragment.Index)
+					}
+
+					data, err := placement.FetchChunk(cmd.Context(), draining, fragment.Index)
+					if err != nil {
+						return err
+					}
+					if hash := sha256.Sum256(data); !bytes.Equal(hash[:], recorded.Hash) {
+						return fmt.Errorf("chunk %s on %s does not match the hash %s recorded", fragment.Index, connectionID, fragment.Url)
+					}
+
+					stripe := meta.StoredMeta.StripeConnections(fragment.Index)
+					i, err := placement.MigrationTarget(loads, connectionID, stripe, uint64(len(data)))
+					if err != nil {
+						return fmt.Errorf("chunk %s of %s: %w", fragment.Index, fragment.Url, err)
+					}
+					loads[i].Bytes += uint64(len(data))
+
+					msgs = append(msgs, &metastoretypes.MsgMigrateFragment{
+						Creator:          owner,
+						Url:              fragment.Url,
+						Index:            fragment.Index,
+						ConnectionId:     loads[i].ConnectionId,
+						Data:             data,
+						TimeoutTimestamp: timeoutTimestamp,
+					})
+				}
+
+				if fragments.Pagination == nil || len(fragments.Pagination.NextKey) == 0 {
+					break
+				}
+				key = fragments.Pagination.NextKey
+			}
+			if listed == 0 {
+				return fmt.Errorf("no fragment left on %s", connectionID)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("none of the %d fragments left on %s belongs to %s", listed, connectionID, owner)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().String(flagDrainingRPC, "", "CometBFT RPC address of a node of the draining datachain")
+	cmd.Flags().StringArray(flagDatachain, nil, "Datachain to move fragments to, as connection-id=rpc-address[@capacity in bytes]")
+	cmd.Flags().Uint64(flagMaxFragments, 16, "Fragments to move in the transaction")
+	cmd.Flags().Duration(flagMaxBlockAge, time.Minute, "Leave out datachains whose latest block is older")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, metastorecli.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
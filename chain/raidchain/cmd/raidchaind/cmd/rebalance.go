@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	metastorecli "metachain/x/metastore/client/cli"
+	metastoretypes "metachain/x/metastore/types"
+	"raidchain/placement"
+)
+
+var (
+	flagMaxBytes   = "max-bytes"
+	flagMaxTxBytes = "max-tx-bytes"
+	flagInterval   = "interval"
+)
+
+// NewRebalanceCmd returns the command moving fragments from the fullest datachains to the
+// emptiest ones.
+func NewRebalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance",
+		Short: "Move fragments between active datachains until each stores its share of the bytes",
+		Long: `rebalance spreads the bytes the datachains given with --datachain store, typically after a new
+datachain joined with empty storage. Each datachain is meant to store a share of the total in
+proportion to its @capacity when all of them have one, an even share otherwise. Fragments on a
+datachain storing more than its share go to the one furthest below its share that holds no other
+chunk of their stripe. Datachains without a block in the last --max-block-age, and those
+governance drains or retired, are left out. Only the manifests of the --from account are moved.
+
+With --dry-run the plan is printed as JSON and nothing is sent. Otherwise each fragment is read
+from the datachain it is on, checked against the hash its manifest recorded and sent with
+MsgMoveFragments, one message per manifest. The metachain updates a manifest only once every
+datachain its fragments went to acknowledged them, so a manifest never points to a fragment that
+is not stored. At most --max-bytes are moved per run, in transactions of at most --max-tx-bytes
+sent --interval apart. Run the command again, once the moves sent before are acknowledged, until
+the plan is empty.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			specs, _ := cmd.Flags().GetStringArray(flagDatachain)
+			maxBlockAge, _ := cmd.Flags().GetDuration(flagMaxBlockAge)
+			maxBytes, _ := cmd.Flags().GetUint64(flagMaxBytes)
+			maxTxBytes, _ := cmd.Flags().GetUint64(flagMaxTxBytes)
+			interval, _ := cmd.Flags().GetDuration(flagInterval)
+			timeoutTimestamp, _ := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+			if len(specs) < 2 {
+				return errors.New("at least two --datachain are required")
+			}
+			if maxTxBytes == 0 {
+				return fmt.Errorf("invalid --%s 0", flagMaxTxBytes)
+			}
+
+			queryClient := metastoretypes.NewQueryClient(clientCtx)
+			datachains := make(map[string]placement.Datachain, len(specs))
+			for _, spec := range specs {
+				datachain, err := placement.ParseDatachain(spec)
+				if err != nil {
+					return err
+				}
+				res, err := queryClient.GetDatachain(cmd.Context(), &metastoretypes.QueryGetDatachainRequest{ConnectionId: datachain.ConnectionID})
+				if err != nil {
+					return err
+				}
+				if !res.Datachain.Active() {
+					// migrate-fragments empties a draining datachain
+					continue
+				}
+				datachains[datachain.ConnectionID] = datachain
+			}
+
+			loads, err := placement.QueryLoads(cmd.Context(), slices.Collect(maps.Values(datachains)))
+			if err != nil {
+				return err
+			}
+			loads = placement.Healthy(loads, time.Now(), maxBlockAge)
+			slices.SortFunc(loads, func(a, b metastoretypes.DatachainLoad) int {
+				return strings.Compare(a.ConnectionId, b.ConnectionId)
+			})
+
+			// only the manifests with a fragment on a datachain above its share have fragments to move
+			manifests := make(map[string]metastoretypes.StoredMeta)
+			targets := placement.Targets(loads)
+			for i, load := range loads {
+				if load.Bytes <= targets[i] {
+					continue
+				}
+				var key []byte
+				for {
+					res, err := queryClient.ListDatachainFragments(cmd.Context(), &metastoretypes.QueryDatachainFragmentsRequest{
+						ConnectionId: load.ConnectionId,
+						Pagination:   &query.PageRequest{Key: key},
+					})
+					if err != nil {
+						return err
+					}
+					for _, fragment := range res.Fragments {
+						if _, found := manifests[fragment.Url]; found {
+							continue
+						}
+						meta, err := queryClient.GetStoredMeta(cmd.Context(), &metastoretypes.QueryGetStoredMetaRequest{Index: fragment.Url})
+						if err != nil {
+							return err
+						}
+						if meta.StoredMeta.Creator != clientCtx.GetFromAddress().String() {
+							continue
+						}
+						manifests[fragment.Url] = meta.StoredMeta
+					}
+					if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+						break
+					}
+					key = res.Pagination.NextKey
+				}
+			}
+
+			plan := placement.PlanRebalance(loads, slices.Collect(maps.Values(manifests)), maxBytes)
+			if dryRun {
+				out, err := json.MarshalIndent(plan, "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return err
+			}
+			if len(plan.Files) == 0 {
+				return errors.New("the datachains are balanced, no fragment to move")
+			}
+
+			// interchain account packets need an absolute timeout
+			timeoutTimestamp += uint64(time.Now().UnixNano())
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			var (
+				msgs    []sdk.Msg
+				txBytes uint64
+				sent    int
+			)
+			broadcast := func() error {
+				if sent > 0 {
+					time.Sleep(interval)
+				}
+				if err := tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msgs...); err != nil {
+					return err
+				}
+				txf = txf.WithSequence(txf.Sequence() + 1)
+				msgs, txBytes = nil, 0
+				sent++
+				return nil
+			}
+			for _, file := range plan.Files {
+				msg := &metastoretypes.MsgMoveFragments{
+					Creator:          clientCtx.GetFromAddress().String(),
+					Url:              file.URL,
+					TimeoutTimestamp: timeoutTimestamp,
+				}
+				var size uint64
+				for _, move := range file.Moves {
+					recorded, _ := manifests[file.URL].DatachainFragment(move.Index)
+					data, err := placement.FetchChunk(cmd.Context(), datachains[move.From], move.Index)
+					if err != nil {
+						return err
+					}
+					if hash := sha256.Sum256(data); !bytes.Equal(hash[:], recorded.Hash) {
+						return fmt.Errorf("chunk %s on %s does not match the hash %s recorded", move.Index, move.From, file.URL)
+					}
+					msg.Moves = append(msg.Moves, metastoretypes.FragmentMove{
+						Index:        move.Index,
+						ConnectionId: move.To,
+						Data:         data,
+					})
+					size += uint64(len(data))
+				}
+
+				// a manifest is moved in one message, so only a larger one fills a transaction alone
+				if len(msgs) > 0 && txBytes+size > maxTxBytes {
+					if err := broadcast(); err != nil {
+						return err
+					}
+				}
+				msgs = append(msgs, msg)
+				txBytes += size
+			}
+			return broadcast()
+		},
+	}
+
+	cmd.Flags().StringArray(flagDatachain, nil, "Datachain to rebalance, as connection-id=rpc-address[@capacity in bytes]")
+	cmd.Flags().Duration(flagMaxBlockAge, time.Minute, "Leave out datachains whose latest block is older")
+	cmd.Flags().Uint64(flagMaxBytes, 64<<20, "Bytes to move in this run, 0 for no bound")
+	cmd.Flags().Uint64(flagMaxTxBytes, 4<<20, "Fragment bytes to send per transaction")
+	cmd.Flags().Duration(flagInterval, 6*time.Second, "Time to wait between two transactions")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, metastorecli.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
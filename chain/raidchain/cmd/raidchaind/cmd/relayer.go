@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"metachain/relayer"
+)
+
+var (
+	flagRelayerHome = "relayer-home"
+	flagAccount     = "account"
+)
+
+// NewRelayerCmd returns the built-in relayer for the datastore and metastore channels. It reads
+// the relayer home written by `testnet raidchain`.
+func NewRelayerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer",
+		Short: "Relay packets between the datachains and the metachain",
+		Long: `relayer is a minimal IBC relayer for local development and CI. It links each path of the
+relayer home with a datastore <-> metastore channel and relays the packets sent on those
+channels. It is not a general purpose relayer; use rly for anything else.`,
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.PersistentFlags().String(flagRelayerHome, "./.raidchain/relayer", "Relayer home directory")
+
+	cmd.AddCommand(
+		newRelayerKeysCmd(),
+		newRelayerLinkCmd(),
+		newRelayerStartCmd(),
+	)
+
+	return cmd
+}
+
+func newRelayerKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "keys",
+		Short:                      "Manage the relayer keys",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	restoreCmd := &cobra.Command{
+		Use:   "restore [chain-id] [mnemonic]",
+		Short: "Restore the relayer key of a chain from a mnemonic",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			home, _ := cmd.Flags().GetString(flagRelayerHome)
+			account, _ := cmd.Flags().GetUint32(flagAccount)
+
+			config, err := relayer.LoadConfig(home)
+			if err != nil {
+				return err
+			}
+			chainConfig, err := config.ChainConfig(args[0])
+			if err != nil {
+				return err
+			}
+			kr, err := relayerKeyring(cmd, clientCtx, home, chainConfig)
+			if err != nil {
+				return err
+			}
+
+			hdPath := hd.CreateHDPath(sdk.GetConfig().GetCoinType(), account, 0).String()
+			record, err := kr.NewAccount(chainConfig.Key, args[1], keyring.DefaultBIP39Passphrase, hdPath, hd.Secp256k1)
+			if err != nil {
+				return err
+			}
+			addr, err := record.GetAddress()
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(addr.String())
+			return nil
+		},
+	}
+	restoreCmd.Flags().Uint32(flagAccount, 0, "HD account index of the key")
+
+	cmd.AddCommand(restoreCmd)
+
+	return cmd
+}
+
+func newRelayerLinkCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "link [path]",
+		Short: "Create the clients, connection and channel of a path",
+		Long: `link creates a client of each chain of the path on the other, then opens a connection and an
+unordered datastore <-> metastore channel between them. The identifiers created are written
+back to the path in config.yaml even when a later step fails, so running link again resumes it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			home, _ := cmd.Flags().GetString(flagRelayerHome)
+			config, err := relayer.LoadConfig(home)
+			if err != nil {
+				return err
+			}
+			path, ok := config.Paths[args[0]]
+			if !ok {
+				return fmt.Errorf("path %s not found in config", args[0])
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			chains, err := relayerChainClients(cmd, clientCtx, home, config, logger, path.Src.ChainID, path.Dst.ChainID)
+			if err != nil {
+				return err
+			}
+
+			linkErr := relayer.Link(cmd.Context(), chains[path.Src.ChainID], chains[path.Dst.ChainID], &path)
+			config.Paths[args[0]] = path
+			if err := config.Save(home); err != nil {
+				return err
+			}
+			if linkErr != nil {
+				return linkErr
+			}
+
+			cmd.Printf("linked %s: %s/%s <-> %s/%s\n", args[0], path.Src.ChainID, path.Src.ChannelID, path.Dst.ChainID, path.Dst.ChannelID)
+			return nil
+		},
+	}
+}
+
+func newRelayerStartCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Relay the packets of every linked path",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			home, _ := cmd.Flags().GetString(flagRelayerHome)
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+
+			config, err := relayer.LoadConfig(home)
+			if err != nil {
+				return err
+			}
+
+			var chainIDs []string
+			for _, path := range config.Paths {
+				chainIDs = append(chainIDs, path.Src.ChainID, path.Dst.ChainID)
+			}
+			logger := log.NewLogger(cmd.OutOrStdout())
+			chains, err := relayerChainClients(cmd, clientCtx, home, config, logger, chainIDs...)
+			if err != nil {
+				return err
+			}
+
+			r, err := relayer.NewRelayer(chains, config.Paths, pollInterval, logger)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			logger.Info("relaying", "paths", len(config.Paths), "poll_interval", pollInterval.String())
+			return r.Run(ctx)
+		},
+	}
+
+	cmd.Flags().Duration(flagPollInterval, time.Second, "Interval between polls for new blocks")
+
+	return cmd
+}
+
+// relayerKeyring opens the keyring of a chain in the relayer home.
+func relayerKeyring(cmd *cobra.Command, clientCtx client.Context, home string, chainConfig relayer.ChainConfig) (keyring.Keyring, error) {
+	return keyring.New(
+		sdk.KeyringServiceName(),
+		chainConfig.KeyringBackend,
+		relayer.KeyringDir(home, chainConfig.ChainID),
+		bufio.NewReader(cmd.InOrStdin()),
+		clientCtx.Codec,
+	)
+}
+
+// relayerChainClients connects to each of chainIDs with the key restored for it.
+func relayerChainClients(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	home string,
+	config *relayer.Config,
+	logger log.Logger,
+	chainIDs ...string,
+) (map[string]*relayer.ChainClient, error) {
+	chains := make(map[string]*relayer.ChainClient, len(chainIDs))
+	for _, chainID := range chainIDs {
+		if _, ok := chains[chainID]; ok {
+			continue
+		}
+
+		chainConfig, err := config.ChainConfig(chainID)
+		if err != nil {
+			return nil, err
+		}
+		kr, err := relayerKeyring(cmd, clientCtx, home, chainConfig)
+		if err != nil {
+			return nil, err
+		}
+		chain, err := relayer.NewChainClient(clientCtx, chainConfig, kr, logger)
+		if err != nil {
+			return nil, err
+		}
+		chains[chainID] = chain
+	}
+
+	return chains, nil
+}
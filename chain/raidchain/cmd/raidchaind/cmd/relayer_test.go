@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestRelayerKeysRestore(t *testing.T) {
+	dir := newTopology(t, 1)
+	relayerHome := filepath.Join(dir, "relayer")
+
+	for _, chainID := range []string{"meta-0", "data-0"} {
+		mnemonic, err := os.ReadFile(filepath.Join(dir, "mnemonics", chainID+".mnemonic"))
+		require.NoError(t, err)
+
+		// the key restored as printed by testnet raidchain is the relayer account of the genesis
+		out, err := execute(t, "relayer", "keys", "restore", chainID, string(mnemonic), "--account", "1", "--relayer-home", relayerHome)
+		require.NoError(t, err, out)
+
+		kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, filepath.Join(dir, chainID), nil, moduletestutil.MakeTestEncodingConfig().Codec)
+		require.NoError(t, err)
+		record, err := kb.Key(raidchainAccounts[1].name)
+		require.NoError(t, err)
+		addr, err := record.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, addr.String(), strings.TrimSpace(out))
+	}
+
+	_, err := execute(t, "relayer", "keys", "restore", "data-9", "word", "--relayer-home", relayerHome)
+	require.Error(t, err)
+	_, err = execute(t, "relayer", "link", "path-data-9-to-meta-0", "--relayer-home", relayerHome)
+	require.ErrorContains(t, err, "path path-data-9-to-meta-0 not found in config")
+}
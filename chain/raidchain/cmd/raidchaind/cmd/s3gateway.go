@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	metastorecli "metachain/x/metastore/client/cli"
+	"raidchain/placement"
+	"raidchain/s3gateway"
+)
+
+var (
+	flagGatewayAddr     = "listen"
+	flagCredentialsFile = "credentials-file"
+	flagBucket          = "bucket"
+	flagRegion          = "region"
+	flagMaxObjectSize   = "max-object-size"
+	flagUploadTimeout   = "upload-timeout"
+	flagMultipartDir    = "multipart-dir"
+)
+
+// NewS3GatewayCmd returns the server of the S3 API over the metastore and the datachains.
+func NewS3GatewayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "s3-gateway",
+		Short: "Serve the S3 API, keeping objects as metastore manifests with their chunks on datachains",
+		Long: `s3-gateway serves a subset of the S3 API: PutObject, GetObject with a Range, HeadObject,
+DeleteObject, ListObjectsV2 and multipart uploads. Each --bucket maps a bucket name to a url
+prefix of the metastore, and the object key is appended to it to form the url of the manifest:
+
+  --bucket photos=example.com/photos/ --bucket backups=backups.example.com/
+
+Buckets are addressed in the path (http://localhost:9000/photos/cat.jpg). Requests are
+authenticated with signature v4 against the access keys of --credentials-file, one
+access-key-id=secret-access-key per line, with # comments.
+
+Objects are uploaded with MsgUploadChunks as the --from account, whose interchain account must
+be registered on every datachain given with --datachain. The chunks are placed as upload-file
+places them, and an object is uploaded in one transaction, so --max-object-size must fit in a
+block. An overwrite replaces the manifest of an object only once the datachains stored all of
+its new chunks, and neither an overwrite nor a delete removes the old chunks from the
+datachains.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			listenAddr, _ := cmd.Flags().GetString(flagGatewayAddr)
+			credentialsFile, _ := cmd.Flags().GetString(flagCredentialsFile)
+			bucketSpecs, _ := cmd.Flags().GetStringArray(flagBucket)
+			specs, _ := cmd.Flags().GetStringArray(flagDatachain)
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+			config := s3gateway.Config{Buckets: map[string]string{}}
+			config.Region, _ = cmd.Flags().GetString(flagRegion)
+			config.PlacementPolicy, _ = cmd.Flags().GetString(flagPlacementPolicy)
+			config.StripeWidth, _ = cmd.Flags().GetUint32(flagStripeWidth)
+			config.ChunkSize, _ = cmd.Flags().GetUint64(flagChunkSize)
+			config.MaxBlockAge, _ = cmd.Flags().GetDuration(flagMaxBlockAge)
+			config.MaxObjectSize, _ = cmd.Flags().GetInt64(flagMaxObjectSize)
+			config.UploadTimeout, _ = cmd.Flags().GetDuration(flagUploadTimeout)
+			config.MultipartDir, _ = cmd.Flags().GetString(flagMultipartDir)
+			config.PollInterval = pollInterval
+			timeoutTimestamp, _ := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			config.PacketTimeout = time.Duration(timeoutTimestamp)
+			if len(specs) == 0 {
+				return errors.New("at least one --datachain is required")
+			}
+
+			config.Credentials, err = readCredentials(credentialsFile)
+			if err != nil {
+				return err
+			}
+			for _, spec := range bucketSpecs {
+				name, prefix, ok := strings.Cut(spec, "=")
+				if !ok {
+					return fmt.Errorf("invalid --%s %q, expected name=url-prefix", flagBucket, spec)
+				}
+				config.Buckets[name] = prefix
+			}
+			datachains := make([]placement.Datachain, 0, len(specs))
+			for _, spec := range specs {
+				datachain, err := placement.ParseDatachain(spec)
+				if err != nil {
+					return err
+				}
+				datachains = append(datachains, datachain)
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			logger := log.NewLogger(cmd.OutOrStdout())
+			gateway, err := s3gateway.NewGateway(s3gateway.NewNodeChains(clientCtx, txf, datachains, pollInterval), config, logger)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			server := &http.Server{Addr: listenAddr, Handler: gateway, ReadHeaderTimeout: 10 * time.Second}
+			serveErr := make(chan error, 1)
+			go func() {
+				serveErr <- server.ListenAndServe()
+				stop()
+			}()
+
+			logger.Info("serving the S3 API", "listen", listenAddr, "buckets", len(config.Buckets), "datachains", len(datachains))
+			<-ctx.Done()
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				return err
+			}
+			if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGatewayAddr, "localhost:9000", "Address to serve the S3 API on")
+	cmd.Flags().String(flagCredentialsFile, "", "File of the access keys of the clients, as access-key-id=secret-access-key lines")
+	cmd.Flags().StringArray(flagBucket, nil, "Bucket to serve, as name=url-prefix")
+	cmd.Flags().String(flagRegion, "us-east-1", "Region the signatures of the clients are scoped to")
+	cmd.Flags().StringArray(flagDatachain, nil, "Datachain to place chunks on, as connection-id=rpc-address[@capacity in bytes]")
+	cmd.Flags().String(flagPlacementPolicy, placement.SpreadStripes, fmt.Sprintf("Placement policy, one of %v", placement.PolicyNames()))
+	cmd.Flags().Uint32(flagStripeWidth, 0, "Chunks per stripe, 0 for as many as the healthy datachains")
+	cmd.Flags().Uint64(flagChunkSize, 256<<10, "Size of the chunks in bytes")
+	cmd.Flags().Duration(flagMaxBlockAge, time.Minute, "Leave out datachains whose latest block is older")
+	cmd.Flags().Int64(flagMaxObjectSize, 4<<20, "Size of the largest object in bytes, which is uploaded in one transaction")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, metastorecli.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	cmd.Flags().Duration(flagUploadTimeout, 2*time.Minute, "How long an upload waits for the datachains to store its chunks")
+	cmd.Flags().Duration(flagPollInterval, time.Second, "Interval between polls for transactions and manifests")
+	cmd.Flags().String(flagMultipartDir, "s3-multipart", "Directory keeping the parts of multipart uploads")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readCredentials reads the access keys of path, one access-key-id=secret-access-key per line.
+func readCredentials(path string) (map[string]string, error) {
+	if path == "" {
+		return nil, fmt.Errorf("--%s is required", flagCredentialsFile)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	credentials := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		accessKeyID, secret, ok := strings.Cut(text, "=")
+		if !ok || accessKeyID == "" || secret == "" {
+			return nil, fmt.Errorf("%s:%d: expected access-key-id=secret-access-key", path, line)
+		}
+		credentials[accessKeyID] = secret
+	}
+	return credentials, scanner.Err()
+}
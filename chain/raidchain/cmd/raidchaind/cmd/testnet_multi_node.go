@@ -26,7 +26,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/server"
-	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -37,6 +36,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	runtime "github.com/cosmos/cosmos-sdk/runtime"
+
+	"raidchain/app"
 )
 
 var (
@@ -61,6 +62,7 @@ type initArgs struct {
 	startingIPAddress      string
 	validatorsStakesAmount map[int]sdk.Coin
 	ports                  map[int]string
+	role                   app.Role
 }
 
 // NewTestnetMultiNodeCmd returns a cmd to initialize all files for tendermint testnet and application
@@ -77,7 +79,7 @@ or a similar setup where each node has a manually configurable IP address.
 Note, strict routability for addresses is turned off in the config file.
 
 Example:
-	metachaind multi-node --v 4 --output-dir ./.testnets --validators-stake-amount 1000000,200000,300000,400000 --list-ports 47222,50434,52851,44210
+	raidchaind multi-node --role datachain --v 4 --output-dir ./.testnets --validators-stake-amount 1000000,200000,300000,400000 --list-ports 47222,50434,52851,44210
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -97,6 +99,10 @@ Example:
 			args.startingIPAddress, _ = cmd.Flags().GetString(flagStartingIPAddress)
 			args.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
 			args.algo, _ = cmd.Flags().GetString(flags.FlagKeyType)
+			role, _ := cmd.Flags().GetString(flagRole)
+			if args.role, err = app.ParseRole(role); err != nil {
+				return err
+			}
 
 			args.ports = map[int]string{}
 			args.validatorsStakesAmount = make(map[int]sdk.Coin)
@@ -138,6 +144,8 @@ Example:
 	cmd.Flags().String(flagValidatorsStakeAmount, "100000000,100000000,100000000,100000000", "Amount of stake for each validator")
 	cmd.Flags().String(flagStartingIPAddress, "localhost", "Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
 	cmd.Flags().String(flags.FlagKeyringBackend, "test", "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flagRole, "", `Stores served by the chain: "datachain", "metachain" or "all"`)
+	_ = cmd.MarkFlagRequired(flagRole)
 
 	return cmd
 }
@@ -174,7 +182,7 @@ func initTestnetFiles(
 	nodeIDs := make([]string, args.numValidators)
 	valPubKeys := make([]cryptotypes.PubKey, args.numValidators)
 
-	appConfig := srvconfig.DefaultConfig()
+	appConfig := newAppConfig()
 	appConfig.MinGasPrices = args.minGasPrices
 	appConfig.API.Enable = false
 	appConfig.BaseConfig.MinGasPrices = "0.0001" + sdk.DefaultBondDenom
@@ -309,10 +317,10 @@ func initTestnetFiles(
 
 		appConfig.GRPC.Address = args.startingIPAddress + ":" + strconv.Itoa(9090-2*i)
 		appConfig.API.Address = "tcp://localhost:" + strconv.Itoa(1317-i)
-		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config", "app.toml"), appConfig)
+		writeAppConfig(filepath.Join(nodeDir, "config", "app.toml"), appConfig)
 	}
 
-	if err := initGenFiles(clientCtx, mbm, args.chainID, genAccounts, genBalances, genFiles, args.numValidators); err != nil {
+	if err := initGenFiles(clientCtx, mbm, args.chainID, args.role, genAccounts, genBalances, genFiles, args.numValidators); err != nil {
 		return err
 	}
 	// copy gentx file
@@ -359,12 +367,17 @@ func writeFile(file, dir string, contents []byte) error {
 }
 
 func initGenFiles(
-	clientCtx client.Context, mbm module.BasicManager, chainID string,
+	clientCtx client.Context, mbm module.BasicManager, chainID string, role app.Role,
 	genAccounts []authtypes.GenesisAccount, genBalances []banktypes.Balance,
 	genFiles []string, numValidators int,
 ) error {
 	appGenState := mbm.DefaultGenesis(clientCtx.Codec)
 
+	// every validator serves the stores of the role
+	if err := app.SetGenesisRole(clientCtx.Codec, appGenState, role); err != nil {
+		return err
+	}
+
 	// set the accounts in the genesis state
 	var authGenState authtypes.GenesisState
 	clientCtx.Codec.MustUnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState)
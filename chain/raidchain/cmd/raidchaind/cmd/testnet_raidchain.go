@@ -0,0 +1,415 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"metachain/relayer"
+	"raidchain/app"
+)
+
+var flagDatachains = "datachains"
+
+// Accounts seeded on every chain, derived from one mnemonic per chain at account indexes 0, 1
+// and 2, as the Helm chart's entrypoint-chain.sh does.
+var raidchainAccounts = []struct {
+	name    string
+	balance int64
+}{
+	{name: "validator", balance: 1_000_000_000_000},
+	{name: "relayer", balance: 100_000_000_000},
+	{name: "creator", balance: 100_000_000_000},
+}
+
+const (
+	raidchainValidatorStake = 1_000_000_000
+	raidchainMinGasPrices   = "0.001"
+)
+
+// raidchainNode is one single-validator chain of the topology. Each chain gets its own block
+// of ports so the whole system fits on one host.
+type raidchainNode struct {
+	chainID string
+	role    app.Role
+	home    string
+	index   int
+}
+
+func (n raidchainNode) port(base int) string {
+	return strconv.Itoa(base + 10*n.index)
+}
+
+// NewTestnetCmd groups the commands that generate local networks.
+func NewTestnetCmd(genBalIterator banktypes.GenesisBalancesIterator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "testnet",
+		Short:                      "Subcommands for generating local networks",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewTestnetRaidchainCmd(genBalIterator))
+
+	return cmd
+}
+
+// NewTestnetRaidchainCmd returns a cmd to initialize a metachain, its datachains and the relayer
+// configuration that connects them.
+func NewTestnetRaidchainCmd(genBalIterator banktypes.GenesisBalancesIterator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "raidchain",
+		Short: "Initialize home directories for a metachain, its datachains and the relayer that connects them on one host",
+		Long: `raidchain sets up the topology the Helm chart deploys: one metachain (meta-0) and
+"datachains" datachains (data-0, data-1, ...), each with a single validator. Every chain gets
+validator, relayer and creator accounts derived from its own mnemonic, which is written to
+mnemonics/<chain-id>.mnemonic. The genesis of each chain sets its role.
+
+The relayer directory is a relayer home with every chain and one path per datachain, in the
+go-relayer layout so both "raidchaind relayer" and rly can use it. The commands to start each
+node, restore the relayer keys, link the paths and start relaying are printed at the end.
+
+Example:
+	raidchaind testnet raidchain --datachains 2 --output-dir ./.raidchain
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputDir, _ := cmd.Flags().GetString(flagOutputDir)
+			numDatachains, _ := cmd.Flags().GetInt(flagDatachains)
+			if numDatachains < 1 {
+				return fmt.Errorf("at least one datachain is required, got %d", numDatachains)
+			}
+
+			binary, err := os.Executable()
+			if err != nil {
+				return err
+			}
+
+			nodes := []raidchainNode{{chainID: "meta-0", role: app.RoleMetachain}}
+			for i := 0; i < numDatachains; i++ {
+				nodes = append(nodes, raidchainNode{chainID: fmt.Sprintf("data-%d", i), role: app.RoleDatachain})
+			}
+			for i := range nodes {
+				nodes[i].index = i
+				nodes[i].home = filepath.Join(outputDir, nodes[i].chainID)
+			}
+
+			for _, node := range nodes {
+				if err := initRaidchainNode(cmd, clientCtx, genBalIterator, binary, outputDir, node); err != nil {
+					_ = os.RemoveAll(outputDir)
+					return fmt.Errorf("%s: %w", node.chainID, err)
+				}
+			}
+
+			if err := writeRelayerConfig(outputDir, nodes); err != nil {
+				return err
+			}
+
+			printRaidchainUsage(cmd, filepath.Base(binary), outputDir, nodes)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagDatachains, 2, "Number of datachains to initialize")
+	cmd.Flags().StringP(flagOutputDir, "o", "./.raidchain", "Directory to store the chain homes and relayer configuration in")
+
+	return cmd
+}
+
+// initRaidchainNode initializes a chain home with binary, then seeds the accounts, signs the
+// validator's gentx and collects it into the genesis file.
+func initRaidchainNode(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	genBalIterator banktypes.GenesisBalancesIterator,
+	binary, outputDir string,
+	node raidchainNode,
+) error {
+	initCmd := exec.CommandContext(cmd.Context(), binary, "init", node.chainID, "--chain-id", node.chainID, "--home", node.home)
+	if out, err := initCmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s init: %w\n%s", filepath.Base(binary), err, out)
+	}
+
+	nodeConfig := cmtconfig.DefaultConfig()
+	nodeConfig.SetRoot(node.home)
+	nodeConfig.Moniker = node.chainID
+	nodeID, valPubKey, err := genutil.InitializeNodeValidatorFiles(nodeConfig)
+	if err != nil {
+		return err
+	}
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, node.home, bufio.NewReader(cmd.InOrStdin()), clientCtx.Codec)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mnemonic    string
+		genAccounts []authtypes.GenesisAccount
+		genBalances []banktypes.Balance
+	)
+	coinType := sdk.GetConfig().GetCoinType()
+	for i, account := range raidchainAccounts {
+		hdPath := hd.CreateHDPath(coinType, uint32(i), 0).String()
+
+		var record *keyring.Record
+		if mnemonic == "" {
+			record, mnemonic, err = kb.NewMnemonic(account.name, keyring.English, hdPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		} else {
+			record, err = kb.NewAccount(account.name, mnemonic, keyring.DefaultBIP39Passphrase, hdPath, hd.Secp256k1)
+		}
+		if err != nil {
+			return err
+		}
+		addr, err := record.GetAddress()
+		if err != nil {
+			return err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(account.balance)))
+		genBalances = append(genBalances, banktypes.Balance{Address: addr.String(), Coins: coins})
+		genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
+	}
+
+	mnemonicsDir := filepath.Join(outputDir, "mnemonics")
+	if err := writeFile(filepath.Join(mnemonicsDir, node.chainID+".mnemonic"), mnemonicsDir, []byte(mnemonic)); err != nil {
+		return err
+	}
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(nodeConfig.GenesisFile())
+	if err != nil {
+		return err
+	}
+	if appGenesis.AppState, err = addGenesisAccounts(clientCtx, appGenesis.AppState, genAccounts, genBalances); err != nil {
+		return err
+	}
+	if appGenesis.AppState, err = setGenesisRole(clientCtx.Codec, appGenesis.AppState, node.role); err != nil {
+		return err
+	}
+	if err := appGenesis.SaveAs(nodeConfig.GenesisFile()); err != nil {
+		return err
+	}
+
+	memo := fmt.Sprintf("%s@127.0.0.1:%s", nodeID, node.port(26656))
+	gentxsDir := filepath.Join(node.home, "config", "gentx")
+	if err := writeRaidchainGentx(cmd, clientCtx, kb, node, valPubKey, memo, filepath.Join(gentxsDir, fmt.Sprintf("gentx-%s.json", nodeID))); err != nil {
+		return err
+	}
+
+	initCfg := genutiltypes.NewInitConfig(node.chainID, gentxsDir, nodeID, valPubKey)
+	if _, err := genutil.GenAppStateFromConfig(clientCtx.Codec, clientCtx.TxConfig, nodeConfig, initCfg, appGenesis, genBalIterator,
+		genutiltypes.DefaultMessageValidator, clientCtx.TxConfig.SigningContext().ValidatorAddressCodec()); err != nil {
+		return err
+	}
+
+	nodeConfig.P2P.ListenAddress = "tcp://0.0.0.0:" + node.port(26656)
+	nodeConfig.P2P.AllowDuplicateIP = true
+	nodeConfig.RPC.ListenAddress = "tcp://0.0.0.0:" + node.port(26657)
+	nodeConfig.RPC.CORSAllowedOrigins = []string{"*"}
+	nodeConfig.RPC.PprofListenAddress = "localhost:" + strconv.Itoa(6060+node.index)
+	nodeConfig.BaseConfig.ProxyApp = "tcp://127.0.0.1:" + node.port(26658)
+	nodeConfig.Instrumentation.PrometheusListenAddr = ":" + node.port(26660)
+	cmtconfig.WriteConfigFile(filepath.Join(node.home, "config", "config.toml"), nodeConfig)
+
+	appConfig := newAppConfig()
+	appConfig.MinGasPrices = raidchainMinGasPrices + sdk.DefaultBondDenom
+	appConfig.API.Enable = true
+	appConfig.API.EnableUnsafeCORS = true
+	appConfig.API.Address = "tcp://0.0.0.0:" + node.port(1317)
+	appConfig.GRPC.Enable = true
+	appConfig.GRPC.Address = "0.0.0.0:" + node.port(9090)
+	appConfig.Telemetry.Enabled = false
+	writeAppConfig(filepath.Join(node.home, "config", "app.toml"), appConfig)
+
+	return nil
+}
+
+// addGenesisAccounts adds the accounts and their balances to the auth and bank sections of an
+// app state.
+func addGenesisAccounts(
+	clientCtx client.Context, appStateJSON json.RawMessage,
+	genAccounts []authtypes.GenesisAccount, genBalances []banktypes.Balance,
+) (json.RawMessage, error) {
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appStateJSON, &appState); err != nil {
+		return nil, err
+	}
+
+	var authGenState authtypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appState[authtypes.ModuleName], &authGenState)
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return nil, err
+	}
+	authGenState.Accounts = append(authGenState.Accounts, accounts...)
+	appState[authtypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&authGenState)
+
+	var bankGenState banktypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appState[banktypes.ModuleName], &bankGenState)
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(append(bankGenState.Balances, genBalances...))
+	for _, bal := range genBalances {
+		bankGenState.Supply = bankGenState.Supply.Add(bal.Coins...)
+	}
+	appState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
+
+	return json.MarshalIndent(appState, "", "  ")
+}
+
+// writeRaidchainGentx signs the validator's MsgCreateValidator and writes it to file.
+func writeRaidchainGentx(
+	cmd *cobra.Command, clientCtx client.Context, kb keyring.Keyring,
+	node raidchainNode, valPubKey cryptotypes.PubKey, memo, file string,
+) error {
+	record, err := kb.Key(raidchainAccounts[0].name)
+	if err != nil {
+		return err
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return err
+	}
+
+	createValMsg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(addr).String(),
+		valPubKey,
+		sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(raidchainValidatorStake)),
+		stakingtypes.NewDescription(node.chainID, "", "", "", ""),
+		stakingtypes.NewCommissionRates(math.LegacyMustNewDecFromStr("0.1"), math.LegacyMustNewDecFromStr("0.2"), math.LegacyMustNewDecFromStr("0.01")),
+		math.OneInt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	txBuilder := clientCtx.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(createValMsg); err != nil {
+		return err
+	}
+	txBuilder.SetMemo(memo)
+
+	txFactory := tx.Factory{}.
+		WithChainID(node.chainID).
+		WithMemo(memo).
+		WithKeybase(kb).
+		WithTxConfig(clientCtx.TxConfig)
+	if err := tx.Sign(cmd.Context(), txFactory, raidchainAccounts[0].name, txBuilder, true); err != nil {
+		return err
+	}
+
+	txBz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	return writeFile(file, filepath.Dir(file), txBz)
+}
+
+// relayerPathName names the path between a datachain and the metachain the way init-relayer.sh does.
+func relayerPathName(datachain, metachain raidchainNode) string {
+	return fmt.Sprintf("path-%s-to-%s", datachain.chainID, metachain.chainID)
+}
+
+// writeRelayerConfig writes a relayer home to outputDir/relayer, with every chain and a path
+// from each datachain to the metachain. Each path is also written to relayer/paths for rly.
+func writeRelayerConfig(outputDir string, nodes []raidchainNode) error {
+	relayerHome := filepath.Join(outputDir, "relayer")
+	metachain, datachains := nodes[0], nodes[1:]
+
+	var config relayer.Config
+	config.Global.APIListenAddr = ":5183"
+	config.Global.Timeout = "10s"
+	config.Global.LightCacheSize = 20
+	config.Chains = make(map[string]relayer.Chain, len(nodes))
+	config.Paths = make(map[string]relayer.Path, len(datachains))
+
+	for _, node := range nodes {
+		config.Chains[node.chainID] = relayer.Chain{
+			Type: "cosmos",
+			Value: relayer.ChainConfig{
+				Key:            raidchainAccounts[1].name,
+				ChainID:        node.chainID,
+				RPCAddr:        "http://127.0.0.1:" + node.port(26657),
+				GRPCAddr:       "127.0.0.1:" + node.port(9090),
+				AccountPrefix:  sdk.GetConfig().GetBech32AccountAddrPrefix(),
+				KeyringBackend: keyring.BackendTest,
+				GasAdjustment:  1.5,
+				GasPrices:      raidchainMinGasPrices + sdk.DefaultBondDenom,
+				Timeout:        "20s",
+				OutputFormat:   "json",
+				SignMode:       "direct",
+			},
+		}
+	}
+
+	pathsDir := filepath.Join(relayerHome, "paths")
+	for _, datachain := range datachains {
+		path := relayer.Path{
+			Src:    relayer.PathEnd{ChainID: datachain.chainID},
+			Dst:    relayer.PathEnd{ChainID: metachain.chainID},
+			Filter: relayer.Filter{ChannelList: []string{}},
+		}
+		name := relayerPathName(datachain, metachain)
+		config.Paths[name] = path
+
+		bz, err := json.MarshalIndent(path, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := writeFile(filepath.Join(pathsDir, name+".json"), pathsDir, bz); err != nil {
+			return err
+		}
+	}
+
+	return config.Save(relayerHome)
+}
+
+func printRaidchainUsage(cmd *cobra.Command, binary, outputDir string, nodes []raidchainNode) {
+	relayerHome := filepath.Join(outputDir, "relayer")
+	metachain, datachains := nodes[0], nodes[1:]
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Successfully initialized %d chains in %s\n\n", len(nodes), outputDir)
+
+	b.WriteString("Start each node:\n")
+	for _, node := range nodes {
+		fmt.Fprintf(&b, "  %s start --home %s\n", binary, node.home)
+	}
+
+	b.WriteString("\nRestore the relayer keys, link the datachains to the metachain and start relaying:\n")
+	for _, node := range nodes {
+		fmt.Fprintf(&b, "  %s relayer keys restore %s \"$(cat %s)\" --account 1 --relayer-home %s\n",
+			binary, node.chainID, filepath.Join(outputDir, "mnemonics", node.chainID+".mnemonic"), relayerHome)
+	}
+	for _, datachain := range datachains {
+		fmt.Fprintf(&b, "  %s relayer link %s --relayer-home %s\n", binary, relayerPathName(datachain, metachain), relayerHome)
+	}
+	fmt.Fprintf(&b, "  %s relayer start --relayer-home %s\n", binary, relayerHome)
+
+	cmd.Print(b.String())
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	clienthelpers "cosmossdk.io/client/v2/helpers"
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	datastoretypes "datachain/x/datastore/types"
+	"metachain/relayer"
+	metastoretypes "metachain/x/metastore/types"
+	"raidchain/app"
+)
+
+func TestMain(m *testing.M) {
+	// testnet raidchain initializes every chain home by running the binary it is part of
+	if len(os.Args) > 1 && os.Args[1] == "init" {
+		rootCmd := NewRootCmd()
+		if err := svrcmd.Execute(rootCmd, clienthelpers.EnvPrefix, app.DefaultNodeHome); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// execute runs raidchaind with args in process and returns what it printed.
+func execute(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	rootCmd := NewRootCmd()
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&out)
+	rootCmd.SetArgs(args)
+	err := svrcmd.Execute(rootCmd, clienthelpers.EnvPrefix, filepath.Join(t.TempDir(), "home"))
+	return out.String(), err
+}
+
+// newTopology generates a raidchain topology with datachains datachains and returns its
+// directory.
+func newTopology(t *testing.T, datachains int) string {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "raidchain")
+	out, err := execute(t, "testnet", "raidchain", "--datachains", fmt.Sprint(datachains), "--output-dir", dir)
+	require.NoError(t, err, out)
+	require.Contains(t, out, fmt.Sprintf("Successfully initialized %d chains in %s", datachains+1, dir))
+	return dir
+}
+
+func TestTestnetRaidchain(t *testing.T) {
+	dir := newTopology(t, 2)
+
+	roles := map[string]app.Role{"meta-0": app.RoleMetachain, "data-0": app.RoleDatachain, "data-1": app.RoleDatachain}
+	rpcAddrs := map[string]bool{}
+	config, err := relayer.LoadConfig(filepath.Join(dir, "relayer"))
+	require.NoError(t, err)
+	require.Len(t, config.Chains, len(roles))
+
+	for chainID, role := range roles {
+		home := filepath.Join(dir, chainID)
+		genesisFile := filepath.Join(home, "config", "genesis.json")
+		out, err := execute(t, "genesis", "validate", genesisFile, "--home", home)
+		require.NoError(t, err, out)
+
+		appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+		require.NoError(t, err)
+		require.Equal(t, chainID, appGenesis.ChainID)
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(appGenesis.AppState, &appState))
+
+		chainApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID(chainID))
+		cdc := chainApp.AppCodec()
+
+		// the role is in the genesis
+		var datastoreGenesis datastoretypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[datastoretypes.ModuleName], &datastoreGenesis)
+		var metastoreGenesis metastoretypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[metastoretypes.ModuleName], &metastoreGenesis)
+		require.Equal(t, role.ChunkStore(), datastoreGenesis.Params.Enabled)
+		require.Equal(t, role.MetadataStore(), metastoreGenesis.Params.Enabled)
+
+		// every account is funded
+		var bankGenesis banktypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis)
+		require.Len(t, bankGenesis.Balances, len(raidchainAccounts))
+
+		// the gentx of the validator makes a chain that starts
+		res, err := chainApp.InitChain(&abci.RequestInitChain{
+			ChainId:         chainID,
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   appGenesis.AppState,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Validators, 1)
+
+		// every chain has its own ports
+		chainConfig, err := config.ChainConfig(chainID)
+		require.NoError(t, err)
+		require.False(t, rpcAddrs[chainConfig.RPCAddr], chainConfig.RPCAddr)
+		rpcAddrs[chainConfig.RPCAddr] = true
+
+		mnemonic, err := os.ReadFile(filepath.Join(dir, "mnemonics", chainID+".mnemonic"))
+		require.NoError(t, err)
+		require.NotEmpty(t, mnemonic)
+	}
+
+	// one path from each datachain to the metachain
+	require.Len(t, config.Paths, 2)
+	for _, datachain := range []string{"data-0", "data-1"} {
+		path, ok := config.Paths[fmt.Sprintf("path-%s-to-meta-0", datachain)]
+		require.True(t, ok)
+		require.Equal(t, datachain, path.Src.ChainID)
+		require.Equal(t, "meta-0", path.Dst.ChainID)
+	}
+
+	_, err = execute(t, "testnet", "raidchain", "--datachains", "0", "--output-dir", filepath.Join(t.TempDir(), "none"))
+	require.ErrorContains(t, err, "at least one datachain is required")
+}
@@ -7,8 +7,8 @@ import (
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 
-	"metachain/app"
-	"metachain/cmd/metachaind/cmd"
+	"raidchain/app"
+	"raidchain/cmd/raidchaind/cmd"
 )
 
 func main() {
@@ -0,0 +1,157 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+)
+
+// applyEvent indexes a typed metastore or datastore event. Other events, and the events of
+// failed packet receipts that IBC renames, are skipped.
+func applyEvent(ctx context.Context, tx *sql.Tx, block Block, event abci.Event) error {
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return nil
+	}
+
+	switch e := msg.(type) {
+	case *metastoretypes.EventUploadStarted:
+		var size uint64
+		fragments := make([]Fragment, 0, len(e.Chunks))
+		for _, chunk := range e.Chunks {
+			size += chunk.Size_
+			fragments = append(fragments, Fragment{Index: chunk.Index, ConnectionID: chunk.ConnectionId, Size: chunk.Size_, Hash: hexHash(chunk.Hash)})
+		}
+		if err := upsertResource(ctx, tx, block, e.Url, e.Url, e.Creator, StatusPending, size, true); err != nil {
+			return err
+		}
+		return setFragments(ctx, tx, block.ChainID, e.Url, fragments)
+
+	case *metastoretypes.EventUploadFailed:
+		_, err := tx.ExecContext(ctx,
+			`UPDATE resources SET status = ?, updated_height = ?, updated_time = ?
+			WHERE chain_id = ? AND idx = ? AND status = ?`,
+			StatusFailed, block.Height, block.Time.UnixNano(), block.ChainID, e.Url, StatusPending,
+		)
+		return err
+
+	case *metastoretypes.EventStoredMetaCreated:
+		return setStoredMeta(ctx, tx, block, e.Index, e.Url, e.Creator, e.Size_, e.Chunks, true)
+
+	case *metastoretypes.EventStoredMetaUpdated:
+		return setStoredMeta(ctx, tx, block, e.Index, e.Url, e.Creator, e.Size_, e.Chunks, false)
+
+	case *metastoretypes.EventStoredMetaDeleted:
+		_, err := tx.ExecContext(ctx,
+			`UPDATE resources SET status = ?, updated_height = ?, updated_time = ? WHERE chain_id = ? AND idx = ?`,
+			StatusDeleted, block.Height, block.Time.UnixNano(), block.ChainID, e.Index,
+		)
+		return err
+
+	case *datastoretypes.EventStoredChunkCreated:
+		return upsertChunk(ctx, tx, block, e.Index, e.Creator, e.Size_, e.Hash)
+
+	case *datastoretypes.EventStoredChunkUpdated:
+		return upsertChunk(ctx, tx, block, e.Index, e.Creator, e.Size_, e.Hash)
+
+	case *datastoretypes.EventStoredChunkDeleted:
+		return setChunkStatus(ctx, tx, block, e.Index, ChunkDeleted)
+
+	case *datastoretypes.EventStoredChunkPruned:
+		return setChunkStatus(ctx, tx, block, e.Index, ChunkPruned)
+	}
+
+	return nil
+}
+
+// setStoredMeta indexes the metadata of a resource. The chunks attested by datachains replace
+// its fragments; metadata stored by an upload carries none and keeps those the upload listed.
+func setStoredMeta(
+	ctx context.Context,
+	tx *sql.Tx,
+	block Block,
+	index, url, creator string,
+	size uint64,
+	chunks []metastoretypes.VerifiedChunk,
+	created bool,
+) error {
+	if err := upsertResource(ctx, tx, block, index, url, creator, StatusRegistered, size, created); err != nil {
+		return err
+	}
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	fragments := make([]Fragment, 0, len(chunks))
+	for _, chunk := range chunks {
+		fragments = append(fragments, Fragment{Index: chunk.Index, Size: chunk.Size_, Hash: hexHash(chunk.Hash)})
+	}
+	return setFragments(ctx, tx, block.ChainID, index, fragments)
+}
+
+// upsertResource records a resource at block. created resets its creation height and time, which
+// an update leaves alone. The size of an upload is only known from its chunks, so a size of 0
+// keeps the recorded one.
+func upsertResource(ctx context.Context, tx *sql.Tx, block Block, index, url, creator, status string, size uint64, created bool) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO resources (chain_id, idx, url, creator, status, mime_type, size, created_height, created_time, updated_height, updated_time)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?8, ?9)
+		ON CONFLICT (chain_id, idx) DO UPDATE SET
+			url = excluded.url,
+			creator = excluded.creator,
+			status = excluded.status,
+			mime_type = excluded.mime_type,
+			size = CASE WHEN excluded.size = 0 THEN size ELSE excluded.size END,
+			created_height = CASE WHEN ?10 THEN excluded.created_height ELSE created_height END,
+			created_time = CASE WHEN ?10 THEN excluded.created_time ELSE created_time END,
+			updated_height = excluded.updated_height,
+			updated_time = excluded.updated_time`,
+		block.ChainID, index, url, creator, status, mimeType(url), size, block.Height, block.Time.UnixNano(), created,
+	)
+	return err
+}
+
+func setFragments(ctx context.Context, tx *sql.Tx, chainID, resource string, fragments []Fragment) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM fragments WHERE chain_id = ? AND resource = ?`, chainID, resource); err != nil {
+		return err
+	}
+	for i, fragment := range fragments {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO fragments (chain_id, resource, position, chunk_index, connection_id, size, hash) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			chainID, resource, i, fragment.Index, fragment.ConnectionID, fragment.Size, fragment.Hash,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertChunk(ctx context.Context, tx *sql.Tx, block Block, index, creator string, size uint64, hash []byte) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO chunks (chain_id, chunk_index, creator, size, hash, status, updated_height, updated_time)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (chain_id, chunk_index) DO UPDATE SET
+			creator = excluded.creator,
+			size = excluded.size,
+			hash = excluded.hash,
+			status = excluded.status,
+			updated_height = excluded.updated_height,
+			updated_time = excluded.updated_time`,
+		block.ChainID, index, creator, size, hexHash(hash), ChunkStored, block.Height, block.Time.UnixNano(),
+	)
+	return err
+}
+
+func setChunkStatus(ctx context.Context, tx *sql.Tx, block Block, index, status string) error {
+	_, err := tx.ExecContext(ctx,
+		`UPDATE chunks SET status = ?, updated_height = ?, updated_time = ? WHERE chain_id = ? AND chunk_index = ?`,
+		status, block.Height, block.Time.UnixNano(), block.ChainID, index,
+	)
+	return err
+}
@@ -0,0 +1,140 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// Chain reads the blocks of one chain through its CometBFT RPC endpoint.
+type Chain struct {
+	chainID string
+	rpc     rpcclient.Client
+}
+
+// NewChain connects to the CometBFT RPC endpoint at rpcAddr and reads its chain id.
+func NewChain(ctx context.Context, rpcAddr string) (*Chain, error) {
+	rpc, err := client.NewClientFromNode(rpcAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", rpcAddr, err)
+	}
+	status, err := rpc.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the status of %s: %w", rpcAddr, err)
+	}
+
+	return &Chain{chainID: status.NodeInfo.Network, rpc: rpc}, nil
+}
+
+// ChainID returns the chain id of the chain.
+func (c *Chain) ChainID() string {
+	return c.chainID
+}
+
+// Heights returns the earliest and latest heights the node has the blocks of.
+func (c *Chain) Heights(ctx context.Context) (int64, int64, error) {
+	status, err := c.rpc.Status(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return status.SyncInfo.EarliestBlockHeight, status.SyncInfo.LatestBlockHeight, nil
+}
+
+// Block returns the time and the events of the successful txs and of the finalization of the
+// block at height.
+func (c *Chain) Block(ctx context.Context, height int64) (Block, error) {
+	header, err := c.rpc.Header(ctx, &height)
+	if err != nil {
+		return Block{}, err
+	}
+	res, err := c.rpc.BlockResults(ctx, &height)
+	if err != nil {
+		return Block{}, err
+	}
+
+	block := Block{ChainID: c.chainID, Height: height, Time: header.Header.Time}
+	for _, txResult := range res.TxsResults {
+		if txResult.Code == 0 {
+			block.Events = append(block.Events, txResult.Events...)
+		}
+	}
+	block.Events = append(block.Events, res.FinalizeBlockEvents...)
+
+	return block, nil
+}
+
+// Indexer replays the blocks of its chains into a Store. On start, each chain resumes after the
+// height the store last indexed it at, or from the earliest block its node has.
+type Indexer struct {
+	store        *Store
+	chains       []*Chain
+	pollInterval time.Duration
+	logger       log.Logger
+}
+
+// NewIndexer returns an indexer of chains into store.
+func NewIndexer(store *Store, chains []*Chain, pollInterval time.Duration, logger log.Logger) *Indexer {
+	return &Indexer{
+		store:        store,
+		chains:       chains,
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+}
+
+// Run indexes the chains until ctx is done. Errors are logged and the failed block is retried
+// on the next poll.
+func (i *Indexer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(i.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, chain := range i.chains {
+			if err := i.catchUp(ctx, chain); err != nil && ctx.Err() == nil {
+				i.logger.Error("failed to index blocks", "chain", chain.ChainID(), "err", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// catchUp indexes the blocks of chain up to its latest one.
+func (i *Indexer) catchUp(ctx context.Context, chain *Chain) error {
+	cursor, err := i.store.Cursor(ctx, chain.ChainID())
+	if err != nil {
+		return err
+	}
+	earliest, latest, err := chain.Heights(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := max(cursor+1, earliest, 1)
+	if cursor > 0 && height > cursor+1 {
+		i.logger.Error("blocks were pruned before being indexed", "chain", chain.ChainID(), "from", cursor+1, "to", height-1)
+	}
+	for ; height <= latest; height++ {
+		if ctx.Err() != nil {
+			return nil
+		}
+		block, err := chain.Block(ctx, height)
+		if err != nil {
+			return err
+		}
+		if err := i.store.IndexBlock(ctx, block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package indexer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+)
+
+func typedEvents(t *testing.T, msgs ...proto.Message) []abci.Event {
+	t.Helper()
+
+	events := make([]abci.Event, 0, len(msgs))
+	for _, msg := range msgs {
+		event, err := sdk.TypedEventToEvent(msg)
+		require.NoError(t, err)
+		events = append(events, abci.Event(event))
+	}
+	return events
+}
+
+func TestIndexer(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "indexer.db")
+	store, err := OpenStore(dbPath)
+	require.NoError(t, err)
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	logo, page := []byte("logo"), []byte("<html></html>")
+	logoHash, pageHash := sha256.Sum256(logo), sha256.Sum256(page)
+
+	// an upload of two resources, one of which fails, on the metachain
+	require.NoError(t, store.IndexBlock(ctx, Block{ChainID: "metachain", Height: 1, Time: start, Events: typedEvents(t,
+		&metastoretypes.EventUploadStarted{Url: "example.com/blog/logo.png", Creator: "alice", Chunks: []metastoretypes.UploadedChunk{
+			{ConnectionId: "connection-0", Index: "logo-0", Size_: uint64(len(logo)), Hash: logoHash[:]},
+		}},
+		&metastoretypes.EventUploadStarted{Url: "example.com/blog/index.html", Creator: "bob", Chunks: []metastoretypes.UploadedChunk{
+			{ConnectionId: "connection-1", Index: "page-0", Size_: uint64(len(page)), Hash: pageHash[:]},
+		}},
+	)}))
+	require.NoError(t, store.IndexBlock(ctx, Block{ChainID: "datachain-0", Height: 7, Time: start, Events: typedEvents(t,
+		&datastoretypes.EventStoredChunkCreated{Index: "logo-0", Creator: "ica-0", Size_: uint64(len(logo)), Hash: logoHash[:]},
+	)}))
+	require.NoError(t, store.IndexBlock(ctx, Block{ChainID: "metachain", Height: 2, Time: start.Add(time.Hour), Events: append(
+		// events that are not typed metastore or datastore events are skipped
+		[]abci.Event{{Type: "chunk_upload", Attributes: []abci.EventAttribute{{Key: "url", Value: "example.com/blog/logo.png"}}}},
+		typedEvents(t,
+			&metastoretypes.EventStoredMetaCreated{Index: "example.com/blog/logo.png", Url: "example.com/blog/logo.png", Creator: "alice"},
+			&metastoretypes.EventUploadFailed{Url: "example.com/blog/index.html", Creator: "bob", Reason: "packet timed out"},
+			&metastoretypes.EventStoredMetaCreated{Index: "other.org/a.txt", Url: "other.org/a.txt", Creator: "bob", Size_: 4, Chunks: []metastoretypes.VerifiedChunk{
+				{Index: "txt-0", Size_: 4, Hash: logoHash[:], Height: 3},
+			}},
+		)...,
+	)}))
+
+	// a block already indexed is not applied again
+	require.NoError(t, store.IndexBlock(ctx, Block{ChainID: "metachain", Height: 2, Time: start, Events: typedEvents(t,
+		&metastoretypes.EventStoredMetaDeleted{Index: "other.org/a.txt", Url: "other.org/a.txt", Creator: "bob"},
+	)}))
+
+	search := func(q Query) []string {
+		t.Helper()
+		resources, err := store.Search(ctx, q)
+		require.NoError(t, err)
+		urls := make([]string, 0, len(resources))
+		for _, resource := range resources {
+			urls = append(urls, resource.URL)
+		}
+		return urls
+	}
+	require.Equal(t, []string{"example.com/blog/index.html", "example.com/blog/logo.png", "other.org/a.txt"}, search(Query{}))
+	require.Equal(t, []string{"example.com/blog/index.html", "example.com/blog/logo.png"}, search(Query{Prefix: "example.com/blog/"}))
+	require.Empty(t, search(Query{Prefix: "example.com/blog/z"}))
+	require.Equal(t, []string{"example.com/blog/index.html", "other.org/a.txt"}, search(Query{Creator: "bob"}))
+	require.Equal(t, []string{"example.com/blog/logo.png"}, search(Query{MimeType: "image/*"}))
+	require.Equal(t, []string{"example.com/blog/index.html"}, search(Query{MimeType: "text/html"}))
+	require.Equal(t, []string{"example.com/blog/index.html"}, search(Query{Status: StatusFailed}))
+	require.Equal(t, []string{"example.com/blog/logo.png", "other.org/a.txt"}, search(Query{From: start.Add(time.Minute)}))
+	require.Equal(t, []string{"example.com/blog/index.html"}, search(Query{To: start}))
+	require.Equal(t, []string{"example.com/blog/logo.png"}, search(Query{Limit: 1, Offset: 1}))
+
+	resources, err := store.Resource(ctx, "example.com/blog/logo.png")
+	require.NoError(t, err)
+	require.Equal(t, []Resource{{
+		ChainID:       "metachain",
+		Index:         "example.com/blog/logo.png",
+		URL:           "example.com/blog/logo.png",
+		Creator:       "alice",
+		Status:        StatusRegistered,
+		MimeType:      "image/png",
+		Size:          uint64(len(logo)),
+		CreatedHeight: 2,
+		CreatedTime:   start.Add(time.Hour),
+		UpdatedHeight: 2,
+		UpdatedTime:   start.Add(time.Hour),
+		Fragments: []Fragment{
+			{Index: "logo-0", ConnectionID: "connection-0", Size: uint64(len(logo)), Hash: hex.EncodeToString(logoHash[:]), Datachains: []string{"datachain-0"}},
+		},
+	}}, resources)
+
+	// the cursors survive a restart, and pruned chunks no longer count as stored
+	require.NoError(t, store.Close())
+	store, err = OpenStore(dbPath)
+	require.NoError(t, err)
+	defer store.Close()
+
+	cursor, err := store.Cursor(ctx, "metachain")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), cursor)
+	require.NoError(t, store.IndexBlock(ctx, Block{ChainID: "datachain-0", Height: 8, Time: start, Events: typedEvents(t,
+		&datastoretypes.EventStoredChunkPruned{Index: "logo-0", Creator: "ica-0"},
+	)}))
+
+	server := httptest.NewServer(NewHandler(store))
+	defer server.Close()
+
+	get := func(path string, code int, v any) {
+		t.Helper()
+		res, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, code, res.StatusCode)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(v))
+	}
+
+	var found struct {
+		Resources []Resource `json:"resources"`
+	}
+	get("/resources/example.com/blog/logo.png", http.StatusOK, &found)
+	require.Len(t, found.Resources, 1)
+	require.Empty(t, found.Resources[0].Fragments[0].Datachains)
+
+	get("/resources?prefix=example.com/&creator=alice&from=2025-01-01T00:30:00Z", http.StatusOK, &found)
+	require.Len(t, found.Resources, 1)
+	require.Equal(t, "example.com/blog/logo.png", found.Resources[0].URL)
+
+	var status struct {
+		Chains []Cursor `json:"chains"`
+	}
+	get("/status", http.StatusOK, &status)
+	require.Equal(t, []Cursor{{ChainID: "datachain-0", Height: 8}, {ChainID: "metachain", Height: 2}}, status.Chains)
+
+	var failure map[string]string
+	get("/resources?from=yesterday", http.StatusBadRequest, &failure)
+	get("/resources/missing", http.StatusNotFound, &failure)
+}
+
+func TestPrefixEnd(t *testing.T) {
+	end, ok := prefixEnd("ab")
+	require.True(t, ok)
+	require.Equal(t, "ac", end)
+
+	end, ok = prefixEnd("a\xff")
+	require.True(t, ok)
+	require.Equal(t, "b", end)
+
+	_, ok = prefixEnd("\xff\xff")
+	require.False(t, ok)
+}
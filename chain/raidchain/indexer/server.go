@@ -0,0 +1,108 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+// NewHandler returns the HTTP/JSON API of store:
+//
+//	GET /resources?prefix=&creator=&mime_type=&status=&from=&to=&limit=&offset=
+//	GET /resources/{index}
+//	GET /status
+//
+// from and to are RFC3339 times bounding the creation of the resources. limit defaults to 100
+// and is capped at 1000.
+func NewHandler(store *Store) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /resources", func(w http.ResponseWriter, r *http.Request) {
+		q, err := parseQuery(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		resources, err := store.Search(r.Context(), q)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, map[string]any{"resources": resources})
+	})
+
+	mux.HandleFunc("GET /resources/{index...}", func(w http.ResponseWriter, r *http.Request) {
+		resources, err := store.Resource(r.Context(), r.PathValue("index"))
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		if len(resources) == 0 {
+			writeError(w, http.StatusNotFound, fmt.Errorf("resource %s not found", r.PathValue("index")))
+			return
+		}
+		writeJSON(w, map[string]any{"resources": resources})
+	})
+
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, r *http.Request) {
+		cursors, err := store.Cursors(r.Context())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, map[string]any{"chains": cursors})
+	})
+
+	return mux
+}
+
+func parseQuery(r *http.Request) (Query, error) {
+	values := r.URL.Query()
+	q := Query{
+		Prefix:   values.Get("prefix"),
+		Creator:  values.Get("creator"),
+		MimeType: values.Get("mime_type"),
+		Status:   values.Get("status"),
+	}
+
+	var err error
+	for name, t := range map[string]*time.Time{"from": &q.From, "to": &q.To} {
+		if value := values.Get(name); value != "" {
+			if *t, err = time.Parse(time.RFC3339, value); err != nil {
+				return Query{}, fmt.Errorf("invalid %s: %w", name, err)
+			}
+		}
+	}
+	for name, n := range map[string]*int{"limit": &q.Limit, "offset": &q.Offset} {
+		if value := values.Get(name); value != "" {
+			if *n, err = strconv.Atoi(value); err != nil || *n < 0 {
+				return Query{}, fmt.Errorf("invalid %s: %q", name, value)
+			}
+		}
+	}
+
+	if q.Limit == 0 {
+		q.Limit = defaultLimit
+	}
+	q.Limit = min(q.Limit, maxLimit)
+
+	return q, nil
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,406 @@
+// Package indexer follows the metastore and datastore events of a metachain and its datachains
+// into a SQLite database, and serves searches over the resources it found. It keeps the height
+// it indexed each chain up to, so a restarted indexer picks up where it stopped.
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/mattn/go-sqlite3" // registers the sqlite3 driver
+)
+
+// Resource statuses.
+const (
+	// StatusPending is a resource whose chunks are being uploaded to datachains.
+	StatusPending = "pending"
+	// StatusRegistered is a resource whose metadata is stored on the metachain.
+	StatusRegistered = "registered"
+	// StatusFailed is an upload a datachain rejected or timed out.
+	StatusFailed = "failed"
+	// StatusDeleted is a resource whose metadata was deleted.
+	StatusDeleted = "deleted"
+)
+
+// Chunk statuses.
+const (
+	ChunkStored  = "stored"
+	ChunkDeleted = "deleted"
+	ChunkPruned  = "pruned"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS cursors (
+	chain_id TEXT PRIMARY KEY,
+	height   INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS resources (
+	chain_id       TEXT NOT NULL,
+	idx            TEXT NOT NULL,
+	url            TEXT NOT NULL,
+	creator        TEXT NOT NULL,
+	status         TEXT NOT NULL,
+	mime_type      TEXT NOT NULL,
+	size           INTEGER NOT NULL,
+	created_height INTEGER NOT NULL,
+	created_time   INTEGER NOT NULL,
+	updated_height INTEGER NOT NULL,
+	updated_time   INTEGER NOT NULL,
+	PRIMARY KEY (chain_id, idx)
+);
+CREATE INDEX IF NOT EXISTS resources_url ON resources (url);
+CREATE INDEX IF NOT EXISTS resources_creator ON resources (creator);
+CREATE INDEX IF NOT EXISTS resources_mime_type ON resources (mime_type);
+CREATE INDEX IF NOT EXISTS resources_created_time ON resources (created_time);
+
+CREATE TABLE IF NOT EXISTS fragments (
+	chain_id      TEXT NOT NULL,
+	resource      TEXT NOT NULL,
+	position      INTEGER NOT NULL,
+	chunk_index   TEXT NOT NULL,
+	connection_id TEXT NOT NULL,
+	size          INTEGER NOT NULL,
+	hash          TEXT NOT NULL,
+	PRIMARY KEY (chain_id, resource, position)
+);
+CREATE INDEX IF NOT EXISTS fragments_chunk_index ON fragments (chunk_index);
+
+CREATE TABLE IF NOT EXISTS chunks (
+	chain_id       TEXT NOT NULL,
+	chunk_index    TEXT NOT NULL,
+	creator        TEXT NOT NULL,
+	size           INTEGER NOT NULL,
+	hash           TEXT NOT NULL,
+	status         TEXT NOT NULL,
+	updated_height INTEGER NOT NULL,
+	updated_time   INTEGER NOT NULL,
+	PRIMARY KEY (chain_id, chunk_index)
+);
+`
+
+// Block holds the events of a committed block of one chain.
+type Block struct {
+	ChainID string
+	Height  int64
+	Time    time.Time
+	Events  []abci.Event
+}
+
+// Resource is a metastore entry, or an upload that did not store one yet.
+type Resource struct {
+	ChainID       string     `json:"chain_id"`
+	Index         string     `json:"index"`
+	URL           string     `json:"url"`
+	Creator       string     `json:"creator"`
+	Status        string     `json:"status"`
+	MimeType      string     `json:"mime_type"`
+	Size          uint64     `json:"size"`
+	CreatedHeight int64      `json:"created_height"`
+	CreatedTime   time.Time  `json:"created_time"`
+	UpdatedHeight int64      `json:"updated_height"`
+	UpdatedTime   time.Time  `json:"updated_time"`
+	Fragments     []Fragment `json:"fragments,omitempty"`
+}
+
+// Fragment is a chunk of a resource, in the order the resource lists them.
+type Fragment struct {
+	Index        string `json:"index"`
+	ConnectionID string `json:"connection_id,omitempty"`
+	Size         uint64 `json:"size"`
+	Hash         string `json:"hash"`
+	// Datachains are the chains the indexer saw store the chunk, and which did not delete it.
+	Datachains []string `json:"datachains"`
+}
+
+// Cursor is the last height indexed on a chain.
+type Cursor struct {
+	ChainID string `json:"chain_id"`
+	Height  int64  `json:"height"`
+}
+
+// Query selects resources. Empty fields match everything.
+type Query struct {
+	// Prefix matches the resources whose url starts with it.
+	Prefix  string
+	Creator string
+	// MimeType matches a type exactly, or a whole family when it ends with "/*", like "image/*".
+	MimeType string
+	Status   string
+	// From and To bound the creation time of the resources, both inclusive.
+	From   time.Time
+	To     time.Time
+	Limit  int
+	Offset int
+}
+
+// Store is the SQLite database of the indexer.
+type Store struct {
+	db *sql.DB
+}
+
+// OpenStore opens, or creates, the database at path.
+func OpenStore(path string) (*Store, error) {
+	db, err := sql.Open("sqlite3", "file:"+path+"?_journal_mode=WAL&_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Cursor returns the last height indexed on chainID, 0 if none was.
+func (s *Store) Cursor(ctx context.Context, chainID string) (int64, error) {
+	var height int64
+	err := s.db.QueryRowContext(ctx, `SELECT height FROM cursors WHERE chain_id = ?`, chainID).Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// Cursors returns the last height indexed on every chain.
+func (s *Store) Cursors(ctx context.Context) ([]Cursor, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT chain_id, height FROM cursors ORDER BY chain_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cursors := []Cursor{}
+	for rows.Next() {
+		var cursor Cursor
+		if err := rows.Scan(&cursor.ChainID, &cursor.Height); err != nil {
+			return nil, err
+		}
+		cursors = append(cursors, cursor)
+	}
+	return cursors, rows.Err()
+}
+
+// IndexBlock applies the events of block and moves the cursor of its chain to it, in one
+// transaction. Blocks at or below the cursor are ignored, so a block is never applied twice.
+func (s *Store) IndexBlock(ctx context.Context, block Block) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // a no-op once committed
+
+	var cursor int64
+	err = tx.QueryRowContext(ctx, `SELECT height FROM cursors WHERE chain_id = ?`, block.ChainID).Scan(&cursor)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if block.Height <= cursor {
+		return nil
+	}
+
+	for _, event := range block.Events {
+		if err := applyEvent(ctx, tx, block, event); err != nil {
+			return fmt.Errorf("failed to index %s event at height %d of %s: %w", event.Type, block.Height, block.ChainID, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO cursors (chain_id, height) VALUES (?, ?)
+		ON CONFLICT (chain_id) DO UPDATE SET height = excluded.height`,
+		block.ChainID, block.Height,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Search returns the resources matching q, ordered by url, without their fragments.
+func (s *Store) Search(ctx context.Context, q Query) ([]Resource, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	if q.Prefix != "" {
+		conds = append(conds, "url >= ?")
+		args = append(args, q.Prefix)
+		if end, ok := prefixEnd(q.Prefix); ok {
+			conds = append(conds, "url < ?")
+			args = append(args, end)
+		}
+	}
+	if q.Creator != "" {
+		conds = append(conds, "creator = ?")
+		args = append(args, q.Creator)
+	}
+	if family, ok := strings.CutSuffix(q.MimeType, "/*"); ok {
+		conds = append(conds, "mime_type >= ? AND mime_type < ?")
+		args = append(args, family+"/", family+"0") // '0' follows '/'
+	} else if q.MimeType != "" {
+		conds = append(conds, "mime_type = ?")
+		args = append(args, q.MimeType)
+	}
+	if q.Status != "" {
+		conds = append(conds, "status = ?")
+		args = append(args, q.Status)
+	}
+	if !q.From.IsZero() {
+		conds = append(conds, "created_time >= ?")
+		args = append(args, q.From.UnixNano())
+	}
+	if !q.To.IsZero() {
+		conds = append(conds, "created_time <= ?")
+		args = append(args, q.To.UnixNano())
+	}
+
+	query := `SELECT ` + resourceColumns + ` FROM resources`
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += ` ORDER BY url, chain_id LIMIT ? OFFSET ?`
+	limit := q.Limit
+	if limit <= 0 {
+		limit = -1 // no limit
+	}
+	args = append(args, limit, q.Offset)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resources := []Resource{}
+	for rows.Next() {
+		resource, err := scanResource(rows)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+	return resources, rows.Err()
+}
+
+// Resource returns the resources stored under index, on every indexed metachain, with their
+// fragments.
+func (s *Store) Resource(ctx context.Context, index string) ([]Resource, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+resourceColumns+` FROM resources WHERE idx = ? ORDER BY chain_id`, index)
+	if err != nil {
+		return nil, err
+	}
+	resources := []Resource{}
+	for rows.Next() {
+		resource, err := scanResource(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+	if err := errors.Join(rows.Err(), rows.Close()); err != nil {
+		return nil, err
+	}
+
+	for i := range resources {
+		if resources[i].Fragments, err = s.fragments(ctx, resources[i].ChainID, index); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func (s *Store) fragments(ctx context.Context, chainID, resource string) ([]Fragment, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT f.chunk_index, f.connection_id, f.size, f.hash, coalesce(group_concat(c.chain_id), '')
+		FROM fragments f LEFT JOIN chunks c ON c.chunk_index = f.chunk_index AND c.status = ?
+		WHERE f.chain_id = ? AND f.resource = ?
+		GROUP BY f.position ORDER BY f.position`,
+		ChunkStored, chainID, resource,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fragments []Fragment
+	for rows.Next() {
+		var (
+			fragment   Fragment
+			datachains string
+		)
+		if err := rows.Scan(&fragment.Index, &fragment.ConnectionID, &fragment.Size, &fragment.Hash, &datachains); err != nil {
+			return nil, err
+		}
+		fragment.Datachains = []string{}
+		if datachains != "" {
+			fragment.Datachains = strings.Split(datachains, ",")
+		}
+		fragments = append(fragments, fragment)
+	}
+	return fragments, rows.Err()
+}
+
+const resourceColumns = `chain_id, idx, url, creator, status, mime_type, size,
+	created_height, created_time, updated_height, updated_time`
+
+func scanResource(rows *sql.Rows) (Resource, error) {
+	var (
+		resource                 Resource
+		createdTime, updatedTime int64
+	)
+	err := rows.Scan(
+		&resource.ChainID, &resource.Index, &resource.URL, &resource.Creator, &resource.Status,
+		&resource.MimeType, &resource.Size,
+		&resource.CreatedHeight, &createdTime, &resource.UpdatedHeight, &updatedTime,
+	)
+	resource.CreatedTime = time.Unix(0, createdTime).UTC()
+	resource.UpdatedTime = time.Unix(0, updatedTime).UTC()
+	return resource, err
+}
+
+// prefixEnd returns the smallest string greater than every string starting with prefix, and
+// false if there is none.
+func prefixEnd(prefix string) (string, bool) {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1]), true
+		}
+	}
+	return "", false
+}
+
+// mimeType guesses the media type of a resource from the extension of its url, since the
+// metastore does not record one. It is empty when the extension is unknown.
+func mimeType(rawURL string) string {
+	p := rawURL
+	if u, err := url.Parse(rawURL); err == nil && u.Path != "" {
+		p = u.Path
+	}
+	mediaType, _, err := mime.ParseMediaType(mime.TypeByExtension(path.Ext(p)))
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+func hexHash(hash []byte) string {
+	return hex.EncodeToString(hash)
+}
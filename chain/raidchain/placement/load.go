@@ -0,0 +1,149 @@
+package placement
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+)
+
+// Datachain is a datachain chunks can be placed on: the connection the metachain reaches it
+// over, the CometBFT RPC endpoint of one of its nodes, and the bytes it is meant to hold, 0 for
+// no bound.
+type Datachain struct {
+	ConnectionID string
+	RPC          string
+	Capacity     uint64
+}
+
+// ParseDatachain parses a datachain given as connection-id=rpc-address, optionally followed by
+// @capacity in bytes.
+func ParseDatachain(s string) (Datachain, error) {
+	connectionID, rpcAddr, ok := strings.Cut(s, "=")
+	if !ok || connectionID == "" || rpcAddr == "" {
+		return Datachain{}, fmt.Errorf("invalid datachain %q, expected connection-id=rpc-address[@capacity]", s)
+	}
+
+	datachain := Datachain{ConnectionID: connectionID, RPC: rpcAddr}
+	if i := strings.LastIndex(rpcAddr, "@"); i >= 0 {
+		capacity, err := strconv.ParseUint(rpcAddr[i+1:], 10, 64)
+		if err != nil {
+			return Datachain{}, fmt.Errorf("invalid capacity of datachain %q: %w", s, err)
+		}
+		datachain.RPC, datachain.Capacity = rpcAddr[:i], capacity
+	}
+	return datachain, nil
+}
+
+// QueryLoad queries the chain id and latest block time of the node of datachain, and the chunks
+// and bytes its datastore holds.
+func QueryLoad(ctx context.Context, datachain Datachain) (metastoretypes.DatachainLoad, error) {
+	rpc, err := client.NewClientFromNode(datachain.RPC)
+	if err != nil {
+		return metastoretypes.DatachainLoad{}, fmt.Errorf("failed to connect to %s: %w", datachain.RPC, err)
+	}
+	status, err := rpc.Status(ctx)
+	if err != nil {
+		return metastoretypes.DatachainLoad{}, fmt.Errorf("failed to query the status of %s: %w", datachain.RPC, err)
+	}
+
+	res, err := datastoreQueryClient(rpc).StorageUsage(ctx, &datastoretypes.QueryStorageUsageRequest{})
+	if err != nil {
+		return metastoretypes.DatachainLoad{}, fmt.Errorf("failed to query the storage usage of %s: %w", status.NodeInfo.Network, err)
+	}
+
+	return metastoretypes.DatachainLoad{
+		ConnectionId:    datachain.ConnectionID,
+		ChainId:         status.NodeInfo.Network,
+		Chunks:          res.Usage.Chunks,
+		Bytes:           res.Usage.Bytes,
+		Capacity:        datachain.Capacity,
+		LatestBlockTime: status.SyncInfo.LatestBlockTime.UTC(),
+	}, nil
+}
+
+// FetchChunk returns the data of the chunk index datachain stores, decompressed.
+func FetchChunk(ctx context.Context, datachain Datachain, index string) ([]byte, error) {
+	rpc, err := client.NewClientFromNode(datachain.RPC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", datachain.RPC, err)
+	}
+	res, err := datastoreQueryClient(rpc).GetStoredChunk(ctx, &datastoretypes.QueryGetStoredChunkRequest{Index: index})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query chunk %s on %s: %w", index, datachain.ConnectionID, err)
+	}
+	return res.StoredChunk.Data, nil
+}
+
+func datastoreQueryClient(rpc client.CometRPC) datastoretypes.QueryClient {
+	clientCtx := client.Context{}.
+		WithClient(rpc).
+		WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	return datastoretypes.NewQueryClient(clientCtx)
+}
+
+// QueryLoads queries the load of every datachain.
+func QueryLoads(ctx context.Context, datachains []Datachain) ([]metastoretypes.DatachainLoad, error) {
+	loads := make([]metastoretypes.DatachainLoad, 0, len(datachains))
+	for _, datachain := range datachains {
+		load, err := QueryLoad(ctx, datachain)
+		if err != nil {
+			return nil, err
+		}
+		loads = append(loads, load)
+	}
+	return loads, nil
+}
+
+// Healthy returns the loads of the datachains that produced a block within maxBlockAge of now.
+// A datachain that stopped producing blocks would leave the upload waiting for its ack.
+func Healthy(loads []metastoretypes.DatachainLoad, now time.Time, maxBlockAge time.Duration) []metastoretypes.DatachainLoad {
+	healthy := make([]metastoretypes.DatachainLoad, 0, len(loads))
+	for _, load := range loads {
+		if now.Sub(load.LatestBlockTime) <= maxBlockAge {
+			healthy = append(healthy, load)
+		}
+	}
+	return healthy
+}
+
+// Place assigns chunks of sizes to the healthy datachains of loads with policy, and returns
+// the placement to record along with the connection of each chunk. A stripe width of 0 makes
+// stripes as wide as the healthy datachains.
+func Place(
+	policy PlacementPolicy,
+	stripeWidth uint32,
+	sizes []uint64,
+	loads []metastoretypes.DatachainLoad,
+	now time.Time,
+	maxBlockAge time.Duration,
+) (metastoretypes.Placement, []string, error) {
+	datachains := Healthy(loads, now, maxBlockAge)
+	if len(datachains) == 0 {
+		return metastoretypes.Placement{}, nil, fmt.Errorf("none of the %d datachains produced a block in the last %s", len(loads), maxBlockAge)
+	}
+	sortDatachains(datachains)
+	if stripeWidth == 0 {
+		stripeWidth = uint32(len(datachains))
+	}
+
+	p := metastoretypes.Placement{
+		Policy:      policy.Name(),
+		StripeWidth: stripeWidth,
+		Datachains:  datachains,
+		ChunkSizes:  sizes,
+	}
+	connections, err := policy.Place(p)
+	if err != nil {
+		return metastoretypes.Placement{}, nil, err
+	}
+	return p, connections, nil
+}
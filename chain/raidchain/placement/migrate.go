@@ -0,0 +1,30 @@
+package placement
+
+import (
+	"fmt"
+
+	metastoretypes "metachain/x/metastore/types"
+)
+
+// MigrationTarget returns the position in loads of the datachain a chunk of size bytes is moved
+// to: the one storing the fewest bytes among those holding no chunk of its stripe, skipping the
+// connection it is moved off. The chunk fits on a datachain of bounded capacity only if it leaves
+// some. loads are sorted by connection id, which breaks ties.
+func MigrationTarget(loads []metastoretypes.DatachainLoad, from string, stripe map[string]bool, size uint64) (int, error) {
+	best := -1
+	for i, load := range loads {
+		if load.ConnectionId == from || stripe[load.ConnectionId] {
+			continue
+		}
+		if load.Capacity != 0 && load.Bytes+size > load.Capacity {
+			continue
+		}
+		if best < 0 || load.Bytes < loads[best].Bytes {
+			best = i
+		}
+	}
+	if best < 0 {
+		return -1, fmt.Errorf("none of the %d datachains can take a chunk of %d bytes without holding two chunks of its stripe", len(loads), size)
+	}
+	return best, nil
+}
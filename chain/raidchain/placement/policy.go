@@ -0,0 +1,206 @@
+// Package placement decides which datachain each chunk of an upload is written to. A policy only
+// reads the metastore Placement it is given, which the uploader records in the manifest of the
+// resource, so that a repair job can place the chunks again and find the same datachains.
+package placement
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+	"sort"
+	"strings"
+
+	metastoretypes "metachain/x/metastore/types"
+)
+
+// Names of the policies.
+const (
+	RoundRobin         = "round-robin"
+	LeastUsedBytes     = "least-used-bytes"
+	WeightedByCapacity = "weighted-by-capacity"
+	SpreadStripes      = "spread-stripes"
+)
+
+// PlacementPolicy assigns chunks to datachains.
+type PlacementPolicy interface {
+	// Name is the name the policy is recorded under.
+	Name() string
+	// Place returns the connection id of the datachain of each chunk of p, in order. It depends on
+	// nothing but p: placing the same chunks on the same datachains gives the same connections.
+	Place(p metastoretypes.Placement) ([]string, error)
+}
+
+// Policies are the policies by name.
+var Policies = map[string]PlacementPolicy{
+	RoundRobin:         roundRobin{},
+	LeastUsedBytes:     leastUsedBytes{},
+	WeightedByCapacity: weightedByCapacity{},
+	SpreadStripes:      spreadStripes{},
+}
+
+// PolicyNames returns the names of the policies, sorted.
+func PolicyNames() []string {
+	names := make([]string, 0, len(Policies))
+	for name := range Policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Policy returns the policy called name.
+func Policy(name string) (PlacementPolicy, error) {
+	policy, ok := Policies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown placement policy %q, expected one of %v", name, PolicyNames())
+	}
+	return policy, nil
+}
+
+// Reproduce places the chunks of a recorded placement again with the policy it names.
+func Reproduce(p metastoretypes.Placement) ([]string, error) {
+	policy, err := Policy(p.Policy)
+	if err != nil {
+		return nil, err
+	}
+	return policy.Place(p)
+}
+
+// roundRobin deals the chunks to the datachains in turn.
+type roundRobin struct{}
+
+func (roundRobin) Name() string { return RoundRobin }
+
+func (roundRobin) Place(p metastoretypes.Placement) ([]string, error) {
+	if len(p.Datachains) == 0 {
+		return nil, errNoDatachains
+	}
+
+	connections := make([]string, len(p.ChunkSizes))
+	for i := range p.ChunkSizes {
+		connections[i] = p.Datachains[i%len(p.Datachains)].ConnectionId
+	}
+	return connections, nil
+}
+
+// leastUsedBytes writes each chunk to the datachain storing the fewest bytes, counting the chunks
+// placed before it.
+type leastUsedBytes struct{}
+
+func (leastUsedBytes) Name() string { return LeastUsedBytes }
+
+func (leastUsedBytes) Place(p metastoretypes.Placement) ([]string, error) {
+	if len(p.Datachains) == 0 {
+		return nil, errNoDatachains
+	}
+
+	used := usedBytes(p.Datachains)
+	connections := make([]string, len(p.ChunkSizes))
+	for i, size := range p.ChunkSizes {
+		best := leastUsed(used, nil)
+		connections[i] = p.Datachains[best].ConnectionId
+		used[best] += size
+	}
+	return connections, nil
+}
+
+// weightedByCapacity writes each chunk to the datachain left with the smallest share of its
+// capacity used, so that datachains fill up in proportion to their capacity. Datachains of
+// unbounded capacity, and those the chunk does not fit on, are passed over.
+type weightedByCapacity struct{}
+
+func (weightedByCapacity) Name() string { return WeightedByCapacity }
+
+func (weightedByCapacity) Place(p metastoretypes.Placement) ([]string, error) {
+	used := usedBytes(p.Datachains)
+	connections := make([]string, len(p.ChunkSizes))
+	for i, size := range p.ChunkSizes {
+		best := -1
+		for j, datachain := range p.Datachains {
+			if datachain.Capacity == 0 || used[j]+size > datachain.Capacity {
+				continue
+			}
+			// compare (used + size) / capacity across datachains without rounding
+			if best < 0 || lessRatio(used[j]+size, datachain.Capacity, used[best]+size, p.Datachains[best].Capacity) {
+				best = j
+			}
+		}
+		if best < 0 {
+			return nil, fmt.Errorf("no datachain has the capacity left for chunk %d of %d bytes", i, size)
+		}
+		connections[i] = p.Datachains[best].ConnectionId
+		used[best] += size
+	}
+	return connections, nil
+}
+
+// spreadStripes never writes two chunks of a stripe to the same datachain, so that losing one
+// datachain costs each stripe at most one chunk. Within a stripe, chunks go to the datachain
+// storing the fewest bytes among those the stripe does not use yet.
+type spreadStripes struct{}
+
+func (spreadStripes) Name() string { return SpreadStripes }
+
+func (spreadStripes) Place(p metastoretypes.Placement) ([]string, error) {
+	width := int(p.StripeWidth)
+	if width == 0 {
+		return nil, fmt.Errorf("%s needs a stripe width", SpreadStripes)
+	}
+	if width > len(p.Datachains) {
+		return nil, fmt.Errorf("stripes of %d chunks do not fit on %d distinct datachains", width, len(p.Datachains))
+	}
+
+	used := usedBytes(p.Datachains)
+	connections := make([]string, len(p.ChunkSizes))
+	taken := make([]bool, len(p.Datachains))
+	for i, size := range p.ChunkSizes {
+		if i%width == 0 {
+			clear(taken)
+		}
+		best := leastUsed(used, taken)
+		connections[i] = p.Datachains[best].ConnectionId
+		used[best] += size
+		taken[best] = true
+	}
+	return connections, nil
+}
+
+var errNoDatachains = errors.New("no datachain to place chunks on")
+
+func usedBytes(datachains []metastoretypes.DatachainLoad) []uint64 {
+	used := make([]uint64, len(datachains))
+	for i, datachain := range datachains {
+		used[i] = datachain.Bytes
+	}
+	return used
+}
+
+// leastUsed returns the index of the least used datachain that is not skipped, the first one on
+// ties.
+func leastUsed(used []uint64, skip []bool) int {
+	best := -1
+	for i := range used {
+		if skip != nil && skip[i] {
+			continue
+		}
+		if best < 0 || used[i] < used[best] {
+			best = i
+		}
+	}
+	return best
+}
+
+// lessRatio reports whether a/b < c/d.
+func lessRatio(a, b, c, d uint64) bool {
+	hi1, lo1 := bits.Mul64(a, d)
+	hi2, lo2 := bits.Mul64(c, b)
+	return hi1 < hi2 || (hi1 == hi2 && lo1 < lo2)
+}
+
+// sortDatachains orders datachains by connection id, the order policies break ties in.
+func sortDatachains(datachains []metastoretypes.DatachainLoad) {
+	slices.SortFunc(datachains, func(a, b metastoretypes.DatachainLoad) int {
+		return strings.Compare(a.ConnectionId, b.ConnectionId)
+	})
+}
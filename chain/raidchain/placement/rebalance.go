@@ -0,0 +1,151 @@
+package placement
+
+import (
+	"math/bits"
+	"slices"
+	"strings"
+
+	metastoretypes "metachain/x/metastore/types"
+)
+
+// Move is the move of a fragment of a manifest from one datachain to another.
+type Move struct {
+	Index string `json:"index"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Size  uint64 `json:"size"`
+}
+
+// FileMoves are the moves of the fragments of one manifest, which the metastore applies together.
+type FileMoves struct {
+	URL   string `json:"url"`
+	Moves []Move `json:"moves"`
+}
+
+// Share is the bytes a datachain stores, the bytes it is meant to store, and the bytes it stores
+// once the plan is carried out.
+type Share struct {
+	ConnectionID string `json:"connection_id"`
+	Bytes        uint64 `json:"bytes"`
+	Target       uint64 `json:"target"`
+	Planned      uint64 `json:"planned"`
+}
+
+// RebalancePlan is what the rebalancer does: the share of each datachain and the moves, by
+// manifest.
+type RebalancePlan struct {
+	Datachains []Share     `json:"datachains"`
+	Files      []FileMoves `json:"files"`
+	Bytes      uint64      `json:"bytes"`
+}
+
+// Targets returns the bytes each datachain of loads is meant to store: the bytes they store in
+// total, split in proportion to their capacity when all of them have one, evenly otherwise.
+func Targets(loads []metastoretypes.DatachainLoad) []uint64 {
+	var total, capacity uint64
+	bounded := true
+	for _, load := range loads {
+		total += load.Bytes
+		capacity += load.Capacity
+		bounded = bounded && load.Capacity > 0
+	}
+
+	targets := make([]uint64, len(loads))
+	if len(loads) == 0 {
+		return targets
+	}
+	var assigned uint64
+	for i, load := range loads {
+		if bounded {
+			// total * capacity / sum of capacities, without overflowing
+			hi, lo := bits.Mul64(total, load.Capacity)
+			targets[i], _ = bits.Div64(hi, lo, capacity)
+		} else {
+			targets[i] = total / uint64(len(loads))
+		}
+		assigned += targets[i]
+	}
+	// the bytes rounding left out go to the first datachains
+	for i := 0; assigned < total; i = (i + 1) % len(targets) {
+		targets[i]++
+		assigned++
+	}
+	return targets
+}
+
+// PlanRebalance plans the moves bringing the datachains of loads closer to their target. It goes
+// once over the fragments of manifests that live on a datachain storing more than its target,
+// and moves each to the datachain furthest below its target that holds no chunk of its stripe,
+// if that narrows the gap between the two. The moves stop before they add up to more than
+// maxBytes, 0 for no bound. loads are sorted by connection id.
+func PlanRebalance(loads []metastoretypes.DatachainLoad, manifests []metastoretypes.StoredMeta, maxBytes uint64) RebalancePlan {
+	targets := Targets(loads)
+	used := usedBytes(loads)
+	position := make(map[string]int, len(loads))
+	for i, load := range loads {
+		position[load.ConnectionId] = i
+	}
+
+	manifests = slices.Clone(manifests)
+	slices.SortFunc(manifests, func(a, b metastoretypes.StoredMeta) int {
+		return strings.Compare(a.Index, b.Index)
+	})
+
+	var plan RebalancePlan
+	for _, meta := range manifests {
+		// moves are planned on a copy of the manifest, so that the stripe of a later fragment
+		// sees where the earlier ones went
+		meta.UploadedChunks = slices.Clone(meta.UploadedChunks)
+		file := FileMoves{URL: meta.Index}
+		for _, fragment := range meta.DatachainFragments() {
+			from, ok := position[fragment.ConnectionId]
+			if !ok || used[from] <= targets[from] {
+				continue
+			}
+			if maxBytes != 0 && plan.Bytes+fragment.Size_ > maxBytes {
+				continue
+			}
+
+			stripe := meta.StripeConnections(fragment.Index)
+			to := -1
+			for i, load := range loads {
+				if i == from || stripe[load.ConnectionId] || used[i] >= targets[i] {
+					continue
+				}
+				if load.Capacity != 0 && used[i]+fragment.Size_ > load.Capacity {
+					continue
+				}
+				if to < 0 || targets[i]-used[i] > targets[to]-used[to] {
+					to = i
+				}
+			}
+			if to < 0 || used[to]+fragment.Size_ >= used[from] {
+				continue
+			}
+
+			meta.MoveFragment(fragment.Index, loads[to].ConnectionId)
+			used[from] -= fragment.Size_
+			used[to] += fragment.Size_
+			plan.Bytes += fragment.Size_
+			file.Moves = append(file.Moves, Move{
+				Index: fragment.Index,
+				From:  fragment.ConnectionId,
+				To:    loads[to].ConnectionId,
+				Size:  fragment.Size_,
+			})
+		}
+		if len(file.Moves) > 0 {
+			plan.Files = append(plan.Files, file)
+		}
+	}
+
+	for i, load := range loads {
+		plan.Datachains = append(plan.Datachains, Share{
+			ConnectionID: load.ConnectionId,
+			Bytes:        load.Bytes,
+			Target:       targets[i],
+			Planned:      used[i],
+		})
+	}
+	return plan
+}
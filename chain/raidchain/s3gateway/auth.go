@@ -0,0 +1,381 @@
+package s3gateway
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm  = "AWS4-HMAC-SHA256"
+	amzDateFormat     = "20060102T150405Z"
+	maxClockSkew      = 15 * time.Minute
+	maxPresignExpires = 7 * 24 * time.Hour
+
+	unsignedPayload          = "UNSIGNED-PAYLOAD"
+	streamingPayload         = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	streamingUnsignedTrailer = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+
+	// maxStreamingChunk bounds the chunks of an aws-chunked body, which are held in memory to
+	// check their signature
+	maxStreamingChunk = 16 << 20
+)
+
+// emptySHA256 is the hex SHA-256 digest of no data.
+var emptySHA256 = hex.EncodeToString(sha256.New().Sum(nil))
+
+// signature is a signature v4 of a request, from its Authorization header or its query.
+type signature struct {
+	accessKeyID   string
+	scope         string
+	signedHeaders []string
+	signature     string
+	amzDate       string
+	payloadHash   string
+	presigned     bool
+	expires       time.Duration
+}
+
+// authenticate checks the signature v4 of r against the credentials of the gateway and replaces
+// the body of r with one that fails to read once it does not match the payload hash the
+// signature covers.
+func (g *Gateway) authenticate(r *http.Request) error {
+	sig, err := parseSignature(r)
+	if err != nil {
+		return err
+	}
+
+	secret, ok := g.config.Credentials[sig.accessKeyID]
+	if !ok {
+		return newError(http.StatusForbidden, "InvalidAccessKeyId", "the access key id %s is not known", sig.accessKeyID)
+	}
+	date, region, service, err := parseScope(sig.scope)
+	if err != nil {
+		return err
+	}
+	if region != g.config.Region || service != "s3" {
+		return newError(http.StatusBadRequest, "AuthorizationHeaderMalformed", "the credential is scoped to %s/%s, expected %s/s3", region, service, g.config.Region)
+	}
+	signedAt, err := time.Parse(amzDateFormat, sig.amzDate)
+	if err != nil || sig.amzDate[:8] != date {
+		return newError(http.StatusForbidden, "AccessDenied", "invalid X-Amz-Date %q", sig.amzDate)
+	}
+
+	now := g.now()
+	switch {
+	case sig.presigned && now.Before(signedAt.Add(-maxClockSkew)):
+		return newError(http.StatusForbidden, "AccessDenied", "the request is not valid before %s", signedAt)
+	case sig.presigned && now.After(signedAt.Add(sig.expires)):
+		return newError(http.StatusForbidden, "AccessDenied", "the request expired at %s", signedAt.Add(sig.expires))
+	case !sig.presigned && (now.Sub(signedAt) > maxClockSkew || signedAt.Sub(now) > maxClockSkew):
+		return newError(http.StatusForbidden, "RequestTimeTooSkewed", "the request was signed at %s", signedAt)
+	}
+
+	key := signingKey(secret, date, region, service)
+	stringToSign := strings.Join([]string{signingAlgorithm, sig.amzDate, sig.scope, hexSHA256([]byte(canonicalRequest(r, sig)))}, "\n")
+	expected := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	if !hmac.Equal([]byte(expected), []byte(sig.signature)) {
+		return newError(http.StatusForbidden, "SignatureDoesNotMatch", "the signature of the request does not match the one computed with the secret of %s", sig.accessKeyID)
+	}
+
+	switch sig.payloadHash {
+	case unsignedPayload:
+	case streamingPayload, streamingUnsignedTrailer:
+		if err := g.decodeAWSChunked(r, sig, key); err != nil {
+			return err
+		}
+	default:
+		want, err := hex.DecodeString(sig.payloadHash)
+		if err != nil || len(want) != sha256.Size {
+			return newError(http.StatusBadRequest, "InvalidArgument", "unsupported x-amz-content-sha256 %q", sig.payloadHash)
+		}
+		r.Body = io.NopCloser(&hashReader{r: r.Body, hash: sha256.New(), want: want})
+	}
+	return nil
+}
+
+// parseSignature reads the signature of r from its Authorization header, or from its query when
+// it is presigned.
+func parseSignature(r *http.Request) (signature, error) {
+	query := r.URL.Query()
+	if query.Has("X-Amz-Algorithm") {
+		if algorithm := query.Get("X-Amz-Algorithm"); algorithm != signingAlgorithm {
+			return signature{}, newError(http.StatusBadRequest, "AuthorizationQueryParametersError", "unsupported X-Amz-Algorithm %q", algorithm)
+		}
+		accessKeyID, scope, _ := strings.Cut(query.Get("X-Amz-Credential"), "/")
+		expires, err := strconv.ParseInt(query.Get("X-Amz-Expires"), 10, 64)
+		if err != nil || expires < 0 || time.Duration(expires)*time.Second > maxPresignExpires {
+			return signature{}, newError(http.StatusBadRequest, "AuthorizationQueryParametersError", "invalid X-Amz-Expires %q", query.Get("X-Amz-Expires"))
+		}
+		sig := signature{
+			accessKeyID:   accessKeyID,
+			scope:         scope,
+			signedHeaders: strings.Split(query.Get("X-Amz-SignedHeaders"), ";"),
+			signature:     query.Get("X-Amz-Signature"),
+			amzDate:       query.Get("X-Amz-Date"),
+			payloadHash:   unsignedPayload,
+			presigned:     true,
+			expires:       time.Duration(expires) * time.Second,
+		}
+		if hash := r.Header.Get("X-Amz-Content-Sha256"); hash != "" {
+			sig.payloadHash = hash
+		}
+		return sig, nil
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		return signature{}, newError(http.StatusForbidden, "AccessDenied", "the request is not signed")
+	}
+	algorithm, fields, _ := strings.Cut(authorization, " ")
+	if algorithm != signingAlgorithm {
+		return signature{}, newError(http.StatusBadRequest, "AuthorizationHeaderMalformed", "unsupported algorithm %q, only %s is", algorithm, signingAlgorithm)
+	}
+	var sig signature
+	for _, field := range strings.Split(fields, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+		switch name {
+		case "Credential":
+			sig.accessKeyID, sig.scope, _ = strings.Cut(value, "/")
+		case "SignedHeaders":
+			sig.signedHeaders = strings.Split(value, ";")
+		case "Signature":
+			sig.signature = value
+		}
+	}
+	if sig.accessKeyID == "" || len(sig.signedHeaders) == 0 || sig.signature == "" {
+		return signature{}, newError(http.StatusBadRequest, "AuthorizationHeaderMalformed", "the Authorization header needs a Credential, SignedHeaders and a Signature")
+	}
+	sig.amzDate = r.Header.Get("X-Amz-Date")
+	sig.payloadHash = r.Header.Get("X-Amz-Content-Sha256")
+	if sig.payloadHash == "" {
+		return signature{}, newError(http.StatusBadRequest, "MissingSecurityHeader", "the x-amz-content-sha256 header is required")
+	}
+	return sig, nil
+}
+
+// parseScope splits the scope of a credential, date/region/service/aws4_request.
+func parseScope(scope string) (date, region, service string, err error) {
+	parts := strings.Split(scope, "/")
+	if len(parts) != 4 || parts[3] != "aws4_request" {
+		return "", "", "", newError(http.StatusBadRequest, "AuthorizationHeaderMalformed", "invalid credential scope %q", scope)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// canonicalRequest returns the canonical form of r that sig signs.
+func canonicalRequest(r *http.Request, sig signature) string {
+	var query []string
+	for name, values := range r.URL.Query() {
+		if sig.presigned && name == "X-Amz-Signature" {
+			continue
+		}
+		for _, value := range values {
+			query = append(query, escape(name, true)+"="+escape(value, true))
+		}
+	}
+	slices.Sort(query)
+
+	var headers strings.Builder
+	for _, name := range sig.signedHeaders {
+		var values []string
+		switch name {
+		case "host":
+			values = []string{r.Host}
+		case "content-length":
+			values = []string{strconv.FormatInt(r.ContentLength, 10)}
+		default:
+			values = slices.Clone(r.Header.Values(name))
+		}
+		for i, value := range values {
+			values[i] = strings.Join(strings.Fields(value), " ")
+		}
+		fmt.Fprintf(&headers, "%s:%s\n", name, strings.Join(values, ","))
+	}
+
+	return strings.Join([]string{
+		r.Method,
+		escape(r.URL.Path, false),
+		strings.Join(query, "&"),
+		headers.String(),
+		strings.Join(sig.signedHeaders, ";"),
+		sig.payloadHash,
+	}, "\n")
+}
+
+// escape percent-encodes s the way signature v4 does: every byte but the unreserved characters,
+// and slashes unless encodeSlash.
+func escape(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' || c == '/' && !encodeSlash {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func signingKey(secret, date, region, service string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secret), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	return hmacSHA256(key, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hashReader fails at the end of r if the data it read does not hash to want.
+type hashReader struct {
+	r    io.Reader
+	hash hash.Hash
+	want []byte
+}
+
+func (h *hashReader) Read(p []byte) (int, error) {
+	n, err := h.r.Read(p)
+	h.hash.Write(p[:n])
+	if errors.Is(err, io.EOF) && !bytes.Equal(h.hash.Sum(nil), h.want) {
+		return n, newError(http.StatusBadRequest, "XAmzContentSHA256Mismatch", "the body does not hash to x-amz-content-sha256")
+	}
+	return n, err
+}
+
+// decodeAWSChunked replaces the aws-chunked body of r with the data it carries. The chunks of
+// a streamingPayload are each signed with key, chaining from the signature of the request.
+func (g *Gateway) decodeAWSChunked(r *http.Request, sig signature, key []byte) error {
+	if sig.payloadHash == streamingPayload && sig.presigned {
+		return newError(http.StatusBadRequest, "InvalidArgument", "presigned requests cannot stream signed chunks")
+	}
+	decoded, err := strconv.ParseInt(r.Header.Get("X-Amz-Decoded-Content-Length"), 10, 64)
+	if err != nil || decoded < 0 {
+		return newError(http.StatusBadRequest, "MissingContentLength", "aws-chunked bodies need x-amz-decoded-content-length")
+	}
+
+	chunked := &chunkedReader{r: bufio.NewReader(r.Body)}
+	if sig.payloadHash == streamingPayload {
+		previous := sig.signature
+		chunked.verify = func(chunkSignature string, data []byte) bool {
+			stringToSign := strings.Join([]string{signingAlgorithm + "-PAYLOAD", sig.amzDate, sig.scope, previous, emptySHA256, hexSHA256(data)}, "\n")
+			previous = hex.EncodeToString(hmacSHA256(key, stringToSign))
+			return hmac.Equal([]byte(previous), []byte(chunkSignature))
+		}
+	}
+	r.Body = io.NopCloser(&decodedBody{Reader: chunked, n: decoded})
+	r.ContentLength = decoded
+	return nil
+}
+
+// chunkedReader reads the data of an aws-chunked body. Each chunk is a line with its size in hex,
+// followed by chunk-signature=signature for signed bodies, then the data and CRLF. A chunk of
+// size 0 ends the data, and is followed by the trailers and an empty line.
+type chunkedReader struct {
+	r      *bufio.Reader
+	verify func(chunkSignature string, data []byte) bool
+	chunk  []byte
+	done   bool
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for len(c.chunk) == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		if err := c.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.chunk)
+	c.chunk = c.chunk[n:]
+	return n, nil
+}
+
+func (c *chunkedReader) next() error {
+	header, err := c.line()
+	if err != nil {
+		return err
+	}
+	sizeHex, extension, _ := strings.Cut(header, ";")
+	size, err := strconv.ParseUint(sizeHex, 16, 64)
+	if err != nil || size > maxStreamingChunk {
+		return newError(http.StatusBadRequest, "IncompleteBody", "invalid chunk size %q", sizeHex)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(c.r, data); err != nil {
+		return newError(http.StatusBadRequest, "IncompleteBody", "the body ends within a chunk")
+	}
+	if c.verify != nil {
+		chunkSignature, ok := strings.CutPrefix(extension, "chunk-signature=")
+		if !ok || !c.verify(chunkSignature, data) {
+			return newError(http.StatusForbidden, "SignatureDoesNotMatch", "the signature of a chunk does not match")
+		}
+	}
+
+	if size > 0 {
+		if line, err := c.line(); err != nil || line != "" {
+			return newError(http.StatusBadRequest, "IncompleteBody", "a chunk is not followed by CRLF")
+		}
+		c.chunk = data
+		return nil
+	}
+
+	// the trailers, whose checksums the payload hash does not cover, are skipped
+	for {
+		line, err := c.line()
+		if err != nil {
+			return err
+		}
+		if line == "" {
+			c.done = true
+			return nil
+		}
+	}
+}
+
+func (c *chunkedReader) line() (string, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return "", newError(http.StatusBadRequest, "IncompleteBody", "the body ends within a chunk header")
+	}
+	return strings.TrimSuffix(line, "\r\n"), nil
+}
+
+// decodedBody fails at the end of the data of an aws-chunked body if it is not of the decoded
+// length the request announced.
+type decodedBody struct {
+	io.Reader
+	n    int64
+	read int64
+}
+
+func (d *decodedBody) Read(p []byte) (int, error) {
+	n, err := d.Reader.Read(p)
+	d.read += int64(n)
+	if errors.Is(err, io.EOF) && d.read != d.n {
+		return n, newError(http.StatusBadRequest, "IncompleteBody", "the body carries %d bytes, not x-amz-decoded-content-length %d", d.read, d.n)
+	}
+	return n, err
+}
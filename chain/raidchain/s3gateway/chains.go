@@ -0,0 +1,154 @@
+package s3gateway
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metastoretypes "metachain/x/metastore/types"
+	"raidchain/placement"
+)
+
+// Chains are the metachain and the datachains the gateway keeps objects on.
+type Chains interface {
+	// Owner returns the address objects are uploaded and deleted as.
+	Owner() string
+	// Metastore returns the client of the metastore queries of the metachain.
+	Metastore() metastoretypes.QueryClient
+	// Loads returns the load of the datachains new objects may be placed on.
+	Loads(ctx context.Context) ([]metastoretypes.DatachainLoad, error)
+	// FetchChunk returns the data of the chunk index the datachain behind connectionID stores.
+	FetchChunk(ctx context.Context, connectionID, index string) ([]byte, error)
+	// Send delivers msgs in one transaction signed by Owner, and returns once it is in a block.
+	Send(ctx context.Context, msgs ...sdk.Msg) error
+}
+
+// NodeChains reaches the metachain through the node of a client context, and the datachains
+// through the CometBFT RPC endpoint of one of their nodes.
+type NodeChains struct {
+	clientCtx    client.Context
+	datachains   []placement.Datachain
+	pollInterval time.Duration
+
+	// mu serializes the transactions of the owner, whose sequence txf tracks
+	mu  sync.Mutex
+	txf tx.Factory
+}
+
+var _ Chains = (*NodeChains)(nil)
+
+// NewNodeChains returns the chains behind clientCtx, whose from account owns the objects, and
+// datachains. Transactions are signed with txf and polled for every pollInterval.
+func NewNodeChains(clientCtx client.Context, txf tx.Factory, datachains []placement.Datachain, pollInterval time.Duration) *NodeChains {
+	datachains = slices.Clone(datachains)
+	slices.SortFunc(datachains, func(a, b placement.Datachain) int {
+		return strings.Compare(a.ConnectionID, b.ConnectionID)
+	})
+	return &NodeChains{clientCtx: clientCtx, txf: txf, datachains: datachains, pollInterval: pollInterval}
+}
+
+// Owner implements Chains.
+func (c *NodeChains) Owner() string {
+	return c.clientCtx.GetFromAddress().String()
+}
+
+// Metastore implements Chains.
+func (c *NodeChains) Metastore() metastoretypes.QueryClient {
+	return metastoretypes.NewQueryClient(c.clientCtx)
+}
+
+// Loads implements Chains.
+func (c *NodeChains) Loads(ctx context.Context) ([]metastoretypes.DatachainLoad, error) {
+	return placement.QueryLoads(ctx, c.datachains)
+}
+
+// FetchChunk implements Chains.
+func (c *NodeChains) FetchChunk(ctx context.Context, connectionID, index string) ([]byte, error) {
+	for _, datachain := range c.datachains {
+		if datachain.ConnectionID == connectionID {
+			return placement.FetchChunk(ctx, datachain, index)
+		}
+	}
+	return nil, fmt.Errorf("no RPC endpoint for the datachain behind %s", connectionID)
+}
+
+// Send implements Chains.
+func (c *NodeChains) Send(ctx context.Context, msgs ...sdk.Msg) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	hash, err := c.broadcast(msgs)
+	if err != nil {
+		// the sequence is queried again for the next transaction
+		c.txf = c.txf.WithSequence(0)
+		return err
+	}
+	c.txf = c.txf.WithSequence(c.txf.Sequence() + 1)
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("transaction %X not in a block yet: %w", hash, ctx.Err())
+		case <-ticker.C:
+		}
+
+		res, err := c.clientCtx.Client.Tx(ctx, hash, false)
+		if err != nil {
+			// not in a block yet
+			continue
+		}
+		if res.TxResult.Code != 0 {
+			return fmt.Errorf("transaction %X failed with code %d: %s", hash, res.TxResult.Code, res.TxResult.Log)
+		}
+		return nil
+	}
+}
+
+// broadcast signs msgs and hands them to the node, returning the hash of the transaction once
+// its checks passed.
+func (c *NodeChains) broadcast(msgs []sdk.Msg) ([]byte, error) {
+	txf, err := c.txf.Prepare(c.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	c.txf = txf
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(c.clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(context.Background(), txf, c.clientCtx.FromName, builder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := c.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("transaction rejected with code %d: %s", res.Code, res.RawLog)
+	}
+	return hex.DecodeString(res.TxHash)
+}
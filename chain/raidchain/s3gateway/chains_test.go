@@ -0,0 +1,215 @@
+package s3gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+	"raidchain/app"
+)
+
+// testChains runs a metachain and its datachains in process, relaying every packet a
+// transaction sends before Send returns.
+type testChains struct {
+	t          *testing.T
+	metaChain  *ibctesting.TestChain
+	dataChains []*ibctesting.TestChain
+	// paths[i] connects metaChain (endpoint A) to dataChains[i] (endpoint B) over the interchain
+	// account of the owner
+	paths []*ibctesting.Path
+}
+
+var _ Chains = (*testChains)(nil)
+
+// newTestChains boots a metachain and n datachains and opens an interchain account of the
+// sender of the metachain on each datachain.
+func newTestChains(t *testing.T, n int) *testChains {
+	t.Helper()
+
+	coord := ibctesting.NewCustomAppCoordinator(t, 0, nil)
+	c := &testChains{t: t}
+	c.metaChain = ibctesting.NewCustomAppTestChain(t, coord, "meta-0", func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		chainApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+		genesis := chainApp.DefaultGenesis()
+		require.NoError(t, app.SetGenesisRole(chainApp.AppCodec(), genesis, app.RoleMetachain))
+		return chainApp, genesis
+	})
+	coord.Chains[c.metaChain.ChainID] = c.metaChain
+
+	owner := c.Owner()
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+
+	for i := range n {
+		dataChain := ibctesting.NewCustomAppTestChain(t, coord, fmt.Sprintf("data-%d", i), func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+			chainApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+			genesis := chainApp.DefaultGenesis()
+			require.NoError(t, app.SetGenesisRole(chainApp.AppCodec(), genesis, app.RoleDatachain))
+			return chainApp, genesis
+		})
+		coord.Chains[dataChain.ChainID] = dataChain
+
+		path := ibctesting.NewPath(c.metaChain, dataChain)
+		path.SetupConnections()
+
+		res, err := c.metaChain.SendMsgs(&metastoretypes.MsgRegisterDatachainAccount{Creator: owner, ConnectionId: path.EndpointA.ConnectionID})
+		require.NoError(t, err)
+		channelID, err := ibctesting.ParseChannelIDFromEvents(res.Events)
+		require.NoError(t, err)
+
+		channel, found := c.metaChain.App.GetIBCKeeper().ChannelKeeper.GetChannel(c.metaChain.GetContext(), portID, channelID)
+		require.True(t, found)
+		path.EndpointA.ChannelID = channelID
+		path.EndpointA.ChannelConfig.PortID = portID
+		path.EndpointA.ChannelConfig.Version = channel.Version
+		path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+		path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+		path.EndpointB.ChannelConfig.Version = channel.Version
+		path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+		require.NoError(t, path.EndpointB.ChanOpenTry())
+		require.NoError(t, path.EndpointA.ChanOpenAck())
+		require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+		c.dataChains = append(c.dataChains, dataChain)
+		c.paths = append(c.paths, path)
+	}
+	return c
+}
+
+func (c *testChains) Owner() string {
+	return c.metaChain.SenderAccount.GetAddress().String()
+}
+
+func (c *testChains) Metastore() metastoretypes.QueryClient {
+	return metastoretypes.NewQueryClient(abciConn{c.metaChain})
+}
+
+func (c *testChains) Loads(ctx context.Context) ([]metastoretypes.DatachainLoad, error) {
+	loads := make([]metastoretypes.DatachainLoad, 0, len(c.dataChains))
+	for i, dataChain := range c.dataChains {
+		res, err := datastoretypes.NewQueryClient(abciConn{dataChain}).StorageUsage(ctx, &datastoretypes.QueryStorageUsageRequest{})
+		if err != nil {
+			return nil, err
+		}
+		loads = append(loads, metastoretypes.DatachainLoad{
+			ConnectionId: c.paths[i].EndpointA.ConnectionID,
+			ChainId:      dataChain.ChainID,
+			Chunks:       res.Usage.Chunks,
+			Bytes:        res.Usage.Bytes,
+			// the chains make a block whenever a transaction is sent
+			LatestBlockTime: time.Now(),
+		})
+	}
+	return loads, nil
+}
+
+func (c *testChains) FetchChunk(ctx context.Context, connectionID, index string) ([]byte, error) {
+	for i, path := range c.paths {
+		if path.EndpointA.ConnectionID != connectionID {
+			continue
+		}
+		res, err := datastoretypes.NewQueryClient(abciConn{c.dataChains[i]}).GetStoredChunk(ctx, &datastoretypes.QueryGetStoredChunkRequest{Index: index})
+		if err != nil {
+			return nil, err
+		}
+		return res.StoredChunk.Data, nil
+	}
+	return nil, fmt.Errorf("unknown connection %s", connectionID)
+}
+
+func (c *testChains) Send(_ context.Context, msgs ...sdk.Msg) error {
+	res, err := c.metaChain.SendMsgs(msgs...)
+	if err != nil {
+		return err
+	}
+	return c.relay(res.Events)
+}
+
+// relay relays the packets sent with events to the datachains, and those the metachain sends
+// handling their acknowledgements, as the deletes of the chunks an upload replaced.
+func (c *testChains) relay(events []abci.Event) error {
+	packets, err := ibctesting.ParsePacketsFromEvents(channeltypes.EventTypeSendPacket, events)
+	if err != nil {
+		// nothing to relay
+		return nil
+	}
+	for _, packet := range packets {
+		for _, path := range c.paths {
+			if path.EndpointA.ChannelID != packet.SourceChannel {
+				continue
+			}
+			if err := path.EndpointB.UpdateClient(); err != nil {
+				return err
+			}
+			res, err := path.EndpointB.RecvPacketWithResult(packet)
+			if err != nil {
+				return err
+			}
+			ack, err := ibctesting.ParseAckFromEvents(res.Events)
+			if err != nil {
+				return err
+			}
+			if res, err = path.EndpointA.AcknowledgePacketWithResult(packet, ack); err != nil {
+				return err
+			}
+			if err := c.relay(res.Events); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// chunks returns the number of chunks the datachains store.
+func (c *testChains) chunks() uint64 {
+	var chunks uint64
+	for _, dataChain := range c.dataChains {
+		res, err := datastoretypes.NewQueryClient(abciConn{dataChain}).StorageUsage(context.Background(), &datastoretypes.QueryStorageUsageRequest{})
+		require.NoError(c.t, err)
+		chunks += res.Usage.Chunks
+	}
+	return chunks
+}
+
+// abciConn answers gRPC queries through the ABCI Query of an in-process chain.
+type abciConn struct {
+	chain *ibctesting.TestChain
+}
+
+func (c abciConn) Invoke(ctx context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	req, err := proto.Marshal(args.(proto.Message))
+	if err != nil {
+		return err
+	}
+	res, err := c.chain.App.Query(ctx, &abci.RequestQuery{Path: method, Data: req})
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return errorsmod.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+	return proto.Unmarshal(res.Value, reply.(proto.Message))
+}
+
+func (abciConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("streaming queries are not supported")
+}
@@ -0,0 +1,271 @@
+package s3gateway
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+
+	"raidchain/placement"
+)
+
+// xmlns is the namespace of the S3 API responses.
+const xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// bucketName is what S3 accepts as the name of a bucket.
+var bucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// Config is the configuration of a gateway.
+type Config struct {
+	// Region is the region signatures are scoped to.
+	Region string
+	// Credentials are the secret access keys of the clients, by access key id.
+	Credentials map[string]string
+	// Buckets are the metastore url prefixes the buckets map to, by bucket name. The object key
+	// of a bucket is appended to its prefix to form the url of its manifest.
+	Buckets map[string]string
+
+	// PlacementPolicy and StripeWidth place the chunks of new objects on the datachains, among
+	// those with a block in the last MaxBlockAge.
+	PlacementPolicy string
+	StripeWidth     uint32
+	MaxBlockAge     time.Duration
+	// ChunkSize is the size of the chunks new objects are split into.
+	ChunkSize uint64
+	// MaxObjectSize bounds the size of objects, which are uploaded in one transaction.
+	MaxObjectSize int64
+	// PacketTimeout is how long the datachains have to store the chunks of a new object.
+	PacketTimeout time.Duration
+	// UploadTimeout is how long an upload waits for the manifest of its object, polled for every
+	// PollInterval.
+	UploadTimeout time.Duration
+	PollInterval  time.Duration
+	// MultipartDir keeps the parts of multipart uploads until they are completed.
+	MultipartDir string
+}
+
+// Gateway serves the objects of the buckets of its config over the S3 API, keeping them as
+// manifests on the metachain of its chains with their chunks on the datachains:
+//
+//	GET    /                                              ListBuckets
+//	HEAD   /{bucket}                                      HeadBucket
+//	GET    /{bucket}?list-type=2                          ListObjectsV2
+//	PUT    /{bucket}/{key}                                PutObject
+//	GET    /{bucket}/{key}                                GetObject, with a Range
+//	HEAD   /{bucket}/{key}                                HeadObject
+//	DELETE /{bucket}/{key}                                DeleteObject
+//	POST   /{bucket}/{key}?uploads                        CreateMultipartUpload
+//	PUT    /{bucket}/{key}?partNumber={n}&uploadId={id}   UploadPart
+//	POST   /{bucket}/{key}?uploadId={id}                  CompleteMultipartUpload
+//	DELETE /{bucket}/{key}?uploadId={id}                  AbortMultipartUpload
+//
+// Buckets are addressed in the path. Every request is authenticated with signature v4.
+type Gateway struct {
+	chains Chains
+	config Config
+	policy placement.PlacementPolicy
+	logger log.Logger
+	now    func() time.Time
+}
+
+var _ http.Handler = (*Gateway)(nil)
+
+// NewGateway returns the gateway of config to chains.
+func NewGateway(chains Chains, config Config, logger log.Logger) (*Gateway, error) {
+	if config.Region == "" {
+		return nil, errors.New("a region is required")
+	}
+	if len(config.Credentials) == 0 {
+		return nil, errors.New("at least one access key is required")
+	}
+	if len(config.Buckets) == 0 {
+		return nil, errors.New("at least one bucket is required")
+	}
+	for name, prefix := range config.Buckets {
+		if !bucketName.MatchString(name) {
+			return nil, fmt.Errorf("invalid bucket name %q", name)
+		}
+		if prefix == "" {
+			return nil, fmt.Errorf("bucket %s needs a url prefix", name)
+		}
+	}
+	if config.ChunkSize == 0 {
+		return nil, errors.New("the chunk size must be positive")
+	}
+	if config.MaxObjectSize <= 0 {
+		return nil, errors.New("the maximum object size must be positive")
+	}
+	policy, err := placement.Policy(config.PlacementPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(config.MultipartDir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &Gateway{chains: chains, config: config, policy: policy, logger: logger, now: time.Now}, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	requestID := make([]byte, 8)
+	_, _ = rand.Read(requestID)
+	w.Header().Set("x-amz-request-id", strings.ToUpper(hex.EncodeToString(requestID)))
+
+	if err := g.serve(w, r); err != nil {
+		g.writeError(w, r, err)
+	}
+}
+
+func (g *Gateway) serve(w http.ResponseWriter, r *http.Request) error {
+	if err := g.authenticate(r); err != nil {
+		return err
+	}
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+	if bucket == "" {
+		if r.Method != http.MethodGet {
+			return errMethodNotAllowed
+		}
+		return g.listBuckets(w)
+	}
+
+	prefix, ok := g.config.Buckets[bucket]
+	if !ok {
+		return newError(http.StatusNotFound, "NoSuchBucket", "the bucket %s does not exist", bucket)
+	}
+	if key == "" {
+		switch {
+		case r.Method == http.MethodHead:
+			return nil
+		case r.Method == http.MethodGet && query.Get("list-type") == "2":
+			return g.listObjectsV2(w, r, bucket, prefix)
+		case r.Method == http.MethodGet:
+			return newError(http.StatusNotImplemented, "NotImplemented", "only ListObjectsV2 lists a bucket")
+		}
+		return errMethodNotAllowed
+	}
+
+	url := prefix + key
+	switch r.Method {
+	case http.MethodPut:
+		switch {
+		case r.Header.Get("X-Amz-Copy-Source") != "":
+			return newError(http.StatusNotImplemented, "NotImplemented", "objects cannot be copied")
+		case query.Has("uploadId"):
+			return g.uploadPart(w, r, url)
+		}
+		return g.putObject(w, r, url)
+	case http.MethodGet:
+		if query.Has("uploadId") {
+			return newError(http.StatusNotImplemented, "NotImplemented", "the parts of uploads cannot be listed")
+		}
+		return g.getObject(w, r, url, true)
+	case http.MethodHead:
+		return g.getObject(w, r, url, false)
+	case http.MethodDelete:
+		if query.Has("uploadId") {
+			return g.abortMultipartUpload(w, r, url)
+		}
+		return g.deleteObject(w, r, url)
+	case http.MethodPost:
+		switch {
+		case query.Has("uploads"):
+			return g.createMultipartUpload(w, bucket, key, url)
+		case query.Has("uploadId"):
+			return g.completeMultipartUpload(w, r, bucket, key, url)
+		}
+	}
+	return errMethodNotAllowed
+}
+
+// Error is an error of the S3 API.
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+}
+
+func newError(status int, code, format string, args ...any) *Error {
+	return &Error{Status: status, Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+var (
+	errMethodNotAllowed = newError(http.StatusMethodNotAllowed, "MethodNotAllowed", "the method is not allowed against this resource")
+	errNoSuchKey        = newError(http.StatusNotFound, "NoSuchKey", "the object does not exist")
+)
+
+// writeError writes err as an S3 error response. Errors of the chains are internal errors.
+func (g *Gateway) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		g.logger.Error("request failed", "method", r.Method, "path", r.URL.Path, "err", err)
+		apiErr = newError(http.StatusInternalServerError, "InternalError", "%s", err)
+	}
+
+	if r.Method == http.MethodHead {
+		// responses to HEAD have no body
+		w.WriteHeader(apiErr.Status)
+		return
+	}
+	writeXML(w, apiErr.Status, struct {
+		XMLName   xml.Name `xml:"Error"`
+		Code      string
+		Message   string
+		Resource  string
+		RequestID string `xml:"RequestId"`
+	}{
+		Code:      apiErr.Code,
+		Message:   apiErr.Message,
+		Resource:  r.URL.Path,
+		RequestID: w.Header().Get("x-amz-request-id"),
+	})
+}
+
+func writeXML(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Owner   owner
+	Buckets []bucketEntry `xml:"Buckets>Bucket"`
+}
+
+type owner struct {
+	ID string
+}
+
+type bucketEntry struct {
+	Name string
+}
+
+func (g *Gateway) listBuckets(w http.ResponseWriter) error {
+	res := listAllMyBucketsResult{Xmlns: xmlns, Owner: owner{ID: g.chains.Owner()}}
+	for name := range g.config.Buckets {
+		res.Buckets = append(res.Buckets, bucketEntry{Name: name})
+	}
+	slices.SortFunc(res.Buckets, func(a, b bucketEntry) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	writeXML(w, http.StatusOK, res)
+	return nil
+}
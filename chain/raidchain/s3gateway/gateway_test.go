@@ -0,0 +1,332 @@
+package s3gateway
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	datastoretypes "datachain/x/datastore/types"
+	metastoretypes "metachain/x/metastore/types"
+	"raidchain/placement"
+)
+
+const (
+	testAccessKeyID = "raidchain"
+	testSecret      = "raidchain-secret"
+)
+
+// handlerTransport serves the requests of a client with a handler, without a network.
+type handlerTransport struct {
+	handler http.Handler
+}
+
+func (t handlerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	if r.Host == "" {
+		r.Host = r.URL.Host
+	}
+	if r.Body == nil {
+		r.Body = http.NoBody
+	}
+	r.RequestURI = r.URL.RequestURI()
+
+	w := httptest.NewRecorder()
+	t.handler.ServeHTTP(w, r)
+	return w.Result(), nil
+}
+
+func newTestGateway(t *testing.T) (*Gateway, *testChains) {
+	t.Helper()
+
+	chains := newTestChains(t, 2)
+	g, err := NewGateway(chains, Config{
+		Region:          "us-east-1",
+		Credentials:     map[string]string{testAccessKeyID: testSecret},
+		Buckets:         map[string]string{"photos": "s3://photos/", "docs": "s3://docs/"},
+		PlacementPolicy: placement.RoundRobin,
+		MaxBlockAge:     time.Minute,
+		// small chunks spread objects over both datachains
+		ChunkSize:     4,
+		MaxObjectSize: 1 << 20,
+		PacketTimeout: 10 * time.Minute,
+		UploadTimeout: 10 * time.Second,
+		PollInterval:  10 * time.Millisecond,
+		MultipartDir:  t.TempDir(),
+	}, log.NewNopLogger())
+	require.NoError(t, err)
+	return g, chains
+}
+
+func newTestClient(t *testing.T, g *Gateway, secret string) *s3.S3 {
+	t.Helper()
+
+	// a CA bundle of the environment cannot be loaded into handlerTransport
+	t.Setenv("AWS_CA_BUNDLE", "")
+	sess, err := session.NewSession(&aws.Config{
+		Region:           aws.String("us-east-1"),
+		Credentials:      credentials.NewStaticCredentials(testAccessKeyID, secret, ""),
+		Endpoint:         aws.String("http://s3.raidchain.test"),
+		S3ForcePathStyle: aws.Bool(true),
+		HTTPClient:       &http.Client{Transport: handlerTransport{g}},
+		MaxRetries:       aws.Int(0),
+	})
+	require.NoError(t, err)
+	return s3.New(sess)
+}
+
+func requireErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var awsErr awserr.Error
+	require.ErrorAs(t, err, &awsErr)
+	require.Equal(t, code, awsErr.Code())
+}
+
+func getObject(t *testing.T, client *s3.S3, bucket, key string) []byte {
+	t.Helper()
+
+	res, err := client.GetObject(&s3.GetObjectInput{Bucket: aws.String(bucket), Key: aws.String(key)})
+	require.NoError(t, err)
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return data
+}
+
+func TestObjects(t *testing.T) {
+	g, chains := newTestGateway(t)
+	client := newTestClient(t, g, testSecret)
+	data := []byte("objects are split into chunks of four bytes")
+
+	put, err := client.PutObject(&s3.PutObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt"), Body: bytes.NewReader(data)})
+	require.NoError(t, err)
+	require.Equal(t, data, getObject(t, client, "photos", "cat.txt"))
+
+	// the object is the manifest at the url prefix of its bucket, with chunks on both datachains
+	meta, err := chains.Metastore().GetStoredMeta(context.Background(), &metastoretypes.QueryGetStoredMetaRequest{Index: "s3://photos/cat.txt"})
+	require.NoError(t, err)
+	require.Equal(t, chains.Owner(), meta.StoredMeta.Creator)
+	indexes := meta.StoredMeta.FragmentIndexes()
+	require.Len(t, indexes, (len(data)+3)/4)
+	connections := map[string]bool{}
+	for _, index := range indexes {
+		chunk, found := meta.StoredMeta.DatachainFragment(index)
+		require.True(t, found)
+		connections[chunk.ConnectionId] = true
+	}
+	require.Len(t, connections, 2)
+
+	head, err := client.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt")})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), aws.Int64Value(head.ContentLength))
+	require.Equal(t, aws.StringValue(put.ETag), aws.StringValue(head.ETag))
+	require.Equal(t, "text/plain; charset=utf-8", aws.StringValue(head.ContentType))
+
+	ranged, err := client.GetObject(&s3.GetObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt"), Range: aws.String("bytes=3-9")})
+	require.NoError(t, err)
+	rangedData, err := io.ReadAll(ranged.Body)
+	require.NoError(t, err)
+	require.Equal(t, data[3:10], rangedData)
+	require.Equal(t, "bytes 3-9/43", aws.StringValue(ranged.ContentRange))
+
+	suffix, err := client.GetObject(&s3.GetObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt"), Range: aws.String("bytes=-5")})
+	require.NoError(t, err)
+	suffixData, err := io.ReadAll(suffix.Body)
+	require.NoError(t, err)
+	require.Equal(t, data[len(data)-5:], suffixData)
+
+	_, err = client.GetObject(&s3.GetObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt"), Range: aws.String("bytes=43-")})
+	requireErrorCode(t, err, "InvalidRange")
+
+	// buckets do not share objects
+	_, err = client.GetObject(&s3.GetObjectInput{Bucket: aws.String("docs"), Key: aws.String("cat.txt")})
+	requireErrorCode(t, err, s3.ErrCodeNoSuchKey)
+	_, err = client.GetObject(&s3.GetObjectInput{Bucket: aws.String("videos"), Key: aws.String("cat.txt")})
+	requireErrorCode(t, err, s3.ErrCodeNoSuchBucket)
+
+	require.Equal(t, uint64(len(indexes)), chains.chunks())
+
+	// an overwrite replaces the manifest and deletes the chunks of the version it replaced
+	_, err = client.PutObject(&s3.PutObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt"), Body: bytes.NewReader([]byte("meow"))})
+	require.NoError(t, err)
+	require.Equal(t, []byte("meow"), getObject(t, client, "photos", "cat.txt"))
+	require.Equal(t, uint64(1), chains.chunks())
+
+	_, err = client.PutObject(&s3.PutObjectInput{Bucket: aws.String("photos"), Key: aws.String("empty"), Body: bytes.NewReader(nil)})
+	require.NoError(t, err)
+	require.Empty(t, getObject(t, client, "photos", "empty"))
+
+	require.Equal(t, uint64(2), chains.chunks())
+
+	// a delete removes the manifest and its chunks
+	_, err = client.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt")})
+	require.NoError(t, err)
+	_, err = client.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt")})
+	requireErrorCode(t, err, "NotFound")
+	require.Equal(t, uint64(1), chains.chunks())
+	// deleting a missing object succeeds, as in S3
+	_, err = client.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt")})
+	require.NoError(t, err)
+}
+
+func TestFailedOverwrite(t *testing.T) {
+	g, chains := newTestGateway(t)
+	client := newTestClient(t, g, testSecret)
+	data := []byte("the first version")
+
+	_, err := client.PutObject(&s3.PutObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt"), Body: bytes.NewReader(data)})
+	require.NoError(t, err)
+
+	// the datachains already hold a chunk under the index the overwrite will use, so they refuse
+	// its chunk
+	now := time.Now()
+	g.now = func() time.Time { return now }
+	g.config.UploadTimeout = time.Second
+	for _, dataChain := range chains.dataChains {
+		_, err := dataChain.SendMsgs(&datastoretypes.MsgCreateStoredChunk{
+			Creator: dataChain.SenderAccount.GetAddress().String(),
+			Index:   fmt.Sprintf("s3://photos/cat.txt#%d.0", now.UnixNano()),
+			Data:    []byte("taken"),
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = client.PutObject(&s3.PutObjectInput{Bucket: aws.String("photos"), Key: aws.String("cat.txt"), Body: bytes.NewReader([]byte("meow"))})
+	requireErrorCode(t, err, "ServiceUnavailable")
+	// the first version is still served
+	require.Equal(t, data, getObject(t, client, "photos", "cat.txt"))
+}
+
+func TestListObjectsV2(t *testing.T) {
+	g, _ := newTestGateway(t)
+	client := newTestClient(t, g, testSecret)
+
+	keys := []string{"a.txt", "dir/b.txt", "dir/c.txt", "dir/sub/d.txt", "e.txt"}
+	for _, key := range keys {
+		_, err := client.PutObject(&s3.PutObjectInput{Bucket: aws.String("docs"), Key: aws.String(key), Body: bytes.NewReader([]byte(key))})
+		require.NoError(t, err)
+	}
+	_, err := client.PutObject(&s3.PutObjectInput{Bucket: aws.String("photos"), Key: aws.String("dog.jpg"), Body: bytes.NewReader([]byte("woof"))})
+	require.NoError(t, err)
+
+	list := func(input *s3.ListObjectsV2Input) (contents, prefixes []string) {
+		t.Helper()
+		input.Bucket = aws.String("docs")
+		require.NoError(t, client.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, _ bool) bool {
+			if input.MaxKeys != nil {
+				require.LessOrEqual(t, aws.Int64Value(page.KeyCount), aws.Int64Value(input.MaxKeys))
+			}
+			for _, object := range page.Contents {
+				contents = append(contents, aws.StringValue(object.Key))
+			}
+			for _, prefix := range page.CommonPrefixes {
+				prefixes = append(prefixes, aws.StringValue(prefix.Prefix))
+			}
+			return true
+		}))
+		return contents, prefixes
+	}
+
+	contents, prefixes := list(&s3.ListObjectsV2Input{})
+	require.Equal(t, keys, contents)
+	require.Empty(t, prefixes)
+
+	contents, _ = list(&s3.ListObjectsV2Input{MaxKeys: aws.Int64(2)})
+	require.Equal(t, keys, contents)
+
+	contents, prefixes = list(&s3.ListObjectsV2Input{Delimiter: aws.String("/")})
+	require.Equal(t, []string{"a.txt", "e.txt"}, contents)
+	require.Equal(t, []string{"dir/"}, prefixes)
+
+	contents, prefixes = list(&s3.ListObjectsV2Input{Prefix: aws.String("dir/"), Delimiter: aws.String("/"), MaxKeys: aws.Int64(1)})
+	require.Equal(t, []string{"dir/b.txt", "dir/c.txt"}, contents)
+	require.Equal(t, []string{"dir/sub/"}, prefixes)
+
+	contents, _ = list(&s3.ListObjectsV2Input{StartAfter: aws.String("dir/c.txt")})
+	require.Equal(t, []string{"dir/sub/d.txt", "e.txt"}, contents)
+
+	contents, _ = list(&s3.ListObjectsV2Input{Prefix: aws.String("x")})
+	require.Empty(t, contents)
+}
+
+func TestMultipartUpload(t *testing.T) {
+	g, _ := newTestGateway(t)
+	client := newTestClient(t, g, testSecret)
+	bucket, key := aws.String("photos"), aws.String("album.zip")
+
+	upload, err := client.CreateMultipartUpload(&s3.CreateMultipartUploadInput{Bucket: bucket, Key: key})
+	require.NoError(t, err)
+
+	parts := [][]byte{[]byte("first part, "), []byte("second part")}
+	var completed []*s3.CompletedPart
+	for i, part := range parts {
+		res, err := client.UploadPart(&s3.UploadPartInput{
+			Bucket: bucket, Key: key, UploadId: upload.UploadId,
+			PartNumber: aws.Int64(int64(i + 1)),
+			Body:       bytes.NewReader(part),
+		})
+		require.NoError(t, err)
+		completed = append(completed, &s3.CompletedPart{PartNumber: aws.Int64(int64(i + 1)), ETag: res.ETag})
+	}
+
+	// the parts must be listed in order
+	_, err = client.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket: bucket, Key: key, UploadId: upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: []*s3.CompletedPart{completed[1], completed[0]}},
+	})
+	requireErrorCode(t, err, "InvalidPartOrder")
+
+	res, err := client.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket: bucket, Key: key, UploadId: upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("first part, second part"), getObject(t, client, "photos", "album.zip"))
+	head, err := client.HeadObject(&s3.HeadObjectInput{Bucket: bucket, Key: key})
+	require.NoError(t, err)
+	require.Equal(t, aws.StringValue(res.ETag), aws.StringValue(head.ETag))
+
+	// a completed upload is gone
+	_, err = client.UploadPart(&s3.UploadPartInput{Bucket: bucket, Key: key, UploadId: upload.UploadId, PartNumber: aws.Int64(3), Body: bytes.NewReader(nil)})
+	requireErrorCode(t, err, "NoSuchUpload")
+
+	aborted, err := client.CreateMultipartUpload(&s3.CreateMultipartUploadInput{Bucket: bucket, Key: key})
+	require.NoError(t, err)
+	_, err = client.AbortMultipartUpload(&s3.AbortMultipartUploadInput{Bucket: bucket, Key: key, UploadId: aborted.UploadId})
+	require.NoError(t, err)
+	_, err = client.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket: bucket, Key: key, UploadId: aborted.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+	requireErrorCode(t, err, "NoSuchUpload")
+}
+
+func TestUnauthenticated(t *testing.T) {
+	g, _ := newTestGateway(t)
+
+	_, err := newTestClient(t, g, "not the secret").ListBuckets(&s3.ListBucketsInput{})
+	requireErrorCode(t, err, "SignatureDoesNotMatch")
+
+	res, err := newTestClient(t, g, testSecret).ListBuckets(&s3.ListBucketsInput{})
+	require.NoError(t, err)
+	require.Len(t, res.Buckets, 2)
+	require.Equal(t, "docs", aws.StringValue(res.Buckets[0].Name))
+
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/photos/cat.txt", nil))
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,191 @@
+package s3gateway
+
+import (
+	"crypto/md5" //nolint:gosec // part ETags are MD5 digests, as in S3
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxPartNumber = 10000
+	// uploadURLFile holds the url of the object of a multipart upload, in the directory of the
+	// upload
+	uploadURLFile = "url"
+)
+
+// uploadID is what CreateMultipartUpload hands out, so the only name of a directory of
+// MultipartDir a request can reach.
+var uploadID = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Bucket   string
+	Key      string
+	UploadID string `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	Parts []completedPart `xml:"Part"`
+}
+
+type completedPart struct {
+	PartNumber int
+	ETag       string
+}
+
+type completeMultipartUploadResult struct {
+	XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Bucket  string
+	Key     string
+	ETag    string
+}
+
+// createMultipartUpload starts an upload whose parts are kept in a directory of MultipartDir
+// until it is completed.
+func (g *Gateway) createMultipartUpload(w http.ResponseWriter, bucket, key, url string) error {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	res := initiateMultipartUploadResult{Xmlns: xmlns, Bucket: bucket, Key: key, UploadID: hex.EncodeToString(id)}
+
+	dir := filepath.Join(g.config.MultipartDir, res.UploadID)
+	if err := os.Mkdir(dir, 0o700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, uploadURLFile), []byte(url), 0o600); err != nil {
+		return err
+	}
+
+	writeXML(w, http.StatusOK, res)
+	return nil
+}
+
+// uploadDir returns the directory of the upload of r, which must be one of url.
+func (g *Gateway) uploadDir(r *http.Request, url string) (string, error) {
+	id := r.URL.Query().Get("uploadId")
+	if !uploadID.MatchString(id) {
+		return "", errNoSuchUpload
+	}
+	dir := filepath.Join(g.config.MultipartDir, id)
+	uploadURL, err := os.ReadFile(filepath.Join(dir, uploadURLFile))
+	switch {
+	case errors.Is(err, fs.ErrNotExist) || err == nil && string(uploadURL) != url:
+		return "", errNoSuchUpload
+	case err != nil:
+		return "", err
+	}
+	return dir, nil
+}
+
+var errNoSuchUpload = newError(http.StatusNotFound, "NoSuchUpload", "the upload does not exist")
+
+func partFile(dir string, number int) string {
+	return filepath.Join(dir, fmt.Sprintf("part-%05d", number))
+}
+
+func (g *Gateway) uploadPart(w http.ResponseWriter, r *http.Request, url string) error {
+	dir, err := g.uploadDir(r, url)
+	if err != nil {
+		return err
+	}
+	number, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || number < 1 || number > maxPartNumber {
+		return newError(http.StatusBadRequest, "InvalidArgument", "the part number must be between 1 and %d", maxPartNumber)
+	}
+	data, err := g.readBody(r)
+	if err != nil {
+		return err
+	}
+
+	// a part uploaded again replaces the previous one whole
+	tmp, err := os.CreateTemp(dir, "tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), partFile(dir, number)); err != nil {
+		return err
+	}
+
+	sum := md5.Sum(data) //nolint:gosec // part ETags are MD5 digests, as in S3
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// completeMultipartUpload uploads the parts the request lists, in order, as the object of url.
+func (g *Gateway) completeMultipartUpload(w http.ResponseWriter, r *http.Request, bucket, key, url string) error {
+	dir, err := g.uploadDir(r, url)
+	if err != nil {
+		return err
+	}
+	var req completeMultipartUpload
+	if err := xml.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Parts) == 0 {
+		return newError(http.StatusBadRequest, "MalformedXML", "the request does not list the parts of the upload")
+	}
+
+	var data []byte
+	for i, part := range req.Parts {
+		if i > 0 && part.PartNumber <= req.Parts[i-1].PartNumber {
+			return newError(http.StatusBadRequest, "InvalidPartOrder", "the parts must be listed in ascending order")
+		}
+		partData, err := os.ReadFile(partFile(dir, part.PartNumber))
+		if errors.Is(err, fs.ErrNotExist) {
+			return newError(http.StatusBadRequest, "InvalidPart", "part %d was not uploaded", part.PartNumber)
+		}
+		if err != nil {
+			return err
+		}
+		sum := md5.Sum(partData) //nolint:gosec // part ETags are MD5 digests, as in S3
+		if strings.Trim(part.ETag, `"`) != hex.EncodeToString(sum[:]) {
+			return newError(http.StatusBadRequest, "InvalidPart", "part %d does not match its ETag", part.PartNumber)
+		}
+		if int64(len(data)+len(partData)) > g.config.MaxObjectSize {
+			return newError(http.StatusBadRequest, "EntityTooLarge", "objects are at most %d bytes", g.config.MaxObjectSize)
+		}
+		data = append(data, partData...)
+	}
+
+	obj, err := g.put(r.Context(), url, data)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		g.logger.Error("failed to remove the parts of a completed upload", "dir", dir, "err", err)
+	}
+
+	writeXML(w, http.StatusOK, completeMultipartUploadResult{Xmlns: xmlns, Bucket: bucket, Key: key, ETag: obj.etag})
+	return nil
+}
+
+func (g *Gateway) abortMultipartUpload(w http.ResponseWriter, r *http.Request, url string) error {
+	dir, err := g.uploadDir(r, url)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,455 @@
+package s3gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // Content-MD5 is MD5
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	metastoretypes "metachain/x/metastore/types"
+	"raidchain/placement"
+)
+
+// defaultMaxKeys is the number of keys ListObjectsV2 returns at most.
+const defaultMaxKeys = 1000
+
+// object is a manifest served as an object: its chunks in order, with their datachain.
+type object struct {
+	meta   metastoretypes.StoredMeta
+	chunks []metastoretypes.UploadedChunk
+	size   int64
+	etag   string
+}
+
+func newObject(meta metastoretypes.StoredMeta) (object, error) {
+	obj := object{meta: meta}
+	digest := sha256.New()
+	for _, index := range meta.FragmentIndexes() {
+		chunk, found := meta.DatachainFragment(index)
+		if !found {
+			return object{}, fmt.Errorf("the manifest of %s does not record the datachain of chunk %s", meta.Index, index)
+		}
+		obj.chunks = append(obj.chunks, chunk)
+		obj.size += int64(chunk.Size_)
+		digest.Write(chunk.Hash)
+	}
+	// the chunk hashes are all the gateway knows of the data without reading it
+	obj.etag = `"` + hex.EncodeToString(digest.Sum(nil)) + `"`
+	return obj, nil
+}
+
+// stat returns the object of the manifest of url.
+func (g *Gateway) stat(ctx context.Context, url string) (object, error) {
+	res, err := g.chains.Metastore().GetStoredMeta(ctx, &metastoretypes.QueryGetStoredMetaRequest{Index: url})
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) || status.Code(err) == codes.NotFound {
+			return object{}, errNoSuchKey
+		}
+		return object{}, err
+	}
+	return newObject(res.StoredMeta)
+}
+
+// read returns the bytes first to last of obj, fetching the chunks they are in from their
+// datachain and checking them against the manifest.
+func (g *Gateway) read(ctx context.Context, obj object, first, last int64) ([]byte, error) {
+	data := make([]byte, 0, last-first+1)
+	var offset int64
+	for _, chunk := range obj.chunks {
+		start, end := offset, offset+int64(chunk.Size_)
+		offset = end
+		if end <= first || start > last {
+			continue
+		}
+
+		chunkData, err := g.chains.FetchChunk(ctx, chunk.ConnectionId, chunk.Index)
+		if err != nil {
+			return nil, err
+		}
+		if hash := sha256.Sum256(chunkData); !bytes.Equal(hash[:], chunk.Hash) {
+			return nil, fmt.Errorf("chunk %s on %s does not match the hash %s recorded", chunk.Index, chunk.ConnectionId, obj.meta.Index)
+		}
+		data = append(data, chunkData[max(first-start, 0):min(last+1, end)-start]...)
+	}
+	return data, nil
+}
+
+// put uploads data as the object of url, replacing the one there, and returns once the
+// datachains stored its chunks and the metachain its manifest. The metastore replaces the
+// manifest of an object only once the datachains acknowledged all the new chunks, so the object
+// there is kept if the upload fails, and deletes the chunks of the one replaced then.
+func (g *Gateway) put(ctx context.Context, url string, data []byte) (object, error) {
+	owner := g.chains.Owner()
+	existing, err := g.stat(ctx, url)
+	switch {
+	case err == nil && existing.meta.Creator != owner:
+		return object{}, newError(http.StatusForbidden, "AccessDenied", "the object was uploaded by %s", existing.meta.Creator)
+	case err != nil && !errors.Is(err, errNoSuchKey):
+		return object{}, err
+	}
+
+	var parts [][]byte
+	for len(data) > 0 {
+		n := min(uint64(len(data)), g.config.ChunkSize)
+		parts, data = append(parts, data[:n]), data[n:]
+	}
+	if len(parts) == 0 {
+		// an empty object is one empty chunk
+		parts = [][]byte{{}}
+	}
+	sizes := make([]uint64, len(parts))
+	for i, part := range parts {
+		sizes[i] = uint64(len(part))
+	}
+
+	loads, err := g.chains.Loads(ctx)
+	if err != nil {
+		return object{}, err
+	}
+	now := g.now()
+	p, connections, err := placement.Place(g.policy, g.config.StripeWidth, sizes, loads, now, g.config.MaxBlockAge)
+	if err != nil {
+		return object{}, newError(http.StatusServiceUnavailable, "ServiceUnavailable", "%s", err)
+	}
+
+	chunks := make([]metastoretypes.ChunkUpload, len(parts))
+	for i, part := range parts {
+		chunks[i] = metastoretypes.ChunkUpload{
+			ConnectionId: connections[i],
+			// datachains refuse to overwrite a chunk, and the version replaced is served until
+			// this one is stored, so every version of an object has its own
+			Index: fmt.Sprintf("%s#%d.%d", url, now.UnixNano(), i),
+			Data:  part,
+		}
+	}
+	msg := metastoretypes.NewMsgUploadChunks(owner, url, chunks, uint64(now.Add(g.config.PacketTimeout).UnixNano()))
+	msg.Placement = &p
+	if err := g.chains.Send(ctx, msg); err != nil {
+		return object{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, g.config.UploadTimeout)
+	defer cancel()
+	ticker := time.NewTicker(g.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		obj, err := g.stat(ctx, url)
+		switch {
+		case err == nil && len(obj.chunks) > 0 && obj.chunks[0].Index == chunks[0].Index:
+			return obj, nil
+		case err != nil && !errors.Is(err, errNoSuchKey):
+			return object{}, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return object{}, newError(http.StatusServiceUnavailable, "ServiceUnavailable", "the datachains did not store the chunks of %s within %s", url, g.config.UploadTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (g *Gateway) putObject(w http.ResponseWriter, r *http.Request, url string) error {
+	data, err := g.readBody(r)
+	if err != nil {
+		return err
+	}
+	obj, err := g.put(r.Context(), url, data)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", obj.etag)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// readBody reads the body of r, up to the maximum object size, and checks it against its
+// Content-MD5.
+func (g *Gateway) readBody(r *http.Request) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r.Body, g.config.MaxObjectSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > g.config.MaxObjectSize {
+		return nil, newError(http.StatusBadRequest, "EntityTooLarge", "objects are at most %d bytes", g.config.MaxObjectSize)
+	}
+	if contentMD5 := r.Header.Get("Content-MD5"); contentMD5 != "" {
+		sum := md5.Sum(data) //nolint:gosec // Content-MD5 is MD5
+		if contentMD5 != base64.StdEncoding.EncodeToString(sum[:]) {
+			return nil, newError(http.StatusBadRequest, "BadDigest", "the body does not match its Content-MD5")
+		}
+	}
+	return data, nil
+}
+
+func (g *Gateway) getObject(w http.ResponseWriter, r *http.Request, url string, withBody bool) error {
+	obj, err := g.stat(r.Context(), url)
+	if err != nil {
+		return err
+	}
+
+	var (
+		data          []byte
+		first, last   int64
+		ranged        bool
+		code          = http.StatusOK
+		contentLength = obj.size
+	)
+	if withBody {
+		if obj.meta.Encryption != nil {
+			return newError(http.StatusNotImplemented, "NotImplemented", "the chunks of %s are encrypted", url)
+		}
+		first, last, ranged, err = parseRange(r.Header.Get("Range"), obj.size)
+		if err != nil {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", obj.size))
+			return err
+		}
+		if obj.size > 0 {
+			if data, err = g.read(r.Context(), obj, first, last); err != nil {
+				return err
+			}
+		}
+		contentLength = int64(len(data))
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(url))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(contentLength, 10))
+	w.Header().Set("ETag", obj.etag)
+	w.Header().Set("Accept-Ranges", "bytes")
+	if ranged {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", first, last, obj.size))
+		code = http.StatusPartialContent
+	}
+	w.WriteHeader(code)
+	_, err = w.Write(data)
+	return err
+}
+
+// parseRange returns the first and last bytes of an object of size the Range header header
+// asks for. Like S3, it ignores a header it cannot parse and serves the whole object then.
+func parseRange(header string, size int64) (first, last int64, ranged bool, err error) {
+	spec, ok := strings.CutPrefix(header, "bytes=")
+	start, end, ok2 := strings.Cut(spec, "-")
+	if !ok || !ok2 || strings.Contains(spec, ",") {
+		return 0, size - 1, false, nil
+	}
+
+	switch {
+	case start == "":
+		// the last end bytes
+		n, err := strconv.ParseInt(end, 10, 64)
+		if err != nil || n < 0 {
+			return 0, size - 1, false, nil
+		}
+		if n == 0 || size == 0 {
+			return 0, 0, false, newError(http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "the range is not satisfiable")
+		}
+		return max(size-n, 0), size - 1, true, nil
+	default:
+		first, err := strconv.ParseInt(start, 10, 64)
+		if err != nil || first < 0 {
+			return 0, size - 1, false, nil
+		}
+		last := size - 1
+		if end != "" {
+			if last, err = strconv.ParseInt(end, 10, 64); err != nil || last < first {
+				return 0, size - 1, false, nil
+			}
+		}
+		if first >= size {
+			return 0, 0, false, newError(http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "the range is not satisfiable")
+		}
+		return first, min(last, size-1), true, nil
+	}
+}
+
+func (g *Gateway) deleteObject(w http.ResponseWriter, r *http.Request, url string) error {
+	obj, err := g.stat(r.Context(), url)
+	switch {
+	case errors.Is(err, errNoSuchKey):
+		// deleting what does not exist succeeds
+	case err != nil:
+		return err
+	case obj.meta.Creator != g.chains.Owner():
+		return newError(http.StatusForbidden, "AccessDenied", "the object was uploaded by %s", obj.meta.Creator)
+	default:
+		// the metastore deletes the chunks of the object from the datachains along with it
+		if err := g.chains.Send(r.Context(), &metastoretypes.MsgDeleteStoredMeta{Creator: obj.meta.Creator, Index: url}); err != nil {
+			return err
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Xmlns                 string   `xml:"xmlns,attr"`
+	Name                  string
+	Prefix                string
+	Delimiter             string `xml:",omitempty"`
+	StartAfter            string `xml:",omitempty"`
+	ContinuationToken     string `xml:",omitempty"`
+	NextContinuationToken string `xml:",omitempty"`
+	EncodingType          string `xml:",omitempty"`
+	MaxKeys               int
+	KeyCount              int
+	IsTruncated           bool
+	Contents              []objectEntry
+	CommonPrefixes        []commonPrefix
+}
+
+type objectEntry struct {
+	Key          string
+	ETag         string
+	Size         int64
+	StorageClass string
+}
+
+type commonPrefix struct {
+	Prefix string
+}
+
+// listObjectsV2 lists the manifests under the prefix of bucket, in the order of their url.
+// Continuation tokens are the key the next page starts at.
+func (g *Gateway) listObjectsV2(w http.ResponseWriter, r *http.Request, bucket, bucketPrefix string) error {
+	q := r.URL.Query()
+	res := listBucketResult{
+		Xmlns:             xmlns,
+		Name:              bucket,
+		Prefix:            q.Get("prefix"),
+		Delimiter:         q.Get("delimiter"),
+		StartAfter:        q.Get("start-after"),
+		ContinuationToken: q.Get("continuation-token"),
+		EncodingType:      q.Get("encoding-type"),
+		MaxKeys:           defaultMaxKeys,
+	}
+	if res.EncodingType != "" && res.EncodingType != "url" {
+		return newError(http.StatusBadRequest, "InvalidArgument", "invalid encoding-type %q", res.EncodingType)
+	}
+	if maxKeys := q.Get("max-keys"); maxKeys != "" {
+		n, err := strconv.Atoi(maxKeys)
+		if err != nil || n < 0 {
+			return newError(http.StatusBadRequest, "InvalidArgument", "invalid max-keys %q", maxKeys)
+		}
+		res.MaxKeys = min(n, defaultMaxKeys)
+	}
+
+	// cursor is the first key left to list
+	var cursor string
+	switch {
+	case res.ContinuationToken != "":
+		token, err := base64.RawURLEncoding.DecodeString(res.ContinuationToken)
+		if err != nil {
+			return newError(http.StatusBadRequest, "InvalidArgument", "invalid continuation-token")
+		}
+		cursor = string(token)
+	case res.StartAfter != "":
+		cursor = res.StartAfter + "\x00"
+	}
+
+	listPrefix := bucketPrefix + res.Prefix
+	for exhausted := false; !exhausted && !res.IsTruncated; {
+		// the metastore pages by the part of the url after the listed prefix
+		var key []byte
+		if cursor > res.Prefix {
+			if !strings.HasPrefix(cursor, res.Prefix) {
+				// past every key under the prefix
+				break
+			}
+			key = []byte(cursor[len(res.Prefix):])
+		}
+
+		// one entry past the page tells whether it is the last
+		page, err := g.chains.Metastore().ListStoredMetaByPrefix(r.Context(), &metastoretypes.QueryStoredMetaByPrefixRequest{
+			Prefix:     listPrefix,
+			Pagination: &query.PageRequest{Key: key, Limit: uint64(res.MaxKeys - res.KeyCount + 1)},
+		})
+		if err != nil {
+			return err
+		}
+		exhausted = page.Pagination == nil || len(page.Pagination.NextKey) == 0
+
+		for _, meta := range page.StoredMeta {
+			objectKey := strings.TrimPrefix(meta.Index, bucketPrefix)
+			if res.KeyCount == res.MaxKeys {
+				res.IsTruncated = true
+				res.NextContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(objectKey))
+				break
+			}
+
+			if i := strings.Index(objectKey[len(res.Prefix):], res.Delimiter); res.Delimiter != "" && i >= 0 {
+				prefix := objectKey[:len(res.Prefix)+i+len(res.Delimiter)]
+				res.CommonPrefixes = append(res.CommonPrefixes, commonPrefix{Prefix: encodeKey(prefix, res.EncodingType)})
+				res.KeyCount++
+				// the next page starts past the keys under the common prefix
+				cursor = prefixEnd(prefix)
+				exhausted = cursor == ""
+				break
+			}
+
+			obj, err := newObject(meta)
+			if err != nil {
+				return err
+			}
+			res.Contents = append(res.Contents, objectEntry{
+				Key:          encodeKey(objectKey, res.EncodingType),
+				ETag:         obj.etag,
+				Size:         obj.size,
+				StorageClass: "STANDARD",
+			})
+			res.KeyCount++
+			cursor = objectKey + "\x00"
+		}
+	}
+	res.Prefix = encodeKey(res.Prefix, res.EncodingType)
+	res.Delimiter = encodeKey(res.Delimiter, res.EncodingType)
+	res.StartAfter = encodeKey(res.StartAfter, res.EncodingType)
+
+	writeXML(w, http.StatusOK, res)
+	return nil
+}
+
+// encodeKey encodes key as encoding-type asks.
+func encodeKey(key, encodingType string) string {
+	if encodingType == "url" {
+		return url.PathEscape(key)
+	}
+	return key
+}
+
+// prefixEnd returns the first string after every string starting with prefix, or "" if there
+// is none.
+func prefixEnd(prefix string) string {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1])
+		}
+	}
+	return ""
+}
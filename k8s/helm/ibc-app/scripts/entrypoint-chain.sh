@@ -3,7 +3,9 @@ set -e
 
 # --- 環境変数と設定 ---
 CHAIN_ID=${CHAIN_INSTANCE_NAME}
-CHAIN_APP_NAME=${CHAIN_APP_NAME:-datachain}
+CHAIN_APP_NAME=${CHAIN_APP_NAME:-raidchain}
+# datachain / metachain / all。チェーンの全ノードで同じ値が必要で、既定値はない
+CHAIN_ROLE=${CHAIN_ROLE:?CHAIN_ROLE must be set to datachain, metachain or all}
 DENOM="uatom"
 USER_HOME="/home/$CHAIN_APP_NAME"
 CHAIN_HOME="$USER_HOME/.$CHAIN_APP_NAME"
@@ -12,9 +14,11 @@ MNEMONIC_FILE="/etc/mnemonics/${CHAIN_INSTANCE_NAME}.mnemonic"
 
 # --- 初期化処理 ---
 if [ ! -d "$CHAIN_HOME/config" ]; then
-    echo "--- Initializing chain: $CHAIN_ID (type: $CHAIN_APP_NAME) ---"
+    echo "--- Initializing chain: $CHAIN_ID (type: $CHAIN_APP_NAME, role: $CHAIN_ROLE) ---"
 
     $CHAIN_BINARY init "$CHAIN_ID" --chain-id "$CHAIN_ID" --home "$CHAIN_HOME"
+    # 役割は genesis に書き込み、チェーンの全ノードが同じ役割で動く
+    $CHAIN_BINARY genesis set-role "$CHAIN_ROLE" --home "$CHAIN_HOME"
     # sed -i "s/\"stake\"/\"$DENOM\"/g" "$CHAIN_HOME/config/genesis.json"
 
     SHARED_MNEMONIC=$(cat "$MNEMONIC_FILE")